@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/spf13/cobra"
+)
+
+// NewAbortCmd creates the abort command
+func NewAbortCmd(orch *orchestrator.PRReleaseOrchestrator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "abort <pr-number|version>",
+		Short: "Abort a release PR",
+		Long: `Abort a release by its pull request number (42 or #42) or version (v1.2.0).
+
+This command:
+- Closes the open release pull request with a comment
+- Deletes the release branch locally and on the remote
+- Marks the release sessions of the version rolled back
+
+It is safe to run again, and it also cleans up after a failed run that never
+opened a pull request.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return orch.Abort(cmd.Context(), orchestrator.AbortConfig{Target: args[0]})
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/spf13/cobra"
+)
+
+// NewApplyCmd creates the apply command
+func NewApplyCmd(orch *orchestrator.PRReleaseOrchestrator) *cobra.Command {
+	var (
+		planPath       string
+		ciOutput       bool
+		enableRollback bool
+	)
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Run pr-release for an approved plan",
+		Long: `Run pr-release with the options of a plan saved with "plan --output json".
+
+The plan is computed again first. When the base commit, version, files, commands or pull request
+differ from the approved plan, nothing is changed and the plan has to be approved again.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			data, err := os.ReadFile(planPath)
+			if err != nil {
+				return fmt.Errorf("failed to read release plan: %w", err)
+			}
+			plan, err := domain.ParseReleasePlan(data)
+			if err != nil {
+				return err
+			}
+			return orch.Apply(cmd.Context(), plan, orchestrator.PRReleaseConfig{
+				CIOutput:       ciOutput,
+				EnableRollback: enableRollback,
+			})
+		},
+	}
+	cmd.Flags().StringVar(&planPath, "plan", "", "Path of the approved JSON plan")
+	cmd.Flags().BoolVar(&ciOutput, "ci-output", false, "Output in CI-friendly format")
+	cmd.Flags().BoolVar(&enableRollback, "enable-rollback", false, "Enable automatic rollback on failure")
+	if err := cmd.MarkFlagRequired("plan"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
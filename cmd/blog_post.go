@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/spf13/cobra"
+)
+
+// NewBlogPostCmd creates the blog-post command
+func NewBlogPostCmd(orch *orchestrator.BlogPostOrchestrator) *cobra.Command {
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "blog-post <version>",
+		Short: "Open a pull request with a blog post draft of a release on the website repository",
+		Long: `Turn the release notes of a release into a blog post draft and propose it to the website repository.
+
+This command:
+- Reads the release notes from the GitHub release of the version, draft or published
+- Renders a draft with front matter, a summary and one section per release notes section, using
+  blog_template, .releasepr/templates/blog_post.md.tmpl or the built-in template
+- Commits it as <blog_dir>/<repo>-<version>.md to a blog/<repo>-<version> branch of blog_repo
+- Opens or updates the pull request of that branch into blog_base_branch
+
+With --dry-run, prints the draft without touching the website repository.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := orch.Execute(cmd.Context(), orchestrator.BlogPostConfig{Version: args[0], DryRun: dryRun})
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				cmd.Print(result.Content)
+				return nil
+			}
+			cmd.Printf("Proposed %s to %s in %s\n", result.Path, result.Repo, result.PR.URL)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the draft without committing it or opening the pull request")
+	return cmd
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/spf13/cobra"
+)
+
+// NewCatchUpCmd creates the catch-up command
+func NewCatchUpCmd(orch *orchestrator.CatchUpOrchestrator) *cobra.Command {
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "catch-up",
+		Short: "Backfill CHANGELOG.md sections of releases it does not document",
+		Long: `Backfill CHANGELOG.md for a repository that fell behind, e.g. after releases tagged by hand.
+
+This command:
+- Finds every version tag without a section in CHANGELOG.md
+- Renders the section of each missed release with the configured git-cliff setup
+- Dates each section with its tag: the tagger date of annotated tags, the commit date otherwise
+- Inserts the sections among the documented releases in version order
+
+CHANGELOG.md is written but not committed, so the result can be reviewed first.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			backfilled, err := orch.Execute(cmd.Context(), orchestrator.CatchUpConfig{DryRun: dryRun})
+			if err != nil {
+				return err
+			}
+			if len(backfilled) == 0 {
+				cmd.Println("CHANGELOG.md documents every release tag")
+				return nil
+			}
+			verb := "Backfilled"
+			if dryRun {
+				verb = "Would backfill"
+			}
+			for _, tag := range backfilled {
+				cmd.Printf("%s %s (%s)\n", verb, tag.Name, tag.Date.Format(time.DateOnly))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the releases to backfill without writing CHANGELOG.md")
+	return cmd
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// changelogFilePermissions is the mode of a changelog written with --output.
+const changelogFilePermissions = 0o644
+
+// Formats of the changelog preview output.
+const (
+	changelogPreviewMarkdown = "md"
+	changelogPreviewJSON     = "json"
+)
+
+// changelogPreview is the JSON output of changelog preview.
+type changelogPreview struct {
+	Version   string `json:"version"`
+	Changelog string `json:"changelog"`
+}
+
+// NewChangelogCmd creates the changelog command.
+func NewChangelogCmd(
+	fsRepo repository.FileSystemRepository,
+	cliffSvc service.CliffService,
+	prOrch *orchestrator.PRReleaseOrchestrator,
+) *cobra.Command {
+	var (
+		from     string
+		to       string
+		audience string
+		output   string
+	)
+	cmd := &cobra.Command{
+		Use:   "changelog",
+		Short: "Generate the changelog of a commit range",
+		Long: "Renders the changelog of the commits reachable from --to but not from --from with the configured " +
+			"git-cliff setup, e.g. for a hotfix branch or an audit window between two tags. It prints to stdout " +
+			"unless --output is set, and changes nothing else in the repository.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg := config.FromContext(cmd.Context())
+			if audience == "" {
+				audience = cfg.ChangelogFileAudience
+			}
+			uc, err := changelogUseCase(cfg, cliffSvc, audience)
+			if err != nil {
+				return err
+			}
+			changelog, err := uc.ExecuteRange(cmd.Context(), from, to)
+			if err != nil {
+				return fmt.Errorf("failed to generate changelog for %s..%s: %w", from, to, err)
+			}
+			if output == "" {
+				fmt.Fprintln(cmd.OutOrStdout(), changelog)
+				return nil
+			}
+			if err := afero.WriteFile(fsRepo, output, []byte(changelog+"\n"), changelogFilePermissions); err != nil {
+				return fmt.Errorf("failed to write %s: %w", output, err)
+			}
+			cmd.Printf("Wrote the changelog of %s..%s to %s\n", from, to, output)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&from, "from", "", "Revision the range starts after, e.g. v1.2.0")
+	cmd.Flags().StringVar(&to, "to", "HEAD", "Revision the range ends at, e.g. a hotfix branch or tag")
+	cmd.Flags().StringVar(&audience, "audience", "",
+		"Changelog audience, internal or public (default changelog_file_audience)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write the changelog to this file instead of stdout")
+	if err := cmd.MarkFlagRequired("from"); err != nil {
+		panic(err)
+	}
+	cmd.AddCommand(newChangelogPreviewCmd(prOrch))
+	return cmd
+}
+
+// newChangelogPreviewCmd creates the changelog preview command.
+func newChangelogPreviewCmd(prOrch *orchestrator.PRReleaseOrchestrator) *cobra.Command {
+	var (
+		audience string
+		output   string
+	)
+	cmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Print the changelog of the next release without writing files",
+		Long: "Computes the next version and prints the changelog section the next release PR would contain, " +
+			"including pending notes. CHANGELOG.md, RELEASE_NOTES.md and every other file are left untouched, " +
+			"so it is safe to run at any time.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			format := strings.ToLower(strings.TrimSpace(output))
+			if format != changelogPreviewMarkdown && format != changelogPreviewJSON {
+				return fmt.Errorf("invalid output format %q (must be one of: md, json)", output)
+			}
+			preview, err := prOrch.PreviewChangelog(cmd.Context(), audience)
+			if err != nil {
+				return err
+			}
+			if format == changelogPreviewJSON {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(changelogPreview{Version: preview.Version, Changelog: preview.Changelog})
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), preview.Changelog)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&audience, "audience", "",
+		"Changelog audience, internal or public (default release_changelog_audience)")
+	cmd.Flags().StringVar(&output, "output", changelogPreviewMarkdown, "Output format: md or json")
+	return cmd
+}
+
+// changelogUseCase configures changelog generation for audience with the markdown policy and style of
+// cfg.
+func changelogUseCase(
+	cfg *config.Config,
+	cliffSvc service.CliffService,
+	audience string,
+) (*usecase.GenerateChangelogUseCase, error) {
+	filter, err := cfg.AudienceFilter(audience)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --audience: %w", err)
+	}
+	policy, err := domain.ParseMarkdownPolicy(cfg.ChangelogMarkdownAllowlist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid changelog markdown allowlist: %w", err)
+	}
+	style, err := domain.ParseChangelogStyle(cfg.ChangelogStyle)
+	if err != nil {
+		return nil, fmt.Errorf("invalid changelog_style: %w", err)
+	}
+	return &usecase.GenerateChangelogUseCase{CliffSvc: cliffSvc, Policy: &policy, Filter: filter, Style: style}, nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/compozy/releasepr/internal/orchestrator"
 	"github.com/compozy/releasepr/internal/repository"
 	"github.com/compozy/releasepr/internal/service"
+	"github.com/compozy/releasepr/internal/telemetry"
+	"github.com/compozy/releasepr/pkg/version"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -25,15 +27,24 @@ type container struct {
 	ghRepo   repository.GithubRepository
 	cliffSvc service.CliffService
 	npmSvc   service.NpmService
+
+	stateRepo repository.StateRepository
 }
 
-// newContainer creates a new container with all the dependencies.
-func newContainer() (*container, error) {
-	cfg, err := config.LoadConfig()
-	if err != nil {
-		return nil, err
+// configureNetwork applies the proxy, TLS and GitHub cache settings of cfg to every outbound client.
+func configureNetwork(cfg *config.Config) error {
+	if err := repository.ConfigureTransport(repository.TransportOptions{
+		ProxyURL:           cfg.ProxyURL,
+		CABundle:           cfg.CABundle,
+		InsecureSkipVerify: cfg.TLSInsecureSkipVerify,
+	}); err != nil {
+		return fmt.Errorf("failed to configure network transport: %w", err)
 	}
+	return repository.ConfigureGitHubCache(cfg.GitHubCacheDir)
+}
 
+// newContainer creates a new container with all the dependencies of the repository commands.
+func newContainer(cfg *config.Config) (*container, error) {
 	fsRepo := repository.FileSystemRepository(afero.NewOsFs())
 	gitRepo, err := repository.NewGitRepository()
 	if err != nil {
@@ -50,37 +61,76 @@ func newContainer() (*container, error) {
 		ghRepo = repository.NewGithubNoopRepository(cfg.GithubOwner, cfg.GithubRepo)
 	}
 
-	cliffSvc := service.NewCliffService()
-	npmSvc := service.NewNpmService()
+	cliffSvc := service.NewCliffService(fsRepo, cfg.CliffOptions())
+	npmSvc := service.NewNpmService(fsRepo)
+	stateRepo, err := repository.NewStateRepositoryForBackend(
+		cfg.StateBackend,
+		fsRepo,
+		".release-state",
+		cfg.StateDBPath,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize state repository: %w", err)
+	}
 
 	return &container{
-		cfg:      cfg,
-		fsRepo:   fsRepo,
-		gitRepo:  gitRepo,
-		ghRepo:   ghRepo,
-		cliffSvc: cliffSvc,
-		npmSvc:   npmSvc,
+		cfg:       cfg,
+		fsRepo:    fsRepo,
+		gitRepo:   gitRepo,
+		ghRepo:    ghRepo,
+		cliffSvc:  cliffSvc,
+		npmSvc:    npmSvc,
+		stateRepo: stateRepo,
 	}, nil
 }
 
 // InitCommands initializes all commands with their dependencies
 func InitCommands() error {
-	c, err := newContainer()
+	args := os.Args[1:]
+	cfg, err := config.LoadToolConfigFile(configPath(args))
 	if err != nil {
 		return err
 	}
+	if err := configureNetwork(cfg); err != nil {
+		return err
+	}
 	ctx := context.Background()
-	ctx = config.IntoContext(ctx, c.cfg)
-	appLogger, err := logger.New(c.cfg.LoggerConfig())
+	ctx = config.IntoContext(ctx, cfg)
+	ctx = service.EnvResolverIntoContext(ctx, cfg.ServiceEnvironment)
+	appLogger, err := logger.New(cfg.LoggerConfig())
 	if err != nil {
 		return fmt.Errorf("failed to initialize logger: %w", err)
 	}
 	ctx = logger.IntoContext(ctx, appLogger)
+	shutdownTracing, err = telemetry.Setup(ctx, cfg.OTLPEndpoint, version.Version)
+	if err != nil {
+		return fmt.Errorf("failed to initialize tracing: %w", err)
+	}
 	rootCmd.SetContext(ctx)
 	rootCmd.PersistentPostRunE = func(cmd *cobra.Command, _ []string) error {
 		return logger.Sync(logger.FromContext(cmd.Context()))
 	}
+
+	// Commands that manage the binary itself run outside a git checkout
+	rootCmd.AddCommand(NewSelfUpdateCmd(orchestrator.NewSelfUpdateOrchestrator(
+		repository.NewToolReleaseRepository(cfg.GithubToken),
+		afero.NewOsFs(),
+	)))
+	rootCmd.AddCommand(newVersionCmd())
+	if standaloneCommand(args) {
+		return nil
+	}
+
+	if err := cfg.ResolveRepository(); err != nil {
+		return err
+	}
+	c, err := newContainer(cfg)
+	if err != nil {
+		return err
+	}
 	rootCmd.AddCommand(NewAddNoteCmd(c.fsRepo))
+	rootCmd.AddCommand(NewNoteCmd(c.fsRepo))
+	rootCmd.AddCommand(NewStateCmd(c.stateRepo))
 
 	// Individual commands have been replaced by orchestrator commands
 
@@ -89,19 +139,33 @@ func InitCommands() error {
 		return err
 	}
 
-	rootCmd.AddCommand(newVersionCmd())
-
 	return nil
 }
 
+// standaloneCommand reports whether args invoke one of the commands registered before the repository
+// container, which then is not built at all.
+func standaloneCommand(args []string) bool {
+	cmd, _, err := rootCmd.Find(args)
+	return err == nil && cmd != rootCmd
+}
+
 // addOrchestratorCommands adds the new consolidated commands
 func addOrchestratorCommands(ctx context.Context, c *container) error {
 	log := logger.FromContext(ctx).Named("cmd.container")
+	if warning := c.cfg.TLSWarning(); warning != "" {
+		log.Warn(warning)
+	}
 	// Initialize extended repositories for orchestrators
-	gitExtRepo, err := repository.NewGitExtendedRepositoryWithTimeout(c.cfg.GitPushTimeoutMinutes)
+	gitExtRepo, err := repository.NewGitExtendedRepositoryForBackend(
+		c.cfg.GitBackend,
+		c.cfg.GitRemote,
+		c.cfg.GitPushTimeoutMinutes,
+		c.cfg.GitFetchTags,
+	)
 	if err != nil {
 		return fmt.Errorf("failed to initialize git extended repository: %w", err)
 	}
+	gitExtRepo = repository.NewTracingGitRepository(gitExtRepo)
 
 	token := c.cfg.GithubToken
 	tokenSource := "config"
@@ -130,6 +194,16 @@ func addOrchestratorCommands(ctx context.Context, c *container) error {
 		log.Warn("GitHub token not provided; GitHub operations will be skipped")
 		githubExtRepo = repository.NewGithubNoopExtendedRepository(owner, repo)
 	} else {
+		if warning := config.GitHubTokenFormatWarning(token); warning != "" {
+			log.Warn(warning)
+		}
+		if c.cfg.VerifyGithubToken {
+			identity, err := repository.VerifyGitHubToken(ctx, token)
+			if err != nil {
+				return fmt.Errorf("github token verification failed: %w", err)
+			}
+			log.Info("Verified GitHub token", zap.String("identity", identity))
+		}
 		log.Info("Initializing GitHub extended repository", zap.Int("token_length", len(token)))
 		var err error
 		githubExtRepo, err = repository.NewGithubExtendedRepository(token, owner, repo)
@@ -139,6 +213,9 @@ func addOrchestratorCommands(ctx context.Context, c *container) error {
 		log.Info("Initialized GitHub extended repository", zap.String("owner", owner), zap.String("repo", repo))
 	}
 
+	// Workflow events are shared so an integration subscribes once for every orchestrator
+	events := orchestrator.NewEventBus()
+
 	// Create PR Release orchestrator
 	prOrch := orchestrator.NewPRReleaseOrchestrator(
 		gitExtRepo,
@@ -147,7 +224,14 @@ func addOrchestratorCommands(ctx context.Context, c *container) error {
 		c.cliffSvc,
 		c.npmSvc,
 	)
+	prOrch.SetStateRepository(c.stateRepo)
+	prOrch.SetEventBus(events)
+	rootCmd.AddCommand(NewChangelogCmd(c.fsRepo, c.cliffSvc, prOrch))
 	rootCmd.AddCommand(NewPRReleaseCmd(prOrch))
+	rootCmd.AddCommand(NewPlanCmd(prOrch))
+	rootCmd.AddCommand(NewApplyCmd(prOrch))
+	rootCmd.AddCommand(NewAbortCmd(prOrch))
+	rootCmd.AddCommand(NewServeCmd(prOrch))
 
 	// Create Dry Run orchestrator
 	goreleaserSvc := service.NewGoReleaserService()
@@ -157,8 +241,36 @@ func addOrchestratorCommands(ctx context.Context, c *container) error {
 		c.cliffSvc,
 		goreleaserSvc,
 		c.fsRepo,
+		c.npmSvc,
 	)
 	rootCmd.AddCommand(NewDryRunCmd(dryRunOrch))
 
+	// Create Promote orchestrator
+	promoteOrch := orchestrator.NewPromoteOrchestrator(
+		gitExtRepo,
+		githubExtRepo,
+		c.cliffSvc,
+		goreleaserSvc,
+		c.fsRepo,
+	)
+	rootCmd.AddCommand(NewPromoteCmd(promoteOrch))
+	rootCmd.AddCommand(NewCatchUpCmd(orchestrator.NewCatchUpOrchestrator(gitExtRepo, c.cliffSvc, c.fsRepo)))
+	rootCmd.AddCommand(NewImpactCmd(orchestrator.NewImpactOrchestrator(githubExtRepo, c.fsRepo)))
+	rootCmd.AddCommand(NewBlogPostCmd(orchestrator.NewBlogPostOrchestrator(githubExtRepo, c.fsRepo)))
+
+	// Create webhook orchestrator for listen mode
+	publishOrch := orchestrator.NewPublishOrchestrator(
+		gitExtRepo,
+		goreleaserSvc,
+		githubExtRepo,
+		c.fsRepo,
+		service.NewCosignService(),
+	)
+	publishOrch.SetEventBus(events)
+	rootCmd.AddCommand(NewPublishCmd(publishOrch))
+	webhookOrch := orchestrator.NewWebhookOrchestrator(gitExtRepo, prOrch, publishOrch)
+	rootCmd.AddCommand(NewListenCmd(webhookOrch, owner+"/"+repo))
+	rootCmd.AddCommand(NewDoctorCmd(service.NewToolVersionService(), githubExtRepo))
+
 	return nil
 }
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// NewDoctorCmd creates the doctor command.
+func NewDoctorCmd(
+	toolVersionSvc service.ToolVersionService,
+	githubRepo repository.GithubExtendedRepository,
+) *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the installed tools against tools_lock and the GitHub token permissions",
+		Long: "Prints the installed version of every external tool the release depends on and compares it with " +
+			"the version pinned in tools_lock. A mismatch fails the command when tools_lock_action is fail.\n\n" +
+			"Then probes the GitHub API for the write access of the token on the target repository and prints " +
+			"a permission matrix. The probes send empty write requests GitHub rejects, so nothing is written.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			toolsErr := checkToolVersions(cmd, toolVersionSvc)
+			return errors.Join(toolsErr, checkTokenPermissions(cmd, githubRepo))
+		},
+	}
+}
+
+func checkToolVersions(cmd *cobra.Command, toolVersionSvc service.ToolVersionService) error {
+	cfg := config.FromContext(cmd.Context())
+	uc := &usecase.CheckToolVersionsUseCase{ToolVersionSvc: toolVersionSvc, Lock: cfg.ToolsLock}
+	checks, err := uc.Execute(cmd.Context())
+	if err != nil {
+		return err
+	}
+	mismatches := 0
+	for _, check := range checks {
+		status := "ok"
+		if !check.OK() {
+			status = "mismatch"
+			mismatches++
+		}
+		cmd.Printf("%-8s %s\n", status, check)
+	}
+	if mismatches == 0 {
+		return nil
+	}
+	if strings.EqualFold(strings.TrimSpace(cfg.ToolsLockAction), orchestrator.ToolsLockActionFail) {
+		return fmt.Errorf("%d tool(s) do not match tools_lock", mismatches)
+	}
+	cmd.Printf("warning: %d tool(s) do not match tools_lock\n", mismatches)
+	return nil
+}
+
+// checkTokenPermissions prints the permission matrix of the GitHub token, warning about every
+// permission it cannot write with. Without a token the check is skipped.
+func checkTokenPermissions(cmd *cobra.Command, githubRepo repository.GithubExtendedRepository) error {
+	checks, err := githubRepo.TokenPermissions(cmd.Context())
+	if errors.Is(err, repository.ErrGithubTokenRequired) {
+		cmd.Println("skipped  GitHub token permissions: no GitHub token")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to probe GitHub token permissions: %w", err)
+	}
+	cmd.Printf("\n%-14s %-8s %s\n", "permission", "access", "used to")
+	missing := 0
+	for _, check := range checks {
+		cmd.Printf("%-14s %-8s %s\n", check.Permission, check.Access, check.Operations())
+		if !check.Granted() {
+			missing++
+		}
+	}
+	for _, check := range checks {
+		if !check.Granted() && check.Detail != "" {
+			cmd.Printf("  %s\n", check)
+		}
+	}
+	if missing > 0 {
+		cmd.Printf("warning: the GitHub token cannot write with %d permission(s)\n", missing)
+	}
+	return nil
+}
@@ -2,23 +2,45 @@
 package cmd
 
 import (
+	"github.com/compozy/releasepr/internal/config"
 	"github.com/compozy/releasepr/internal/orchestrator"
 	"github.com/spf13/cobra"
 )
 
 func NewDryRunCmd(o *orchestrator.DryRunOrchestrator) *cobra.Command {
 	var ciOutput bool
+	var npmCheck bool
+	var buildMetadata bool
 	cmd := &cobra.Command{
 		Use:   "dry-run",
 		Short: "Perform dry-run validations for release PR",
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			appCfg := config.FromContext(cmd.Context())
 			cfg := orchestrator.DryRunConfig{
-				CIOutput: ciOutput,
-				DryRun:   true,
+				CIOutput:              ciOutput,
+				DryRun:                true,
+				Cliff:                 appCfg.CliffOptions(),
+				BuildMetadata:         buildMetadata || appCfg.SnapshotBuildMetadata,
+				ToolsLock:             appCfg.ToolsLock,
+				ToolsLockAction:       appCfg.ToolsLockAction,
+				ReleaseHeaderTemplate: appCfg.ReleaseHeaderTemplate,
+				ReleaseFooterTemplate: appCfg.ReleaseFooterTemplate,
+				Report:                appCfg.DryRunReport,
+				CargoCrates:           appCfg.CargoCrates,
+				CargoRegistry:         appCfg.CargoRegistry,
+				PyPIPackages:          appCfg.PyPIPackages,
+				PyPIBuildCommand:      appCfg.PyPIBuildCommand,
+			}
+			if npmCheck || appCfg.DryRunNPMCheck {
+				cfg.ToolsDir = appCfg.ToolsDir
 			}
 			return o.Execute(cmd.Context(), cfg)
 		},
 	}
 	cmd.Flags().BoolVar(&ciOutput, "ci-output", false, "Output in CI-friendly format")
+	cmd.Flags().BoolVar(&npmCheck, "npm-check", false,
+		"Check the npm registry for already published versions and publish rights")
+	cmd.Flags().BoolVar(&buildMetadata, "build-metadata", false,
+		"Expose CI build metadata (build.<run>.sha.<commit>) to the snapshot as PR_RELEASE_BUILD_METADATA")
 	return cmd
 }
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/spf13/cobra"
+)
+
+// NewImpactCmd creates the impact command
+func NewImpactCmd(orch *orchestrator.ImpactOrchestrator) *cobra.Command {
+	var fileIssues bool
+	cmd := &cobra.Command{
+		Use:   "impact <version>",
+		Short: "List the downstream repositories that should update to a release",
+		Long: `List the repositories of downstream_repos that use the released package and should update to it.
+
+This command:
+- Reads the package from impact_package, or the module path of go.mod
+- Reads the version each repository pins from its manifest, or finds its usages with GitHub code search
+- Lists the repositories that use the package and whether they are behind the version
+- With --file-issues, opens or updates an "Update <package> to <version>" issue in each repository behind
+
+A repository that cannot be read is reported after the others are checked.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usages, err := orch.Execute(cmd.Context(), orchestrator.ImpactConfig{
+				Version:    args[0],
+				FileIssues: fileIssues,
+			})
+			for _, usage := range usages {
+				cmd.Println(describeUsage(usage, args[0]))
+			}
+			if len(usages) == 0 && err == nil {
+				cmd.Println("No downstream repository uses the package")
+			}
+			return err
+		},
+	}
+	cmd.Flags().BoolVar(&fileIssues, "file-issues", false,
+		"Open or update a tracking issue in every downstream repository that should update")
+	return cmd
+}
+
+// describeUsage returns the line the impact command prints for a downstream repository.
+func describeUsage(usage domain.DownstreamUsage, version string) string {
+	var b strings.Builder
+	b.WriteString(usage.Repo + ": ")
+	if usage.Version != "" {
+		fmt.Fprintf(&b, "%s pins %s", strings.Join(usage.Files, ", "), usage.Version)
+	} else {
+		fmt.Fprintf(&b, "referenced in %s", strings.Join(usage.Files, ", "))
+	}
+	if !usage.Outdated {
+		return b.String() + ", up to date"
+	}
+	fmt.Fprintf(&b, ", update to %s", version)
+	if usage.Issue != 0 {
+		fmt.Fprintf(&b, " (issue #%d)", usage.Issue)
+	}
+	return b.String()
+}
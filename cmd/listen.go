@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/compozy/releasepr/internal/server"
+	"github.com/spf13/cobra"
+)
+
+// NewListenCmd creates the listen command
+func NewListenCmd(orch *orchestrator.WebhookOrchestrator, repository string) *cobra.Command {
+	var (
+		addr         string
+		secret       string
+		releaseLabel string
+	)
+	cmd := &cobra.Command{
+		Use:   "listen",
+		Short: "Run release workflows from GitHub webhooks",
+		Long: `Receive GitHub webhooks and run the matching release workflow automatically.
+
+Deliveries are verified with the X-Hub-Signature-256 HMAC before anything runs,
+and only events for the configured repository are handled:
+- push to the default branch: creates or updates the release PR (with rollback)
+- pull request merged into the default branch with the release label: tags
+  the merge commit and publishes the release with GoReleaser
+
+Release and merge commits, and commits by github-actions[bot], are ignored so the
+release commit does not loop. Workflows run one at a time in arrival order.
+
+Point the GitHub webhook at POST /webhook with content type application/json.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if secret == "" {
+				secret = os.Getenv("GITHUB_WEBHOOK_SECRET")
+			}
+			webhook, err := server.NewWebhook(orch, server.WebhookOptions{
+				Secret:       secret,
+				Repository:   repository,
+				ReleaseLabel: releaseLabel,
+			})
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return webhook.ListenAndServe(ctx, addr)
+		},
+	}
+	cmd.Flags().StringVar(&addr, "addr", ":8080", "Address to listen on")
+	cmd.Flags().StringVar(&secret, "secret", "", "Webhook secret (defaults to GITHUB_WEBHOOK_SECRET)")
+	cmd.Flags().StringVar(&releaseLabel, "release-label", server.DefaultReleaseLabel,
+		"Label marking release pull requests")
+	return cmd
+}
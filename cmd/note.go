@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// NewNoteCmd creates the note command.
+func NewNoteCmd(fsRepo repository.FileSystemRepository) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "note",
+		Short: "Manage manual changelog entries",
+	}
+	cmd.AddCommand(newNoteAddCmd(fsRepo))
+	return cmd
+}
+
+func newNoteAddCmd(fsRepo repository.FileSystemRepository) *cobra.Command {
+	var (
+		noteType string
+		scope    string
+	)
+	cmd := &cobra.Command{
+		Use:   "add <description>",
+		Short: "Add a changelog entry for changes without conventional commits",
+		Long: "Appends an entry to " + domain.PendingNotesFile + ". The next release adds it to the changelog " +
+			"like a commit of the given type and scope, then deletes the file in the release commit.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			uc := &usecase.AddPendingNoteUseCase{FSRepo: fsRepo}
+			note, err := uc.Execute(cmd.Context(), usecase.AddPendingNoteInput{
+				Type:        noteType,
+				Scope:       scope,
+				Description: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Added %q to %s\n", note.Header(), domain.PendingNotesFile)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&noteType, "type", "", "Conventional commit type of the entry, e.g. feat, fix, revert")
+	cmd.Flags().StringVar(&scope, "scope", "", "Optional conventional commit scope of the entry")
+	if err := cmd.MarkFlagRequired("type"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
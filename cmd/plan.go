@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/spf13/cobra"
+)
+
+// Formats of the plan command output.
+const (
+	planOutputText = "text"
+	planOutputJSON = "json"
+)
+
+// NewPlanCmd creates the plan command
+func NewPlanCmd(orch *orchestrator.PRReleaseOrchestrator) *cobra.Command {
+	var (
+		output    string
+		force     bool
+		skipPR    bool
+		skipSteps []string
+	)
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Describe the mutations of a release PR run without performing them",
+		Long: `Describe every mutation a pr-release run would make without performing it, so an external
+approval system can review the release before it is applied.
+
+The plan lists:
+- The version to release and the release branch to create from the base commit
+- Every file of the release commit with its unified diff, and archived or consumed files
+- The custom version updaters and release artifact commands the run executes
+- The title, labels and branches of the release pull request
+
+Save the JSON plan with --output json, approve it, then run "apply --plan <file>". The run is
+refused when it no longer matches the approved plan.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			format := strings.ToLower(strings.TrimSpace(output))
+			if format != planOutputText && format != planOutputJSON {
+				return fmt.Errorf("invalid output format %q (must be one of: text, json)", output)
+			}
+			plan, err := orch.Plan(cmd.Context(), orchestrator.PRReleaseConfig{
+				ForceRelease: force,
+				SkipPR:       skipPR,
+				SkipSteps:    normalizeSkipSteps(skipSteps),
+			})
+			if err != nil {
+				return err
+			}
+			if format == planOutputJSON {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(plan)
+			}
+			printReleasePlan(cmd.OutOrStdout(), plan)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", planOutputText, "Output format: text or json")
+	cmd.Flags().BoolVar(&force, "force", false, "Plan a release even if no changes are detected")
+	cmd.Flags().BoolVar(&skipPR, "skip-pr", false, "Plan the release without a pull request")
+	cmd.Flags().StringSliceVar(&skipSteps, "skip", nil,
+		"Workflow steps to skip, e.g. --skip steps=changelog,package-versions")
+	return cmd
+}
+
+// printReleasePlan writes a human-readable summary of plan.
+func printReleasePlan(w io.Writer, plan *domain.ReleasePlan) {
+	if plan.Branch == nil {
+		fmt.Fprintln(w, plan.Reason)
+		return
+	}
+	fmt.Fprintf(w, "Release %s from %s\n", plan.Version, plan.BaseCommit)
+	fmt.Fprintf(w, "Branch: %s (from %s)\n", plan.Branch.Name, plan.Branch.Base)
+	for _, file := range plan.Files {
+		if file.From != "" {
+			fmt.Fprintf(w, "  %s %s -> %s\n", file.Action, file.From, file.Path)
+			continue
+		}
+		fmt.Fprintf(w, "  %s %s\n", file.Action, file.Path)
+	}
+	for _, command := range plan.Commands {
+		fmt.Fprintf(w, "Runs %s (%s): %s\n", command.Name, command.Step, command.Command)
+	}
+	if plan.PullRequest != nil {
+		fmt.Fprintf(w, "Pull request: %q into %s [%s]\n",
+			plan.PullRequest.Title, plan.PullRequest.Base, strings.Join(plan.PullRequest.Labels, ", "))
+	}
+}
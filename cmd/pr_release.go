@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"strings"
+
 	"github.com/compozy/releasepr/internal/orchestrator"
 	"github.com/spf13/cobra"
 )
@@ -15,6 +17,7 @@ func NewPRReleaseCmd(orch *orchestrator.PRReleaseOrchestrator) *cobra.Command {
 		prReleaseEnableRollback bool
 		prReleaseRollback       bool
 		prReleaseSessionID      string
+		prReleaseSkipSteps      []string
 	)
 	cmd := &cobra.Command{
 		Use:   "pr-release",
@@ -31,7 +34,12 @@ This command orchestrates the entire PR release workflow:
 
 With rollback support enabled (--enable-rollback), the workflow can be
 automatically rolled back if any step fails, restoring the repository
-to its previous state.`,
+to its previous state.
+
+Individual steps can be skipped with --skip (or the skip_steps config key):
+package-versions, changelog, release-notes, release-artifacts, archive-notes,
+push, pull-request. Skipping push requires skipping pull-request, and skipping
+release-notes requires skipping archive-notes.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			// Execute PR release workflow
 			cfg := orchestrator.PRReleaseConfig{
@@ -42,6 +50,7 @@ to its previous state.`,
 				EnableRollback: prReleaseEnableRollback,
 				Rollback:       prReleaseRollback,
 				SessionID:      prReleaseSessionID,
+				SkipSteps:      normalizeSkipSteps(prReleaseSkipSteps),
 			}
 			return orch.Execute(cmd.Context(), cfg)
 		},
@@ -55,5 +64,16 @@ to its previous state.`,
 	cmd.Flags().BoolVar(&prReleaseRollback, "rollback", false, "Rollback a failed release session")
 	cmd.Flags().
 		StringVar(&prReleaseSessionID, "session-id", "", "Session ID to rollback (uses latest if not specified)")
+	cmd.Flags().StringSliceVar(&prReleaseSkipSteps, "skip", nil,
+		"Workflow steps to skip, e.g. --skip steps=changelog,package-versions")
 	return cmd
 }
+
+// normalizeSkipSteps accepts both "--skip changelog" and "--skip steps=changelog,package-versions".
+func normalizeSkipSteps(values []string) []string {
+	steps := make([]string, 0, len(values))
+	for _, value := range values {
+		steps = append(steps, strings.TrimPrefix(strings.TrimSpace(value), "steps="))
+	}
+	return steps
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/spf13/cobra"
+)
+
+// NewPromoteCmd creates the promote command
+func NewPromoteCmd(orch *orchestrator.PromoteOrchestrator) *cobra.Command {
+	var skipPublish, forceTag, skipSoak bool
+	cmd := &cobra.Command{
+		Use:   "promote <prerelease-tag>",
+		Short: "Promote a prerelease tag to a final release",
+		Long: `Promote an existing prerelease tag (for example v1.4.0-rc.2) to a final release.
+
+This command:
+- Re-tags the prerelease commit as the final version (v1.4.0)
+- Regenerates release notes consolidating every prerelease since the last final release
+- Publishes the release with GoReleaser
+
+An existing final tag is never moved unless --force-tag is given. A moved tag is put back on its
+previous commit when pushing or publishing fails.
+
+With promote_soak_hours set, the GitHub release of the prerelease must have been published at
+least that many hours ago. --skip-soak promotes it anyway.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := orchestrator.PromoteConfig{
+				Tag:         args[0],
+				SkipPublish: skipPublish,
+				ForceTag:    forceTag,
+				SkipSoak:    skipSoak,
+			}
+			return orch.Execute(cmd.Context(), cfg)
+		},
+	}
+	cmd.Flags().BoolVar(&skipPublish, "skip-publish", false, "Create and push the final tag without publishing")
+	cmd.Flags().BoolVar(&forceTag, "force-tag", false, "Move the final tag when it already exists")
+	cmd.Flags().BoolVar(&skipSoak, "skip-soak", false, "Promote before the promote_soak_hours soak period has passed")
+	return cmd
+}
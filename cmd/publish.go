@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/spf13/cobra"
+)
+
+// NewPublishCmd creates the publish command, which publishes a merged release in two phases so its
+// artifacts can be reviewed before they go live.
+func NewPublishCmd(orch *orchestrator.PublishOrchestrator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "publish",
+		Short: "Stage a merged release as a draft, then finalize it",
+	}
+	cmd.AddCommand(newPublishStageCmd(orch), newPublishFinalizeCmd(orch))
+	return cmd
+}
+
+func newPublishStageCmd(orch *orchestrator.PublishOrchestrator) *cobra.Command {
+	var ref string
+	var forceTag bool
+	cmd := &cobra.Command{
+		Use:   "stage <version>",
+		Short: "Tag a merged release and publish it as a draft GitHub release",
+		Long: `Tag a merged release and publish it as a draft GitHub release.
+
+This command:
+- Checks out --ref, typically the merge commit of the release PR, and verifies its sign-off
+- Creates and pushes the release tag
+- Runs GoReleaser with --draft and PR_RELEASE_STAGED=true, so the assets are uploaded to a draft
+- Writes and attaches the release manifest and, when configured, signs the release
+
+Nothing is published to package registries. Review the draft, then run publish finalize.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return orch.Stage(cmd.Context(), orchestrator.PublishConfig{
+				Version:  args[0],
+				Ref:      ref,
+				ForceTag: forceTag,
+			})
+		},
+	}
+	cmd.Flags().StringVar(&ref, "ref", "", "Commit or branch to tag (default: the current checkout)")
+	cmd.Flags().BoolVar(&forceTag, "force-tag", false, "Move the release tag when it already exists")
+	return cmd
+}
+
+func newPublishFinalizeCmd(orch *orchestrator.PublishOrchestrator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "finalize <version>",
+		Short: "Publish a staged draft release",
+		Long: `Publish a release staged with publish stage.
+
+This command:
+- Checks out the release tag and publishes its draft GitHub release
+- Points the npm dist-tag of the release channel (latest by default) at the version
+- Publishes the configured crates and Python packages, marks security releases and posts release comments`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return orch.Finalize(cmd.Context(), orchestrator.PublishFinalizeConfig{Version: args[0]})
+		},
+	}
+}
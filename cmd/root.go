@@ -1,10 +1,16 @@
 package cmd
 
 import (
+	"context"
 	"errors"
+	"strings"
+	"time"
 
+	"github.com/compozy/releasepr/internal/config"
 	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/telemetry"
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 )
 
 var rootCmd = &cobra.Command{
@@ -13,8 +19,40 @@ var rootCmd = &cobra.Command{
 	Long:  `pr-release automates tagging, changelog generation, and release pull request orchestration for GitHub repositories.`,
 }
 
+// configFlag is the root flag naming an explicit config file. Dependencies are built from the config
+// before cobra parses the command line, so InitCommands reads it from the arguments with configPath.
+const configFlag = "config"
+
+func init() {
+	rootCmd.PersistentFlags().String(configFlag, "",
+		"Config file to load instead of .pr-release.yaml in the working directory (env "+config.EnvConfigFile+")")
+}
+
+// configPath returns the value of --config in args, or "" when it is not given.
+func configPath(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if value, ok := strings.CutPrefix(arg, "--"+configFlag+"="); ok {
+			return value
+		}
+		if arg == "--"+configFlag && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// tracingShutdownTimeout bounds how long the last spans may take to reach the collector.
+const tracingShutdownTimeout = 5 * time.Second
+
+// shutdownTracing flushes the spans of the run; InitCommands sets it.
+var shutdownTracing telemetry.Shutdown
+
 func Execute() error {
 	execErr := rootCmd.Execute()
+	flushTracing(rootCmd.Context())
 	syncErr := logger.Sync(logger.FromContext(rootCmd.Context()))
 	if execErr != nil {
 		if syncErr != nil {
@@ -24,3 +62,15 @@ func Execute() error {
 	}
 	return syncErr
 }
+
+// flushTracing sends the last spans. A collector outage must not fail a release, so it only warns.
+func flushTracing(ctx context.Context) {
+	if shutdownTracing == nil {
+		return
+	}
+	flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), tracingShutdownTimeout)
+	defer cancel()
+	if err := shutdownTracing(flushCtx); err != nil {
+		logger.FromContext(ctx).Warn("Failed to flush traces", zap.Error(err))
+	}
+}
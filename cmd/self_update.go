@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/spf13/cobra"
+)
+
+// NewSelfUpdateCmd creates the self-update command
+func NewSelfUpdateCmd(orch *orchestrator.SelfUpdateOrchestrator) *cobra.Command {
+	var check bool
+	cmd := &cobra.Command{
+		Use:   "self-update",
+		Short: "Update pr-release to its latest release",
+		Long: `Replace the running pr-release binary with the latest release of compozy/releasepr.
+
+This command:
+- Reads the latest release from the GitHub Releases of compozy/releasepr
+- Downloads checksums.txt with its cosign signature and certificate, and verifies with cosign that
+  a release workflow of compozy/releasepr signed it
+- Downloads the archive for the current OS and architecture and verifies its SHA-256 checksum
+- Extracts pr-release and renames it over the running binary
+
+Nothing is downloaded when the binary is already on the latest release. With --check, only reports
+whether a newer release exists. cosign must be on PATH to update. A GitHub token, when configured,
+raises the API rate limit.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			result, err := orch.Execute(cmd.Context(), orchestrator.SelfUpdateConfig{CheckOnly: check})
+			if err != nil {
+				return err
+			}
+			switch {
+			case result.Updated:
+				cmd.Printf("Updated pr-release from %s to %s\n", result.Current, result.Latest)
+			case result.UpdateAvailable():
+				cmd.Printf("pr-release %s is available (current: %s)\n", result.Latest, result.Current)
+			default:
+				cmd.Printf("pr-release %s is up to date\n", result.Current)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&check, "check", false, "Only report whether a newer release exists")
+	return cmd
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/compozy/releasepr/internal/orchestrator"
+	"github.com/compozy/releasepr/internal/server"
+	"github.com/spf13/cobra"
+)
+
+// NewServeCmd creates the serve command
+func NewServeCmd(orch *orchestrator.PRReleaseOrchestrator) *cobra.Command {
+	var (
+		addr  string
+		token string
+	)
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve a dashboard and JSON API over release sessions",
+		Long: `Serve a small web dashboard and JSON API over the recorded release sessions.
+
+The dashboard lists sessions from the rollback state directory, shows per-step
+status, and can trigger a rollback or resume of a session. Actions run one at a
+time in the background.
+
+API routes:
+- GET  /api/sessions                  List sessions, most recent first
+- GET  /api/sessions/{id}             Full session state with steps
+- POST /api/sessions/{id}/rollback    Roll back the session
+- POST /api/sessions/{id}/resume      Re-run the workflow for a failed session
+- GET  /api/action                    Status of the last triggered action
+
+When --token (or PR_RELEASE_SERVE_TOKEN) is set, every request must present it as
+a bearer token or as the basic auth password.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if token == "" {
+				token = os.Getenv("PR_RELEASE_SERVE_TOKEN")
+			}
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return server.New(ctx, orch, token).ListenAndServe(ctx, addr)
+		},
+	}
+	cmd.Flags().StringVar(&addr, "addr", "127.0.0.1:8080", "Address to listen on")
+	cmd.Flags().StringVar(&token, "token", "", "Require this token on every request")
+	return cmd
+}
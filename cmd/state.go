@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// defaultStateRetention is how old a session must be before state prune removes it by default.
+const defaultStateRetention = 30 * 24 * time.Hour
+
+var workflowStatuses = []domain.WorkflowStatus{
+	domain.WorkflowStatusPending,
+	domain.WorkflowStatusRunning,
+	domain.WorkflowStatusCompleted,
+	domain.WorkflowStatusFailed,
+	domain.WorkflowStatusRolledBack,
+}
+
+// NewStateCmd creates the state command, which inspects and prunes the recorded release sessions.
+func NewStateCmd(stateRepo repository.StateRepository) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Inspect and prune recorded release sessions",
+	}
+	cmd.AddCommand(newStateListCmd(stateRepo), newStatePruneCmd(stateRepo))
+	return cmd
+}
+
+func newStateListCmd(stateRepo repository.StateRepository) *cobra.Command {
+	var status string
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List release sessions, most recently started first",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			states, err := listStates(cmd, stateRepo, status)
+			if err != nil {
+				return err
+			}
+			for _, state := range states {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\t%s\t%s\n",
+					state.SessionID, state.Status, state.Version, state.StartedAt.Format(time.RFC3339))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&status, "status", "", "Only list sessions with this status")
+	return cmd
+}
+
+func listStates(
+	cmd *cobra.Command,
+	stateRepo repository.StateRepository,
+	status string,
+) ([]*domain.RollbackState, error) {
+	if status == "" {
+		return stateRepo.List(cmd.Context())
+	}
+	if !slices.Contains(workflowStatuses, domain.WorkflowStatus(status)) {
+		return nil, fmt.Errorf("invalid --status %q: must be one of %v", status, workflowStatuses)
+	}
+	return stateRepo.ListByStatus(cmd.Context(), domain.WorkflowStatus(status))
+}
+
+func newStatePruneCmd(stateRepo repository.StateRepository) *cobra.Command {
+	var olderThan time.Duration
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete release sessions not updated for a while",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if olderThan <= 0 {
+				return fmt.Errorf("--older-than must be positive")
+			}
+			removed, err := stateRepo.Prune(cmd.Context(), time.Now().Add(-olderThan))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "pruned %d session(s)\n", removed)
+			return nil
+		},
+	}
+	cmd.Flags().DurationVar(&olderThan, "older-than", defaultStateRetention,
+		"Delete sessions last updated longer ago than this")
+	return cmd
+}
@@ -1,29 +1,159 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 	"unicode"
 
+	"github.com/compozy/releasepr/internal/domain"
 	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/compozy/releasepr/internal/telemetry"
 	"github.com/go-git/go-git/v5"
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	GithubToken           string                   `mapstructure:"github_token"`
-	GithubOwner           string                   `mapstructure:"github_owner"`
-	GithubRepo            string                   `mapstructure:"github_repo"`
-	ToolsDir              string                   `mapstructure:"tools_dir"`
-	NpmToken              string                   `mapstructure:"npm_token"`
-	LogLevel              string                   `mapstructure:"log_level"`
-	LogFormat             string                   `mapstructure:"log_format"`
-	GitPushTimeoutMinutes int                      `mapstructure:"git_push_timeout_minutes"`
-	ReleaseArtifacts      []ReleaseArtifactCommand `mapstructure:"release_artifacts"`
+	GithubToken                string                   `mapstructure:"github_token"`
+	GithubOwner                string                   `mapstructure:"github_owner"`
+	GithubRepo                 string                   `mapstructure:"github_repo"`
+	ToolsDir                   string                   `mapstructure:"tools_dir"`
+	NpmToken                   string                   `mapstructure:"npm_token"`
+	LogLevel                   string                   `mapstructure:"log_level"`
+	LogFormat                  string                   `mapstructure:"log_format"`
+	GitPushTimeoutMinutes      int                      `mapstructure:"git_push_timeout_minutes"`
+	ReleaseArtifacts           []ReleaseArtifactCommand `mapstructure:"release_artifacts"`
+	ArtifactMetadataPath       string                   `mapstructure:"artifact_metadata_path"`
+	GitBackend                 string                   `mapstructure:"git_backend"`
+	GitRemote                  string                   `mapstructure:"git_remote"`
+	GitFetchTags               string                   `mapstructure:"git_fetch_tags"`
+	ChangelogMarkdownAllowlist []string                 `mapstructure:"changelog_markdown_allowlist"`
+	ReleaseChangelogAudience   string                   `mapstructure:"release_changelog_audience"`
+	ChangelogFileAudience      string                   `mapstructure:"changelog_file_audience"`
+	PublicExcludeTypes         []string                 `mapstructure:"public_changelog_exclude_types"`
+	PublicExcludeScopes        []string                 `mapstructure:"public_changelog_exclude_scopes"`
+	VerifyGithubToken          bool                     `mapstructure:"verify_github_token"`
+	ReleaseManifestPath        string                   `mapstructure:"release_manifest_path"`
+	AttachReleaseManifest      bool                     `mapstructure:"release_manifest_attach"`
+	AttachReleaseNotes         bool                     `mapstructure:"release_notes_attach"`
+	SkipSteps                  []string                 `mapstructure:"skip_steps"`
+	ReleaseTimezone            string                   `mapstructure:"release_timezone"`
+	ReleaseDateFormat          string                   `mapstructure:"release_date_format"`
+	RequestCodeOwnerReviews    bool                     `mapstructure:"request_codeowner_reviews"`
+	FallbackReviewers          []string                 `mapstructure:"fallback_reviewers"`
+	GoModuleMajorBump          string                   `mapstructure:"go_module_major_bump"`
+	CliffConfigPath            string                   `mapstructure:"cliff_config"`
+	CliffWorkdir               string                   `mapstructure:"cliff_workdir"`
+	CliffArgs                  []string                 `mapstructure:"cliff_args"`
+	SnapshotBuildMetadata      bool                     `mapstructure:"snapshot_build_metadata"`
+	ReleaseStats               bool                     `mapstructure:"release_stats"`
+	Signing                    string                   `mapstructure:"signing"`
+	CosignKey                  string                   `mapstructure:"cosign_key"`
+	ProxyURL                   string                   `mapstructure:"proxy_url"`
+	CABundle                   string                   `mapstructure:"ca_bundle"`
+	TLSInsecureSkipVerify      bool                     `mapstructure:"tls_insecure_skip_verify"`
+	IssueLinks                 bool                     `mapstructure:"issue_links"`
+	GitHubCacheDir             string                   `mapstructure:"github_cache_dir"`
+	PRBodyTemplate             string                   `mapstructure:"pr_body_template"`
+	ReleaseNotesTemplate       string                   `mapstructure:"release_notes_template"`
+	Strict                     bool                     `mapstructure:"strict"`
+	ChangeDetection            string                   `mapstructure:"change_detection"`
+	ReleasePRMaxFiles          int                      `mapstructure:"release_pr_max_files"`
+	ReleasePRMaxLines          int                      `mapstructure:"release_pr_max_lines"`
+	ReleasePRSizeAction        string                   `mapstructure:"release_pr_size_action"`
+	ReleasePRExclude           []string                 `mapstructure:"release_pr_exclude"`
+	VersionScheme              string                   `mapstructure:"version_scheme"`
+	CalVerFormat               string                   `mapstructure:"calver_format"`
+	ReadmeVersionPatterns      []string                 `mapstructure:"readme_version_patterns"`
+	SubmoduleBump              bool                     `mapstructure:"submodule_bump"`
+	OTLPEndpoint               string                   `mapstructure:"otlp_endpoint"`
+	ReleaseLocale              string                   `mapstructure:"release_locale"`
+	ReleaseLocaleFile          string                   `mapstructure:"release_locale_file"`
+	PRLabels                   []LabelConfig            `mapstructure:"pr_labels"`
+	ToolsLock                  map[string]string        `mapstructure:"tools_lock"`
+	ToolsLockAction            string                   `mapstructure:"tools_lock_action"`
+	PRBodyMergedPRs            bool                     `mapstructure:"pr_body_merged_prs"`
+	PRBodyHeader               *string                  `mapstructure:"pr_body_header"`
+	PRBodyFooter               string                   `mapstructure:"pr_body_footer"`
+	ReleaseHeaderTemplate      string                   `mapstructure:"release_header_template"`
+	ReleaseFooterTemplate      string                   `mapstructure:"release_footer_template"`
+	StateBackend               string                   `mapstructure:"state_backend"`
+	StateDBPath                string                   `mapstructure:"state_db_path"`
+	DryRunReport               string                   `mapstructure:"dry_run_report"`
+	DryRunNPMCheck             bool                     `mapstructure:"dry_run_npm_check"`
+	ReleaseChannels            []ReleaseChannelConfig   `mapstructure:"release_channels"`
+	CommitSkipAuthors          []string                 `mapstructure:"commit_skip_authors"`
+	CommitSkipMessages         []string                 `mapstructure:"commit_skip_messages"`
+	CommitSkipPaths            []string                 `mapstructure:"commit_skip_paths"`
+	ReleaseCommentPRs          bool                     `mapstructure:"release_comment_prs"`
+	ReleaseCommentIssues       bool                     `mapstructure:"release_comment_issues"`
+	MinCommits                 int                      `mapstructure:"min_commits"`
+	RequireTypes               []string                 `mapstructure:"require_types"`
+	ChangelogStyle             string                   `mapstructure:"changelog_style"`
+	FailureIssue               bool                     `mapstructure:"failure_issue"`
+	ReleaseCaptain             string                   `mapstructure:"release_captain"`
+	CargoCrates                []string                 `mapstructure:"cargo_crates"`
+	CargoRegistry              string                   `mapstructure:"cargo_registry"`
+	CargoToken                 string                   `mapstructure:"cargo_token"`
+	PyPIPackages               []string                 `mapstructure:"pypi_packages"`
+	PyPIBuildCommand           []string                 `mapstructure:"pypi_build_command"`
+	PyPIRepositoryURL          string                   `mapstructure:"pypi_repository_url"`
+	PyPIToken                  string                   `mapstructure:"pypi_token"`
+	DownstreamRepos            []DownstreamRepoConfig   `mapstructure:"downstream_repos"`
+	ImpactPackage              string                   `mapstructure:"impact_package"`
+	SecuritySection            bool                     `mapstructure:"security_section"`
+	SecurityLabels             []string                 `mapstructure:"security_labels"`
+	SignOffMinApprovals        int                      `mapstructure:"signoff_min_approvals"`
+	SignOffRequiredChecks      []string                 `mapstructure:"signoff_required_checks"`
+	SignOffOverride            string                   `mapstructure:"signoff_override"`
+	ChangelogDiff              string                   `mapstructure:"changelog_diff"`
+	RollbackMode               string                   `mapstructure:"rollback_mode"`
+	AllowedRepositories        []string                 `mapstructure:"allowed_repositories"`
+	PromoteSoakHours           int                      `mapstructure:"promote_soak_hours"`
+	ServiceEnv                 map[string][]EnvVar      `mapstructure:"service_env"`
+	BlogRepo                   string                   `mapstructure:"blog_repo"`
+	BlogBaseBranch             string                   `mapstructure:"blog_base_branch"`
+	BlogDir                    string                   `mapstructure:"blog_dir"`
+	BlogTemplate               string                   `mapstructure:"blog_template"`
+}
+
+// EnvVar is an extra environment variable of an external command. References in Value, such as
+// ${GORELEASER_TOKEN}, are expanded when the command runs rather than when the config is loaded.
+type EnvVar struct {
+	Name  string `mapstructure:"name"`
+	Value string `mapstructure:"value"`
+}
+
+// LabelConfig sets the color and description a release PR label is created with when the
+// repository does not have it yet.
+type LabelConfig struct {
+	Name        string `mapstructure:"name"`
+	Color       string `mapstructure:"color"`
+	Description string `mapstructure:"description"`
+}
+
+// ReleaseChannelConfig maps the branches matching Branch to a release channel.
+type ReleaseChannelConfig struct {
+	Branch     string `mapstructure:"branch"`
+	Channel    string `mapstructure:"channel"`
+	Prerelease bool   `mapstructure:"prerelease"`
+}
+
+// DownstreamRepoConfig is a repository that depends on the released package. Manifest names the file
+// pinning the package, such as go.mod or package.json; without it the usages are found by code search.
+type DownstreamRepoConfig struct {
+	Repo     string `mapstructure:"repo"`
+	Manifest string `mapstructure:"manifest"`
 }
 
 type ReleaseArtifactCommand struct {
@@ -32,14 +162,25 @@ type ReleaseArtifactCommand struct {
 	Args           []string `mapstructure:"args"`
 	Add            []string `mapstructure:"add"`
 	TimeoutSeconds int      `mapstructure:"timeout_seconds"`
+	Env            []EnvVar `mapstructure:"env"`
 }
 
 var configFileCandidates = []string{".pr-release", ".compozy-release"}
 
+var envVarName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var knownGitHubTokenPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^[a-fA-F0-9]{40}$`),
+	regexp.MustCompile(`^(ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9_.-]+$`),
+	regexp.MustCompile(`^github_pat_[A-Za-z0-9_]+$`),
+}
+
 const (
 	minReleaseArtifactTimeoutSeconds = 1
 	maxReleaseArtifactTimeoutSeconds = 3600
 	releaseArtifactSupportedCommands = "bun, go, make, node, npm, npx, pnpm, yarn"
+	// maxLabelDescriptionLength is the longest label description GitHub accepts.
+	maxLabelDescriptionLength = 100
 )
 
 func DefaultConfig() *Config {
@@ -48,10 +189,37 @@ func DefaultConfig() *Config {
 		logFormat = "console"
 	}
 	return &Config{
-		ToolsDir:              "tools",
-		LogLevel:              "info",
-		LogFormat:             logFormat,
-		GitPushTimeoutMinutes: 2,
+		ToolsDir:                   "tools",
+		LogLevel:                   "info",
+		LogFormat:                  logFormat,
+		GitPushTimeoutMinutes:      2,
+		ArtifactMetadataPath:       "dist/metadata.json",
+		GitBackend:                 "go-git",
+		GitRemote:                  "origin",
+		GitFetchTags:               "always",
+		ChangelogMarkdownAllowlist: []string{"links"},
+		ReleaseChangelogAudience:   "internal",
+		ChangelogFileAudience:      "internal",
+		PublicExcludeTypes:         []string{"chore", "ci", "test"},
+		ReleaseManifestPath:        "release-manifest.json",
+		ReleaseTimezone:            "UTC",
+		ReleaseDateFormat:          "2006-01-02",
+		GoModuleMajorBump:          "warn",
+		VersionScheme:              "semver",
+		CalVerFormat:               domain.CalVerYearMonthMicro,
+		ReadmeVersionPatterns:      slices.Clone(domain.DefaultReadmeVersionPatterns),
+		Signing:                    "none",
+		ReleaseLocale:              domain.DefaultLocale,
+		ReleaseHeaderTemplate:      ".goreleaser.release-header.md.tmpl",
+		ReleaseFooterTemplate:      ".goreleaser.release-footer.md.tmpl",
+		StateBackend:               "json",
+		StateDBPath:                ".release-state/state.db",
+		DryRunReport:               "comment",
+		SecurityLabels:             []string{"security"},
+		ChangelogDiff:              "off",
+		RollbackMode:               "fail-fast",
+		BlogBaseBranch:             "main",
+		BlogDir:                    "content/blog",
 	}
 }
 
@@ -104,13 +272,254 @@ func (c *Config) Validate() error {
 	if err := validateReleaseArtifacts(c.ReleaseArtifacts); err != nil {
 		return err
 	}
+	if err := validateGitBackend(c.GitBackend); err != nil {
+		return err
+	}
+	if err := validateGitFetchTags(c.GitFetchTags); err != nil {
+		return err
+	}
+	if err := validateGitRemote(c.GitRemote); err != nil {
+		return err
+	}
+	if err := validateChangelogMarkdownAllowlist(c.ChangelogMarkdownAllowlist); err != nil {
+		return err
+	}
+	if err := validateChangelogAudience("release_changelog_audience", c.ReleaseChangelogAudience); err != nil {
+		return err
+	}
+	if err := validateChangelogAudience("changelog_file_audience", c.ChangelogFileAudience); err != nil {
+		return err
+	}
+	if err := validateConventionalNames("public_changelog_exclude_types", c.PublicExcludeTypes); err != nil {
+		return err
+	}
+	if err := validateConventionalNames("public_changelog_exclude_scopes", c.PublicExcludeScopes); err != nil {
+		return err
+	}
+	if err := validateReleaseManifest(c.ReleaseManifestPath, c.AttachReleaseManifest); err != nil {
+		return err
+	}
+	if _, err := domain.ParseSkippedSteps(c.SkipSteps); err != nil {
+		return fmt.Errorf("invalid skip_steps: %w", err)
+	}
+	if err := validateReleaseDate(c.ReleaseTimezone, c.ReleaseDateFormat); err != nil {
+		return err
+	}
+	if err := validateReviewers("fallback_reviewers", c.FallbackReviewers); err != nil {
+		return err
+	}
+	if err := validateGoModuleMajorBump(c.GoModuleMajorBump); err != nil {
+		return err
+	}
+	if err := validateCliffArgs(c.CliffArgs); err != nil {
+		return err
+	}
+	if err := validateSigning(c.Signing, c.CosignKey); err != nil {
+		return err
+	}
+	if err := validateProxyURL(c.ProxyURL); err != nil {
+		return err
+	}
+	if err := validateTemplatePath("pr_body_template", c.PRBodyTemplate); err != nil {
+		return err
+	}
+	if err := validateTemplatePath("release_notes_template", c.ReleaseNotesTemplate); err != nil {
+		return err
+	}
+	if err := validateChangeDetection(c.ChangeDetection); err != nil {
+		return err
+	}
+	if err := validateReleasePRSize(c.ReleasePRMaxFiles, c.ReleasePRMaxLines, c.ReleasePRSizeAction); err != nil {
+		return err
+	}
+	if _, err := domain.ParsePathPatterns(c.ReleasePRExclude); err != nil {
+		return fmt.Errorf("invalid release_pr_exclude: %w", err)
+	}
+	if err := validateVersionScheme(c.VersionScheme, c.CalVerFormat); err != nil {
+		return err
+	}
+	if _, err := domain.ParseReadmeVersionPatterns(c.ReadmeVersionPatterns, c.GithubOwner, c.GithubRepo); err != nil {
+		return fmt.Errorf("invalid readme_version_patterns: %w", err)
+	}
+	if c.OTLPEndpoint != "" {
+		if _, err := telemetry.TracesURL(c.OTLPEndpoint); err != nil {
+			return fmt.Errorf("invalid otlp_endpoint: %w", err)
+		}
+	}
+	if _, err := domain.LoadLocale(c.ReleaseLocale); err != nil {
+		return fmt.Errorf("invalid release_locale: %w", err)
+	}
+	if err := validateTemplatePath("release_locale_file", c.ReleaseLocaleFile); err != nil {
+		return err
+	}
+	if err := validatePRLabels(c.PRLabels); err != nil {
+		return err
+	}
+	if err := validateToolsLock(c.ToolsLock, c.ToolsLockAction); err != nil {
+		return err
+	}
+	if err := validateTemplatePath("release_header_template", c.ReleaseHeaderTemplate); err != nil {
+		return err
+	}
+	if err := validateTemplatePath("release_footer_template", c.ReleaseFooterTemplate); err != nil {
+		return err
+	}
+	if err := validateStateBackend(c.StateBackend, c.StateDBPath); err != nil {
+		return err
+	}
+	if err := validateDryRunReport(c.DryRunReport); err != nil {
+		return err
+	}
+	if err := validateReleaseChannels(c.ReleaseChannels); err != nil {
+		return err
+	}
+	if _, err := c.CommitRules(); err != nil {
+		return fmt.Errorf("invalid commit_skip_messages or commit_skip_paths: %w", err)
+	}
+	if c.ReleaseCommentIssues && !c.ReleaseCommentPRs {
+		return fmt.Errorf("release_comment_issues requires release_comment_prs")
+	}
+	if err := validateReleaseThreshold(c.MinCommits, c.RequireTypes); err != nil {
+		return err
+	}
+	if _, err := domain.ParseChangelogStyle(c.ChangelogStyle); err != nil {
+		return fmt.Errorf("invalid changelog_style: %w", err)
+	}
+	if err := validateReleaseCaptain(c.ReleaseCaptain); err != nil {
+		return err
+	}
+	if err := validateCargo(c.CargoCrates, c.CargoRegistry); err != nil {
+		return err
+	}
+	if err := validatePyPI(c.PyPIPackages, c.PyPIRepositoryURL); err != nil {
+		return err
+	}
+	if err := validateDownstreamRepos(c.DownstreamRepos); err != nil {
+		return err
+	}
+	if err := validateSecurityLabels(c.SecurityLabels); err != nil {
+		return err
+	}
+	if err := validateSignOff(c.SignOffMinApprovals, c.SignOffRequiredChecks); err != nil {
+		return err
+	}
+	if err := validateChangelogDiff(c.ChangelogDiff); err != nil {
+		return err
+	}
+	if err := validateRollbackMode(c.RollbackMode); err != nil {
+		return err
+	}
+	if err := validateAllowedRepositories(c.AllowedRepositories); err != nil {
+		return err
+	}
+	if c.PromoteSoakHours < 0 {
+		return fmt.Errorf("promote_soak_hours cannot be negative, got %d", c.PromoteSoakHours)
+	}
+	if err := validateServiceEnv(c.ServiceEnv); err != nil {
+		return err
+	}
+	if err := validateBlog(c.BlogRepo, c.BlogDir, c.BlogTemplate); err != nil {
+		return err
+	}
 	return nil
 }
 
+// CustomTemplates reports whether a PR body or release notes template is configured.
+func (c *Config) CustomTemplates() bool {
+	return strings.TrimSpace(c.PRBodyTemplate) != "" || strings.TrimSpace(c.ReleaseNotesTemplate) != ""
+}
+
+// AudienceFilter returns the commit filter of a changelog written for audience: the public
+// exclusions for the public audience, none for the internal one.
+func (c *Config) AudienceFilter(audience string) (domain.CommitFilter, error) {
+	parsed, err := domain.ParseChangelogAudience(audience)
+	if err != nil {
+		return domain.CommitFilter{}, err
+	}
+	if parsed != domain.ChangelogAudiencePublic {
+		return domain.CommitFilter{}, nil
+	}
+	return domain.CommitFilter{ExcludeTypes: c.PublicExcludeTypes, ExcludeScopes: c.PublicExcludeScopes}, nil
+}
+
+// Labels returns the configured release PR labels with normalized colors.
+func (c *Config) Labels() []domain.Label {
+	labels := make([]domain.Label, 0, len(c.PRLabels))
+	for _, label := range c.PRLabels {
+		color, err := domain.NormalizeLabelColor(label.Color)
+		if err != nil {
+			color = ""
+		}
+		labels = append(labels, domain.Label{
+			Name:        strings.TrimSpace(label.Name),
+			Color:       color,
+			Description: strings.TrimSpace(label.Description),
+		})
+	}
+	return labels
+}
+
+// Channels returns the configured release channels in matching order.
+func (c *Config) Channels() []domain.ReleaseChannel {
+	channels := make([]domain.ReleaseChannel, 0, len(c.ReleaseChannels))
+	for _, channel := range c.ReleaseChannels {
+		channels = append(channels, domain.ReleaseChannel{
+			Name:       strings.TrimSpace(channel.Channel),
+			Branch:     strings.TrimSpace(channel.Branch),
+			Prerelease: channel.Prerelease,
+		})
+	}
+	return channels
+}
+
 func (c *Config) LoggerConfig() logger.Config {
 	return logger.Config{Level: c.LogLevel, Format: c.LogFormat}
 }
 
+// CommitRules returns the rules skipping commits by author, message and path before the version bump
+// and the changelog.
+func (c *Config) CommitRules() (domain.CommitRules, error) {
+	return domain.ParseCommitRules(c.CommitSkipAuthors, c.CommitSkipMessages, c.CommitSkipPaths)
+}
+
+// SignOffPolicy returns what a release pull request needs before it is published, from
+// signoff_min_approvals and signoff_required_checks.
+func (c *Config) SignOffPolicy() domain.SignOffPolicy {
+	return domain.SignOffPolicy{MinApprovals: c.SignOffMinApprovals, RequiredChecks: c.SignOffRequiredChecks}
+}
+
+// RepositoryAllowed reports whether the configured owner/repo may be changed: allowed_repositories
+// is empty or lists it, compared case-insensitively as GitHub does.
+func (c *Config) RepositoryAllowed() bool {
+	if len(c.AllowedRepositories) == 0 {
+		return true
+	}
+	slug := c.GithubOwner + "/" + c.GithubRepo
+	return slices.ContainsFunc(c.AllowedRepositories, func(allowed string) bool {
+		return strings.EqualFold(strings.TrimSpace(allowed), slug)
+	})
+}
+
+// ReleaseThreshold returns the minimum changes a release needs, from min_commits and require_types.
+func (c *Config) ReleaseThreshold() domain.ReleaseThreshold {
+	return domain.ReleaseThreshold{MinCommits: c.MinCommits, RequireTypes: c.RequireTypes}
+}
+
+func (c *Config) CliffOptions() service.CliffOptions {
+	// Validate rejects invalid rules; an unvalidated config with invalid ones is left unfiltered.
+	rules, err := c.CommitRules()
+	if err != nil {
+		rules = domain.CommitRules{}
+	}
+	return service.CliffOptions{
+		ConfigPath:       strings.TrimSpace(c.CliffConfigPath),
+		WorkDir:          strings.TrimSpace(c.CliffWorkdir),
+		ExtraArgs:        c.CliffArgs,
+		PendingNotesPath: domain.PendingNotesFile,
+		CommitRules:      rules,
+	}
+}
+
 func validateLogLevel(level string) error {
 	switch strings.ToLower(strings.TrimSpace(level)) {
 	case "debug", "info", "warn", "error":
@@ -127,6 +536,301 @@ func validateLogFormat(format string) error {
 	return fmt.Errorf("invalid log_format: %s", format)
 }
 
+func validateStateBackend(backend, dbPath string) error {
+	switch backend {
+	case "", "json":
+		return nil
+	case "sqlite":
+		if strings.TrimSpace(dbPath) == "" {
+			return fmt.Errorf("state_db_path is required when state_backend is sqlite")
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid state_backend: %s (must be one of: json, sqlite)", backend)
+}
+
+func validateGitBackend(backend string) error {
+	switch backend {
+	case "", "go-git", "cli", "auto":
+		return nil
+	}
+	return fmt.Errorf("invalid git_backend: %s (must be one of: go-git, cli, auto)", backend)
+}
+
+func validateGitFetchTags(mode string) error {
+	switch mode {
+	case "", "always", "never", "on-miss":
+		return nil
+	}
+	return fmt.Errorf("invalid git_fetch_tags: %s (must be one of: always, never, on-miss)", mode)
+}
+
+func validateGitRemote(remote string) error {
+	if remote == "" {
+		return nil
+	}
+	validRemote := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._\-]*$`)
+	if !validRemote.MatchString(remote) || strings.Contains(remote, "..") {
+		return fmt.Errorf("invalid git_remote: %s", remote)
+	}
+	return nil
+}
+
+func validateChangelogMarkdownAllowlist(constructs []string) error {
+	for _, construct := range constructs {
+		switch strings.ToLower(strings.TrimSpace(construct)) {
+		case "html", "images", "links":
+			continue
+		}
+		return fmt.Errorf(
+			"invalid changelog_markdown_allowlist entry: %s (must be one of: html, images, links)",
+			construct,
+		)
+	}
+	return nil
+}
+
+func validateChangelogAudience(key, audience string) error {
+	switch strings.ToLower(strings.TrimSpace(audience)) {
+	case "", "internal", "public":
+		return nil
+	}
+	return fmt.Errorf("invalid %s: %s (must be one of: internal, public)", key, audience)
+}
+
+func validateConventionalNames(key string, names []string) error {
+	validName := regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]*$`)
+	for _, name := range names {
+		if !validName.MatchString(strings.TrimSpace(name)) {
+			return fmt.Errorf("invalid %s entry: %q", key, name)
+		}
+	}
+	return nil
+}
+
+func validateReleaseManifest(path string, attach bool) error {
+	if strings.TrimSpace(path) == "" {
+		if attach {
+			return fmt.Errorf("release_manifest_attach requires release_manifest_path")
+		}
+		return nil
+	}
+	if err := validateReleaseArtifactAddPattern(path); err != nil {
+		return fmt.Errorf("release_manifest_path: %w", err)
+	}
+	return nil
+}
+
+func validateTemplatePath(key, path string) error {
+	if strings.TrimSpace(path) == "" {
+		return nil
+	}
+	if err := validateReleaseArtifactAddPattern(path); err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	return nil
+}
+
+func validateReleaseDate(timezone, format string) error {
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("invalid release_timezone: %w", err)
+	}
+	if format == "" {
+		return nil
+	}
+	sample := time.Date(2017, time.November, 23, 21, 37, 48, 0, time.UTC)
+	if sample.Format(format) == format {
+		return fmt.Errorf("release_date_format must contain a Go reference date element such as 2006-01-02")
+	}
+	return nil
+}
+
+func validateReviewers(key string, reviewers []string) error {
+	validReviewer := regexp.MustCompile(`^@?[A-Za-z0-9][A-Za-z0-9-]*(/[A-Za-z0-9][A-Za-z0-9._-]*)?$`)
+	for _, reviewer := range reviewers {
+		if !validReviewer.MatchString(strings.TrimSpace(reviewer)) {
+			return fmt.Errorf("invalid %s entry: %q (must be a user or org/team)", key, reviewer)
+		}
+	}
+	return nil
+}
+
+// validateReleaseCaptain checks that the release captain is a single GitHub user, who can be assigned an issue.
+func validateReleaseCaptain(captain string) error {
+	validCaptain := regexp.MustCompile(`^@?[A-Za-z0-9][A-Za-z0-9-]*$`)
+	if captain != "" && !validCaptain.MatchString(strings.TrimSpace(captain)) {
+		return fmt.Errorf("invalid release_captain: %q (must be a GitHub user)", captain)
+	}
+	return nil
+}
+
+// validateCargo checks that every crate is a directory inside the repository and the registry is a
+// registry name of .cargo/config.toml.
+func validateCargo(crates []string, registry string) error {
+	for _, crate := range crates {
+		if !insideRepository(crate) {
+			return fmt.Errorf("invalid cargo_crates entry: %q (must be a directory inside the repository)", crate)
+		}
+	}
+	validRegistry := regexp.MustCompile(`^[A-Za-z0-9_-]*$`)
+	if !validRegistry.MatchString(registry) {
+		return fmt.Errorf("invalid cargo_registry: %q (must be a registry name)", registry)
+	}
+	return nil
+}
+
+// validatePyPI checks that every package is a directory inside the repository and the repository URL
+// is an http or https URL.
+func validatePyPI(packages []string, repositoryURL string) error {
+	for _, pkg := range packages {
+		if !insideRepository(pkg) {
+			return fmt.Errorf("invalid pypi_packages entry: %q (must be a directory inside the repository)", pkg)
+		}
+	}
+	if repositoryURL == "" {
+		return nil
+	}
+	u, err := url.Parse(repositoryURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid pypi_repository_url: %q (must be an http or https URL)", repositoryURL)
+	}
+	return nil
+}
+
+// insideRepository reports whether path is a relative path that stays inside the repository.
+func insideRepository(path string) bool {
+	path = strings.TrimSpace(path)
+	return path != "" && !filepath.IsAbs(path) && !slices.Contains(strings.Split(filepath.ToSlash(path), "/"), "..")
+}
+
+func validateChangeDetection(mode string) error {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", "commits", "change-files":
+		return nil
+	}
+	return fmt.Errorf("invalid change_detection: %s (must be one of: commits, change-files)", mode)
+}
+
+func validateReleaseThreshold(minCommits int, requireTypes []string) error {
+	if minCommits < 0 {
+		return fmt.Errorf("min_commits cannot be negative, got %d", minCommits)
+	}
+	validType := regexp.MustCompile(`^[A-Za-z]+$`)
+	for _, commitType := range requireTypes {
+		if !validType.MatchString(commitType) {
+			return fmt.Errorf("invalid require_types entry %q: must be a commit type such as feat", commitType)
+		}
+	}
+	return nil
+}
+
+func validateReleasePRSize(maxFiles, maxLines int, action string) error {
+	if maxFiles < 0 {
+		return fmt.Errorf("release_pr_max_files cannot be negative, got %d", maxFiles)
+	}
+	if maxLines < 0 {
+		return fmt.Errorf("release_pr_max_lines cannot be negative, got %d", maxLines)
+	}
+	switch strings.ToLower(strings.TrimSpace(action)) {
+	case "", "warn", "fail":
+		return nil
+	}
+	return fmt.Errorf("invalid release_pr_size_action: %s (must be one of: warn, fail)", action)
+}
+
+func validateVersionScheme(scheme, calverFormat string) error {
+	switch strings.ToLower(strings.TrimSpace(scheme)) {
+	case "", "semver":
+		return nil
+	case "calver":
+		if strings.TrimSpace(calverFormat) == "" {
+			return nil
+		}
+		if _, err := domain.ParseCalVerFormat(calverFormat); err != nil {
+			return fmt.Errorf("invalid calver_format: %w", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid version_scheme: %s (must be one of: semver, calver)", scheme)
+}
+
+func validateGoModuleMajorBump(mode string) error {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", "warn", "fail", "rewrite", "ignore":
+		return nil
+	}
+	return fmt.Errorf("invalid go_module_major_bump: %s (must be one of: warn, fail, rewrite, ignore)", mode)
+}
+
+// reservedCliffFlags are git-cliff flags releasepr controls itself; passing them through cliff_args
+// would change the output releasepr parses or duplicate a dedicated setting.
+var reservedCliffFlags = []string{
+	"-c", "--config",
+	"-w", "--workdir",
+	"-o", "--output",
+	"-p", "--prepend",
+	"-x", "--context",
+	"-u", "--unreleased",
+	"-t", "--tag",
+	"-s", "--strip",
+	"--bumped-version",
+}
+
+func validateCliffArgs(args []string) error {
+	for _, arg := range args {
+		trimmed := strings.TrimSpace(arg)
+		if trimmed == "" {
+			return fmt.Errorf("invalid cliff_args: entries cannot be empty")
+		}
+		flag, _, _ := strings.Cut(trimmed, "=")
+		if slices.Contains(reservedCliffFlags, flag) {
+			return fmt.Errorf("invalid cliff_args entry: %s is managed by releasepr", flag)
+		}
+	}
+	return nil
+}
+
+func validateSigning(mode, key string) error {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", "none", "keyless":
+		return nil
+	case "key":
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("signing: key requires cosign_key")
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid signing: %s (must be one of: none, keyless, key)", mode)
+}
+
+func validateProxyURL(proxyURL string) error {
+	if proxyURL == "" {
+		return nil
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return fmt.Errorf("invalid proxy_url: %w", err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("invalid proxy_url: %s (scheme must be http, https or socks5)", u.Redacted())
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid proxy_url: %s (missing host)", u.Redacted())
+	}
+	return nil
+}
+
+// TLSWarning returns an advisory message when certificate verification is disabled.
+func (c *Config) TLSWarning() string {
+	if !c.TLSInsecureSkipVerify {
+		return ""
+	}
+	return "tls_insecure_skip_verify disables certificate verification for GitHub and git traffic; " +
+		"configure ca_bundle with the proxy's CA instead"
+}
+
 func validateReleaseArtifacts(commands []ReleaseArtifactCommand) error {
 	for index, command := range commands {
 		label := fmt.Sprintf("release_artifacts[%d]", index)
@@ -147,6 +851,9 @@ func validateReleaseArtifacts(commands []ReleaseArtifactCommand) error {
 				return fmt.Errorf("%s.add[%d]: %w", label, addIndex, err)
 			}
 		}
+		if err := validateEnvVars(label+".env", command.Env); err != nil {
+			return err
+		}
 		if command.TimeoutSeconds == 0 {
 			continue
 		}
@@ -164,6 +871,201 @@ func validateReleaseArtifacts(commands []ReleaseArtifactCommand) error {
 	return nil
 }
 
+func validatePRLabels(labels []LabelConfig) error {
+	for index, label := range labels {
+		key := fmt.Sprintf("pr_labels[%d]", index)
+		if strings.TrimSpace(label.Name) == "" {
+			return fmt.Errorf("%s.name cannot be empty", key)
+		}
+		if _, err := domain.NormalizeLabelColor(label.Color); err != nil {
+			return fmt.Errorf("%s.color: %w", key, err)
+		}
+		if len([]rune(strings.TrimSpace(label.Description))) > maxLabelDescriptionLength {
+			return fmt.Errorf("%s.description must be at most %d characters", key, maxLabelDescriptionLength)
+		}
+	}
+	return nil
+}
+
+func validateReleaseChannels(channels []ReleaseChannelConfig) error {
+	for index, channel := range channels {
+		err := domain.ValidateReleaseChannel(domain.ReleaseChannel{
+			Name:   strings.TrimSpace(channel.Channel),
+			Branch: strings.TrimSpace(channel.Branch),
+		})
+		if err != nil {
+			return fmt.Errorf("release_channels[%d]: %w", index, err)
+		}
+	}
+	return nil
+}
+
+func validateDownstreamRepos(repos []DownstreamRepoConfig) error {
+	for index, downstream := range repos {
+		owner, repo, err := parseRepoSlug(downstream.Repo)
+		if err == nil {
+			err = ValidateGitHubOwnerRepo(owner, repo)
+		}
+		if err != nil {
+			return fmt.Errorf("downstream_repos[%d].repo: %q must be owner/name: %w", index, downstream.Repo, err)
+		}
+		if downstream.Manifest != "" && !insideRepository(downstream.Manifest) {
+			return fmt.Errorf("downstream_repos[%d].manifest: %q must be a relative path without ..",
+				index, downstream.Manifest)
+		}
+	}
+	return nil
+}
+
+func validateBlog(repo, dir, template string) error {
+	if repo != "" {
+		owner, name, err := parseRepoSlug(strings.TrimSpace(repo))
+		if err == nil {
+			err = ValidateGitHubOwnerRepo(owner, name)
+		}
+		if err != nil {
+			return fmt.Errorf("blog_repo: %q must be owner/name: %w", repo, err)
+		}
+	}
+	if dir != "" && !insideRepository(dir) {
+		return fmt.Errorf("blog_dir: %q must be a relative path without ..", dir)
+	}
+	return validateTemplatePath("blog_template", template)
+}
+
+func validateServiceEnv(services map[string][]EnvVar) error {
+	for _, name := range slices.Sorted(maps.Keys(services)) {
+		if !slices.Contains(domain.EnvServices, name) {
+			return fmt.Errorf("service_env.%s: unknown service, must be one of: %s",
+				name, strings.Join(domain.EnvServices, ", "))
+		}
+		if err := validateEnvVars("service_env."+name, services[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateEnvVars(key string, vars []EnvVar) error {
+	seen := make(map[string]bool, len(vars))
+	for index, envVar := range vars {
+		if !envVarName.MatchString(envVar.Name) {
+			return fmt.Errorf("%s[%d].name: %q is not a valid environment variable name", key, index, envVar.Name)
+		}
+		if seen[envVar.Name] {
+			return fmt.Errorf("%s[%d].name: %s is set more than once", key, index, envVar.Name)
+		}
+		seen[envVar.Name] = true
+	}
+	return nil
+}
+
+// ResolveEnv expands the references in the values of vars against the current environment and returns
+// them as KEY=value entries in config order. key names the setting in errors.
+func ResolveEnv(key string, vars []EnvVar) ([]string, error) {
+	env := make([]string, 0, len(vars))
+	for _, envVar := range vars {
+		value, err := interpolateString(key+"."+envVar.Name, envVar.Value)
+		if err != nil {
+			return nil, err
+		}
+		env = append(env, envVar.Name+"="+value)
+	}
+	return env, nil
+}
+
+// ServiceEnvironment resolves the extra environment configured for the external commands of name,
+// such as goreleaser or npm. It satisfies service.EnvResolver.
+func (c *Config) ServiceEnvironment(name string) ([]string, error) {
+	return ResolveEnv("service_env."+name, c.ServiceEnv[name])
+}
+
+// Hash fingerprints the effective configuration as "sha256:<hex>" so sessions can detect a config
+// change between runs. Tokens and log settings are left out, so rotating a credential or raising
+// the log level does not change the hash.
+func (c *Config) Hash() string {
+	clone := *c
+	clone.GithubToken, clone.NpmToken, clone.CargoToken, clone.PyPIToken = "", "", "", ""
+	clone.LogLevel, clone.LogFormat = "", ""
+	data, err := json.Marshal(clone)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func validateAllowedRepositories(repos []string) error {
+	for index, slug := range repos {
+		owner, repo, err := parseRepoSlug(strings.TrimSpace(slug))
+		if err == nil {
+			err = ValidateGitHubOwnerRepo(owner, repo)
+		}
+		if err != nil {
+			return fmt.Errorf("allowed_repositories[%d]: %q must be owner/name: %w", index, slug, err)
+		}
+	}
+	return nil
+}
+
+func validateSecurityLabels(labels []string) error {
+	for index, label := range labels {
+		if strings.TrimSpace(label) == "" {
+			return fmt.Errorf("security_labels[%d]: label must not be empty", index)
+		}
+	}
+	return nil
+}
+
+func validateSignOff(minApprovals int, requiredChecks []string) error {
+	if minApprovals < 0 {
+		return fmt.Errorf("signoff_min_approvals cannot be negative, got %d", minApprovals)
+	}
+	for index, check := range requiredChecks {
+		if strings.TrimSpace(check) == "" {
+			return fmt.Errorf("signoff_required_checks[%d]: check name must not be empty", index)
+		}
+	}
+	return nil
+}
+
+func validateChangelogDiff(mode string) error {
+	switch mode {
+	case "", "off", "log", "comment":
+		return nil
+	}
+	return fmt.Errorf("invalid changelog_diff: %s (must be one of: off, log, comment)", mode)
+}
+
+func validateRollbackMode(mode string) error {
+	switch mode {
+	case "", "fail-fast", "best-effort":
+		return nil
+	}
+	return fmt.Errorf("invalid rollback_mode: %s (must be one of: fail-fast, best-effort)", mode)
+}
+
+func validateToolsLock(lock map[string]string, action string) error {
+	for _, tool := range slices.Sorted(maps.Keys(lock)) {
+		if err := domain.ValidateToolPin(tool, lock[tool]); err != nil {
+			return fmt.Errorf("invalid tools_lock: %w", err)
+		}
+	}
+	switch strings.ToLower(strings.TrimSpace(action)) {
+	case "", "warn", "fail":
+		return nil
+	}
+	return fmt.Errorf("invalid tools_lock_action: %s (must be one of: warn, fail)", action)
+}
+
+func validateDryRunReport(report string) error {
+	switch strings.ToLower(strings.TrimSpace(report)) {
+	case "", "comment", "check-run", "both":
+		return nil
+	}
+	return fmt.Errorf("invalid dry_run_report: %s (must be one of: comment, check-run, both)", report)
+}
+
 func NormalizeReleaseArtifactCommand(command string) (string, error) {
 	switch strings.TrimSpace(command) {
 	case "bun":
@@ -224,6 +1126,19 @@ func ValidateGitHubToken(token string) error {
 	return nil
 }
 
+// GitHubTokenFormatWarning returns an advisory message when the token does not look like any known GitHub
+// token format. Unknown formats are still accepted because GitHub Enterprise and newer token types differ.
+func GitHubTokenFormatWarning(token string) string {
+	trimmed := strings.TrimSpace(token)
+	for _, pattern := range knownGitHubTokenPatterns {
+		if pattern.MatchString(trimmed) {
+			return ""
+		}
+	}
+	return "github token does not match a known GitHub token format; " +
+		"enable verify_github_token to confirm it against the API"
+}
+
 // ValidateGitHubOwnerRepo validates GitHub owner and repository names (exported for reuse).
 func ValidateGitHubOwnerRepo(owner, repo string) error {
 	if owner == "" {
@@ -267,6 +1182,441 @@ func bindEnvironmentVariables(v *viper.Viper) error {
 			"PR_RELEASE_GIT_PUSH_TIMEOUT_MINUTES",
 			"COMPOZY_RELEASE_GIT_PUSH_TIMEOUT_MINUTES",
 		},
+		"artifact_metadata_path": {
+			"ARTIFACT_METADATA_PATH",
+			"PR_RELEASE_ARTIFACT_METADATA_PATH",
+			"COMPOZY_RELEASE_ARTIFACT_METADATA_PATH",
+		},
+		"git_backend": {
+			"GIT_BACKEND",
+			"PR_RELEASE_GIT_BACKEND",
+			"COMPOZY_RELEASE_GIT_BACKEND",
+		},
+		"git_remote": {
+			"GIT_REMOTE",
+			"PR_RELEASE_GIT_REMOTE",
+			"COMPOZY_RELEASE_GIT_REMOTE",
+		},
+		"git_fetch_tags": {
+			"GIT_FETCH_TAGS",
+			"PR_RELEASE_GIT_FETCH_TAGS",
+			"COMPOZY_RELEASE_GIT_FETCH_TAGS",
+		},
+		"changelog_markdown_allowlist": {
+			"CHANGELOG_MARKDOWN_ALLOWLIST",
+			"PR_RELEASE_CHANGELOG_MARKDOWN_ALLOWLIST",
+			"COMPOZY_RELEASE_CHANGELOG_MARKDOWN_ALLOWLIST",
+		},
+		"release_changelog_audience": {
+			"RELEASE_CHANGELOG_AUDIENCE",
+			"PR_RELEASE_RELEASE_CHANGELOG_AUDIENCE",
+			"COMPOZY_RELEASE_RELEASE_CHANGELOG_AUDIENCE",
+		},
+		"changelog_file_audience": {
+			"CHANGELOG_FILE_AUDIENCE",
+			"PR_RELEASE_CHANGELOG_FILE_AUDIENCE",
+			"COMPOZY_RELEASE_CHANGELOG_FILE_AUDIENCE",
+		},
+		"public_changelog_exclude_types": {
+			"PUBLIC_CHANGELOG_EXCLUDE_TYPES",
+			"PR_RELEASE_PUBLIC_CHANGELOG_EXCLUDE_TYPES",
+			"COMPOZY_RELEASE_PUBLIC_CHANGELOG_EXCLUDE_TYPES",
+		},
+		"public_changelog_exclude_scopes": {
+			"PUBLIC_CHANGELOG_EXCLUDE_SCOPES",
+			"PR_RELEASE_PUBLIC_CHANGELOG_EXCLUDE_SCOPES",
+			"COMPOZY_RELEASE_PUBLIC_CHANGELOG_EXCLUDE_SCOPES",
+		},
+		"verify_github_token": {
+			"VERIFY_GITHUB_TOKEN",
+			"PR_RELEASE_VERIFY_GITHUB_TOKEN",
+			"COMPOZY_RELEASE_VERIFY_GITHUB_TOKEN",
+		},
+		"release_manifest_path": {
+			"RELEASE_MANIFEST_PATH",
+			"PR_RELEASE_RELEASE_MANIFEST_PATH",
+			"COMPOZY_RELEASE_RELEASE_MANIFEST_PATH",
+		},
+		"release_manifest_attach": {
+			"RELEASE_MANIFEST_ATTACH",
+			"PR_RELEASE_RELEASE_MANIFEST_ATTACH",
+			"COMPOZY_RELEASE_RELEASE_MANIFEST_ATTACH",
+		},
+		"release_notes_attach": {
+			"RELEASE_NOTES_ATTACH",
+			"PR_RELEASE_RELEASE_NOTES_ATTACH",
+			"COMPOZY_RELEASE_RELEASE_NOTES_ATTACH",
+		},
+		"skip_steps": {
+			"SKIP_STEPS",
+			"PR_RELEASE_SKIP_STEPS",
+			"COMPOZY_RELEASE_SKIP_STEPS",
+		},
+		"release_timezone": {
+			"RELEASE_TIMEZONE",
+			"PR_RELEASE_RELEASE_TIMEZONE",
+			"COMPOZY_RELEASE_RELEASE_TIMEZONE",
+		},
+		"release_date_format": {
+			"RELEASE_DATE_FORMAT",
+			"PR_RELEASE_RELEASE_DATE_FORMAT",
+			"COMPOZY_RELEASE_RELEASE_DATE_FORMAT",
+		},
+		"request_codeowner_reviews": {
+			"REQUEST_CODEOWNER_REVIEWS",
+			"PR_RELEASE_REQUEST_CODEOWNER_REVIEWS",
+			"COMPOZY_RELEASE_REQUEST_CODEOWNER_REVIEWS",
+		},
+		"fallback_reviewers": {
+			"FALLBACK_REVIEWERS",
+			"PR_RELEASE_FALLBACK_REVIEWERS",
+			"COMPOZY_RELEASE_FALLBACK_REVIEWERS",
+		},
+		"go_module_major_bump": {
+			"GO_MODULE_MAJOR_BUMP",
+			"PR_RELEASE_GO_MODULE_MAJOR_BUMP",
+			"COMPOZY_RELEASE_GO_MODULE_MAJOR_BUMP",
+		},
+		"cliff_config": {
+			"CLIFF_CONFIG",
+			"PR_RELEASE_CLIFF_CONFIG",
+			"COMPOZY_RELEASE_CLIFF_CONFIG",
+		},
+		"cliff_workdir": {
+			"CLIFF_WORKDIR",
+			"PR_RELEASE_CLIFF_WORKDIR",
+			"COMPOZY_RELEASE_CLIFF_WORKDIR",
+		},
+		"cliff_args": {
+			"CLIFF_ARGS",
+			"PR_RELEASE_CLIFF_ARGS",
+			"COMPOZY_RELEASE_CLIFF_ARGS",
+		},
+		"snapshot_build_metadata": {
+			"SNAPSHOT_BUILD_METADATA",
+			"PR_RELEASE_SNAPSHOT_BUILD_METADATA",
+			"COMPOZY_RELEASE_SNAPSHOT_BUILD_METADATA",
+		},
+		"release_stats": {
+			"RELEASE_STATS",
+			"PR_RELEASE_RELEASE_STATS",
+			"COMPOZY_RELEASE_RELEASE_STATS",
+		},
+		"signing": {
+			"SIGNING",
+			"PR_RELEASE_SIGNING",
+			"COMPOZY_RELEASE_SIGNING",
+		},
+		"cosign_key": {
+			"COSIGN_KEY",
+			"PR_RELEASE_COSIGN_KEY",
+			"COMPOZY_RELEASE_COSIGN_KEY",
+		},
+		"proxy_url": {
+			"PROXY_URL",
+			"PR_RELEASE_PROXY_URL",
+			"COMPOZY_RELEASE_PROXY_URL",
+		},
+		"ca_bundle": {
+			"CA_BUNDLE",
+			"PR_RELEASE_CA_BUNDLE",
+			"COMPOZY_RELEASE_CA_BUNDLE",
+		},
+		"tls_insecure_skip_verify": {
+			"TLS_INSECURE_SKIP_VERIFY",
+			"PR_RELEASE_TLS_INSECURE_SKIP_VERIFY",
+			"COMPOZY_RELEASE_TLS_INSECURE_SKIP_VERIFY",
+		},
+		"issue_links": {
+			"ISSUE_LINKS",
+			"PR_RELEASE_ISSUE_LINKS",
+			"COMPOZY_RELEASE_ISSUE_LINKS",
+		},
+		"github_cache_dir": {
+			"GITHUB_CACHE_DIR",
+			"PR_RELEASE_GITHUB_CACHE_DIR",
+			"COMPOZY_RELEASE_GITHUB_CACHE_DIR",
+		},
+		"pr_body_template": {
+			"PR_BODY_TEMPLATE",
+			"PR_RELEASE_PR_BODY_TEMPLATE",
+			"COMPOZY_RELEASE_PR_BODY_TEMPLATE",
+		},
+		"release_notes_template": {
+			"RELEASE_NOTES_TEMPLATE",
+			"PR_RELEASE_RELEASE_NOTES_TEMPLATE",
+			"COMPOZY_RELEASE_RELEASE_NOTES_TEMPLATE",
+		},
+		"strict": {
+			"STRICT",
+			"PR_RELEASE_STRICT",
+			"COMPOZY_RELEASE_STRICT",
+		},
+		"change_detection": {
+			"CHANGE_DETECTION",
+			"PR_RELEASE_CHANGE_DETECTION",
+			"COMPOZY_RELEASE_CHANGE_DETECTION",
+		},
+		"release_pr_max_files": {
+			"RELEASE_PR_MAX_FILES",
+			"PR_RELEASE_RELEASE_PR_MAX_FILES",
+			"COMPOZY_RELEASE_RELEASE_PR_MAX_FILES",
+		},
+		"release_pr_max_lines": {
+			"RELEASE_PR_MAX_LINES",
+			"PR_RELEASE_RELEASE_PR_MAX_LINES",
+			"COMPOZY_RELEASE_RELEASE_PR_MAX_LINES",
+		},
+		"release_pr_size_action": {
+			"RELEASE_PR_SIZE_ACTION",
+			"PR_RELEASE_RELEASE_PR_SIZE_ACTION",
+			"COMPOZY_RELEASE_RELEASE_PR_SIZE_ACTION",
+		},
+		"release_pr_exclude": {
+			"RELEASE_PR_EXCLUDE",
+			"PR_RELEASE_RELEASE_PR_EXCLUDE",
+			"COMPOZY_RELEASE_RELEASE_PR_EXCLUDE",
+		},
+		"version_scheme": {
+			"VERSION_SCHEME",
+			"PR_RELEASE_VERSION_SCHEME",
+			"COMPOZY_RELEASE_VERSION_SCHEME",
+		},
+		"calver_format": {
+			"CALVER_FORMAT",
+			"PR_RELEASE_CALVER_FORMAT",
+			"COMPOZY_RELEASE_CALVER_FORMAT",
+		},
+		"readme_version_patterns": {
+			"README_VERSION_PATTERNS",
+			"PR_RELEASE_README_VERSION_PATTERNS",
+			"COMPOZY_RELEASE_README_VERSION_PATTERNS",
+		},
+		"submodule_bump": {
+			"SUBMODULE_BUMP",
+			"PR_RELEASE_SUBMODULE_BUMP",
+			"COMPOZY_RELEASE_SUBMODULE_BUMP",
+		},
+		"otlp_endpoint": {
+			"OTLP_ENDPOINT",
+			"PR_RELEASE_OTLP_ENDPOINT",
+			"COMPOZY_RELEASE_OTLP_ENDPOINT",
+		},
+		"release_locale": {
+			"RELEASE_LOCALE",
+			"PR_RELEASE_RELEASE_LOCALE",
+			"COMPOZY_RELEASE_RELEASE_LOCALE",
+		},
+		"release_locale_file": {
+			"RELEASE_LOCALE_FILE",
+			"PR_RELEASE_RELEASE_LOCALE_FILE",
+			"COMPOZY_RELEASE_RELEASE_LOCALE_FILE",
+		},
+		"tools_lock_action": {
+			"TOOLS_LOCK_ACTION",
+			"PR_RELEASE_TOOLS_LOCK_ACTION",
+			"COMPOZY_RELEASE_TOOLS_LOCK_ACTION",
+		},
+		"pr_body_merged_prs": {
+			"PR_BODY_MERGED_PRS",
+			"PR_RELEASE_PR_BODY_MERGED_PRS",
+			"COMPOZY_RELEASE_PR_BODY_MERGED_PRS",
+		},
+		"pr_body_header": {
+			"PR_BODY_HEADER",
+			"PR_RELEASE_PR_BODY_HEADER",
+			"COMPOZY_RELEASE_PR_BODY_HEADER",
+		},
+		"pr_body_footer": {
+			"PR_BODY_FOOTER",
+			"PR_RELEASE_PR_BODY_FOOTER",
+			"COMPOZY_RELEASE_PR_BODY_FOOTER",
+		},
+		"release_header_template": {
+			"RELEASE_HEADER_TEMPLATE",
+			"PR_RELEASE_RELEASE_HEADER_TEMPLATE",
+			"COMPOZY_RELEASE_RELEASE_HEADER_TEMPLATE",
+		},
+		"release_footer_template": {
+			"RELEASE_FOOTER_TEMPLATE",
+			"PR_RELEASE_RELEASE_FOOTER_TEMPLATE",
+			"COMPOZY_RELEASE_RELEASE_FOOTER_TEMPLATE",
+		},
+		"state_backend": {
+			"STATE_BACKEND",
+			"PR_RELEASE_STATE_BACKEND",
+			"COMPOZY_RELEASE_STATE_BACKEND",
+		},
+		"state_db_path": {
+			"STATE_DB_PATH",
+			"PR_RELEASE_STATE_DB_PATH",
+			"COMPOZY_RELEASE_STATE_DB_PATH",
+		},
+		"dry_run_report": {
+			"DRY_RUN_REPORT",
+			"PR_RELEASE_DRY_RUN_REPORT",
+			"COMPOZY_RELEASE_DRY_RUN_REPORT",
+		},
+		"dry_run_npm_check": {
+			"DRY_RUN_NPM_CHECK",
+			"PR_RELEASE_DRY_RUN_NPM_CHECK",
+			"COMPOZY_RELEASE_DRY_RUN_NPM_CHECK",
+		},
+		"commit_skip_authors": {
+			"COMMIT_SKIP_AUTHORS",
+			"PR_RELEASE_COMMIT_SKIP_AUTHORS",
+			"COMPOZY_RELEASE_COMMIT_SKIP_AUTHORS",
+		},
+		"commit_skip_messages": {
+			"COMMIT_SKIP_MESSAGES",
+			"PR_RELEASE_COMMIT_SKIP_MESSAGES",
+			"COMPOZY_RELEASE_COMMIT_SKIP_MESSAGES",
+		},
+		"commit_skip_paths": {
+			"COMMIT_SKIP_PATHS",
+			"PR_RELEASE_COMMIT_SKIP_PATHS",
+			"COMPOZY_RELEASE_COMMIT_SKIP_PATHS",
+		},
+		"release_comment_prs": {
+			"RELEASE_COMMENT_PRS",
+			"PR_RELEASE_RELEASE_COMMENT_PRS",
+			"COMPOZY_RELEASE_RELEASE_COMMENT_PRS",
+		},
+		"release_comment_issues": {
+			"RELEASE_COMMENT_ISSUES",
+			"PR_RELEASE_RELEASE_COMMENT_ISSUES",
+			"COMPOZY_RELEASE_RELEASE_COMMENT_ISSUES",
+		},
+		"min_commits": {
+			"MIN_COMMITS",
+			"PR_RELEASE_MIN_COMMITS",
+			"COMPOZY_RELEASE_MIN_COMMITS",
+		},
+		"require_types": {
+			"REQUIRE_TYPES",
+			"PR_RELEASE_REQUIRE_TYPES",
+			"COMPOZY_RELEASE_REQUIRE_TYPES",
+		},
+		"changelog_style": {
+			"CHANGELOG_STYLE",
+			"PR_RELEASE_CHANGELOG_STYLE",
+			"COMPOZY_RELEASE_CHANGELOG_STYLE",
+		},
+		"failure_issue": {
+			"FAILURE_ISSUE",
+			"PR_RELEASE_FAILURE_ISSUE",
+			"COMPOZY_RELEASE_FAILURE_ISSUE",
+		},
+		"release_captain": {
+			"RELEASE_CAPTAIN",
+			"PR_RELEASE_RELEASE_CAPTAIN",
+			"COMPOZY_RELEASE_RELEASE_CAPTAIN",
+		},
+		"cargo_crates": {
+			"CARGO_CRATES",
+			"PR_RELEASE_CARGO_CRATES",
+			"COMPOZY_RELEASE_CARGO_CRATES",
+		},
+		"cargo_registry": {
+			"CARGO_REGISTRY",
+			"PR_RELEASE_CARGO_REGISTRY",
+			"COMPOZY_RELEASE_CARGO_REGISTRY",
+		},
+		"cargo_token": {
+			"CARGO_REGISTRY_TOKEN",
+			"PR_RELEASE_CARGO_TOKEN",
+			"COMPOZY_RELEASE_CARGO_TOKEN",
+		},
+		"pypi_packages": {
+			"PYPI_PACKAGES",
+			"PR_RELEASE_PYPI_PACKAGES",
+			"COMPOZY_RELEASE_PYPI_PACKAGES",
+		},
+		"pypi_build_command": {
+			"PYPI_BUILD_COMMAND",
+			"PR_RELEASE_PYPI_BUILD_COMMAND",
+			"COMPOZY_RELEASE_PYPI_BUILD_COMMAND",
+		},
+		"pypi_repository_url": {
+			"PYPI_REPOSITORY_URL",
+			"PR_RELEASE_PYPI_REPOSITORY_URL",
+			"COMPOZY_RELEASE_PYPI_REPOSITORY_URL",
+		},
+		"pypi_token": {
+			"PYPI_TOKEN",
+			"PR_RELEASE_PYPI_TOKEN",
+			"COMPOZY_RELEASE_PYPI_TOKEN",
+		},
+		"impact_package": {
+			"IMPACT_PACKAGE",
+			"PR_RELEASE_IMPACT_PACKAGE",
+			"COMPOZY_RELEASE_IMPACT_PACKAGE",
+		},
+		"security_section": {
+			"SECURITY_SECTION",
+			"PR_RELEASE_SECURITY_SECTION",
+			"COMPOZY_RELEASE_SECURITY_SECTION",
+		},
+		"security_labels": {
+			"SECURITY_LABELS",
+			"PR_RELEASE_SECURITY_LABELS",
+			"COMPOZY_RELEASE_SECURITY_LABELS",
+		},
+		"signoff_min_approvals": {
+			"SIGNOFF_MIN_APPROVALS",
+			"PR_RELEASE_SIGNOFF_MIN_APPROVALS",
+			"COMPOZY_RELEASE_SIGNOFF_MIN_APPROVALS",
+		},
+		"signoff_required_checks": {
+			"SIGNOFF_REQUIRED_CHECKS",
+			"PR_RELEASE_SIGNOFF_REQUIRED_CHECKS",
+			"COMPOZY_RELEASE_SIGNOFF_REQUIRED_CHECKS",
+		},
+		"signoff_override": {
+			"SIGNOFF_OVERRIDE",
+			"PR_RELEASE_SIGNOFF_OVERRIDE",
+			"COMPOZY_RELEASE_SIGNOFF_OVERRIDE",
+		},
+		"changelog_diff": {
+			"CHANGELOG_DIFF",
+			"PR_RELEASE_CHANGELOG_DIFF",
+			"COMPOZY_RELEASE_CHANGELOG_DIFF",
+		},
+		"rollback_mode": {
+			"ROLLBACK_MODE",
+			"PR_RELEASE_ROLLBACK_MODE",
+			"COMPOZY_RELEASE_ROLLBACK_MODE",
+		},
+		"allowed_repositories": {
+			"ALLOWED_REPOSITORIES",
+			"PR_RELEASE_ALLOWED_REPOSITORIES",
+			"COMPOZY_RELEASE_ALLOWED_REPOSITORIES",
+		},
+		"promote_soak_hours": {
+			"PROMOTE_SOAK_HOURS",
+			"PR_RELEASE_PROMOTE_SOAK_HOURS",
+			"COMPOZY_RELEASE_PROMOTE_SOAK_HOURS",
+		},
+		"blog_repo": {
+			"BLOG_REPO",
+			"PR_RELEASE_BLOG_REPO",
+			"COMPOZY_RELEASE_BLOG_REPO",
+		},
+		"blog_base_branch": {
+			"BLOG_BASE_BRANCH",
+			"PR_RELEASE_BLOG_BASE_BRANCH",
+			"COMPOZY_RELEASE_BLOG_BASE_BRANCH",
+		},
+		"blog_dir": {
+			"BLOG_DIR",
+			"PR_RELEASE_BLOG_DIR",
+			"COMPOZY_RELEASE_BLOG_DIR",
+		},
+		"blog_template": {
+			"BLOG_TEMPLATE",
+			"PR_RELEASE_BLOG_TEMPLATE",
+			"COMPOZY_RELEASE_BLOG_TEMPLATE",
+		},
 	}
 	for key, envs := range bindings {
 		if err := v.BindEnv(append([]string{key}, envs...)...); err != nil {
@@ -282,9 +1632,122 @@ func setConfigDefaults(v *viper.Viper) {
 	v.SetDefault("log_level", defaults.LogLevel)
 	v.SetDefault("log_format", defaults.LogFormat)
 	v.SetDefault("git_push_timeout_minutes", defaults.GitPushTimeoutMinutes)
+	v.SetDefault("artifact_metadata_path", defaults.ArtifactMetadataPath)
+	v.SetDefault("git_backend", defaults.GitBackend)
+	v.SetDefault("git_remote", defaults.GitRemote)
+	v.SetDefault("git_fetch_tags", defaults.GitFetchTags)
+	v.SetDefault("changelog_markdown_allowlist", defaults.ChangelogMarkdownAllowlist)
+	v.SetDefault("release_changelog_audience", defaults.ReleaseChangelogAudience)
+	v.SetDefault("changelog_file_audience", defaults.ChangelogFileAudience)
+	v.SetDefault("public_changelog_exclude_types", defaults.PublicExcludeTypes)
+	v.SetDefault("public_changelog_exclude_scopes", defaults.PublicExcludeScopes)
+	v.SetDefault("verify_github_token", defaults.VerifyGithubToken)
+	v.SetDefault("release_manifest_path", defaults.ReleaseManifestPath)
+	v.SetDefault("release_manifest_attach", defaults.AttachReleaseManifest)
+	v.SetDefault("release_notes_attach", defaults.AttachReleaseNotes)
+	v.SetDefault("skip_steps", defaults.SkipSteps)
+	v.SetDefault("release_timezone", defaults.ReleaseTimezone)
+	v.SetDefault("release_date_format", defaults.ReleaseDateFormat)
+	v.SetDefault("request_codeowner_reviews", defaults.RequestCodeOwnerReviews)
+	v.SetDefault("fallback_reviewers", defaults.FallbackReviewers)
+	v.SetDefault("go_module_major_bump", defaults.GoModuleMajorBump)
+	v.SetDefault("cliff_config", defaults.CliffConfigPath)
+	v.SetDefault("cliff_workdir", defaults.CliffWorkdir)
+	v.SetDefault("cliff_args", defaults.CliffArgs)
+	v.SetDefault("snapshot_build_metadata", defaults.SnapshotBuildMetadata)
+	v.SetDefault("release_stats", defaults.ReleaseStats)
+	v.SetDefault("signing", defaults.Signing)
+	v.SetDefault("cosign_key", defaults.CosignKey)
+	v.SetDefault("proxy_url", defaults.ProxyURL)
+	v.SetDefault("ca_bundle", defaults.CABundle)
+	v.SetDefault("tls_insecure_skip_verify", defaults.TLSInsecureSkipVerify)
+	v.SetDefault("issue_links", defaults.IssueLinks)
+	v.SetDefault("github_cache_dir", defaults.GitHubCacheDir)
+	v.SetDefault("pr_body_template", defaults.PRBodyTemplate)
+	v.SetDefault("release_notes_template", defaults.ReleaseNotesTemplate)
+	v.SetDefault("strict", defaults.Strict)
+	v.SetDefault("change_detection", defaults.ChangeDetection)
+	v.SetDefault("release_pr_max_files", defaults.ReleasePRMaxFiles)
+	v.SetDefault("release_pr_max_lines", defaults.ReleasePRMaxLines)
+	v.SetDefault("release_pr_size_action", defaults.ReleasePRSizeAction)
+	v.SetDefault("release_pr_exclude", defaults.ReleasePRExclude)
+	v.SetDefault("version_scheme", defaults.VersionScheme)
+	v.SetDefault("calver_format", defaults.CalVerFormat)
+	v.SetDefault("readme_version_patterns", defaults.ReadmeVersionPatterns)
+	v.SetDefault("submodule_bump", defaults.SubmoduleBump)
+	v.SetDefault("otlp_endpoint", defaults.OTLPEndpoint)
+	v.SetDefault("release_locale", defaults.ReleaseLocale)
+	v.SetDefault("release_locale_file", defaults.ReleaseLocaleFile)
+	v.SetDefault("tools_lock_action", defaults.ToolsLockAction)
+	v.SetDefault("pr_body_merged_prs", defaults.PRBodyMergedPRs)
+	v.SetDefault("pr_body_footer", defaults.PRBodyFooter)
+	v.SetDefault("release_header_template", defaults.ReleaseHeaderTemplate)
+	v.SetDefault("release_footer_template", defaults.ReleaseFooterTemplate)
+	v.SetDefault("state_backend", defaults.StateBackend)
+	v.SetDefault("state_db_path", defaults.StateDBPath)
+	v.SetDefault("dry_run_report", defaults.DryRunReport)
+	v.SetDefault("dry_run_npm_check", defaults.DryRunNPMCheck)
+	v.SetDefault("commit_skip_authors", defaults.CommitSkipAuthors)
+	v.SetDefault("commit_skip_messages", defaults.CommitSkipMessages)
+	v.SetDefault("commit_skip_paths", defaults.CommitSkipPaths)
+	v.SetDefault("release_comment_prs", defaults.ReleaseCommentPRs)
+	v.SetDefault("release_comment_issues", defaults.ReleaseCommentIssues)
+	v.SetDefault("min_commits", defaults.MinCommits)
+	v.SetDefault("require_types", defaults.RequireTypes)
+	v.SetDefault("changelog_style", defaults.ChangelogStyle)
+	v.SetDefault("failure_issue", defaults.FailureIssue)
+	v.SetDefault("release_captain", defaults.ReleaseCaptain)
+	v.SetDefault("cargo_crates", defaults.CargoCrates)
+	v.SetDefault("cargo_registry", defaults.CargoRegistry)
+	v.SetDefault("pypi_packages", defaults.PyPIPackages)
+	v.SetDefault("pypi_build_command", defaults.PyPIBuildCommand)
+	v.SetDefault("pypi_repository_url", defaults.PyPIRepositoryURL)
+	v.SetDefault("impact_package", defaults.ImpactPackage)
+	v.SetDefault("security_section", defaults.SecuritySection)
+	v.SetDefault("security_labels", defaults.SecurityLabels)
+	v.SetDefault("signoff_min_approvals", defaults.SignOffMinApprovals)
+	v.SetDefault("signoff_required_checks", defaults.SignOffRequiredChecks)
+	v.SetDefault("signoff_override", defaults.SignOffOverride)
+	v.SetDefault("changelog_diff", defaults.ChangelogDiff)
+	v.SetDefault("rollback_mode", defaults.RollbackMode)
+	v.SetDefault("allowed_repositories", defaults.AllowedRepositories)
+	v.SetDefault("promote_soak_hours", defaults.PromoteSoakHours)
+	v.SetDefault("blog_repo", defaults.BlogRepo)
+	v.SetDefault("blog_base_branch", defaults.BlogBaseBranch)
+	v.SetDefault("blog_dir", defaults.BlogDir)
+	v.SetDefault("blog_template", defaults.BlogTemplate)
 }
 
+// EnvConfigFile names an explicit config file when --config is not given.
+const EnvConfigFile = "PR_RELEASE_CONFIG"
+
+// LoadConfig loads the config file named by PR_RELEASE_CONFIG, or the first config file candidate
+// found in the working directory.
 func LoadConfig() (*Config, error) {
+	return LoadConfigFile("")
+}
+
+// LoadConfigFile loads the config from path, falling back to PR_RELEASE_CONFIG when path is empty and
+// to the config file candidates of the working directory when both are. An explicit file must exist,
+// so CI can keep the config outside the repository checkout without silently running on defaults.
+func LoadConfigFile(path string) (*Config, error) {
+	cfg, err := LoadToolConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.ResolveRepository(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// LoadToolConfigFile loads the config like LoadConfigFile but neither detects the GitHub repository nor
+// validates the config, for the commands that manage the pr-release binary outside a repository checkout.
+func LoadToolConfigFile(path string) (*Config, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		path = strings.TrimSpace(os.Getenv(EnvConfigFile))
+	}
 	v := viper.New()
 	v.SetConfigType("yaml")
 	v.AddConfigPath(".")
@@ -294,27 +1757,52 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 	setConfigDefaults(v)
+	if err := readConfigFile(v, path); err != nil {
+		return nil, err
+	}
+	if err := interpolateConfigFile(v); err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// ResolveRepository completes a config loaded with LoadToolConfigFile with the GitHub repository of the
+// working directory and validates it.
+func (c *Config) ResolveRepository() error {
+	if err := populateRepositoryDefaults(c); err != nil {
+		return fmt.Errorf("repository detection failed: %w", err)
+	}
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf("config validation failed: %w", err)
+	}
+	return nil
+}
+
+// readConfigFile reads the config file at path, or the first config file candidate found when path
+// is empty; finding no candidate leaves the defaults.
+func readConfigFile(v *viper.Viper, path string) error {
+	if path != "" {
+		v.SetConfigFile(path)
+		if err := v.ReadInConfig(); err != nil {
+			return fmt.Errorf("failed to read config file %s: %w", path, err)
+		}
+		return nil
+	}
 	for _, name := range configFileCandidates {
 		v.SetConfigName(name)
 		if err := v.ReadInConfig(); err != nil {
 			if _, ok := err.(viper.ConfigFileNotFoundError); ok {
 				continue
 			}
-			return nil, err
+			return err
 		}
 		break
 	}
-	var cfg Config
-	if err := v.Unmarshal(&cfg); err != nil {
-		return nil, err
-	}
-	if err := populateRepositoryDefaults(&cfg); err != nil {
-		return nil, fmt.Errorf("repository detection failed: %w", err)
-	}
-	if err := cfg.Validate(); err != nil {
-		return nil, fmt.Errorf("config validation failed: %w", err)
-	}
-	return &cfg, nil
+	return nil
 }
 
 func populateRepositoryDefaults(cfg *Config) error {
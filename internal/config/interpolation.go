@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// envReference matches ${NAME} and ${NAME:-default} references in config values, and the $$ escape
+// that keeps a literal dollar sign.
+var envReference = regexp.MustCompile(`\$\$|\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// interpolateConfigFile expands the environment variable references in the values of the config file
+// read into v. Environment variables and defaults are not expanded, and neither are tokens, which
+// are read from the environment as they are, nor the extra environment of external commands, which
+// is expanded when the command runs. A reference to an unset variable without a default fails
+// the load, naming the key and the variable.
+func interpolateConfigFile(v *viper.Viper) error {
+	path := v.ConfigFileUsed()
+	if path == "" {
+		return nil
+	}
+	file := viper.New()
+	file.SetConfigFile(path)
+	file.SetConfigType("yaml")
+	if err := file.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+	settings := file.AllSettings()
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if skipInterpolation(key) {
+			continue
+		}
+		expanded, err := interpolateValue(key, settings[key])
+		if err != nil {
+			return err
+		}
+		settings[key] = expanded
+	}
+	return v.MergeConfigMap(settings)
+}
+
+// interpolateValue expands the references in every string of value, descending into lists and maps.
+func interpolateValue(key string, value any) (any, error) {
+	switch typed := value.(type) {
+	case string:
+		return interpolateString(key, typed)
+	case []any:
+		for i, item := range typed {
+			expanded, err := interpolateValue(fmt.Sprintf("%s[%d]", key, i), item)
+			if err != nil {
+				return nil, err
+			}
+			typed[i] = expanded
+		}
+		return typed, nil
+	case map[string]any:
+		for name, item := range typed {
+			if skipInterpolation(name) {
+				continue
+			}
+			expanded, err := interpolateValue(key+"."+name, item)
+			if err != nil {
+				return nil, err
+			}
+			typed[name] = expanded
+		}
+		return typed, nil
+	default:
+		return value, nil
+	}
+}
+
+// skipInterpolation reports whether the value of the setting name is left as written at load time:
+// tokens, service_env and the env of release artifact commands.
+func skipInterpolation(name string) bool {
+	return strings.HasSuffix(name, "_token") || name == "service_env" || name == "env"
+}
+
+func interpolateString(key, value string) (string, error) {
+	var missing []string
+	expanded := envReference.ReplaceAllStringFunc(value, func(reference string) string {
+		if reference == "$$" {
+			return "$"
+		}
+		match := envReference.FindStringSubmatch(reference)
+		if env, ok := os.LookupEnv(match[1]); ok && env != "" {
+			return env
+		}
+		if match[2] != "" {
+			return match[3]
+		}
+		missing = append(missing, match[1])
+		return reference
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("config value %s references unset or empty environment variable %s "+
+			"(set it, or give a default with ${%s:-default})", key, strings.Join(missing, ", "), missing[0])
+	}
+	return expanded, nil
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "strings"
+
+// GitHub Actions annotation levels.
+const (
+	// AnnotationError is the level of annotations that fail a check.
+	AnnotationError = "error"
+	// AnnotationWarning is the level of annotations that flag a problem without failing a check.
+	AnnotationWarning = "warning"
+	// AnnotationNotice is the level of informational annotations.
+	AnnotationNotice = "notice"
+)
+
+// Annotation is a GitHub Actions workflow command that surfaces a message in the checks UI.
+type Annotation struct {
+	Level   string
+	File    string
+	Title   string
+	Message string
+}
+
+var (
+	annotationDataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	annotationPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+// String renders the workflow command, e.g. "::error file=go.mod,title=Failed::message".
+func (a Annotation) String() string {
+	var properties []string
+	if a.File != "" {
+		properties = append(properties, "file="+annotationPropertyEscaper.Replace(a.File))
+	}
+	if a.Title != "" {
+		properties = append(properties, "title="+annotationPropertyEscaper.Replace(a.Title))
+	}
+	command := "::" + a.Level
+	if len(properties) > 0 {
+		command += " " + strings.Join(properties, ",")
+	}
+	return command + "::" + annotationDataEscaper.Replace(a.Message)
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotation_String(t *testing.T) {
+	t.Run("Should render the file and title properties", func(t *testing.T) {
+		annotation := Annotation{
+			Level:   AnnotationError,
+			File:    "CHANGELOG.md",
+			Title:   "Changelog failed",
+			Message: "boom",
+		}
+		assert.Equal(t, "::error file=CHANGELOG.md,title=Changelog failed::boom", annotation.String())
+	})
+	t.Run("Should escape properties and multi-line messages", func(t *testing.T) {
+		annotation := Annotation{
+			Level:   AnnotationError,
+			Title:   "Push Branch: failed, 100%",
+			Message: "failed to push\n\nRemediation: retry 50%",
+		}
+		assert.Equal(t,
+			"::error title=Push Branch%3A failed%2C 100%25::failed to push%0A%0ARemediation: retry 50%25",
+			annotation.String())
+	})
+	t.Run("Should omit empty properties", func(t *testing.T) {
+		assert.Equal(t, "::error::boom", Annotation{Level: AnnotationError, Message: "boom"}.String())
+	})
+}
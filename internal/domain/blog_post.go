@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// blogVersionHeadingPattern matches the version heading git-cliff opens release notes with, such as
+// "1.4.0 - 2026-07-17" or "[v1.4.0]".
+var blogVersionHeadingPattern = regexp.MustCompile(`^\[?v?\d+\.\d+\.\d+`)
+
+// BlogPost is the draft blog post of a release, built from its release notes.
+type BlogPost struct {
+	Title   string
+	Version string
+	Date    string // publication date of the draft, YYYY-MM-DD
+	Slug    string // file name of the post without extension
+	// Summary is the hero paragraph: the introduction of the release notes, or a count of the
+	// changes per section when they have none
+	Summary    string
+	Sections   []BlogPostSection
+	ReleaseURL string
+}
+
+// BlogPostSection is a section of the release notes carried into the blog post.
+type BlogPostSection struct {
+	Title string
+	Body  string
+}
+
+// NewBlogPost builds the blog post of version of owner/repo from its release notes.
+func NewBlogPost(owner, repo, version, date, notes string) BlogPost {
+	intro, sections := parseBlogPostSections(notes)
+	return BlogPost{
+		Title:      fmt.Sprintf("%s %s", repo, version),
+		Version:    version,
+		Date:       date,
+		Slug:       BlogPostSlug(repo, version),
+		Summary:    blogPostSummary(repo, version, intro, sections),
+		Sections:   sections,
+		ReleaseURL: ReleaseURL(owner, repo, version),
+	}
+}
+
+// BlogPostSlug returns the slug of the blog post of a release, such as releasepr-1-4-0 for v1.4.0.
+func BlogPostSlug(repo, version string) string {
+	slug := strings.ToLower(repo + "-" + strings.TrimPrefix(version, "v"))
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '-'
+	}, slug)
+}
+
+// parseBlogPostSections splits release notes at their ## and ### headings. The version heading the
+// notes open with is dropped; text before the first section is returned as the introduction.
+func parseBlogPostSections(notes string) (string, []BlogPostSection) {
+	var intro strings.Builder
+	var sections []BlogPostSection
+	body := &intro
+	flush := func() {
+		if len(sections) > 0 {
+			sections[len(sections)-1].Body = strings.TrimSpace(body.String())
+		}
+	}
+	for line := range strings.Lines(strings.ReplaceAll(notes, "\r\n", "\n")) {
+		title, ok := blogSectionHeading(line)
+		if !ok {
+			body.WriteString(line)
+			continue
+		}
+		if len(sections) == 0 && intro.Len() == 0 && blogVersionHeadingPattern.MatchString(title) {
+			continue
+		}
+		flush()
+		sections = append(sections, BlogPostSection{Title: title})
+		body = &strings.Builder{}
+	}
+	flush()
+	return strings.TrimSpace(intro.String()), sections
+}
+
+// blogSectionHeading returns the title of a ## or ### heading line.
+func blogSectionHeading(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	for _, prefix := range []string{"### ", "## "} {
+		if title, ok := strings.CutPrefix(trimmed, prefix); ok {
+			return strings.TrimSpace(title), true
+		}
+	}
+	return "", false
+}
+
+// blogPostSummary returns the first paragraph of intro, or counts the list items of the sections.
+func blogPostSummary(repo, version, intro string, sections []BlogPostSection) string {
+	if intro != "" {
+		paragraph, _, _ := strings.Cut(intro, "\n\n")
+		return strings.TrimSpace(paragraph)
+	}
+	changes := 0
+	names := make([]string, 0, len(sections))
+	for _, section := range sections {
+		for line := range strings.Lines(section.Body) {
+			trimmed := strings.TrimSpace(line)
+			if strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") {
+				changes++
+			}
+		}
+		names = append(names, strings.TrimLeftFunc(section.Title, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}))
+	}
+	if changes == 0 {
+		return fmt.Sprintf("%s %s is out.", repo, version)
+	}
+	noun := "changes"
+	if changes == 1 {
+		noun = "change"
+	}
+	return fmt.Sprintf("%s %s ships %d %s in %s.", repo, version, changes, noun, joinBlogNames(names))
+}
+
+// joinBlogNames joins names as "A", "A and B" or "A, B and C".
+func joinBlogNames(names []string) string {
+	if len(names) <= 1 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBlogPost(t *testing.T) {
+	t.Run("Should split the release notes into sections and count their changes", func(t *testing.T) {
+		notes := "## 1.4.0 - 2026-07-17\n\n### 🚀 Features\n\n- Add blog posts\n- Add self-update\n\n" +
+			"### 🐛 Bug Fixes\n\n- Reopen pull request\n"
+		post := NewBlogPost("compozy", "releasepr", "v1.4.0", "2026-07-18", notes)
+		assert.Equal(t, "releasepr v1.4.0", post.Title)
+		assert.Equal(t, "releasepr-1-4-0", post.Slug)
+		assert.Equal(t, "https://github.com/compozy/releasepr/releases/tag/v1.4.0", post.ReleaseURL)
+		assert.Equal(t, "releasepr v1.4.0 ships 3 changes in Features and Bug Fixes.", post.Summary)
+		assert.Equal(t, []BlogPostSection{
+			{Title: "🚀 Features", Body: "- Add blog posts\n- Add self-update"},
+			{Title: "🐛 Bug Fixes", Body: "- Reopen pull request"},
+		}, post.Sections)
+	})
+	t.Run("Should use the introduction of the notes as the summary", func(t *testing.T) {
+		notes := "This release adds blog posts.\n\nMore details below.\n\n## Highlights\n\nBlog posts."
+		post := NewBlogPost("compozy", "releasepr", "v1.4.0", "2026-07-18", notes)
+		assert.Equal(t, "This release adds blog posts.", post.Summary)
+		assert.Equal(t, []BlogPostSection{{Title: "Highlights", Body: "Blog posts."}}, post.Sections)
+	})
+	t.Run("Should announce releases without changes", func(t *testing.T) {
+		post := NewBlogPost("compozy", "releasepr", "v1.4.0", "2026-07-18", "## [v1.4.0]\n")
+		assert.Equal(t, "releasepr v1.4.0 is out.", post.Summary)
+		assert.Empty(t, post.Sections)
+	})
+}
+
+func TestBlogPostSlug(t *testing.T) {
+	t.Run("Should keep letters and digits only", func(t *testing.T) {
+		assert.Equal(t, "releasepr-2-0-0-rc-1", BlogPostSlug("releasepr", "v2.0.0-rc.1"))
+		assert.Equal(t, "my-site-1-0-0", BlogPostSlug("My_Site", "1.0.0"))
+	})
+}
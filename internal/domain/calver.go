@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// CalVer formats. Components are never zero-padded because semver forbids leading zeros, so
+// October 2026 renders as 2026.10.0 and 5 March 2026 as 26.3.5.
+const (
+	// CalVerYearMonthMicro numbers releases within a month: 2026.10.0, 2026.10.1, 2026.11.0.
+	CalVerYearMonthMicro = "YYYY.MM.MICRO"
+	// CalVerShortYearMonthDay names releases after their day, allowing one release per day.
+	CalVerShortYearMonthDay = "YY.MM.DD"
+)
+
+const shortYearModulo = 100
+
+// ParseCalVerFormat normalizes a CalVer format value.
+func ParseCalVerFormat(value string) (string, error) {
+	switch format := strings.ToUpper(strings.TrimSpace(value)); format {
+	case CalVerYearMonthMicro, CalVerShortYearMonthDay:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid calver format: %q (must be one of: %s, %s)",
+			value, CalVerYearMonthMicro, CalVerShortYearMonthDay)
+	}
+}
+
+// NextCalVer returns the CalVer release for date that follows latest. A nil latest stands for the
+// first release. A YY.MM.DD release on the day of latest returns latest itself, which reads as
+// "nothing new to release", like a semver bump without releasable commits.
+func NextCalVer(format string, date time.Time, latest *Version) (*Version, error) {
+	parsed, err := ParseCalVerFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	year, month, day := date.Date()
+	var next *semver.Version
+	if parsed == CalVerShortYearMonthDay {
+		next = semver.New(uint64(year%shortYearModulo), uint64(month), uint64(day), "", "")
+	} else {
+		micro := uint64(0)
+		if latest != nil && latest.Major() == uint64(year) && latest.Minor() == uint64(month) {
+			micro = latest.Patch() + 1
+		}
+		next = semver.New(uint64(year), uint64(month), micro, "", "")
+	}
+	if latest != nil && next.LessThan(latest.Version) {
+		return nil, fmt.Errorf("calver version %s would precede latest release %s", next, latest)
+	}
+	return &Version{next}, nil
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextCalVer(t *testing.T) {
+	date := time.Date(2026, time.March, 5, 10, 0, 0, 0, time.UTC)
+	mustVersion := func(t *testing.T, value string) *Version {
+		t.Helper()
+		version, err := NewVersion(value)
+		require.NoError(t, err)
+		return version
+	}
+	t.Run("Should increment the micro component within a month", func(t *testing.T) {
+		next, err := NextCalVer(CalVerYearMonthMicro, date, mustVersion(t, "v2026.3.4"))
+		require.NoError(t, err)
+		assert.Equal(t, "v2026.3.5", next.String())
+	})
+	t.Run("Should restart the micro component in a new month", func(t *testing.T) {
+		next, err := NextCalVer(CalVerYearMonthMicro, date, mustVersion(t, "v2026.2.7"))
+		require.NoError(t, err)
+		assert.Equal(t, "v2026.3.0", next.String())
+		next, err = NextCalVer("yyyy.mm.micro", date, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "v2026.3.0", next.String())
+	})
+	t.Run("Should name short releases after the day without padding", func(t *testing.T) {
+		next, err := NextCalVer(CalVerShortYearMonthDay, date, mustVersion(t, "v1.4.0"))
+		require.NoError(t, err)
+		assert.Equal(t, "v26.3.5", next.String())
+	})
+	t.Run("Should return the latest release on the same day", func(t *testing.T) {
+		next, err := NextCalVer(CalVerShortYearMonthDay, date, mustVersion(t, "v26.3.5"))
+		require.NoError(t, err)
+		assert.Equal(t, "v26.3.5", next.String())
+	})
+	t.Run("Should refuse versions older than the latest release", func(t *testing.T) {
+		_, err := NextCalVer(CalVerShortYearMonthDay, date, mustVersion(t, "v2025.1.0"))
+		assert.ErrorContains(t, err, "would precede latest release")
+	})
+	t.Run("Should reject unknown formats", func(t *testing.T) {
+		_, err := NextCalVer("YYYY.WW", date, nil)
+		assert.ErrorContains(t, err, "invalid calver format")
+	})
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BumpLevel is the semver component a pending change file increments.
+type BumpLevel string
+
+const (
+	BumpLevelPatch BumpLevel = "patch"
+	BumpLevelMinor BumpLevel = "minor"
+	BumpLevelMajor BumpLevel = "major"
+)
+
+// orderedBumpLevels lists bump levels from the most to the least significant.
+var orderedBumpLevels = []BumpLevel{BumpLevelMajor, BumpLevelMinor, BumpLevelPatch}
+
+// ParseBumpLevel validates and normalizes a bump level value.
+func ParseBumpLevel(value string) (BumpLevel, error) {
+	normalized := BumpLevel(strings.TrimSpace(strings.ToLower(value)))
+	switch normalized {
+	case BumpLevelPatch, BumpLevelMinor, BumpLevelMajor:
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("invalid bump level: %q (must be one of: major, minor, patch)", value)
+	}
+}
+
+// Heading returns the changelog section heading for changes of the level.
+func (l BumpLevel) Heading() string {
+	switch l {
+	case BumpLevelMajor:
+		return "### Major Changes"
+	case BumpLevelMinor:
+		return "### Minor Changes"
+	default:
+		return "### Patch Changes"
+	}
+}
+
+// Label returns the release PR label of the level, such as release:minor.
+func (l BumpLevel) Label() string {
+	return "release:" + string(l)
+}
+
+// ChangeFile is one pending entry from the .changes directory.
+type ChangeFile struct {
+	Bump       BumpLevel
+	Summary    string
+	SourcePath string
+}
+
+// ChangeFiles is the set of pending change files that make up the next release.
+type ChangeFiles []ChangeFile
+
+// Bump returns the most significant bump level of the change files, or patch when there are none.
+func (c ChangeFiles) Bump() BumpLevel {
+	for _, level := range orderedBumpLevels {
+		for _, file := range c {
+			if file.Bump == level {
+				return level
+			}
+		}
+	}
+	return BumpLevelPatch
+}
+
+// Paths returns the source paths of the change files.
+func (c ChangeFiles) Paths() []string {
+	paths := make([]string, 0, len(c))
+	for _, file := range c {
+		paths = append(paths, file.SourcePath)
+	}
+	return paths
+}
+
+// RenderChangelog renders the release section of the changelog, grouping summaries by bump level in
+// the same "## <version> - <date>" heading git-cliff uses.
+func (c ChangeFiles) RenderChangelog(version, date string) string {
+	var builder strings.Builder
+	builder.WriteString("## " + strings.TrimPrefix(version, "v"))
+	if date != "" {
+		builder.WriteString(" - " + date)
+	}
+	for _, level := range orderedBumpLevels {
+		first := true
+		for _, file := range c {
+			if file.Bump != level {
+				continue
+			}
+			if first {
+				builder.WriteString("\n\n" + level.Heading() + "\n")
+				first = false
+			}
+			builder.WriteString("\n- " + indentListItem(file.Summary))
+		}
+	}
+	return builder.String() + "\n"
+}
+
+// indentListItem indents continuation lines so a multi-line summary stays inside its list item.
+func indentListItem(summary string) string {
+	lines := strings.Split(strings.TrimSpace(summary), "\n")
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != "" {
+			lines[i] = "  " + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBumpLevel(t *testing.T) {
+	t.Run("Should normalize valid levels", func(t *testing.T) {
+		level, err := ParseBumpLevel(" Minor ")
+		require.NoError(t, err)
+		assert.Equal(t, BumpLevelMinor, level)
+	})
+	t.Run("Should reject unknown levels", func(t *testing.T) {
+		_, err := ParseBumpLevel("huge")
+		assert.ErrorContains(t, err, "invalid bump level")
+	})
+}
+
+func TestChangeFiles_Bump(t *testing.T) {
+	t.Run("Should return the most significant level", func(t *testing.T) {
+		files := ChangeFiles{{Bump: BumpLevelPatch}, {Bump: BumpLevelMinor}, {Bump: BumpLevelPatch}}
+		assert.Equal(t, BumpLevelMinor, files.Bump())
+	})
+	t.Run("Should default to patch", func(t *testing.T) {
+		assert.Equal(t, BumpLevelPatch, ChangeFiles{}.Bump())
+	})
+}
+
+func TestChangeFiles_RenderChangelog(t *testing.T) {
+	t.Run("Should group summaries by level under the release heading", func(t *testing.T) {
+		files := ChangeFiles{
+			{Bump: BumpLevelPatch, Summary: "Fix retries"},
+			{Bump: BumpLevelMajor, Summary: "Drop the v1 API\n\nUse v2 endpoints instead."},
+			{Bump: BumpLevelPatch, Summary: "Fix typo"},
+		}
+		assert.Equal(t, "## 2.0.0 - 2026-01-02\n\n"+
+			"### Major Changes\n\n"+
+			"- Drop the v1 API\n\n  Use v2 endpoints instead.\n\n"+
+			"### Patch Changes\n\n"+
+			"- Fix retries\n"+
+			"- Fix typo\n", files.RenderChangelog("v2.0.0", "2026-01-02"))
+	})
+}
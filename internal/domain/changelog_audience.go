@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ChangelogAudience selects which flavor of the changelog a document receives.
+type ChangelogAudience string
+
+const (
+	// ChangelogAudienceInternal renders every commit.
+	ChangelogAudienceInternal ChangelogAudience = "internal"
+	// ChangelogAudiencePublic renders the curated changelog without maintenance commits.
+	ChangelogAudiencePublic ChangelogAudience = "public"
+)
+
+var conventionalSubjectPattern = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^)]*)\))?(!)?:`)
+
+// ParseChangelogAudience validates and normalizes a changelog audience name; empty means internal.
+func ParseChangelogAudience(value string) (ChangelogAudience, error) {
+	normalized := ChangelogAudience(strings.TrimSpace(strings.ToLower(value)))
+	switch normalized {
+	case "":
+		return ChangelogAudienceInternal, nil
+	case ChangelogAudienceInternal, ChangelogAudiencePublic:
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("invalid changelog audience: %s", value)
+	}
+}
+
+// CommitFilter excludes conventional commits from a changelog by type or scope.
+type CommitFilter struct {
+	ExcludeTypes  []string
+	ExcludeScopes []string
+}
+
+// IsZero reports whether the filter keeps every commit.
+func (f CommitFilter) IsZero() bool {
+	return len(f.ExcludeTypes) == 0 && len(f.ExcludeScopes) == 0
+}
+
+// Excludes reports whether the commit with the given subject is filtered out. Breaking changes and
+// subjects that are not conventional commits are always kept.
+func (f CommitFilter) Excludes(subject string) bool {
+	match := conventionalSubjectPattern.FindStringSubmatch(strings.TrimSpace(subject))
+	if match == nil || match[3] != "" {
+		return false
+	}
+	if containsFold(f.ExcludeTypes, match[1]) {
+		return true
+	}
+	for scope := range strings.SplitSeq(match[2], ",") {
+		if scope = strings.TrimSpace(scope); scope != "" && containsFold(f.ExcludeScopes, scope) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, target string) bool {
+	return slices.ContainsFunc(values, func(value string) bool { return strings.EqualFold(value, target) })
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChangelogAudience(t *testing.T) {
+	t.Run("Should normalize known audiences", func(t *testing.T) {
+		for value, expected := range map[string]ChangelogAudience{
+			"":         ChangelogAudienceInternal,
+			"internal": ChangelogAudienceInternal,
+			" Public ": ChangelogAudiencePublic,
+			"INTERNAL": ChangelogAudienceInternal,
+		} {
+			audience, err := ParseChangelogAudience(value)
+			require.NoError(t, err, value)
+			assert.Equal(t, expected, audience, value)
+		}
+	})
+	t.Run("Should reject unknown audiences", func(t *testing.T) {
+		_, err := ParseChangelogAudience("everyone")
+		assert.ErrorContains(t, err, "invalid changelog audience: everyone")
+	})
+}
+
+func TestCommitFilter_Excludes(t *testing.T) {
+	filter := CommitFilter{ExcludeTypes: []string{"chore", "ci", "test"}, ExcludeScopes: []string{"internal"}}
+	t.Run("Should exclude commits by type and scope", func(t *testing.T) {
+		for _, subject := range []string{
+			"chore: bump deps",
+			"CI(actions): pin runner",
+			"test: cover parser",
+			"feat(internal): add debug endpoint",
+			"fix(api, internal): tighten check",
+		} {
+			assert.True(t, filter.Excludes(subject), subject)
+		}
+	})
+	t.Run("Should keep public, breaking and unconventional commits", func(t *testing.T) {
+		for _, subject := range []string{
+			"feat: add export",
+			"fix(api): handle nil",
+			"chore!: drop Node 16",
+			"feat(internal)!: remove legacy flag",
+			"Update README",
+			"choreography: unrelated",
+		} {
+			assert.False(t, filter.Excludes(subject), subject)
+		}
+	})
+	t.Run("Should keep every commit when empty", func(t *testing.T) {
+		assert.True(t, CommitFilter{}.IsZero())
+		assert.False(t, CommitFilter{}.Excludes("chore: bump deps"))
+	})
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// ChangelogDiff lists the changelog entries a release PR update adds and removes, so reviewers see
+// what changed since they last reviewed the PR.
+type ChangelogDiff struct {
+	Added   []string
+	Removed []string
+}
+
+// DiffChangelog compares the changelog of a release PR update with the previous body of the PR.
+// Entries are the list items of the documents: an entry of changelog that the previous body does not
+// list is added, and an entry of the previous body that the new body no longer lists is removed.
+func DiffChangelog(previousBody, changelog, body string) ChangelogDiff {
+	previous := changelogEntries(previousBody)
+	current := changelogEntries(body)
+	var diff ChangelogDiff
+	for _, entry := range changelogEntries(changelog) {
+		if !slices.Contains(previous, entry) {
+			diff.Added = append(diff.Added, entry)
+		}
+	}
+	for _, entry := range previous {
+		if !slices.Contains(current, entry) {
+			diff.Removed = append(diff.Removed, entry)
+		}
+	}
+	return diff
+}
+
+// IsEmpty reports whether the update leaves the changelog unchanged.
+func (d ChangelogDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0
+}
+
+// Markdown renders the diff as a pull request comment, or returns "" when it is empty.
+func (d ChangelogDiff) Markdown() string {
+	if d.IsEmpty() {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("### Changelog changes since the last update\n")
+	for _, section := range []struct {
+		title   string
+		entries []string
+	}{{"Added", d.Added}, {"Removed", d.Removed}} {
+		if len(section.entries) == 0 {
+			continue
+		}
+		b.WriteString("\n**" + section.title + "**\n\n")
+		for _, entry := range section.entries {
+			b.WriteString("- " + entry + "\n")
+		}
+	}
+	return b.String()
+}
+
+// changelogEntries returns the distinct list items of document without their markers, skipping
+// fenced code blocks.
+func changelogEntries(document string) []string {
+	var entries []string
+	fenced := false
+	for line := range strings.SplitSeq(document, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			fenced = !fenced
+			continue
+		}
+		if fenced {
+			continue
+		}
+		entry, ok := strings.CutPrefix(trimmed, "- ")
+		if !ok {
+			entry, ok = strings.CutPrefix(trimmed, "* ")
+		}
+		entry = strings.TrimSpace(entry)
+		if ok && entry != "" && !slices.Contains(entries, entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffChangelog(t *testing.T) {
+	previous := "## Changelog\n\n### Features\n\n- add export (abc123)\n- add import (def456)\n\n" +
+		"## Checklist\n\n- [ ] Review the changelog\n"
+	t.Run("Should list the entries added and removed since the previous body", func(t *testing.T) {
+		changelog := "### Features\n\n- add export (abc123)\n- add search (fed789)\n"
+		body := "## Changelog\n\n" + changelog + "\n## Checklist\n\n- [ ] Review the changelog\n"
+		diff := DiffChangelog(previous, changelog, body)
+		assert.Equal(t, []string{"add search (fed789)"}, diff.Added)
+		assert.Equal(t, []string{"add import (def456)"}, diff.Removed)
+		assert.Equal(t, "### Changelog changes since the last update\n\n**Added**\n\n- add search (fed789)\n"+
+			"\n**Removed**\n\n- add import (def456)\n", diff.Markdown())
+	})
+	t.Run("Should report no changes for the same changelog", func(t *testing.T) {
+		changelog := "### Features\n\n- add export (abc123)\n- add import (def456)\n"
+		diff := DiffChangelog(previous, changelog, previous)
+		assert.True(t, diff.IsEmpty())
+		assert.Empty(t, diff.Markdown())
+	})
+	t.Run("Should ignore list items in fenced code blocks", func(t *testing.T) {
+		changelog := "- add export (abc123)\n```yaml\n- not an entry\n```\n"
+		diff := DiffChangelog("", changelog, changelog)
+		assert.Equal(t, []string{"add export (abc123)"}, diff.Added)
+		assert.Empty(t, diff.Removed)
+	})
+}
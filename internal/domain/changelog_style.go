@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// ChangelogStyle is a profile for the section titles of generated changelogs. The zero style keeps
+// the titles as rendered by git-cliff or by change files.
+type ChangelogStyle string
+
+const (
+	// ChangelogStyleEmoji prefixes the titles with an emoji, like the default cliff.toml.
+	ChangelogStyleEmoji ChangelogStyle = "emoji"
+	// ChangelogStylePlain uses the same titles without emoji.
+	ChangelogStylePlain ChangelogStyle = "plain"
+	// ChangelogStyleKeepAChangelog folds every group into the Keep a Changelog categories, merging
+	// the groups that share one.
+	ChangelogStyleKeepAChangelog ChangelogStyle = "keep-a-changelog"
+)
+
+// changelogGroup is a changelog section and its title in every style. The plain title identifies
+// the group whatever style rendered it.
+type changelogGroup struct {
+	plain          string
+	emoji          string
+	keepAChangelog string
+}
+
+// changelogGroups lists the groups of the default cliff.toml and of change files.
+var changelogGroups = []changelogGroup{
+	{plain: "Features", emoji: "🎉 Features", keepAChangelog: "Added"},
+	{plain: "Bug Fixes", emoji: "🐛 Bug Fixes", keepAChangelog: "Fixed"},
+	{plain: "Performance Improvements", emoji: "⚡ Performance Improvements", keepAChangelog: "Changed"},
+	{plain: "Security", emoji: "🔒 Security", keepAChangelog: "Security"},
+	{plain: "Documentation", emoji: "📚 Documentation", keepAChangelog: "Changed"},
+	{plain: "Build System", emoji: "📦 Build System", keepAChangelog: "Changed"},
+	{plain: "CI/CD", emoji: "🔧 CI/CD", keepAChangelog: "Changed"},
+	{plain: "Refactoring", emoji: "♻️ Refactoring", keepAChangelog: "Changed"},
+	{plain: "Testing", emoji: "🧪 Testing", keepAChangelog: "Changed"},
+	{plain: "Dependencies", emoji: "📦 Dependencies", keepAChangelog: "Changed"},
+	{plain: "Style", emoji: "💅 Style", keepAChangelog: "Changed"},
+	{plain: "Miscellaneous Tasks", emoji: "🔧 Miscellaneous Tasks", keepAChangelog: "Changed"},
+	{plain: "Reverts", emoji: "⏪ Reverts", keepAChangelog: "Removed"},
+	{plain: "Other Changes", emoji: "📝 Other Changes", keepAChangelog: "Changed"},
+	{plain: "Major Changes", emoji: "💥 Major Changes", keepAChangelog: "Changed"},
+	{plain: "Minor Changes", emoji: "✨ Minor Changes", keepAChangelog: "Added"},
+	{plain: "Patch Changes", emoji: "🩹 Patch Changes", keepAChangelog: "Fixed"},
+}
+
+// keepAChangelogOrder is the order of the Keep a Changelog categories within a release.
+var keepAChangelogOrder = []string{"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"}
+
+// ParseChangelogStyle validates and normalizes a changelog_style value. An empty value is the zero style.
+func ParseChangelogStyle(value string) (ChangelogStyle, error) {
+	normalized := ChangelogStyle(strings.TrimSpace(strings.ToLower(value)))
+	switch normalized {
+	case "", ChangelogStyleEmoji, ChangelogStylePlain, ChangelogStyleKeepAChangelog:
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("invalid changelog style: %q (must be one of: emoji, plain, keep-a-changelog)", value)
+	}
+}
+
+// Apply retitles the group headings of a rendered changelog in the style. Headings of unknown groups
+// are kept, and fenced code blocks are skipped. The Keep a Changelog style also merges the groups of
+// a release that share a category and orders them as the specification does.
+func (s ChangelogStyle) Apply(document string) string {
+	if s == "" {
+		return document
+	}
+	body := strings.TrimRight(document, "\n")
+	lines := strings.Split(body, "\n")
+	fenced := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+			continue
+		}
+		if fenced || !strings.HasPrefix(line, "### ") {
+			continue
+		}
+		if group, ok := findChangelogGroup(line); ok {
+			lines[i] = "### " + s.title(group)
+		}
+	}
+	if s == ChangelogStyleKeepAChangelog {
+		lines = mergeChangelogGroups(lines)
+	}
+	return strings.Join(lines, "\n") + document[len(body):]
+}
+
+func (s ChangelogStyle) title(group changelogGroup) string {
+	switch s {
+	case ChangelogStyleEmoji:
+		return group.emoji
+	case ChangelogStyleKeepAChangelog:
+		return group.keepAChangelog
+	default:
+		return group.plain
+	}
+}
+
+// findChangelogGroup returns the group of a heading by its plain title, ignoring a leading emoji.
+func findChangelogGroup(heading string) (changelogGroup, bool) {
+	text := strings.TrimLeft(heading, "#")
+	start := strings.IndexFunc(text, unicode.IsLetter)
+	if start < 0 {
+		return changelogGroup{}, false
+	}
+	title := strings.TrimSpace(text[start:])
+	for _, group := range changelogGroups {
+		if strings.EqualFold(title, group.plain) {
+			return group, true
+		}
+	}
+	return changelogGroup{}, false
+}
+
+// changelogSubsection is a group heading and the list items under it.
+type changelogSubsection struct {
+	title string
+	items []string
+}
+
+// mergeChangelogGroups merges every run of group sections sharing a title into one section and sorts
+// the run by keepAChangelogOrder. A run ends at a release heading or at a line that is not a list item.
+func mergeChangelogGroups(lines []string) []string {
+	var merged []string
+	for i := 0; i < len(lines); {
+		if !strings.HasPrefix(lines[i], "### ") {
+			merged = append(merged, lines[i])
+			i++
+			continue
+		}
+		var run []*changelogSubsection
+		for i < len(lines) && strings.HasPrefix(lines[i], "### ") {
+			title := strings.TrimSpace(strings.TrimPrefix(lines[i], "### "))
+			index := slices.IndexFunc(run, func(s *changelogSubsection) bool { return s.title == title })
+			if index < 0 {
+				run = append(run, &changelogSubsection{title: title})
+				index = len(run) - 1
+			}
+			for i++; i < len(lines) && isChangelogItemLine(lines[i]); i++ {
+				if strings.TrimSpace(lines[i]) != "" {
+					run[index].items = append(run[index].items, lines[i])
+				}
+			}
+		}
+		slices.SortStableFunc(run, func(a, b *changelogSubsection) int {
+			return keepAChangelogRank(a.title) - keepAChangelogRank(b.title)
+		})
+		for _, subsection := range run {
+			merged = append(merged, "### "+subsection.title, "")
+			merged = append(merged, subsection.items...)
+			merged = append(merged, "")
+		}
+		if i == len(lines) {
+			merged = merged[:len(merged)-1]
+		}
+	}
+	return merged
+}
+
+// isChangelogItemLine reports whether a line belongs to the list of a group section: a list item,
+// an indented continuation, or a blank line.
+func isChangelogItemLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed == "" || strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ") ||
+		strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+}
+
+// keepAChangelogRank orders the Keep a Changelog categories, with other titles last.
+func keepAChangelogRank(title string) int {
+	if index := slices.Index(keepAChangelogOrder, title); index >= 0 {
+		return index
+	}
+	return len(keepAChangelogOrder)
+}
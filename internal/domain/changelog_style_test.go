@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const styleChangelog = "## 1.2.0 - 2026-10-16\n\n### 🐛 Bug Fixes\n\n- Retry uploads\n\n" +
+	"### 📚 Documentation\n\n- Add guide\n\n### 🎉 Features\n\n- Add doctor\n  - **BREAKING:** drop v1\n\n" +
+	"### ♻️  Refactoring\n\n- Split config\n\n### Custom\n\n- Kept\n\n" +
+	"## 1.1.0 - 2026-09-01\n\n### Features\n\n- Old\n"
+
+func TestParseChangelogStyle(t *testing.T) {
+	t.Run("Should normalize known styles", func(t *testing.T) {
+		style, err := ParseChangelogStyle(" Keep-A-Changelog ")
+		require.NoError(t, err)
+		assert.Equal(t, ChangelogStyleKeepAChangelog, style)
+		style, err = ParseChangelogStyle("")
+		require.NoError(t, err)
+		assert.Empty(t, style)
+	})
+	t.Run("Should reject unknown styles", func(t *testing.T) {
+		_, err := ParseChangelogStyle("fancy")
+		require.ErrorContains(t, err, "invalid changelog style")
+	})
+}
+
+func TestChangelogStyle_Apply(t *testing.T) {
+	t.Run("Should keep the rendered titles without a style", func(t *testing.T) {
+		assert.Equal(t, styleChangelog, ChangelogStyle("").Apply(styleChangelog))
+	})
+	t.Run("Should drop emoji in the plain style", func(t *testing.T) {
+		assert.Equal(t, "## 1.2.0 - 2026-10-16\n\n### Bug Fixes\n\n- Retry uploads\n\n"+
+			"### Documentation\n\n- Add guide\n\n### Features\n\n- Add doctor\n  - **BREAKING:** drop v1\n\n"+
+			"### Refactoring\n\n- Split config\n\n### Custom\n\n- Kept\n\n"+
+			"## 1.1.0 - 2026-09-01\n\n### Features\n\n- Old\n",
+			ChangelogStylePlain.Apply(styleChangelog))
+	})
+	t.Run("Should add emoji to plain titles in the emoji style", func(t *testing.T) {
+		assert.Equal(t, "### 🩹 Patch Changes\n\n- Fix\n", ChangelogStyleEmoji.Apply("### Patch Changes\n\n- Fix\n"))
+	})
+	t.Run("Should merge and order groups by Keep a Changelog category", func(t *testing.T) {
+		assert.Equal(t, "## 1.2.0 - 2026-10-16\n\n### Added\n\n- Add doctor\n  - **BREAKING:** drop v1\n\n"+
+			"### Changed\n\n- Add guide\n- Split config\n\n### Fixed\n\n- Retry uploads\n\n### Custom\n\n- Kept\n\n"+
+			"## 1.1.0 - 2026-09-01\n\n### Added\n\n- Old\n",
+			ChangelogStyleKeepAChangelog.Apply(styleChangelog))
+	})
+	t.Run("Should leave headings inside code blocks alone", func(t *testing.T) {
+		document := "```\n### Features\n```\n"
+		assert.Equal(t, document, ChangelogStylePlain.Apply(document))
+	})
+}
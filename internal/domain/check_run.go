@@ -0,0 +1,41 @@
+package domain
+
+// Conclusions of a completed check run.
+const (
+	CheckConclusionSuccess = "success"
+	CheckConclusionFailure = "failure"
+	CheckConclusionNeutral = "neutral"
+	CheckConclusionSkipped = "skipped"
+)
+
+// Levels of a check run annotation.
+const (
+	AnnotationLevelNotice  = "notice"
+	AnnotationLevelWarning = "warning"
+	AnnotationLevelFailure = "failure"
+)
+
+// MaxCheckAnnotations is how many annotations GitHub accepts in a single check run request.
+const MaxCheckAnnotations = 50
+
+// CheckRun is a completed GitHub check run. Title and Summary show at the top of the Checks tab;
+// Text holds the detailed markdown report.
+type CheckRun struct {
+	Name        string
+	HeadSHA     string
+	Conclusion  string
+	Title       string
+	Summary     string
+	Text        string
+	Annotations []CheckAnnotation
+}
+
+// CheckAnnotation flags a line of a repository file in the check run and the pull request diff.
+type CheckAnnotation struct {
+	Path      string
+	StartLine int
+	EndLine   int
+	Level     string
+	Title     string
+	Message   string
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// CodeOwnersLocations lists where GitHub looks for a CODEOWNERS file, in lookup order.
+var CodeOwnersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// CodeOwners is a parsed CODEOWNERS file. Later rules take precedence over earlier ones.
+type CodeOwners struct {
+	rules []codeOwnersRule
+}
+
+type codeOwnersRule struct {
+	pattern *regexp.Regexp
+	owners  []string
+}
+
+// Reviewers holds the users and team slugs to request reviews from.
+type Reviewers struct {
+	Users []string
+	Teams []string
+}
+
+// IsEmpty reports whether there is nobody to request a review from.
+func (r Reviewers) IsEmpty() bool {
+	return len(r.Users) == 0 && len(r.Teams) == 0
+}
+
+// Add records an owner reference such as "@user", "user", "@org/team" or "org/team".
+// Email owners are ignored because GitHub cannot request reviews by email.
+func (r *Reviewers) Add(owner string) {
+	owner = strings.TrimPrefix(strings.TrimSpace(owner), "@")
+	if owner == "" || strings.Contains(owner, "@") {
+		return
+	}
+	if _, team, ok := strings.Cut(owner, "/"); ok {
+		if team != "" && !slices.Contains(r.Teams, team) {
+			r.Teams = append(r.Teams, team)
+		}
+		return
+	}
+	if !slices.ContainsFunc(r.Users, func(user string) bool { return strings.EqualFold(user, owner) }) {
+		r.Users = append(r.Users, owner)
+	}
+}
+
+// ParseCodeOwners parses CODEOWNERS content. Lines with patterns that cannot be compiled are skipped.
+func ParseCodeOwners(content string) CodeOwners {
+	var owners CodeOwners
+	for line := range strings.SplitSeq(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if index := strings.Index(line, " #"); index >= 0 {
+			line = line[:index]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		pattern, err := codeOwnersPattern(fields[0])
+		if err != nil {
+			continue
+		}
+		owners.rules = append(owners.rules, codeOwnersRule{pattern: pattern, owners: fields[1:]})
+	}
+	return owners
+}
+
+// Owners returns the owners of path according to the last matching rule.
+func (c CodeOwners) Owners(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+	for _, rule := range slices.Backward(c.rules) {
+		if rule.pattern.MatchString(path) {
+			return rule.owners
+		}
+	}
+	return nil
+}
+
+// ReviewersFor collects the owners of every path.
+func (c CodeOwners) ReviewersFor(paths []string) Reviewers {
+	var reviewers Reviewers
+	for _, path := range paths {
+		for _, owner := range c.Owners(path) {
+			reviewers.Add(owner)
+		}
+	}
+	return reviewers
+}
+
+// codeOwnersPattern translates a gitignore-style CODEOWNERS pattern into a regular expression.
+func codeOwnersPattern(pattern string) (*regexp.Regexp, error) {
+	directoryOnly := strings.HasSuffix(pattern, "/")
+	trimmed := strings.TrimSuffix(pattern, "/")
+	anchored := strings.HasPrefix(trimmed, "/") || strings.Contains(trimmed, "/")
+	trimmed = strings.TrimPrefix(trimmed, "/")
+	var expr strings.Builder
+	expr.WriteString("^")
+	if !anchored {
+		expr.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(trimmed); i++ {
+		switch {
+		case strings.HasPrefix(trimmed[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += len("**/") - 1
+		case strings.HasPrefix(trimmed[i:], "**"):
+			expr.WriteString(".*")
+			i += len("**") - 1
+		case trimmed[i] == '*':
+			expr.WriteString("[^/]*")
+		case trimmed[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(trimmed[i])))
+		}
+	}
+	if directoryOnly {
+		expr.WriteString("/.*$")
+	} else {
+		expr.WriteString("(?:/.*)?$")
+	}
+	return regexp.Compile(expr.String())
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeOwners(t *testing.T) {
+	codeOwners := ParseCodeOwners(`# Release tooling
+*                   @compozy/maintainers
+*.md                @compozy/docs docs@compozy.com
+/CHANGELOG.md       @release-bot # owned by the release captain
+packages/**/package.json @compozy/frontend @alice
+/internal/          @bob
+/internal/generated
+`)
+	t.Run("Should apply the last matching rule", func(t *testing.T) {
+		assert.Equal(t, []string{"@release-bot"}, codeOwners.Owners("CHANGELOG.md"))
+		assert.Equal(t, []string{"@compozy/docs", "docs@compozy.com"}, codeOwners.Owners("docs/guide.md"))
+		assert.Equal(t, []string{"@compozy/maintainers"}, codeOwners.Owners("go.mod"))
+	})
+	t.Run("Should match anchored directories and double-star patterns", func(t *testing.T) {
+		assert.Equal(t, []string{"@bob"}, codeOwners.Owners("internal/config/config.go"))
+		assert.Equal(t, []string{"@compozy/frontend", "@alice"}, codeOwners.Owners("packages/ui/web/package.json"))
+		assert.Equal(t, []string{"@compozy/maintainers"}, codeOwners.Owners("tools/internal/main.go"))
+	})
+	t.Run("Should treat rules without owners as unowned", func(t *testing.T) {
+		assert.Empty(t, codeOwners.Owners("internal/generated/types.go"))
+	})
+	t.Run("Should collect deduplicated users and team slugs", func(t *testing.T) {
+		reviewers := codeOwners.ReviewersFor([]string{"CHANGELOG.md", "README.md", "docs/a.md", "package.json"})
+		assert.Equal(t, []string{"release-bot"}, reviewers.Users)
+		assert.Equal(t, []string{"docs", "maintainers"}, reviewers.Teams)
+	})
+}
+
+func TestReviewers_Add(t *testing.T) {
+	t.Run("Should accept owners with or without the @ prefix and skip emails", func(t *testing.T) {
+		var reviewers Reviewers
+		assert.True(t, reviewers.IsEmpty())
+		for _, owner := range []string{"@Alice", "alice", "compozy/release", "ops@compozy.com", " "} {
+			reviewers.Add(owner)
+		}
+		assert.Equal(t, Reviewers{Users: []string{"Alice"}, Teams: []string{"release"}}, reviewers)
+		assert.False(t, reviewers.IsEmpty())
+	})
+}
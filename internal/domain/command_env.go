@@ -0,0 +1,21 @@
+package domain
+
+// Services whose external commands can be given extra environment variables with service_env.
+const (
+	EnvServiceCargo      = "cargo"
+	EnvServiceCliff      = "cliff"
+	EnvServiceCosign     = "cosign"
+	EnvServiceGoReleaser = "goreleaser"
+	EnvServiceNpm        = "npm"
+	EnvServicePyPI       = "pypi"
+)
+
+// EnvServices lists the services service_env accepts.
+var EnvServices = []string{
+	EnvServiceCargo,
+	EnvServiceCliff,
+	EnvServiceCosign,
+	EnvServiceGoReleaser,
+	EnvServiceNpm,
+	EnvServicePyPI,
+}
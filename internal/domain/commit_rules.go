@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// CommitDetails is what commit rules look at: who authored a commit, its message and the files it changed.
+type CommitDetails struct {
+	AuthorName  string
+	AuthorEmail string
+	Message     string
+	Files       []string
+}
+
+// CommitRules drop commits, such as dependency bot noise, before the version bump and the changelog.
+type CommitRules struct {
+	authors  []string
+	messages []*regexp.Regexp
+	paths    PathPatterns
+}
+
+// ParseCommitRules builds the rules skipping commits by author name or email, by message regular
+// expression, and by changed paths, failing on the first invalid pattern.
+func ParseCommitRules(authors, messages, paths []string) (CommitRules, error) {
+	var rules CommitRules
+	for _, author := range authors {
+		if trimmed := strings.TrimSpace(author); trimmed != "" {
+			rules.authors = append(rules.authors, trimmed)
+		}
+	}
+	for _, raw := range messages {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		pattern, err := regexp.Compile(raw)
+		if err != nil {
+			return CommitRules{}, fmt.Errorf("invalid message pattern %q: %w", raw, err)
+		}
+		rules.messages = append(rules.messages, pattern)
+	}
+	parsed, err := ParsePathPatterns(paths)
+	if err != nil {
+		return CommitRules{}, err
+	}
+	rules.paths = parsed
+	return rules, nil
+}
+
+// IsZero reports whether the rules keep every commit.
+func (r CommitRules) IsZero() bool {
+	return len(r.authors) == 0 && len(r.messages) == 0 && len(r.paths.patterns) == 0
+}
+
+// Skips reports whether the commit is dropped: its author name or email is listed (case-insensitive),
+// its message matches a message pattern, or every file it changed matches a path pattern.
+func (r CommitRules) Skips(commit CommitDetails) bool {
+	if containsFold(r.authors, commit.AuthorName) || containsFold(r.authors, commit.AuthorEmail) {
+		return true
+	}
+	message := strings.TrimSpace(commit.Message)
+	if slices.ContainsFunc(r.messages, func(pattern *regexp.Regexp) bool { return pattern.MatchString(message) }) {
+		return true
+	}
+	if len(r.paths.patterns) == 0 || len(commit.Files) == 0 {
+		return false
+	}
+	return !slices.ContainsFunc(commit.Files, func(file string) bool { return !r.paths.Match(file) })
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitRules(t *testing.T) {
+	rules, err := ParseCommitRules(
+		[]string{"renovate[bot]", "49699333+dependabot[bot]@users.noreply.github.com"},
+		[]string{`^chore\(deps\):`},
+		[]string{"go.sum", "docs/"},
+	)
+	require.NoError(t, err)
+	t.Run("Should skip commits of listed authors by name or email", func(t *testing.T) {
+		assert.True(t, rules.Skips(CommitDetails{AuthorName: "Renovate[bot]", Message: "fix: bump"}))
+		assert.True(t, rules.Skips(CommitDetails{
+			AuthorName:  "dependabot[bot]",
+			AuthorEmail: "49699333+dependabot[bot]@users.noreply.github.com",
+			Message:     "fix: bump",
+		}))
+	})
+	t.Run("Should skip commits whose message matches a pattern", func(t *testing.T) {
+		assert.True(t, rules.Skips(CommitDetails{AuthorName: "Jane", Message: "chore(deps): update x"}))
+	})
+	t.Run("Should skip commits that only change matching paths", func(t *testing.T) {
+		assert.True(t, rules.Skips(CommitDetails{Message: "fix: typo", Files: []string{"docs/guide.md", "go.sum"}}))
+		assert.False(t, rules.Skips(CommitDetails{Message: "fix: typo", Files: []string{"docs/guide.md", "main.go"}}))
+	})
+	t.Run("Should keep other commits", func(t *testing.T) {
+		assert.False(t, rules.Skips(CommitDetails{AuthorName: "Jane", Message: "feat: add x"}))
+		assert.True(t, CommitRules{}.IsZero())
+		assert.False(t, rules.IsZero())
+	})
+	t.Run("Should reject invalid patterns", func(t *testing.T) {
+		_, err := ParseCommitRules(nil, []string{"("}, nil)
+		require.ErrorContains(t, err, "invalid message pattern")
+	})
+}
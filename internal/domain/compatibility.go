@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// CompatibilityLevel tells consumers of a release whether they can take it without changes.
+type CompatibilityLevel string
+
+const (
+	CompatibilitySafe     CompatibilityLevel = "safe"
+	CompatibilityBreaking CompatibilityLevel = "breaking"
+)
+
+// Label returns the release PR label of the level, compat:safe or compat:breaking.
+func (l CompatibilityLevel) Label() string {
+	return "compat:" + string(l)
+}
+
+// breakingNotesPattern matches the breaking-change markers of release notes: the **BREAKING:** entries
+// of the git-cliff template and the Breaking Changes heading of custom release notes. Prose that
+// merely mentions breaking changes does not match.
+var breakingNotesPattern = regexp.MustCompile(`\*\*BREAKING:\*\*|(?im)^#+\s+Breaking Changes\s*$`)
+
+// Compatibility is the backward compatibility of a release with the previous one.
+type Compatibility struct {
+	Level    CompatibilityLevel
+	Previous string // previous release, empty for the first release
+	// Range is the caret range of the previous release dependency-update tools resolve, e.g. ^1.4.0
+	Range   string
+	Reasons []string
+}
+
+// AssessCompatibility cross-references the version of a release with the breaking changes its notes
+// mark. The release is breaking when next falls outside the caret range of previous, which is what a
+// major bump, or a minor bump before 1.0.0, means to semver ranges, or when notes mark a breaking
+// change whatever the version says. A nil previous, for the first release, has nothing to break.
+func AssessCompatibility(previous, next *Version, notes string) Compatibility {
+	compat := Compatibility{Level: CompatibilitySafe}
+	if previous != nil {
+		compat.Previous = previous.String()
+		compat.Range = "^" + previous.Version.String()
+		if !caretRangeAdmits(previous, next) {
+			compat.Reasons = append(compat.Reasons, fmt.Sprintf("%s is outside %s", next, compat.Range))
+		}
+	}
+	if breakingNotesPattern.MatchString(notes) {
+		compat.Reasons = append(compat.Reasons, "the release notes mark breaking changes")
+	}
+	if len(compat.Reasons) > 0 {
+		compat.Level = CompatibilityBreaking
+	}
+	return compat
+}
+
+// caretRangeAdmits reports whether next matches the caret range of previous: the same major version
+// from 1.0.0, the same minor version before it and the same patch version before 0.1.0.
+func caretRangeAdmits(previous, next *Version) bool {
+	switch {
+	case previous.Major() > 0:
+		return next.Major() == previous.Major()
+	case previous.Minor() > 0:
+		return next.Major() == 0 && next.Minor() == previous.Minor()
+	default:
+		return next.Major() == 0 && next.Minor() == 0 && next.Patch() == previous.Patch()
+	}
+}
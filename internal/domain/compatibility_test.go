@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssessCompatibility(t *testing.T) {
+	versions := func(t *testing.T, previous, next string) (*Version, *Version) {
+		t.Helper()
+		p, err := NewVersion(previous)
+		require.NoError(t, err)
+		n, err := NewVersion(next)
+		require.NoError(t, err)
+		return p, n
+	}
+	t.Run("Should treat releases within the caret range of the previous release as safe", func(t *testing.T) {
+		for _, pair := range [][2]string{{"v1.4.0", "v1.5.0"}, {"v0.3.1", "v0.3.2"}, {"v1.4.0", "v1.5.0-rc.1"}} {
+			previous, next := versions(t, pair[0], pair[1])
+			compat := AssessCompatibility(previous, next, "### Features\n\n- Add flag\n")
+			assert.Equal(t, CompatibilitySafe, compat.Level, pair)
+			assert.Empty(t, compat.Reasons, pair)
+		}
+	})
+	t.Run("Should treat releases outside the caret range as breaking", func(t *testing.T) {
+		for _, pair := range [][2]string{{"v1.4.0", "v2.0.0"}, {"v0.3.1", "v0.4.0"}, {"v0.0.3", "v0.0.4"}} {
+			previous, next := versions(t, pair[0], pair[1])
+			assert.Equal(t, CompatibilityBreaking, AssessCompatibility(previous, next, "").Level, pair)
+		}
+		previous, next := versions(t, "v1.4.0", "v2.0.0")
+		compat := AssessCompatibility(previous, next, "")
+		assert.Equal(t, Compatibility{
+			Level:    CompatibilityBreaking,
+			Previous: "v1.4.0",
+			Range:    "^1.4.0",
+			Reasons:  []string{"v2.0.0 is outside ^1.4.0"},
+		}, compat)
+	})
+	t.Run("Should treat breaking changes in the release notes as breaking whatever the version", func(t *testing.T) {
+		previous, next := versions(t, "v1.4.0", "v1.5.0")
+		for _, notes := range []string{
+			"### Features\n\n- **BREAKING:** drop the legacy flag\n",
+			"#### Breaking Changes\n\n- Config keys were renamed\n",
+		} {
+			compat := AssessCompatibility(previous, next, notes)
+			assert.Equal(t, CompatibilityBreaking, compat.Level, notes)
+			assert.Equal(t, []string{"the release notes mark breaking changes"}, compat.Reasons, notes)
+		}
+	})
+	t.Run("Should not treat notes that only mention breaking changes as breaking", func(t *testing.T) {
+		previous, next := versions(t, "v1.4.0", "v1.5.0")
+		for _, notes := range []string{
+			"### Bug Fixes\n\n- Detect BREAKING CHANGE footers in commit bodies\n",
+			"### Documentation\n\n- Explain the **BREAKING** marker and the breaking changes policy\n",
+		} {
+			assert.Equal(t, CompatibilitySafe, AssessCompatibility(previous, next, notes).Level, notes)
+		}
+	})
+	t.Run("Should treat the first release as safe", func(t *testing.T) {
+		_, next := versions(t, "v0.1.0", "v1.0.0")
+		assert.Equal(t, Compatibility{Level: CompatibilitySafe}, AssessCompatibility(nil, next, ""))
+	})
+}
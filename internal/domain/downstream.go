@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// pinnedVersion matches the first version after a package name on a manifest line, such as the v1.2.0 of
+// a go.mod require, the ^1.2.0 of a package.json dependency or the 1.2.0 of a requirements.txt pin.
+var pinnedVersion = regexp.MustCompile(`^["'\s:=<>!~^@,]*(v?\d+(\.\d+)*[0-9A-Za-z.+-]*)`)
+
+// DownstreamUsage is how a downstream repository uses the released package.
+type DownstreamUsage struct {
+	Repo     string   // owner/name of the downstream repository
+	Files    []string // files referencing the package
+	Version  string   // version pinned in the manifest; empty when the usage was found by code search
+	Outdated bool     // the repository pins an older version, or one that cannot be compared
+	Issue    int      // number of the tracking issue filed in the repository, 0 when none was filed
+}
+
+// PinnedVersion returns the version of pkg pinned in manifest content and whether the manifest
+// references pkg at all. The version is empty when the reference pins none, e.g. a workspace dependency.
+func PinnedVersion(manifest, pkg string) (string, bool) {
+	referenced := false
+	for line := range strings.SplitSeq(manifest, "\n") {
+		rest, ok := afterPackageName(line, pkg)
+		if !ok {
+			continue
+		}
+		referenced = true
+		if match := pinnedVersion.FindStringSubmatch(rest); match != nil {
+			return match[1], true
+		}
+	}
+	return "", referenced
+}
+
+// afterPackageName returns what follows pkg on line when pkg appears there as a whole name, not as a
+// prefix of a longer one such as github.com/acme/sdk-extra for github.com/acme/sdk.
+func afterPackageName(line, pkg string) (string, bool) {
+	for start := 0; ; {
+		index := strings.Index(line[start:], pkg)
+		if index < 0 {
+			return "", false
+		}
+		begin := start + index
+		end := begin + len(pkg)
+		if !isPackageNameChar(line, begin-1) && !isPackageNameChar(line, end) {
+			return line[end:], true
+		}
+		start = begin + 1
+	}
+}
+
+func isPackageNameChar(line string, index int) bool {
+	if index < 0 || index >= len(line) {
+		return false
+	}
+	c := line[index]
+	return c == '-' || c == '_' || c == '.' || c == '/' || c == '@' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
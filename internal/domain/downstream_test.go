@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinnedVersion(t *testing.T) {
+	t.Run("Should read the pinned version from common manifests", func(t *testing.T) {
+		cases := []struct {
+			manifest string
+			pkg      string
+			want     string
+		}{
+			{"module example.com/app\n\nrequire (\n\tgithub.com/acme/sdk v1.2.0 // indirect\n)\n",
+				"github.com/acme/sdk", "v1.2.0"},
+			{`{"dependencies": {"@acme/sdk": "^1.4.0-beta.1"}}`, "@acme/sdk", "1.4.0-beta.1"},
+			{"requests==2.31.0\nacme-sdk>=0.9\n", "acme-sdk", "0.9"},
+		}
+		for _, tc := range cases {
+			version, referenced := PinnedVersion(tc.manifest, tc.pkg)
+			assert.True(t, referenced, tc.pkg)
+			assert.Equal(t, tc.want, version, tc.pkg)
+		}
+	})
+	t.Run("Should not match a package whose name only starts with the released one", func(t *testing.T) {
+		version, referenced := PinnedVersion("require github.com/acme/sdk-extra v0.3.0\n", "github.com/acme/sdk")
+		assert.False(t, referenced)
+		assert.Empty(t, version)
+	})
+	t.Run("Should report a reference without a pinned version", func(t *testing.T) {
+		version, referenced := PinnedVersion(`"@acme/sdk": "workspace:*"`, "@acme/sdk")
+		assert.True(t, referenced)
+		assert.Empty(t, version)
+	})
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "fmt"
+
+// GitHub permissions the release workflow writes with.
+const (
+	PermissionContents     = "contents"
+	PermissionPullRequests = "pull_requests"
+	PermissionIssues       = "issues"
+	PermissionWorkflows    = "workflows"
+)
+
+// Access levels a permission probe reports.
+const (
+	PermissionAccessWrite   = "write"
+	PermissionAccessNone    = "none"
+	PermissionAccessUnknown = "unknown"
+)
+
+var permissionOperations = map[string]string{
+	PermissionContents:     "push release branches and tags, publish releases",
+	PermissionPullRequests: "open and update the release PR, request reviewers",
+	PermissionIssues:       "comment on the release PR, manage labels and milestones",
+	PermissionWorkflows:    "push release commits that change .github/workflows",
+}
+
+// ProbedPermissions returns the permissions a token permission probe checks, in matrix order.
+func ProbedPermissions() []string {
+	return []string{PermissionContents, PermissionPullRequests, PermissionIssues, PermissionWorkflows}
+}
+
+// PermissionCheck is the write access a token was found to have for one GitHub permission.
+type PermissionCheck struct {
+	Permission string
+	Access     string
+	Detail     string // why the access is unknown, or extra context
+}
+
+// Granted reports whether the token can write with the permission.
+func (c PermissionCheck) Granted() bool {
+	return c.Access == PermissionAccessWrite
+}
+
+// Operations describes what the release workflow needs the permission for.
+func (c PermissionCheck) Operations() string {
+	return permissionOperations[c.Permission]
+}
+
+// String describes the check for doctor output and warnings.
+func (c PermissionCheck) String() string {
+	if c.Detail == "" {
+		return fmt.Sprintf("%s: %s", c.Permission, c.Access)
+	}
+	return fmt.Sprintf("%s: %s (%s)", c.Permission, c.Access, c.Detail)
+}
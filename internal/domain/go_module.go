@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// goModuleMajorSuffix matches the /vN suffix Go requires on module paths from v2 onwards.
+var goModuleMajorSuffix = regexp.MustCompile(`/v([2-9]|[1-9][0-9]+)$`)
+
+// GoModulePathForMajor returns the module path Go expects for releases with the given major version.
+// Majors 0 and 1 use the bare path; later majors append /vN. gopkg.in paths are not supported.
+func GoModulePathForMajor(modulePath string, major uint64) (string, error) {
+	if strings.HasPrefix(modulePath, "gopkg.in/") {
+		return "", fmt.Errorf("gopkg.in module %s encodes its major version differently; rename it manually",
+			modulePath)
+	}
+	base := goModuleMajorSuffix.ReplaceAllString(modulePath, "")
+	if major < 2 {
+		return base, nil
+	}
+	return path.Join(base, "v"+strconv.FormatUint(major, 10)), nil
+}
+
+// ParseGoModulePath returns the module path declared in go.mod content.
+func ParseGoModulePath(goMod string) (string, error) {
+	for line := range strings.SplitSeq(goMod, "\n") {
+		fields := strings.Fields(strings.TrimSpace(line))
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], "\"`"), nil
+		}
+	}
+	return "", fmt.Errorf("go.mod has no module directive")
+}
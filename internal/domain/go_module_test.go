@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoModulePathForMajor(t *testing.T) {
+	t.Run("Should add, replace or drop the major suffix", func(t *testing.T) {
+		cases := []struct {
+			path     string
+			major    uint64
+			expected string
+		}{
+			{"github.com/compozy/releasepr", 1, "github.com/compozy/releasepr"},
+			{"github.com/compozy/releasepr", 2, "github.com/compozy/releasepr/v2"},
+			{"github.com/compozy/releasepr/v2", 3, "github.com/compozy/releasepr/v3"},
+			{"github.com/compozy/releasepr/v12", 12, "github.com/compozy/releasepr/v12"},
+			{"github.com/compozy/releasepr/v2", 0, "github.com/compozy/releasepr"},
+			{"github.com/compozy/v1", 2, "github.com/compozy/v1/v2"},
+		}
+		for _, tc := range cases {
+			path, err := GoModulePathForMajor(tc.path, tc.major)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, path, tc.path)
+		}
+	})
+	t.Run("Should refuse gopkg.in paths", func(t *testing.T) {
+		_, err := GoModulePathForMajor("gopkg.in/yaml.v3", 4)
+		assert.ErrorContains(t, err, "gopkg.in module")
+	})
+}
+
+func TestParseGoModulePath(t *testing.T) {
+	t.Run("Should read the module directive", func(t *testing.T) {
+		path, err := ParseGoModulePath("// comment\nmodule \"github.com/compozy/releasepr\"\n\ngo 1.25\n")
+		require.NoError(t, err)
+		assert.Equal(t, "github.com/compozy/releasepr", path)
+	})
+	t.Run("Should fail without a module directive", func(t *testing.T) {
+		_, err := ParseGoModulePath("go 1.25\n")
+		assert.ErrorContains(t, err, "no module directive")
+	})
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// issueReferencePattern matches #123 and GH-123 references, optionally led by a GitHub closing keyword.
+var issueReferencePattern = regexp.MustCompile(
+	`(^|[\s(])((?i:(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+))?(#|(?i:GH-))([1-9][0-9]*)\b`,
+)
+
+// issueLinkSkipPattern matches the inline constructs whose text must not be rewritten: code spans,
+// links, images and autolinks.
+var issueLinkSkipPattern = regexp.MustCompile("`[^`]*`|!?\\[[^\\]]*\\]\\([^)]*\\)|<[^>\\s]+>")
+
+// LinkIssueReferences turns #123 and GH-123 references in changelog markdown into links to the
+// repository's issues and returns the issues referenced with a closing keyword (fixes, closes,
+// resolves), sorted and deduplicated. Fenced code blocks, code spans and existing links are left untouched.
+func LinkIssueReferences(content, owner, repo string) (string, []int) {
+	baseURL := fmt.Sprintf("https://github.com/%s/%s/issues/", owner, repo)
+	lines := strings.Split(content, "\n")
+	var closes []int
+	inFence := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		lines[i] = linkIssueLine(line, baseURL, &closes)
+	}
+	slices.Sort(closes)
+	return strings.Join(lines, "\n"), slices.Compact(closes)
+}
+
+func linkIssueLine(line, baseURL string, closes *[]int) string {
+	var builder strings.Builder
+	last := 0
+	for _, skip := range issueLinkSkipPattern.FindAllStringIndex(line, -1) {
+		builder.WriteString(linkIssueText(line[last:skip[0]], baseURL, closes))
+		builder.WriteString(line[skip[0]:skip[1]])
+		last = skip[1]
+	}
+	builder.WriteString(linkIssueText(line[last:], baseURL, closes))
+	return builder.String()
+}
+
+func linkIssueText(text, baseURL string, closes *[]int) string {
+	return issueReferencePattern.ReplaceAllStringFunc(text, func(match string) string {
+		groups := issueReferencePattern.FindStringSubmatch(match)
+		lead, keyword, prefix, number := groups[1], groups[2], groups[3], groups[4]
+		if keyword != "" {
+			if issue, err := strconv.Atoi(number); err == nil {
+				*closes = append(*closes, issue)
+			}
+		}
+		return fmt.Sprintf("%s%s[%s%s](%s%s)", lead, keyword, prefix, number, baseURL, number)
+	})
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkIssueReferences(t *testing.T) {
+	t.Run("Should link issue references and collect closing references", func(t *testing.T) {
+		content, closes := LinkIssueReferences(`### Bug Fixes
+
+- Handle empty tags (#12), fixes #7
+- Retry pushes, Closes GH-9 and resolves: #7
+- Mention #3 without closing it`, "compozy", "releasepr")
+		assert.Equal(t, `### Bug Fixes
+
+- Handle empty tags ([#12](https://github.com/compozy/releasepr/issues/12)), `+
+			`fixes [#7](https://github.com/compozy/releasepr/issues/7)
+- Retry pushes, Closes [GH-9](https://github.com/compozy/releasepr/issues/9) and `+
+			`resolves: [#7](https://github.com/compozy/releasepr/issues/7)
+- Mention [#3](https://github.com/compozy/releasepr/issues/3) without closing it`, content)
+		assert.Equal(t, []int{7, 9}, closes)
+	})
+	t.Run("Should leave code, existing links and non-references untouched", func(t *testing.T) {
+		content := "## 1.2.0\n\n" +
+			"- Escape `#12` in [#34](https://example.com/34) and <https://example.com/#56>\n" +
+			"- Colors like #fff, anchors like page#7, prefixes #0 and a&#38;b\n" +
+			"```\nfixes #8\n```"
+		linked, closes := LinkIssueReferences(content, "compozy", "releasepr")
+		assert.Equal(t, content, linked)
+		assert.Empty(t, closes)
+	})
+}
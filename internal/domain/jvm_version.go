@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	mavenProjectElement    = "project"
+	mavenVersionElement    = "version"
+	mavenPropertiesElement = "properties"
+	gradleVersionProperty  = "version"
+)
+
+// xmlElementSpan locates the raw text between an element's start and end tags.
+type xmlElementSpan struct {
+	start int
+	end   int
+}
+
+// SetMavenProjectVersion rewrites the project version of pom.xml content, leaving the rest of the
+// document byte for byte. The parent and dependency versions are never touched. When the version is a
+// property reference such as ${revision}, the property in the project's <properties> is updated instead.
+// It reports whether the project declares a version at all; an inherited version is left alone.
+func SetMavenProjectVersion(pom, version string) (string, bool, error) {
+	spans, err := mavenVersionSpans(pom)
+	if err != nil {
+		return "", false, err
+	}
+	span, ok := spans[mavenVersionElement]
+	if !ok {
+		return pom, false, nil
+	}
+	current := strings.TrimSpace(pom[span.start:span.end])
+	if name, isProperty := mavenPropertyReference(current); isProperty {
+		span, ok = spans[name]
+		if !ok {
+			return "", true, fmt.Errorf("pom.xml project version %s references an undefined property", current)
+		}
+	}
+	raw := pom[span.start:span.end]
+	value := strings.TrimSpace(raw)
+	if value == "" || strings.ContainsAny(value, "<>") {
+		return "", true, fmt.Errorf("pom.xml project version %q cannot be rewritten", raw)
+	}
+	replaced := strings.Replace(raw, value, version, 1)
+	return pom[:span.start] + replaced + pom[span.end:], true, nil
+}
+
+// mavenVersionSpans returns the spans of project/version, keyed "version", and of every
+// project/properties child, keyed by property name.
+func mavenVersionSpans(pom string) (map[string]xmlElementSpan, error) {
+	decoder := xml.NewDecoder(strings.NewReader(pom))
+	spans := make(map[string]xmlElementSpan)
+	var stack []string
+	var open *xmlElementSpan
+	for {
+		offset := int(decoder.InputOffset())
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return spans, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse pom.xml: %w", err)
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, element.Name.Local)
+			if mavenTrackedElement(stack) {
+				open = &xmlElementSpan{start: int(decoder.InputOffset())}
+			}
+		case xml.EndElement:
+			if open != nil && mavenTrackedElement(stack) {
+				open.end = offset
+				key := stack[len(stack)-1]
+				if _, seen := spans[key]; !seen {
+					spans[key] = *open
+				}
+				open = nil
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// mavenTrackedElement reports whether the element path is project/version or a project property.
+func mavenTrackedElement(stack []string) bool {
+	if len(stack) < 2 || stack[0] != mavenProjectElement {
+		return false
+	}
+	if len(stack) == 2 {
+		return stack[1] == mavenVersionElement
+	}
+	return len(stack) == 3 && stack[1] == mavenPropertiesElement && stack[2] != mavenVersionElement
+}
+
+// mavenPropertyReference returns the property name of a ${name} value.
+func mavenPropertyReference(value string) (string, bool) {
+	if !strings.HasPrefix(value, "${") || !strings.HasSuffix(value, "}") {
+		return "", false
+	}
+	return value[2 : len(value)-1], true
+}
+
+// SetGradlePropertiesVersion rewrites the version property of gradle.properties content following the
+// Java properties format: comments, other keys and the separator style are preserved. It reports
+// whether a version property was found.
+func SetGradlePropertiesVersion(properties, version string) (string, bool, error) {
+	lines := strings.SplitAfter(properties, "\n")
+	continued := false
+	for i, line := range lines {
+		body := strings.TrimRight(line, "\r\n")
+		isContinuation := continued
+		continued = endsWithContinuation(body)
+		if isContinuation {
+			continue
+		}
+		trimmed := strings.TrimLeft(body, " \t\f")
+		if trimmed == "" || trimmed[0] == '#' || trimmed[0] == '!' {
+			continue
+		}
+		key, valueStart := splitPropertyLine(trimmed)
+		if key != gradleVersionProperty {
+			continue
+		}
+		if continued {
+			return "", true, fmt.Errorf("gradle.properties version spans several lines and cannot be rewritten")
+		}
+		prefix := body[:len(body)-len(trimmed)+valueStart]
+		lines[i] = prefix + version + line[len(body):]
+		return strings.Join(lines, ""), true, nil
+	}
+	return properties, false, nil
+}
+
+// splitPropertyLine returns the unescaped key of a properties line and where its value starts.
+func splitPropertyLine(line string) (string, int) {
+	var key strings.Builder
+	i := 0
+	for i < len(line) {
+		c := line[i]
+		if c == '\\' && i+1 < len(line) {
+			key.WriteByte(line[i+1])
+			i += 2
+			continue
+		}
+		if c == '=' || c == ':' || c == ' ' || c == '\t' || c == '\f' {
+			break
+		}
+		key.WriteByte(c)
+		i++
+	}
+	for i < len(line) && (line[i] == ' ' || line[i] == '\t' || line[i] == '\f') {
+		i++
+	}
+	if i < len(line) && (line[i] == '=' || line[i] == ':') {
+		i++
+		for i < len(line) && (line[i] == ' ' || line[i] == '\t' || line[i] == '\f') {
+			i++
+		}
+	}
+	return key.String(), i
+}
+
+// endsWithContinuation reports whether a properties line ends with an odd number of backslashes.
+func endsWithContinuation(line string) bool {
+	count := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		count++
+	}
+	return count%2 == 1
+}
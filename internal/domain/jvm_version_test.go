@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPOM = `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+  <!-- <version>0.0.0</version> -->
+  <parent>
+    <groupId>com.example</groupId>
+    <artifactId>parent</artifactId>
+    <version>3.1.0</version>
+  </parent>
+  <artifactId>billing</artifactId>
+  <version>1.1.0-SNAPSHOT</version>
+  <dependencies>
+    <dependency>
+      <artifactId>lib</artifactId>
+      <version>1.1.0-SNAPSHOT</version>
+    </dependency>
+  </dependencies>
+</project>
+`
+
+func TestSetMavenProjectVersion(t *testing.T) {
+	t.Run("Should rewrite only the project version", func(t *testing.T) {
+		updated, found, err := SetMavenProjectVersion(testPOM, "1.2.0")
+		require.NoError(t, err)
+		assert.True(t, found)
+		expected := `  <artifactId>billing</artifactId>
+  <version>1.2.0</version>
+  <dependencies>`
+		assert.Contains(t, updated, expected)
+		assert.Contains(t, updated, "<version>3.1.0</version>")
+		assert.Contains(t, updated, "      <version>1.1.0-SNAPSHOT</version>")
+		assert.Contains(t, updated, "<!-- <version>0.0.0</version> -->")
+	})
+	t.Run("Should update the property a CI-friendly version references", func(t *testing.T) {
+		pom := "<project>\n  <version>${revision}</version>\n" +
+			"  <properties>\n    <revision>1.1.0</revision>\n  </properties>\n</project>\n"
+		updated, found, err := SetMavenProjectVersion(pom, "1.2.0")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "<project>\n  <version>${revision}</version>\n"+
+			"  <properties>\n    <revision>1.2.0</revision>\n  </properties>\n</project>\n", updated)
+	})
+	t.Run("Should report an inherited version as not found", func(t *testing.T) {
+		pom := "<project><parent><version>1.0.0</version></parent><artifactId>a</artifactId></project>"
+		updated, found, err := SetMavenProjectVersion(pom, "1.2.0")
+		require.NoError(t, err)
+		assert.False(t, found)
+		assert.Equal(t, pom, updated)
+	})
+	t.Run("Should fail on an undefined version property", func(t *testing.T) {
+		_, _, err := SetMavenProjectVersion("<project><version>${revision}</version></project>", "1.2.0")
+		assert.ErrorContains(t, err, "undefined property")
+	})
+	t.Run("Should fail on malformed XML", func(t *testing.T) {
+		_, _, err := SetMavenProjectVersion("<project><version>1.0.0</project>", "1.2.0")
+		assert.ErrorContains(t, err, "failed to parse pom.xml")
+	})
+}
+
+func TestSetGradlePropertiesVersion(t *testing.T) {
+	t.Run("Should rewrite the version property and keep the rest", func(t *testing.T) {
+		properties := "# version=0.0.1\norg.gradle.jvmargs=-Xmx2g\nversion = 1.1.0\r\ngroup=com.example\n"
+		updated, found, err := SetGradlePropertiesVersion(properties, "1.2.0")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "# version=0.0.1\norg.gradle.jvmargs=-Xmx2g\nversion = 1.2.0\r\ngroup=com.example\n", updated)
+	})
+	t.Run("Should accept colon and whitespace separators", func(t *testing.T) {
+		updated, found, err := SetGradlePropertiesVersion("  version:1.1.0", "1.2.0")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "  version:1.2.0", updated)
+		updated, _, err = SetGradlePropertiesVersion("version 1.1.0\n", "1.2.0")
+		require.NoError(t, err)
+		assert.Equal(t, "version 1.2.0\n", updated)
+	})
+	t.Run("Should ignore continuation lines and similar keys", func(t *testing.T) {
+		properties := "description=first \\\nversion=not-a-key\nversionCode=3\n"
+		updated, found, err := SetGradlePropertiesVersion(properties, "1.2.0")
+		require.NoError(t, err)
+		assert.False(t, found)
+		assert.Equal(t, properties, updated)
+	})
+	t.Run("Should refuse a version continued onto the next line", func(t *testing.T) {
+		_, _, err := SetGradlePropertiesVersion("version=1.1.\\\n  0\n", "1.2.0")
+		assert.ErrorContains(t, err, "several lines")
+	})
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultLabelColor is the color of labels created without a configured one, GitHub's light gray.
+const DefaultLabelColor = "ededed"
+
+var labelColorPattern = regexp.MustCompile(`^[0-9a-f]{6}$`)
+
+// Label is a GitHub label created on demand before it is applied to a release PR.
+type Label struct {
+	Name        string
+	Color       string // six hex digits without the leading #
+	Description string
+}
+
+// DefaultLabels returns the built-in colors and descriptions of the release PR labels.
+func DefaultLabels() []Label {
+	return []Label{
+		{Name: "release-pending", Color: "fbca04", Description: "Release PR waiting to be merged"},
+		{Name: "automated", Color: "c5def5", Description: "Opened by an automated workflow"},
+		{Name: BumpLevelMajor.Label(), Color: "b60205", Description: "Release with breaking changes"},
+		{Name: BumpLevelMinor.Label(), Color: "0e8a16", Description: "Release with new features"},
+		{Name: BumpLevelPatch.Label(), Color: "1d76db", Description: "Release with fixes only"},
+		{Name: CompatibilityBreaking.Label(), Color: "d93f0b", Description: "Release consumers must adapt to"},
+		{Name: CompatibilitySafe.Label(), Color: "c2e0c6", Description: "Release safe to update to automatically"},
+	}
+}
+
+// NormalizeLabelColor lowercases a hex color and drops its leading #. An empty color stays empty.
+func NormalizeLabelColor(color string) (string, error) {
+	normalized := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(color), "#"))
+	if normalized == "" {
+		return "", nil
+	}
+	if !labelColorPattern.MatchString(normalized) {
+		return "", fmt.Errorf("invalid label color %q: must be six hex digits such as 0e8a16", color)
+	}
+	return normalized, nil
+}
+
+// ResolveLabels returns the labels to create for names. A configured label, matched by name
+// ignoring case, overrides the color and description of the default label; names known to neither
+// get the default color and no description.
+func ResolveLabels(names []string, configured []Label) []Label {
+	labels := make([]Label, 0, len(names))
+	for _, name := range names {
+		label := Label{Name: name, Color: DefaultLabelColor}
+		if known, ok := findLabel(DefaultLabels(), name); ok {
+			label.Color = known.Color
+			label.Description = known.Description
+		}
+		if custom, ok := findLabel(configured, name); ok {
+			if custom.Color != "" {
+				label.Color = custom.Color
+			}
+			if custom.Description != "" {
+				label.Description = custom.Description
+			}
+		}
+		labels = append(labels, label)
+	}
+	return labels
+}
+
+func findLabel(labels []Label, name string) (Label, bool) {
+	for _, label := range labels {
+		if strings.EqualFold(label.Name, name) {
+			return label, true
+		}
+	}
+	return Label{}, false
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLabelColor(t *testing.T) {
+	t.Run("Should lowercase colors and drop the leading hash", func(t *testing.T) {
+		color, err := NormalizeLabelColor(" #0E8A16 ")
+		require.NoError(t, err)
+		assert.Equal(t, "0e8a16", color)
+		color, err = NormalizeLabelColor("")
+		require.NoError(t, err)
+		assert.Empty(t, color)
+	})
+	t.Run("Should reject anything but six hex digits", func(t *testing.T) {
+		for _, color := range []string{"green", "#fff", "0e8a1g"} {
+			_, err := NormalizeLabelColor(color)
+			require.ErrorContains(t, err, "must be six hex digits", color)
+		}
+	})
+}
+
+func TestResolveLabels(t *testing.T) {
+	t.Run("Should use the built-in definitions of release PR labels", func(t *testing.T) {
+		labels := ResolveLabels([]string{"release-pending", "release:minor"}, nil)
+		assert.Equal(t, []Label{
+			{Name: "release-pending", Color: "fbca04", Description: "Release PR waiting to be merged"},
+			{Name: "release:minor", Color: "0e8a16", Description: "Release with new features"},
+		}, labels)
+	})
+	t.Run("Should let configured labels override colors and descriptions", func(t *testing.T) {
+		configured := []Label{
+			{Name: "Release:Minor", Color: "5319e7"},
+			{Name: "team:platform", Description: "Owned by the platform team"},
+		}
+		labels := ResolveLabels([]string{"release:minor", "team:platform", "misc"}, configured)
+		assert.Equal(t, []Label{
+			{Name: "release:minor", Color: "5319e7", Description: "Release with new features"},
+			{Name: "team:platform", Color: DefaultLabelColor, Description: "Owned by the platform team"},
+			{Name: "misc", Color: DefaultLabelColor},
+		}, labels)
+	})
+}
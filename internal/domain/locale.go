@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"embed"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultLocale is the language generated release notes are written in when no locale is configured.
+const DefaultLocale = "en"
+
+//go:embed locales/*.yaml
+var localeFiles embed.FS
+
+// supportedLocales lists the built-in locales, each backed by locales/<tag>.yaml.
+var supportedLocales = []string{DefaultLocale, "es", "pt-BR"}
+
+// Locale translates the section headings and boilerplate of generated release notes. Messages are
+// keyed by their English text, so anything a locale does not translate stays in English.
+type Locale struct {
+	Tag      string
+	Messages map[string]string
+}
+
+// SupportedLocales returns the tags of the built-in locales.
+func SupportedLocales() []string {
+	return slices.Clone(supportedLocales)
+}
+
+// LoadLocale returns the built-in locale for tag.
+func LoadLocale(tag string) (Locale, error) {
+	if !slices.Contains(supportedLocales, tag) {
+		return Locale{}, fmt.Errorf(
+			"unsupported locale %q: must be one of %s", tag, strings.Join(supportedLocales, ", "))
+	}
+	data, err := localeFiles.ReadFile("locales/" + tag + ".yaml")
+	if err != nil {
+		return Locale{}, fmt.Errorf("failed to read locale %s: %w", tag, err)
+	}
+	messages, err := ParseLocaleMessages(data)
+	if err != nil {
+		return Locale{}, fmt.Errorf("invalid locale %s: %w", tag, err)
+	}
+	return Locale{Tag: tag, Messages: messages}, nil
+}
+
+// ParseLocaleMessages parses a locale file: a YAML map from English text to its translation.
+func ParseLocaleMessages(data []byte) (map[string]string, error) {
+	messages := map[string]string{}
+	if err := yaml.Unmarshal(data, &messages); err != nil {
+		return nil, fmt.Errorf("locale file must map English text to translations: %w", err)
+	}
+	for message, translation := range messages {
+		if strings.TrimSpace(translation) == "" {
+			return nil, fmt.Errorf("empty translation for %q", message)
+		}
+	}
+	return messages, nil
+}
+
+// WithMessages returns a copy of l where messages add to or replace its translations.
+func (l Locale) WithMessages(messages map[string]string) Locale {
+	merged := make(map[string]string, len(l.Messages)+len(messages))
+	for message, translation := range l.Messages {
+		merged[message] = translation
+	}
+	for message, translation := range messages {
+		merged[message] = translation
+	}
+	return Locale{Tag: l.Tag, Messages: merged}
+}
+
+// T translates message, returning it unchanged when the locale has no translation.
+func (l Locale) T(message string) string {
+	if translation, ok := l.Messages[message]; ok {
+		return translation
+	}
+	return message
+}
+
+// Plural renders count followed by the translated singular or plural noun.
+func (l Locale) Plural(count int, singular, plural string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, l.T(singular))
+	}
+	return fmt.Sprintf("%d %s", count, l.T(plural))
+}
+
+// LocalizeHeadings translates the markdown headings of document, such as the git-cliff commit
+// groups. Leading emoji and punctuation of a heading are kept, and fenced code blocks are skipped.
+func (l Locale) LocalizeHeadings(document string) string {
+	if len(l.Messages) == 0 {
+		return document
+	}
+	lines := strings.Split(document, "\n")
+	fenced := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+			continue
+		}
+		if fenced {
+			continue
+		}
+		lines[i] = l.localizeHeading(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (l Locale) localizeHeading(line string) string {
+	text := strings.TrimLeft(line, "#")
+	if text == line || !strings.HasPrefix(text, " ") {
+		return line
+	}
+	start := strings.IndexFunc(text, unicode.IsLetter)
+	if start < 0 {
+		return line
+	}
+	title := strings.TrimRightFunc(text[start:], unicode.IsSpace)
+	translation, ok := l.Messages[title]
+	if !ok {
+		return line
+	}
+	return line[:len(line)-len(text)] + text[:start] + translation
+}
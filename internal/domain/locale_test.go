@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLocale(t *testing.T) {
+	t.Run("Should load every supported locale", func(t *testing.T) {
+		for _, tag := range SupportedLocales() {
+			locale, err := LoadLocale(tag)
+			require.NoError(t, err, tag)
+			assert.Equal(t, tag, locale.Tag)
+		}
+	})
+	t.Run("Should keep English text as written", func(t *testing.T) {
+		locale, err := LoadLocale(DefaultLocale)
+		require.NoError(t, err)
+		assert.Empty(t, locale.Messages)
+		assert.Equal(t, "Release Notes", locale.T("Release Notes"))
+	})
+	t.Run("Should reject unsupported locales", func(t *testing.T) {
+		_, err := LoadLocale("fr")
+		require.ErrorContains(t, err, `unsupported locale "fr": must be one of en, es, pt-BR`)
+	})
+}
+
+func TestParseLocaleMessages(t *testing.T) {
+	t.Run("Should parse a map of English text to translations", func(t *testing.T) {
+		messages, err := ParseLocaleMessages([]byte("Features: Novidades\n\"%s by %s\": \"%s por %s\"\n"))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"Features": "Novidades", "%s by %s": "%s por %s"}, messages)
+	})
+	t.Run("Should reject other shapes and empty translations", func(t *testing.T) {
+		_, err := ParseLocaleMessages([]byte("- Features\n"))
+		require.ErrorContains(t, err, "locale file must map English text to translations")
+		_, err = ParseLocaleMessages([]byte("Features: \"\"\n"))
+		require.ErrorContains(t, err, `empty translation for "Features"`)
+	})
+}
+
+func TestLocale_WithMessages(t *testing.T) {
+	t.Run("Should add and replace translations without changing the original", func(t *testing.T) {
+		locale := Locale{Tag: "pt-BR", Messages: map[string]string{"Features": "Funcionalidades"}}
+		merged := locale.WithMessages(map[string]string{"Features": "Novidades", "Infra": "Infraestrutura"})
+		assert.Equal(t, "Novidades", merged.T("Features"))
+		assert.Equal(t, "Infraestrutura", merged.T("Infra"))
+		assert.Equal(t, "Funcionalidades", locale.T("Features"))
+		assert.Equal(t, "pt-BR", merged.Tag)
+	})
+}
+
+func TestLocale_Plural(t *testing.T) {
+	t.Run("Should pick the translated singular or plural form", func(t *testing.T) {
+		locale, err := LoadLocale("es")
+		require.NoError(t, err)
+		assert.Equal(t, "1 colaborador", locale.Plural(1, "contributor", "contributors"))
+		assert.Equal(t, "0 colaboradores", locale.Plural(0, "contributor", "contributors"))
+	})
+}
+
+func TestLocale_LocalizeHeadings(t *testing.T) {
+	t.Run("Should translate headings and keep their emoji prefix", func(t *testing.T) {
+		locale, err := LoadLocale("pt-BR")
+		require.NoError(t, err)
+		document := "# Changelog\n\n## [1.2.0] - 2026-10-16\n\n### 🎉 Features\n\n- Features everywhere\n\n" +
+			"### ♻️  Refactoring \n\n#### Unknown Group"
+		want := "# Registro de alterações\n\n## [1.2.0] - 2026-10-16\n\n### 🎉 Funcionalidades\n\n" +
+			"- Features everywhere\n\n### ♻️  Refatoração\n\n#### Unknown Group"
+		assert.Equal(t, want, locale.LocalizeHeadings(document))
+	})
+	t.Run("Should leave fenced code and non-headings alone", func(t *testing.T) {
+		locale := Locale{Messages: map[string]string{"Features": "Novidades"}}
+		document := "```sh\n# Features\n```\n#Features\nFeatures"
+		assert.Equal(t, document, locale.LocalizeHeadings(document))
+	})
+}
@@ -0,0 +1,241 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// MarkdownConstruct identifies a markdown feature that changelog sanitization can allow.
+type MarkdownConstruct string
+
+const (
+	MarkdownConstructHTML   MarkdownConstruct = "html"
+	MarkdownConstructImages MarkdownConstruct = "images"
+	MarkdownConstructLinks  MarkdownConstruct = "links"
+)
+
+// maxInlineLinkLength bounds how far a link is scanned so unbalanced brackets stay linear.
+const maxInlineLinkLength = 2048
+
+var unsafeLinkSchemes = []string{"javascript:", "vbscript:", "data:", "file:"}
+
+var autolinkPattern = regexp.MustCompile(`^<https?://[^\s<>]+>`)
+
+// ParseMarkdownConstruct validates and normalizes a markdown construct name.
+func ParseMarkdownConstruct(value string) (MarkdownConstruct, error) {
+	normalized := MarkdownConstruct(strings.TrimSpace(strings.ToLower(value)))
+	switch normalized {
+	case MarkdownConstructHTML, MarkdownConstructImages, MarkdownConstructLinks:
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("invalid markdown construct: %s", value)
+	}
+}
+
+// MarkdownPolicy decides which markdown constructs survive changelog sanitization.
+type MarkdownPolicy struct {
+	allowed map[MarkdownConstruct]bool
+}
+
+// NewMarkdownPolicy creates a policy that allows only the given constructs.
+func NewMarkdownPolicy(allowed ...MarkdownConstruct) MarkdownPolicy {
+	policy := MarkdownPolicy{allowed: make(map[MarkdownConstruct]bool, len(allowed))}
+	for _, construct := range allowed {
+		policy.allowed[construct] = true
+	}
+	return policy
+}
+
+// ParseMarkdownPolicy builds a policy from configuration values.
+func ParseMarkdownPolicy(values []string) (MarkdownPolicy, error) {
+	constructs := make([]MarkdownConstruct, 0, len(values))
+	for _, value := range values {
+		construct, err := ParseMarkdownConstruct(value)
+		if err != nil {
+			return MarkdownPolicy{}, err
+		}
+		constructs = append(constructs, construct)
+	}
+	return NewMarkdownPolicy(constructs...), nil
+}
+
+// Allows reports whether the construct is allowed by the policy.
+func (p MarkdownPolicy) Allows(construct MarkdownConstruct) bool {
+	return p.allowed[construct]
+}
+
+// Sanitize neutralizes commit-derived markdown that could break or inject into rendered documents.
+// Control characters are removed, raw HTML is escaped, images and links are reduced to their text
+// unless allowed, links with script-capable schemes are always dropped, and unterminated code spans
+// are escaped so they cannot swallow the rest of the document.
+func (p MarkdownPolicy) Sanitize(content string) string {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	for index, line := range lines {
+		lines[index] = p.sanitizeInline(stripControlCharacters(line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func stripControlCharacters(line string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\t' {
+			return r
+		}
+		if unicode.IsControl(r) || r == unicode.ReplacementChar {
+			return -1
+		}
+		return r
+	}, line)
+}
+
+func (p MarkdownPolicy) sanitizeInline(text string) string {
+	var builder strings.Builder
+	for index := 0; index < len(text); {
+		switch char := text[index]; {
+		case char == '\\' && index+1 < len(text):
+			builder.WriteString(text[index : index+2])
+			index += 2
+		case char == '`':
+			span, consumed := codeSpan(text[index:])
+			builder.WriteString(span)
+			index += consumed
+		case char == '<':
+			index += p.writeAngle(&builder, text[index:])
+		case char == '!' && strings.HasPrefix(text[index:], "!["):
+			linkText, target, consumed, ok := inlineLink(text[index+1:])
+			if !ok {
+				builder.WriteByte(char)
+				index++
+				continue
+			}
+			p.writeLink(&builder, linkText, target, true)
+			index += consumed + 1
+		case char == '[':
+			linkText, target, consumed, ok := inlineLink(text[index:])
+			if !ok {
+				builder.WriteByte(char)
+				index++
+				continue
+			}
+			p.writeLink(&builder, linkText, target, false)
+			index += consumed
+		default:
+			builder.WriteByte(char)
+			index++
+		}
+	}
+	return builder.String()
+}
+
+// writeAngle writes an angle bracket sequence and returns the number of bytes consumed.
+func (p MarkdownPolicy) writeAngle(builder *strings.Builder, text string) int {
+	if p.Allows(MarkdownConstructHTML) {
+		builder.WriteByte('<')
+		return 1
+	}
+	if autolink := autolinkPattern.FindString(text); autolink != "" && p.Allows(MarkdownConstructLinks) {
+		builder.WriteString(autolink)
+		return len(autolink)
+	}
+	builder.WriteString("&lt;")
+	return 1
+}
+
+func (p MarkdownPolicy) writeLink(builder *strings.Builder, text, target string, image bool) {
+	safeText := p.sanitizeInline(text)
+	allowed := p.Allows(MarkdownConstructLinks)
+	if image {
+		allowed = p.Allows(MarkdownConstructImages)
+	}
+	if !allowed || isUnsafeLinkTarget(target) {
+		builder.WriteString(safeText)
+		return
+	}
+	if image {
+		builder.WriteByte('!')
+	}
+	builder.WriteString("[" + safeText + "](" + strings.ReplaceAll(target, "<", "%3C") + ")")
+}
+
+func isUnsafeLinkTarget(target string) bool {
+	normalized := strings.ToLower(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, target))
+	normalized = strings.TrimPrefix(normalized, "<")
+	for _, scheme := range unsafeLinkSchemes {
+		if strings.HasPrefix(normalized, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// codeSpan returns a closed code span verbatim, or an escaped backtick run when it is unterminated.
+func codeSpan(text string) (string, int) {
+	opening := backtickRun(text)
+	for index := opening; index < len(text); {
+		if text[index] != '`' {
+			index++
+			continue
+		}
+		closing := backtickRun(text[index:])
+		if closing == opening {
+			return text[:index+closing], index + closing
+		}
+		index += closing
+	}
+	return strings.Repeat("\\`", opening), opening
+}
+
+func backtickRun(text string) int {
+	run := 0
+	for run < len(text) && text[run] == '`' {
+		run++
+	}
+	return run
+}
+
+// inlineLink parses "[text](target)" and returns its parts and the number of bytes consumed.
+func inlineLink(text string) (string, string, int, bool) {
+	if len(text) > maxInlineLinkLength {
+		text = text[:maxInlineLinkLength]
+	}
+	depth := 0
+	closeBracket := -1
+	for index := 0; index < len(text) && closeBracket < 0; index++ {
+		switch text[index] {
+		case '\\':
+			index++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				closeBracket = index
+			}
+		}
+	}
+	if closeBracket < 0 || closeBracket+1 >= len(text) || text[closeBracket+1] != '(' {
+		return "", "", 0, false
+	}
+	depth = 0
+	for index := closeBracket + 1; index < len(text); index++ {
+		switch text[index] {
+		case '\\':
+			index++
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return text[1:closeBracket], text[closeBracket+2 : index], index + 1, true
+			}
+		}
+	}
+	return "", "", 0, false
+}
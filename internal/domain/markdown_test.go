@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarkdownPolicy(t *testing.T) {
+	t.Run("Should normalize allowed constructs", func(t *testing.T) {
+		policy, err := ParseMarkdownPolicy([]string{" Links ", "IMAGES"})
+		require.NoError(t, err)
+		assert.True(t, policy.Allows(MarkdownConstructLinks))
+		assert.True(t, policy.Allows(MarkdownConstructImages))
+		assert.False(t, policy.Allows(MarkdownConstructHTML))
+	})
+	t.Run("Should reject unknown constructs", func(t *testing.T) {
+		_, err := ParseMarkdownPolicy([]string{"tables"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid markdown construct")
+	})
+}
+
+func TestMarkdownPolicy_Sanitize(t *testing.T) {
+	linksOnly := NewMarkdownPolicy(MarkdownConstructLinks)
+	t.Run("Should escape raw HTML when HTML is not allowed", func(t *testing.T) {
+		got := linksOnly.Sanitize("- feat: <script>alert(1)</script> <img src=x onerror=alert(1)>")
+		assert.Equal(t, "- feat: &lt;script>alert(1)&lt;/script> &lt;img src=x onerror=alert(1)>", got)
+	})
+	t.Run("Should keep raw HTML when HTML is allowed", func(t *testing.T) {
+		policy := NewMarkdownPolicy(MarkdownConstructHTML)
+		assert.Equal(t, "<kbd>Ctrl</kbd>", policy.Sanitize("<kbd>Ctrl</kbd>"))
+	})
+	t.Run("Should keep safe links and autolinks when links are allowed", func(t *testing.T) {
+		got := linksOnly.Sanitize("- fix: see [#42](https://github.com/compozy/releasepr/pull/42) <https://example.com>")
+		assert.Equal(t, "- fix: see [#42](https://github.com/compozy/releasepr/pull/42) <https://example.com>", got)
+	})
+	t.Run("Should reduce links to text when links are not allowed", func(t *testing.T) {
+		policy := NewMarkdownPolicy()
+		got := policy.Sanitize("- fix: see [the docs](https://example.com) <https://example.com>")
+		assert.Equal(t, "- fix: see the docs &lt;https://example.com>", got)
+	})
+	t.Run("Should drop links with script-capable schemes", func(t *testing.T) {
+		got := linksOnly.Sanitize("[click](javascript:alert(1)) [data](  DATA:text/html;base64,xx) [v](<vbscript:x>)")
+		assert.Equal(t, "click data v", got)
+	})
+	t.Run("Should strip images unless allowed", func(t *testing.T) {
+		assert.Equal(t, "logo", linksOnly.Sanitize("![logo](https://evil.test/track.png)"))
+		policy := NewMarkdownPolicy(MarkdownConstructImages)
+		assert.Equal(t, "![logo](https://example.com/logo.png)", policy.Sanitize("![logo](https://example.com/logo.png)"))
+	})
+	t.Run("Should sanitize nested link text", func(t *testing.T) {
+		got := linksOnly.Sanitize("[![badge](https://evil.test/b.svg) <b>x</b>](https://example.com)")
+		assert.Equal(t, "[badge &lt;b>x&lt;/b>](https://example.com)", got)
+	})
+	t.Run("Should preserve closed code spans verbatim", func(t *testing.T) {
+		got := linksOnly.Sanitize("- fix: handle `<nil>` and ``a ` b``")
+		assert.Equal(t, "- fix: handle `<nil>` and ``a ` b``", got)
+	})
+	t.Run("Should escape unterminated code spans", func(t *testing.T) {
+		got := linksOnly.Sanitize("- fix: stray ``` fence <b>")
+		assert.Equal(t, "- fix: stray \\`\\`\\` fence &lt;b>", got)
+	})
+	t.Run("Should remove control characters and normalize line endings", func(t *testing.T) {
+		got := linksOnly.Sanitize("- feat: bell\a and\x1b[31m color\r\n- fix:\ttab\u0085")
+		assert.Equal(t, "- feat: bell and[31m color\n- fix:\ttab", got)
+	})
+	t.Run("Should be idempotent", func(t *testing.T) {
+		input := "- feat: <x> `y` [z](https://example.com) ![i](https://example.com/i.png) ``` \\<"
+		once := linksOnly.Sanitize(input)
+		assert.Equal(t, once, linksOnly.Sanitize(once))
+	})
+}
+
+func FuzzMarkdownPolicySanitize(f *testing.F) {
+	seeds := []string{
+		"feat: add <script>alert(document.cookie)</script>",
+		"fix: [click me](javascript:alert(1))",
+		"fix: [x](  jAvAsCrIpT\t:alert(1))",
+		"chore: ![pixel](https://evil.test/p.gif?c=1)",
+		"docs: <!-- hidden --> <details open>",
+		"feat: ``` unterminated fence",
+		"feat: [[[[[[nested](https://a)](https://b)",
+		"fix: \x00\x1b]8;;https://evil.test\x07link\x1b]8;;\x07",
+		"refactor: \\` escaped \\<b>",
+		"feat: <https://example.com> <javascript:alert(1)>",
+		"\xff\xfe invalid utf8 <b>",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+	policy := NewMarkdownPolicy(MarkdownConstructLinks)
+	f.Fuzz(func(t *testing.T, input string) {
+		got := policy.Sanitize(input)
+		for _, r := range got {
+			if r != '\n' && r != '\t' && unicode.IsControl(r) {
+				t.Fatalf("control character %q survived sanitization of %q", r, input)
+			}
+		}
+		if !strings.Contains(input, "`") && strings.Contains(got, "<") {
+			for _, line := range strings.Split(got, "\n") {
+				rest := line
+				for {
+					index := strings.Index(rest, "<")
+					if index < 0 {
+						break
+					}
+					if autolink := autolinkPattern.FindString(rest[index:]); autolink == "" && !isEscaped(rest, index) {
+						t.Fatalf("raw HTML survived sanitization of %q: %q", input, got)
+					}
+					rest = rest[index+1:]
+				}
+			}
+		}
+		for index := strings.Index(got, "["); index >= 0; index = strings.Index(got[index+1:], "[") + index + 1 {
+			if _, target, _, ok := inlineLink(got[index:]); ok && isUnsafeLinkTarget(target) {
+				t.Fatalf("unsafe link survived sanitization of %q: %q", input, got)
+			}
+			if !strings.Contains(got[index+1:], "[") {
+				break
+			}
+		}
+		assert.Equal(t, got, policy.Sanitize(got))
+	})
+}
+
+func isEscaped(text string, index int) bool {
+	backslashes := 0
+	for i := index - 1; i >= 0 && text[i] == '\\'; i-- {
+		backslashes++
+	}
+	return backslashes%2 == 1
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PendingNotesFile collects changelog entries written with `note add` for changes that did not land
+// through conventional commits, such as reverts or vendor syncs. The next release consumes it.
+const PendingNotesFile = ".pending-notes.md"
+
+// pendingNotesHeader starts a pending notes file created by `note add`.
+const pendingNotesHeader = "# Pending changelog entries\n\n" +
+	"Entries below are added to the next changelog and removed by the release commit.\n"
+
+var (
+	pendingNoteTypePattern  = regexp.MustCompile(`^[a-z]+$`)
+	pendingNoteScopePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._/-]*$`)
+	pendingNoteEntryPattern = regexp.MustCompile(`^([a-z]+)(?:\(([^()]+)\))?: (.+)$`)
+)
+
+// PendingNote is a manual changelog entry, written like a conventional commit header.
+type PendingNote struct {
+	Type        string
+	Scope       string
+	Description string
+}
+
+// NewPendingNote validates and normalizes a manual changelog entry.
+func NewPendingNote(noteType, scope, description string) (PendingNote, error) {
+	note := PendingNote{
+		Type:        strings.ToLower(strings.TrimSpace(noteType)),
+		Scope:       strings.TrimSpace(scope),
+		Description: strings.TrimSpace(description),
+	}
+	if !pendingNoteTypePattern.MatchString(note.Type) {
+		return PendingNote{}, fmt.Errorf("invalid note type %q: use a conventional commit type such as feat", noteType)
+	}
+	if note.Scope != "" && !pendingNoteScopePattern.MatchString(note.Scope) {
+		return PendingNote{}, fmt.Errorf("invalid note scope %q", scope)
+	}
+	if note.Description == "" {
+		return PendingNote{}, fmt.Errorf("note description cannot be empty")
+	}
+	if strings.ContainsAny(note.Description, "\r\n") {
+		return PendingNote{}, fmt.Errorf("note description must be a single line")
+	}
+	return note, nil
+}
+
+// Header returns the entry as a conventional commit header, e.g. `feat(cli): add flag`.
+func (n PendingNote) Header() string {
+	if n.Scope == "" {
+		return fmt.Sprintf("%s: %s", n.Type, n.Description)
+	}
+	return fmt.Sprintf("%s(%s): %s", n.Type, n.Scope, n.Description)
+}
+
+// PendingNotes is the content of the pending notes file, in the order the entries were added.
+type PendingNotes []PendingNote
+
+// ParsePendingNotes reads the `- type(scope): description` list items of a pending notes file. Other
+// lines are ignored; a list item that is not a valid entry is an error, since dropping it silently
+// would lose a changelog line.
+func ParsePendingNotes(content string) (PendingNotes, error) {
+	var notes PendingNotes
+	for i, line := range strings.Split(content, "\n") {
+		item, ok := strings.CutPrefix(strings.TrimSpace(line), "- ")
+		if !ok {
+			continue
+		}
+		match := pendingNoteEntryPattern.FindStringSubmatch(strings.TrimSpace(item))
+		if match == nil {
+			return nil, fmt.Errorf("line %d: expected `- type(scope): description`", i+1)
+		}
+		note, err := NewPendingNote(match[1], match[2], match[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		notes = append(notes, note)
+	}
+	return notes, nil
+}
+
+// AppendPendingNote returns the pending notes file content with note added, starting a new file
+// when content is empty.
+func AppendPendingNote(content string, note PendingNote) string {
+	if strings.TrimSpace(content) == "" {
+		content = pendingNotesHeader + "\n"
+	} else if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	return content + "- " + note.Header() + "\n"
+}
+
+// Headers returns the entries as conventional commit headers.
+func (n PendingNotes) Headers() []string {
+	headers := make([]string, 0, len(n))
+	for _, note := range n {
+		headers = append(headers, note.Header())
+	}
+	return headers
+}
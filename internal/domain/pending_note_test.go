@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPendingNote(t *testing.T) {
+	t.Run("Should normalize the type and trim the fields", func(t *testing.T) {
+		note, err := NewPendingNote(" FEAT ", " cli ", " add flag ")
+		require.NoError(t, err)
+		assert.Equal(t, "feat(cli): add flag", note.Header())
+	})
+	t.Run("Should reject invalid types, scopes and descriptions", func(t *testing.T) {
+		_, err := NewPendingNote("feat!", "", "add flag")
+		assert.ErrorContains(t, err, "invalid note type")
+		_, err = NewPendingNote("feat", "a b", "add flag")
+		assert.ErrorContains(t, err, "invalid note scope")
+		_, err = NewPendingNote("feat", "", "")
+		assert.ErrorContains(t, err, "cannot be empty")
+		_, err = NewPendingNote("feat", "", "line\nbreak")
+		assert.ErrorContains(t, err, "single line")
+	})
+}
+
+func TestParsePendingNotes(t *testing.T) {
+	t.Run("Should round-trip appended entries and ignore prose", func(t *testing.T) {
+		first, err := NewPendingNote("feat", "cli", "add flag")
+		require.NoError(t, err)
+		second, err := NewPendingNote("revert", "", "undo cache change")
+		require.NoError(t, err)
+		content := AppendPendingNote(AppendPendingNote("", first), second)
+		assert.Contains(t, content, "# Pending changelog entries")
+		notes, err := ParsePendingNotes(content)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"feat(cli): add flag", "revert: undo cache change"}, notes.Headers())
+	})
+	t.Run("Should report malformed list items", func(t *testing.T) {
+		_, err := ParsePendingNotes("# Notes\n\n- feat: ok\n- no type here\n")
+		assert.ErrorContains(t, err, "line 4")
+	})
+}
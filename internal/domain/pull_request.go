@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PullRequest is a pull request merged into a release, listed in the release PR body.
+type PullRequest struct {
+	Number int
+	Title  string
+	Author string   // GitHub login without the @ prefix
+	Labels []string // label names in the order GitHub returns them
+	Closes []int    // issues the description closes with a closing keyword, sorted
+}
+
+// PullRequestRef identifies the release pull request a run created or updated.
+type PullRequestRef struct {
+	Number int
+	URL    string
+}
+
+// MarkdownRow renders the pull request as a row of the merged pull requests table. Pipes and line
+// breaks in the title are escaped so they cannot break the table.
+func (p PullRequest) MarkdownRow() string {
+	author := ""
+	if p.Author != "" {
+		author = "@" + p.Author
+	}
+	labels := make([]string, 0, len(p.Labels))
+	for _, label := range p.Labels {
+		labels = append(labels, "`"+escapeTableCell(label)+"`")
+	}
+	return fmt.Sprintf("| #%d | %s | %s | %s |", p.Number, escapeTableCell(p.Title), author, strings.Join(labels, " "))
+}
+
+func escapeTableCell(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	return strings.ReplaceAll(text, "|", `\|`)
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequest_MarkdownRow(t *testing.T) {
+	t.Run("Should render the number, title, author and labels", func(t *testing.T) {
+		pr := PullRequest{
+			Number: 42,
+			Title:  "feat: add doctor",
+			Author: "alice",
+			Labels: []string{"enhancement", "cli"},
+		}
+		assert.Equal(t, "| #42 | feat: add doctor | @alice | `enhancement` `cli` |", pr.MarkdownRow())
+	})
+	t.Run("Should escape pipes and line breaks in the title", func(t *testing.T) {
+		pr := PullRequest{Number: 7, Title: "fix: a | b\nc"}
+		assert.Equal(t, `| #7 | fix: a \| b c |  |  |`, pr.MarkdownRow())
+	})
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// readmeVersion matches a release version with an optional v prefix and prerelease or build suffix.
+const readmeVersion = `v?\d+\.\d+\.\d+(?:-[0-9A-Za-z.]+)?(?:\+[0-9A-Za-z.]+)?`
+
+// ReadmeRepositoryPlaceholder stands in README version patterns for the released GitHub repository,
+// github.com/<owner>/<repo>, so a pattern only matches references to the project itself.
+const ReadmeRepositoryPlaceholder = "{repo}"
+
+// DefaultReadmeVersionPatterns find the version references READMEs commonly pin: static
+// shields.io version badges, go install commands and release download URLs of install scripts.
+// Commands and URLs of other projects are left alone.
+var DefaultReadmeVersionPatterns = []string{
+	`img\.shields\.io/badge/version-(` + readmeVersion + `)-`,
+	`go install ` + ReadmeRepositoryPlaceholder + `(?:/\S*)?@(` + readmeVersion + `)`,
+	ReadmeRepositoryPlaceholder + `/releases/download/(` + readmeVersion + `)/\S*`,
+}
+
+// ReadmeVersionPatterns rewrites pinned versions in README content. Each pattern has exactly one
+// capture group holding the version to replace.
+type ReadmeVersionPatterns struct {
+	patterns []*regexp.Regexp
+}
+
+// ParseReadmeVersionPatterns compiles patterns for the GitHub repository owner/repo, failing on the
+// first invalid one or one without exactly one capture group.
+func ParseReadmeVersionPatterns(patterns []string, owner, repo string) (ReadmeVersionPatterns, error) {
+	repository := regexp.QuoteMeta("github.com/" + owner + "/" + repo)
+	var parsed ReadmeVersionPatterns
+	for _, raw := range patterns {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		pattern, err := regexp.Compile(strings.ReplaceAll(raw, ReadmeRepositoryPlaceholder, repository))
+		if err != nil {
+			return ReadmeVersionPatterns{}, fmt.Errorf("invalid README version pattern %q: %w", raw, err)
+		}
+		if pattern.NumSubexp() != 1 {
+			return ReadmeVersionPatterns{}, fmt.Errorf(
+				"invalid README version pattern %q: must have exactly one capture group", raw)
+		}
+		parsed.patterns = append(parsed.patterns, pattern)
+	}
+	return parsed, nil
+}
+
+// Rewrite replaces the captured version of every match with version, keeping whether it had a v
+// prefix. Other occurrences of the captured version inside the match, such as the version in a
+// download asset name, are replaced too.
+func (p ReadmeVersionPatterns) Rewrite(content, version string) string {
+	bare := strings.TrimPrefix(version, "v")
+	for _, pattern := range p.patterns {
+		content = pattern.ReplaceAllStringFunc(content, func(match string) string {
+			groups := pattern.FindStringSubmatch(match)
+			if len(groups) < 2 || groups[1] == "" {
+				return match
+			}
+			return strings.ReplaceAll(match, strings.TrimPrefix(groups[1], "v"), bare)
+		})
+	}
+	return content
+}
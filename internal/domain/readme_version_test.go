@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadmeVersionPatterns_Rewrite(t *testing.T) {
+	t.Run("Should rewrite badges, go install commands and download URLs", func(t *testing.T) {
+		patterns, err := ParseReadmeVersionPatterns(DefaultReadmeVersionPatterns, "acme", "tool")
+		require.NoError(t, err)
+		readme := "![version](https://img.shields.io/badge/version-v1.1.0-blue)\n\n" +
+			"    go install github.com/acme/tool/cmd/tool@v1.1.0\n" +
+			"    curl -sSL https://github.com/acme/tool/releases/download/v1.1.0/tool_1.1.0_linux.tar.gz\n" +
+			"\nSince 1.1.0, tool supports exports.\n"
+		want := "![version](https://img.shields.io/badge/version-v1.2.0-blue)\n\n" +
+			"    go install github.com/acme/tool/cmd/tool@v1.2.0\n" +
+			"    curl -sSL https://github.com/acme/tool/releases/download/v1.2.0/tool_1.2.0_linux.tar.gz\n" +
+			"\nSince 1.1.0, tool supports exports.\n"
+		assert.Equal(t, want, patterns.Rewrite(readme, "v1.2.0"))
+	})
+	t.Run("Should leave pinned versions of other projects alone", func(t *testing.T) {
+		patterns, err := ParseReadmeVersionPatterns(DefaultReadmeVersionPatterns, "acme", "tool")
+		require.NoError(t, err)
+		readme := "    go install golang.org/x/tools/cmd/stringer@v0.30.0\n" +
+			"    go install github.com/acme/toolkit@v1.1.0\n" +
+			"    curl -sSL https://github.com/goreleaser/goreleaser/releases/download/v2.12.0/checksums.txt\n"
+		assert.Equal(t, readme, patterns.Rewrite(readme, "v1.2.0"))
+	})
+	t.Run("Should expand the repository placeholder in configured patterns", func(t *testing.T) {
+		patterns, err := ParseReadmeVersionPatterns([]string{`{repo}@(\S+)`}, "acme", "tool")
+		require.NoError(t, err)
+		assert.Equal(t, "github.com/acme/tool@v1.2.0 github.com/acme/other@v1.1.0",
+			patterns.Rewrite("github.com/acme/tool@v1.1.0 github.com/acme/other@v1.1.0", "v1.2.0"))
+	})
+	t.Run("Should keep the prefix style of the captured version", func(t *testing.T) {
+		patterns, err := ParseReadmeVersionPatterns([]string{`VERSION=(\S+)`}, "acme", "tool")
+		require.NoError(t, err)
+		assert.Equal(t, "VERSION=2.0.0\n", patterns.Rewrite("VERSION=1.9.3\n", "v2.0.0"))
+	})
+	t.Run("Should reject patterns without exactly one capture group", func(t *testing.T) {
+		_, err := ParseReadmeVersionPatterns([]string{`@v\d+`}, "acme", "tool")
+		assert.ErrorContains(t, err, "exactly one capture group")
+		_, err = ParseReadmeVersionPatterns([]string{`(`}, "acme", "tool")
+		assert.ErrorContains(t, err, "invalid README version pattern")
+	})
+}
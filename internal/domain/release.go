@@ -9,4 +9,22 @@ type Release struct {
 	BranchName   string
 	TagName      string
 	PRBody       string
+	Date         string // Release date already formatted for display; empty when unknown
+	Artifacts    []ArtifactBuild
+	ClosedIssues []int // Issues the release PR closes when merged
+	Links        ReleaseLinks
+	// MergedPullRequests lists the pull requests merged since the previous release; empty unless
+	// pr_body_merged_prs is enabled
+	MergedPullRequests []PullRequest
+}
+
+// ArtifactBuild identifies one platform build produced by a GoReleaser run.
+type ArtifactBuild struct {
+	OS   string
+	Arch string
+}
+
+// String returns the build in os/arch form.
+func (b ArtifactBuild) String() string {
+	return b.OS + "/" + b.Arch
 }
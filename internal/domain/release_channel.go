@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// LatestReleaseChannel is the channel of releases cut from the default branch.
+const LatestReleaseChannel = "latest"
+
+// releaseChannelNamePattern matches channel names, which double as npm dist-tags and prerelease identifiers.
+var releaseChannelNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// ReleaseChannel maps long-lived branches to the channel their releases are distributed on.
+type ReleaseChannel struct {
+	// Name is the channel, used as the npm dist-tag and, for prerelease channels, the version suffix.
+	Name string
+	// Branch is a branch name or a path.Match pattern such as lts/*.
+	Branch string
+	// Prerelease versions releases of the channel as vX.Y.Z-<name>.N and marks them as prereleases.
+	Prerelease bool
+}
+
+// DefaultReleaseChannel is the channel of branches no configured channel matches.
+func DefaultReleaseChannel() ReleaseChannel {
+	return ReleaseChannel{Name: LatestReleaseChannel, Branch: "main"}
+}
+
+// ValidateReleaseChannel checks that the channel has a usable name and branch pattern.
+func ValidateReleaseChannel(channel ReleaseChannel) error {
+	if !releaseChannelNamePattern.MatchString(channel.Name) {
+		return fmt.Errorf("invalid channel name %q: use lowercase letters, digits and hyphens", channel.Name)
+	}
+	if strings.TrimSpace(channel.Branch) == "" {
+		return fmt.Errorf("branch cannot be empty")
+	}
+	if _, err := path.Match(channel.Branch, ""); err != nil {
+		return fmt.Errorf("invalid branch pattern %q: %w", channel.Branch, err)
+	}
+	return nil
+}
+
+// Matches reports whether branch belongs to the channel.
+func (c ReleaseChannel) Matches(branch string) bool {
+	matched, err := path.Match(c.Branch, branch)
+	return err == nil && matched
+}
+
+// IsLatest reports whether releases of the channel become the latest release.
+func (c ReleaseChannel) IsLatest() bool {
+	return c.Name == LatestReleaseChannel
+}
+
+// ResolveReleaseChannel returns the first channel matching branch.
+func ResolveReleaseChannel(channels []ReleaseChannel, branch string) (ReleaseChannel, bool) {
+	for _, channel := range channels {
+		if channel.Matches(branch) {
+			return channel, true
+		}
+	}
+	return ReleaseChannel{}, false
+}
+
+// PrereleaseVersion turns next into the next prerelease of the channel. A latest tag already on the
+// channel for the same or a later version continues its numbering, e.g. v1.3.0-next.2 is followed by
+// v1.3.0-next.3; otherwise the numbering starts at next with .1. Stable channels return next as is.
+func (c ReleaseChannel) PrereleaseVersion(next, latest *Version) (*Version, error) {
+	if !c.Prerelease {
+		return next, nil
+	}
+	core := versionCore(next)
+	number := 1
+	if latest != nil {
+		if previous, ok := c.prereleaseNumber(latest); ok && versionCore(latest).Compare(core) >= 0 {
+			core = versionCore(latest)
+			number = previous + 1
+		}
+	}
+	return NewVersion(fmt.Sprintf("%s-%s.%d", core.Version, c.Name, number))
+}
+
+// prereleaseNumber returns N of a vX.Y.Z-<name>.N version of the channel.
+func (c ReleaseChannel) prereleaseNumber(version *Version) (int, bool) {
+	suffix, ok := strings.CutPrefix(version.Prerelease(), c.Name+".")
+	if !ok {
+		return 0, false
+	}
+	number, err := strconv.Atoi(suffix)
+	if err != nil {
+		return 0, false
+	}
+	return number, true
+}
+
+func versionCore(version *Version) *Version {
+	core, err := NewVersion(fmt.Sprintf("%d.%d.%d", version.Major(), version.Minor(), version.Patch()))
+	if err != nil {
+		return version
+	}
+	return core
+}
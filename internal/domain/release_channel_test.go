@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveReleaseChannel(t *testing.T) {
+	channels := []ReleaseChannel{
+		{Name: "latest", Branch: "main"},
+		{Name: "next", Branch: "next", Prerelease: true},
+		{Name: "lts", Branch: "lts/*"},
+	}
+	t.Run("Should match branch names and patterns", func(t *testing.T) {
+		channel, ok := ResolveReleaseChannel(channels, "lts/1.x")
+		require.True(t, ok)
+		assert.Equal(t, "lts", channel.Name)
+		channel, ok = ResolveReleaseChannel(channels, "main")
+		require.True(t, ok)
+		assert.True(t, channel.IsLatest())
+	})
+	t.Run("Should not match unmapped branches", func(t *testing.T) {
+		_, ok := ResolveReleaseChannel(channels, "feature/x")
+		assert.False(t, ok)
+	})
+}
+
+func TestValidateReleaseChannel(t *testing.T) {
+	t.Run("Should reject invalid names and patterns", func(t *testing.T) {
+		require.ErrorContains(t, ValidateReleaseChannel(ReleaseChannel{Name: "Next", Branch: "next"}),
+			"invalid channel name")
+		require.ErrorContains(t, ValidateReleaseChannel(ReleaseChannel{Name: "next"}), "branch cannot be empty")
+		require.ErrorContains(t, ValidateReleaseChannel(ReleaseChannel{Name: "lts", Branch: "lts/["}),
+			"invalid branch pattern")
+		require.NoError(t, ValidateReleaseChannel(ReleaseChannel{Name: "lts", Branch: "lts/*"}))
+	})
+}
+
+func TestReleaseChannelPrereleaseVersion(t *testing.T) {
+	next := ReleaseChannel{Name: "next", Branch: "next", Prerelease: true}
+	version := func(s string) *Version {
+		v, err := NewVersion(s)
+		require.NoError(t, err)
+		return v
+	}
+	t.Run("Should start the numbering of a new prerelease line", func(t *testing.T) {
+		got, err := next.PrereleaseVersion(version("1.3.0"), version("1.2.0"))
+		require.NoError(t, err)
+		assert.Equal(t, "v1.3.0-next.1", got.String())
+	})
+	t.Run("Should continue the numbering of the channel", func(t *testing.T) {
+		got, err := next.PrereleaseVersion(version("1.3.0"), version("1.3.0-next.2"))
+		require.NoError(t, err)
+		assert.Equal(t, "v1.3.0-next.3", got.String())
+	})
+	t.Run("Should restart the numbering when the version moves past the channel", func(t *testing.T) {
+		got, err := next.PrereleaseVersion(version("2.0.0"), version("1.3.0-next.2"))
+		require.NoError(t, err)
+		assert.Equal(t, "v2.0.0-next.1", got.String())
+	})
+	t.Run("Should keep versions of stable channels", func(t *testing.T) {
+		lts := ReleaseChannel{Name: "lts", Branch: "lts/*"}
+		got, err := lts.PrereleaseVersion(version("1.2.1"), version("1.2.0"))
+		require.NoError(t, err)
+		assert.Equal(t, "v1.2.1", got.String())
+	})
+}
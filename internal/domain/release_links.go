@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReleaseLinks holds the computed references release templates can use. Fields stay empty when the
+// information is not available, e.g. PRURL before the release PR is opened.
+type ReleaseLinks struct {
+	PreviousTag  string
+	CompareURL   string
+	PRURL        string
+	MilestoneURL string
+	Contributors []string // GitHub handles with the @ prefix, bots excluded
+}
+
+// NewReleaseLinks returns the links derived from the tags alone. The compare URL spans previousTag
+// to tag, or lists the commits of tag for a first release.
+func NewReleaseLinks(owner, repo, previousTag, tag string) ReleaseLinks {
+	links := ReleaseLinks{PreviousTag: previousTag}
+	if previousTag == "" {
+		links.CompareURL = fmt.Sprintf("%s/commits/%s", githubRepoURL(owner, repo), tag)
+		return links
+	}
+	links.CompareURL = fmt.Sprintf("%s/compare/%s...%s", githubRepoURL(owner, repo), previousTag, tag)
+	return links
+}
+
+// PullRequestURL returns the web URL of a pull request.
+func PullRequestURL(owner, repo string, number int) string {
+	return fmt.Sprintf("%s/pull/%d", githubRepoURL(owner, repo), number)
+}
+
+// ReleaseURL returns the web URL of the GitHub release of a tag.
+func ReleaseURL(owner, repo, tag string) string {
+	return fmt.Sprintf("%s/releases/tag/%s", githubRepoURL(owner, repo), tag)
+}
+
+// MilestoneURL returns the web URL of a milestone.
+func MilestoneURL(owner, repo string, number int) string {
+	return fmt.Sprintf("%s/milestone/%d", githubRepoURL(owner, repo), number)
+}
+
+// ContributorHandles turns GitHub logins into @handles, dropping bot accounts such as dependabot[bot].
+func ContributorHandles(logins []string) []string {
+	handles := make([]string, 0, len(logins))
+	for _, login := range logins {
+		if login == "" || strings.HasSuffix(login, "[bot]") {
+			continue
+		}
+		handles = append(handles, "@"+login)
+	}
+	return handles
+}
+
+func githubRepoURL(owner, repo string) string {
+	return fmt.Sprintf("https://github.com/%s/%s", owner, repo)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReleaseLinks(t *testing.T) {
+	t.Run("Should compare the previous tag with the new one", func(t *testing.T) {
+		links := NewReleaseLinks("compozy", "releasepr", "v1.1.0", "v1.2.0")
+		assert.Equal(t, "v1.1.0", links.PreviousTag)
+		assert.Equal(t, "https://github.com/compozy/releasepr/compare/v1.1.0...v1.2.0", links.CompareURL)
+	})
+	t.Run("Should list the commits of a first release", func(t *testing.T) {
+		links := NewReleaseLinks("compozy", "releasepr", "", "v0.1.0")
+		assert.Empty(t, links.PreviousTag)
+		assert.Equal(t, "https://github.com/compozy/releasepr/commits/v0.1.0", links.CompareURL)
+	})
+}
+
+func TestReleaseLinkURLs(t *testing.T) {
+	t.Run("Should build pull request and milestone URLs", func(t *testing.T) {
+		assert.Equal(t, "https://github.com/compozy/releasepr/pull/42", PullRequestURL("compozy", "releasepr", 42))
+		assert.Equal(t, "https://github.com/compozy/releasepr/milestone/4", MilestoneURL("compozy", "releasepr", 4))
+	})
+	t.Run("Should build release URLs", func(t *testing.T) {
+		assert.Equal(t,
+			"https://github.com/compozy/releasepr/releases/tag/v1.4.0",
+			ReleaseURL("compozy", "releasepr", "v1.4.0"),
+		)
+	})
+}
+
+func TestContributorHandles(t *testing.T) {
+	t.Run("Should prefix logins and drop bots", func(t *testing.T) {
+		handles := ContributorHandles([]string{"alice", "dependabot[bot]", "", "bob"})
+		assert.Equal(t, []string{"@alice", "@bob"}, handles)
+	})
+}
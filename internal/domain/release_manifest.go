@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+// ReleaseManifestSchemaVersion is bumped whenever the manifest layout changes incompatibly.
+const ReleaseManifestSchemaVersion = 1
+
+// ReleaseManifest is the machine-readable record of a release consumed by deployment tooling.
+type ReleaseManifest struct {
+	SchemaVersion int                    `json:"schema_version"`
+	Version       string                 `json:"version"`
+	Tag           string                 `json:"tag"`
+	Commit        string                 `json:"commit"`
+	PRNumber      int                    `json:"pr_number,omitempty"`
+	Artifacts     []ManifestArtifact     `json:"artifacts"`
+	ReleaseNotes  *ManifestReleaseNotes  `json:"release_notes,omitempty"`
+	SignOff       *ManifestSignOff       `json:"sign_off,omitempty"`
+	Compatibility *ManifestCompatibility `json:"compatibility,omitempty"`
+	CreatedAt     time.Time              `json:"created_at"`
+	PublishedAt   *time.Time             `json:"published_at,omitempty"`
+}
+
+// ManifestArtifact describes one file produced by the release build.
+type ManifestArtifact struct {
+	Name   string `json:"name"`
+	Path   string `json:"path,omitempty"`
+	Type   string `json:"type"`
+	OS     string `json:"os,omitempty"`
+	Arch   string `json:"arch,omitempty"`
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// ManifestReleaseNotes identifies the canonical release notes, so consumers can verify the notes they
+// fetched, e.g. the release asset, against the checksum.
+type ManifestReleaseNotes struct {
+	Name   string `json:"name"`
+	SHA256 string `json:"sha256"`
+}
+
+// ManifestSignOff records the sign-off of the release pull request checked before publishing, and
+// the requirements it was published without when the sign-off was overridden.
+type ManifestSignOff struct {
+	PullRequest    int      `json:"pull_request"`
+	Approvers      []string `json:"approvers"`
+	Overrides      []string `json:"overrides,omitempty"`
+	OverrideReason string   `json:"override_reason,omitempty"`
+}
+
+// ManifestCompatibility records whether the release is backward compatible with the previous one, so
+// dependency-update pipelines can decide whether to auto-merge the update.
+type ManifestCompatibility struct {
+	Level           CompatibilityLevel `json:"level"`
+	PreviousVersion string             `json:"previous_version,omitempty"`
+	Range           string             `json:"range,omitempty"`
+	Reasons         []string           `json:"reasons,omitempty"`
+}
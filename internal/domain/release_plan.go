@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// ReleasePlanSchemaVersion is the version of the release plan JSON layout. Plans of another
+// version are rejected rather than applied with a different meaning.
+const ReleasePlanSchemaVersion = 1
+
+// Actions of a planned file change.
+const (
+	FileActionCreate = "create"
+	FileActionModify = "modify"
+	FileActionDelete = "delete"
+	FileActionRename = "rename"
+)
+
+// ReleasePlan describes every mutation a release PR run intends to make, so an external approval
+// system can review it before the run is applied. A plan without changes describes a run that
+// releases nothing.
+type ReleasePlan struct {
+	SchemaVersion int                 `json:"schema_version"`
+	HasChanges    bool                `json:"has_changes"`
+	Reason        string              `json:"reason,omitempty"`
+	Options       PlanOptions         `json:"options"`
+	LatestTag     string              `json:"latest_tag,omitempty"`
+	Version       string              `json:"version,omitempty"`
+	BaseCommit    string              `json:"base_commit,omitempty"`
+	Branch        *PlannedBranch      `json:"branch,omitempty"`
+	Files         []PlannedFileChange `json:"files,omitempty"`
+	Commands      []PlannedCommand    `json:"commands,omitempty"`
+	PullRequest   *PlannedPullRequest `json:"pull_request,omitempty"`
+}
+
+// PlanOptions are the pr-release options a plan was computed with, which applying it reuses.
+type PlanOptions struct {
+	Force     bool     `json:"force,omitempty"`
+	SkipPR    bool     `json:"skip_pr,omitempty"`
+	SkipSteps []string `json:"skip_steps,omitempty"`
+}
+
+// PlannedBranch is the release branch to create from the base branch.
+type PlannedBranch struct {
+	Name string `json:"name"`
+	Base string `json:"base"`
+}
+
+// PlannedFileChange is a file the release commit changes, with the unified diff of a created or
+// modified file and the source of a renamed one.
+type PlannedFileChange struct {
+	Path   string `json:"path"`
+	Action string `json:"action"`
+	From   string `json:"from,omitempty"`
+	Diff   string `json:"diff,omitempty"`
+}
+
+// PlannedCommand is an external command the run executes, such as a custom version updater or a
+// release artifact command. Its file changes cannot be previewed without running it.
+type PlannedCommand struct {
+	Step    WorkflowStep `json:"step"`
+	Name    string       `json:"name"`
+	Command string       `json:"command"`
+}
+
+// PlannedPullRequest is the release pull request to create or update.
+type PlannedPullRequest struct {
+	Title  string   `json:"title"`
+	Head   string   `json:"head"`
+	Base   string   `json:"base"`
+	Labels []string `json:"labels"`
+}
+
+// ParseReleasePlan decodes a release plan and checks its schema version.
+func ParseReleasePlan(data []byte) (*ReleasePlan, error) {
+	var plan ReleasePlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("invalid release plan: %w", err)
+	}
+	if plan.SchemaVersion != ReleasePlanSchemaVersion {
+		return nil, fmt.Errorf("unsupported release plan schema version %d (expected %d)",
+			plan.SchemaVersion, ReleasePlanSchemaVersion)
+	}
+	return &plan, nil
+}
+
+// Differences lists what current, the plan computed now, changes compared to p, the approved plan.
+// An empty list means applying now performs exactly the approved mutations.
+func (p *ReleasePlan) Differences(current *ReleasePlan) []string {
+	var differences []string
+	differ := func(name string, approved, now any) {
+		if !jsonEqual(approved, now) {
+			differences = append(differences, name)
+		}
+	}
+	differ("has_changes", p.HasChanges, current.HasChanges)
+	differ("latest_tag", p.LatestTag, current.LatestTag)
+	differ("version", p.Version, current.Version)
+	differ("base_commit", p.BaseCommit, current.BaseCommit)
+	differ("branch", p.Branch, current.Branch)
+	differ("commands", p.Commands, current.Commands)
+	differ("pull_request", p.PullRequest, current.PullRequest)
+	for _, path := range plannedPaths(p.Files, current.Files) {
+		differ("files/"+path, findPlannedFile(p.Files, path), findPlannedFile(current.Files, path))
+	}
+	return differences
+}
+
+// jsonEqual compares two plan values as they are serialized, the form the approval system reviewed.
+func jsonEqual(a, b any) bool {
+	left, leftErr := json.Marshal(a)
+	right, rightErr := json.Marshal(b)
+	return leftErr == nil && rightErr == nil && string(left) == string(right)
+}
+
+// plannedPaths returns the sorted paths changed in either list of file changes.
+func plannedPaths(lists ...[]PlannedFileChange) []string {
+	var paths []string
+	for _, files := range lists {
+		for _, file := range files {
+			if !slices.Contains(paths, file.Path) {
+				paths = append(paths, file.Path)
+			}
+		}
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+func findPlannedFile(files []PlannedFileChange, path string) *PlannedFileChange {
+	for i := range files {
+		if files[i].Path == path {
+			return &files[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReleasePlan() *ReleasePlan {
+	return &ReleasePlan{
+		SchemaVersion: ReleasePlanSchemaVersion,
+		HasChanges:    true,
+		Version:       "v1.2.3",
+		BaseCommit:    "abc123",
+		Branch:        &PlannedBranch{Name: "release/v1.2.3", Base: "main"},
+		Files: []PlannedFileChange{
+			{Path: "CHANGELOG.md", Action: FileActionModify, Diff: "+## v1.2.3\n"},
+		},
+		PullRequest: &PlannedPullRequest{Title: "release: Release v1.2.3", Labels: []string{"automated"}},
+	}
+}
+
+func TestParseReleasePlan(t *testing.T) {
+	t.Run("Should decode a plan it encoded", func(t *testing.T) {
+		data, err := json.Marshal(testReleasePlan())
+		require.NoError(t, err)
+		plan, err := ParseReleasePlan(data)
+		require.NoError(t, err)
+		assert.Equal(t, testReleasePlan(), plan)
+	})
+	t.Run("Should reject plans of another schema version", func(t *testing.T) {
+		_, err := ParseReleasePlan([]byte(`{"schema_version":2}`))
+		require.ErrorContains(t, err, "unsupported release plan schema version 2")
+		_, err = ParseReleasePlan([]byte(`{`))
+		require.ErrorContains(t, err, "invalid release plan")
+	})
+}
+
+func TestReleasePlan_Differences(t *testing.T) {
+	t.Run("Should find no differences between equal plans", func(t *testing.T) {
+		assert.Empty(t, testReleasePlan().Differences(testReleasePlan()))
+	})
+	t.Run("Should name the fields and files that changed", func(t *testing.T) {
+		current := testReleasePlan()
+		current.BaseCommit = "def456"
+		current.Files[0].Diff = "+## v1.2.3 - 2026-10-17\n"
+		current.Files = append(current.Files, PlannedFileChange{Path: "package.json", Action: FileActionModify})
+		assert.Equal(t, []string{"base_commit", "files/CHANGELOG.md", "files/package.json"},
+			testReleasePlan().Differences(current))
+	})
+}
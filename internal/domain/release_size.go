@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// FileDiffStat counts the lines one file adds and removes in a diff.
+type FileDiffStat struct {
+	Path       string
+	Insertions int
+	Deletions  int
+}
+
+// Lines returns the number of changed lines.
+func (s FileDiffStat) Lines() int {
+	return s.Insertions + s.Deletions
+}
+
+// ReleaseSizeLimits caps the size of the release commit. Zero disables a limit.
+type ReleaseSizeLimits struct {
+	MaxFiles int
+	MaxLines int
+}
+
+// Exceeded describes the limits stats exceed, or returns an empty string when the diff fits.
+func (l ReleaseSizeLimits) Exceeded(stats []FileDiffStat) string {
+	lines := 0
+	for _, stat := range stats {
+		lines += stat.Lines()
+	}
+	var exceeded []string
+	if l.MaxFiles > 0 && len(stats) > l.MaxFiles {
+		exceeded = append(exceeded, fmt.Sprintf("%d files changed (limit %d)", len(stats), l.MaxFiles))
+	}
+	if l.MaxLines > 0 && lines > l.MaxLines {
+		exceeded = append(exceeded, fmt.Sprintf("%d lines changed (limit %d)", lines, l.MaxLines))
+	}
+	return strings.Join(exceeded, ", ")
+}
+
+// LargestFiles returns up to n files ordered by changed lines, largest first.
+func LargestFiles(stats []FileDiffStat, n int) []FileDiffStat {
+	sorted := slices.Clone(stats)
+	slices.SortStableFunc(sorted, func(a, b FileDiffStat) int {
+		return cmp.Compare(b.Lines(), a.Lines())
+	})
+	return sorted[:min(n, len(sorted))]
+}
+
+// PathPatterns matches repository paths against gitignore-style patterns, the syntax CODEOWNERS uses.
+type PathPatterns struct {
+	patterns []*regexp.Regexp
+}
+
+// ParsePathPatterns compiles patterns, failing on the first invalid one.
+func ParsePathPatterns(patterns []string) (PathPatterns, error) {
+	var parsed PathPatterns
+	for _, raw := range patterns {
+		trimmed := strings.TrimSpace(raw)
+		if trimmed == "" {
+			continue
+		}
+		pattern, err := codeOwnersPattern(trimmed)
+		if err != nil {
+			return PathPatterns{}, fmt.Errorf("invalid path pattern %q: %w", raw, err)
+		}
+		parsed.patterns = append(parsed.patterns, pattern)
+	}
+	return parsed, nil
+}
+
+// Match reports whether any pattern matches path.
+func (p PathPatterns) Match(path string) bool {
+	path = strings.TrimPrefix(path, "/")
+	return slices.ContainsFunc(p.patterns, func(pattern *regexp.Regexp) bool {
+		return pattern.MatchString(path)
+	})
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseSizeLimits_Exceeded(t *testing.T) {
+	stats := []FileDiffStat{
+		{Path: "CHANGELOG.md", Insertions: 10},
+		{Path: "dist/bundle.js", Insertions: 900, Deletions: 850},
+		{Path: "package.json", Insertions: 1, Deletions: 1},
+	}
+	t.Run("Should describe every exceeded limit", func(t *testing.T) {
+		limits := ReleaseSizeLimits{MaxFiles: 2, MaxLines: 1000}
+		assert.Equal(t, "3 files changed (limit 2), 1762 lines changed (limit 1000)", limits.Exceeded(stats))
+	})
+	t.Run("Should ignore disabled limits", func(t *testing.T) {
+		assert.Empty(t, ReleaseSizeLimits{}.Exceeded(stats))
+		assert.Empty(t, ReleaseSizeLimits{MaxFiles: 3}.Exceeded(stats))
+	})
+	t.Run("Should list the largest files first", func(t *testing.T) {
+		largest := LargestFiles(stats, 2)
+		require.Len(t, largest, 2)
+		assert.Equal(t, "dist/bundle.js", largest[0].Path)
+		assert.Equal(t, "CHANGELOG.md", largest[1].Path)
+		assert.Len(t, LargestFiles(stats, 10), 3)
+	})
+}
+
+func TestPathPatterns_Match(t *testing.T) {
+	t.Run("Should match gitignore-style patterns", func(t *testing.T) {
+		patterns, err := ParsePathPatterns([]string{"dist/", "*.lock", "docs/**/generated.md"})
+		require.NoError(t, err)
+		assert.True(t, patterns.Match("dist/bundle.js"))
+		assert.True(t, patterns.Match("web/yarn.lock"))
+		assert.True(t, patterns.Match("docs/api/v1/generated.md"))
+		assert.False(t, patterns.Match("CHANGELOG.md"))
+	})
+	t.Run("Should match nothing without patterns", func(t *testing.T) {
+		patterns, err := ParsePathPatterns(nil)
+		require.NoError(t, err)
+		assert.False(t, patterns.Match("CHANGELOG.md"))
+	})
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReleaseStats summarizes the commits and diff that make up a release.
+type ReleaseStats struct {
+	Commits      int
+	Contributors int
+	FilesChanged int
+	Insertions   int
+	Deletions    int
+}
+
+// RenderMarkdown renders the statistics footer appended to release notes, or an empty string when
+// the release has no commits.
+func (s ReleaseStats) RenderMarkdown() string {
+	return s.LocalizedMarkdown(Locale{})
+}
+
+// LocalizedMarkdown renders the statistics footer in the language of locale.
+func (s ReleaseStats) LocalizedMarkdown(locale Locale) string {
+	if s.Commits == 0 {
+		return ""
+	}
+	var builder strings.Builder
+	builder.WriteString("### " + locale.T("Release Statistics") + "\n\n")
+	builder.WriteString("- " + fmt.Sprintf(locale.T("%s by %s"),
+		locale.Plural(s.Commits, "commit", "commits"),
+		locale.Plural(s.Contributors, "contributor", "contributors"),
+	) + "\n")
+	fmt.Fprintf(&builder, "- %s, %s(+), %s(-)",
+		locale.Plural(s.FilesChanged, "file changed", "files changed"),
+		locale.Plural(s.Insertions, "insertion", "insertions"),
+		locale.Plural(s.Deletions, "deletion", "deletions"),
+	)
+	return builder.String()
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseStats_RenderMarkdown(t *testing.T) {
+	t.Run("Should render commits, contributors and diff totals", func(t *testing.T) {
+		stats := ReleaseStats{Commits: 12, Contributors: 4, FilesChanged: 37, Insertions: 820, Deletions: 143}
+		assert.Equal(t, "### Release Statistics\n\n"+
+			"- 12 commits by 4 contributors\n"+
+			"- 37 files changed, 820 insertions(+), 143 deletions(-)", stats.RenderMarkdown())
+	})
+	t.Run("Should use singular forms for single items", func(t *testing.T) {
+		stats := ReleaseStats{Commits: 1, Contributors: 1, FilesChanged: 1, Insertions: 1, Deletions: 0}
+		assert.Equal(t, "### Release Statistics\n\n"+
+			"- 1 commit by 1 contributor\n"+
+			"- 1 file changed, 1 insertion(+), 0 deletions(-)", stats.RenderMarkdown())
+	})
+	t.Run("Should render the footer in the given locale", func(t *testing.T) {
+		locale, err := LoadLocale("pt-BR")
+		require.NoError(t, err)
+		stats := ReleaseStats{Commits: 3, Contributors: 1, FilesChanged: 2, Insertions: 1, Deletions: 5}
+		assert.Equal(t, "### Estatísticas da versão\n\n"+
+			"- 3 commits por 1 colaborador\n"+
+			"- 2 arquivos alterados, 1 inserção(+), 5 remoções(-)", stats.LocalizedMarkdown(locale))
+	})
+	t.Run("Should render nothing for an empty release", func(t *testing.T) {
+		assert.Empty(t, ReleaseStats{}.RenderMarkdown())
+	})
+}
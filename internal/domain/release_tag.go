@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+// ReleaseTag is a git tag and the date it was released on: the tagger date of an annotated tag,
+// or the commit date of a lightweight tag.
+type ReleaseTag struct {
+	Name string
+	Date time.Time
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ReleaseThreshold holds back releases of trivial changes: a release needs at least MinCommits
+// commits since the latest tag and, when RequireTypes is set, one conventional commit of a listed
+// type. Breaking changes always meet RequireTypes.
+type ReleaseThreshold struct {
+	MinCommits   int
+	RequireTypes []string
+}
+
+// IsZero reports whether the threshold releases any change.
+func (t ReleaseThreshold) IsZero() bool {
+	return t.MinCommits <= 1 && len(t.RequireTypes) == 0
+}
+
+// Evaluate decides whether the commits since tag, counted by commits and listed by subjects, meet the
+// threshold. When they do not, the reason explains what is missing.
+func (t ReleaseThreshold) Evaluate(tag string, commits int, subjects []string) (bool, string) {
+	if commits < t.MinCommits {
+		return false, fmt.Sprintf("%d commit(s) since %s, min_commits is %d", commits, tag, t.MinCommits)
+	}
+	if len(t.RequireTypes) == 0 || slices.ContainsFunc(subjects, t.hasRequiredType) {
+		return true, ""
+	}
+	return false, fmt.Sprintf("no %s commit since %s (require_types)", strings.Join(t.RequireTypes, ", "), tag)
+}
+
+// hasRequiredType reports whether subject is a breaking change or a conventional commit of a required type.
+func (t ReleaseThreshold) hasRequiredType(subject string) bool {
+	match := conventionalSubjectPattern.FindStringSubmatch(strings.TrimSpace(subject))
+	if match == nil {
+		return false
+	}
+	return match[3] != "" || containsFold(t.RequireTypes, match[1])
+}
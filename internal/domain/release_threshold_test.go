@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseThreshold_Evaluate(t *testing.T) {
+	threshold := ReleaseThreshold{MinCommits: 3, RequireTypes: []string{"feat", "fix"}}
+	t.Run("Should release when enough commits include a required type", func(t *testing.T) {
+		ok, reason := threshold.Evaluate("v1.2.0", 3, []string{"chore: tidy", "Fix(api): handle nil", "docs: typo"})
+		assert.True(t, ok)
+		assert.Empty(t, reason)
+	})
+	t.Run("Should hold back releases with too few commits", func(t *testing.T) {
+		ok, reason := threshold.Evaluate("v1.2.0", 2, []string{"feat: add x", "fix: y"})
+		assert.False(t, ok)
+		assert.Equal(t, "2 commit(s) since v1.2.0, min_commits is 3", reason)
+	})
+	t.Run("Should hold back releases without a required type", func(t *testing.T) {
+		ok, reason := threshold.Evaluate("v1.2.0", 3, []string{"chore: a", "ci: b", "refactor: c"})
+		assert.False(t, ok)
+		assert.Equal(t, "no feat, fix commit since v1.2.0 (require_types)", reason)
+	})
+	t.Run("Should count breaking changes as a required type", func(t *testing.T) {
+		ok, _ := threshold.Evaluate("v1.2.0", 3, []string{"chore!: drop Go 1.22", "ci: b", "docs: c"})
+		assert.True(t, ok)
+	})
+	t.Run("Should release any change without a threshold", func(t *testing.T) {
+		assert.True(t, ReleaseThreshold{}.IsZero())
+		assert.False(t, threshold.IsZero())
+		ok, _ := ReleaseThreshold{}.Evaluate("v1.2.0", 1, []string{"chore: a"})
+		assert.True(t, ok)
+	})
+}
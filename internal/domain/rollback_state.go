@@ -37,6 +37,7 @@ const (
 	OperationTypeUpdatePackages    OperationType = "update_packages"
 	OperationTypeGenerateChangelog OperationType = "generate_changelog"
 	OperationTypeArchiveNotes      OperationType = "archive_release_notes"
+	OperationTypeConsumeChanges    OperationType = "consume_change_files"
 	OperationTypeCommitChanges     OperationType = "commit_changes"
 	OperationTypePushBranch        OperationType = "push_branch"
 	OperationTypeCreatePR          OperationType = "create_pr"
@@ -50,9 +51,36 @@ type RollbackState struct {
 	Version        string            `json:"version"`
 	BranchName     string            `json:"branch_name"`
 	OriginalBranch string            `json:"original_branch"`
+	BaseBranch     string            `json:"base_branch"`
 	Operations     []OperationRecord `json:"operations"`
 	Status         WorkflowStatus    `json:"status"`
 	Error          string            `json:"error,omitempty"`
+	// PRNumber and PRURL identify the release PR the session created or updated, which rollback closes.
+	PRNumber int    `json:"pr_number,omitempty"`
+	PRURL    string `json:"pr_url,omitempty"`
+	// DryRun marks sessions that only planned their mutating operations; Plan lists them in order.
+	DryRun bool     `json:"dry_run,omitempty"`
+	Plan   []string `json:"plan,omitempty"`
+	// ManualCleanup lists the compensations a best-effort rollback could not complete, whose
+	// resources are left for the operator to clean up.
+	ManualCleanup []ManualCleanup `json:"manual_cleanup,omitempty"`
+	// ToolVersion, StartCommit and ConfigHash record the releasepr binary, the repository HEAD and
+	// the effective configuration the session started with, so resume and rollback can tell when
+	// they run under different conditions.
+	ToolVersion string `json:"tool_version,omitempty"`
+	StartCommit string `json:"start_commit,omitempty"`
+	ConfigHash  string `json:"config_hash,omitempty"`
+	// ForceRelease, SkipPR and SkipSteps record the flags the session ran with, which resume reapplies.
+	ForceRelease bool     `json:"force_release,omitempty"`
+	SkipPR       bool     `json:"skip_pr,omitempty"`
+	SkipSteps    []string `json:"skip_steps,omitempty"`
+}
+
+// ManualCleanup is a compensation that failed during a best-effort rollback.
+type ManualCleanup struct {
+	Operation OperationType `json:"operation"`
+	Step      string        `json:"step"`
+	Error     string        `json:"error"`
 }
 
 // OperationRecord represents a single operation in the workflow
@@ -152,6 +180,18 @@ func (rs *RollbackState) MarkOperationFailed(opType OperationType, err error) {
 	rs.Error = err.Error()
 }
 
+// AddManualCleanup records a compensation a best-effort rollback could not complete
+func (rs *RollbackState) AddManualCleanup(opType OperationType, step string, err error) {
+	rs.ManualCleanup = append(rs.ManualCleanup, ManualCleanup{Operation: opType, Step: step, Error: err.Error()})
+	rs.UpdatedAt = time.Now()
+}
+
+// AddPlannedAction records what a skipped dry-run operation would have done
+func (rs *RollbackState) AddPlannedAction(action string) {
+	rs.Plan = append(rs.Plan, action)
+	rs.UpdatedAt = time.Now()
+}
+
 // generateOperationID creates a unique ID for an operation
 func generateOperationID(opType OperationType) string {
 	return string(opType) + "_" + time.Now().Format("20060102150405")
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// SecurityFixesMarker is the comment that opens the security section of release notes, so publish
+// recognizes a release with security fixes whatever the style and locale of the section heading.
+const SecurityFixesMarker = "<!-- pr-release:security-fixes -->"
+
+// SecurityReleasePrefix is prepended to the name of a GitHub release that contains security fixes.
+const SecurityReleasePrefix = "🔒 "
+
+var (
+	// advisoryID matches CVE and GitHub Security Advisory identifiers.
+	advisoryID = regexp.MustCompile(`(?i)\b(CVE-\d{4}-\d{4,}|GHSA(-[23456789cfghjmpqrvwx]{4}){3})\b`)
+	// conventionalSubject matches a Conventional Commits subject, capturing its type and description.
+	conventionalSubject = regexp.MustCompile(`^([A-Za-z]+)(\([^)]*\))?!?:\s*(.+)$`)
+	// securityFooter matches the footers that mark a commit as a security fix, e.g. "CVE: CVE-2024-1234".
+	securityFooter = regexp.MustCompile(`(?i)^(security|cve|ghsa)(-id)?:\s*\S`)
+	// pullRequestSuffix matches the " (#123)" GitHub appends to squash-merged subjects.
+	pullRequestSuffix = regexp.MustCompile(`\s*\(#\d+\)$`)
+)
+
+// SecurityFix is a change of the release that fixes a vulnerability.
+type SecurityFix struct {
+	Description string
+	Advisories  []string // CVE and GHSA identifiers, in order of appearance
+}
+
+// ParseSecurityCommit returns the security fix of a commit message: a commit of type security, or
+// one with a Security, CVE or GHSA footer. It reports false for any other commit.
+func ParseSecurityCommit(message string) (SecurityFix, bool) {
+	message = strings.TrimSpace(message)
+	subject, body, _ := strings.Cut(message, "\n")
+	match := conventionalSubject.FindStringSubmatch(strings.TrimSpace(subject))
+	if match != nil && strings.EqualFold(match[1], "security") {
+		return NewSecurityFix(subject, message), true
+	}
+	paragraphs := strings.Split(strings.TrimSpace(body), "\n\n")
+	for line := range strings.SplitSeq(paragraphs[len(paragraphs)-1], "\n") {
+		if securityFooter.MatchString(strings.TrimSpace(line)) {
+			return NewSecurityFix(subject, message), true
+		}
+	}
+	return SecurityFix{}, false
+}
+
+// NewSecurityFix returns the fix described by summary, a commit subject or pull request title without
+// its type, with the advisories mentioned in text.
+func NewSecurityFix(summary, text string) SecurityFix {
+	summary = strings.TrimSpace(summary)
+	if match := conventionalSubject.FindStringSubmatch(summary); match != nil {
+		summary = match[3]
+	}
+	fix := SecurityFix{Description: upperFirst(summary)}
+	for _, id := range advisoryID.FindAllString(text, -1) {
+		id = normalizeAdvisoryID(id)
+		if !slices.Contains(fix.Advisories, id) {
+			fix.Advisories = append(fix.Advisories, id)
+		}
+	}
+	return fix
+}
+
+// AdvisoryURL returns the page of a CVE in the National Vulnerability Database, or of a GHSA in the
+// GitHub Advisory Database.
+func AdvisoryURL(id string) string {
+	if strings.HasPrefix(id, "GHSA-") {
+		return "https://github.com/advisories/" + id
+	}
+	return "https://nvd.nist.gov/vuln/detail/" + id
+}
+
+// SecurityFixes are the security fixes of a release, each listed once.
+type SecurityFixes []SecurityFix
+
+// Add returns f with fix, merging it into a listed fix with the same description, such as the commit
+// and the pull request of one change.
+func (f SecurityFixes) Add(fix SecurityFix) SecurityFixes {
+	key := securityFixKey(fix.Description)
+	for i := range f {
+		if securityFixKey(f[i].Description) != key {
+			continue
+		}
+		for _, id := range fix.Advisories {
+			if !slices.Contains(f[i].Advisories, id) {
+				f[i].Advisories = append(f[i].Advisories, id)
+			}
+		}
+		return f
+	}
+	return append(f, fix)
+}
+
+// Markdown renders the security section of release notes with its heading in style, linking every
+// advisory. It is empty when there is no fix.
+func (f SecurityFixes) Markdown(style ChangelogStyle) string {
+	if len(f) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "### %s\n\n%s\n\n", securityHeading(style), SecurityFixesMarker)
+	for _, fix := range f {
+		b.WriteString("- " + fix.Description)
+		if len(fix.Advisories) > 0 {
+			links := make([]string, 0, len(fix.Advisories))
+			for _, id := range fix.Advisories {
+				links = append(links, fmt.Sprintf("[%s](%s)", id, AdvisoryURL(id)))
+			}
+			b.WriteString(" (" + strings.Join(links, ", ") + ")")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// InsertSecuritySection puts section at the top of the first release of document, right below its
+// heading, replacing the Security group git-cliff rendered for that release.
+func InsertSecuritySection(document, section string) string {
+	if section == "" {
+		return document
+	}
+	lines := strings.Split(strings.TrimRight(document, "\n"), "\n")
+	isRelease := func(line string) bool { return strings.HasPrefix(line, "## ") }
+	start := slices.IndexFunc(lines, isRelease) + 1
+	end := len(lines)
+	if next := slices.IndexFunc(lines[start:], isRelease); next >= 0 {
+		end = start + next
+	}
+	var release []string
+	inSecurity := false
+	for _, line := range lines[start:end] {
+		if strings.HasPrefix(line, "### ") {
+			group, ok := findChangelogGroup(line)
+			inSecurity = ok && group.plain == "Security"
+		}
+		if !inSecurity {
+			release = append(release, line)
+		}
+	}
+	var parts []string
+	for _, part := range []string{
+		strings.Join(lines[:start], "\n"),
+		section,
+		strings.Join(release, "\n"),
+		strings.Join(lines[end:], "\n"),
+	} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// securityHeading returns the title of the security section in style; the zero style uses the emoji
+// title of the default cliff.toml.
+func securityHeading(style ChangelogStyle) string {
+	group, _ := findChangelogGroup("### Security")
+	if style == "" {
+		return group.emoji
+	}
+	return style.title(group)
+}
+
+// securityFixKey identifies a fix by its description, ignoring case and a pull request reference.
+func securityFixKey(description string) string {
+	return strings.ToLower(pullRequestSuffix.ReplaceAllString(strings.TrimSpace(description), ""))
+}
+
+func normalizeAdvisoryID(id string) string {
+	if strings.HasPrefix(strings.ToUpper(id), "GHSA-") {
+		return "GHSA-" + strings.ToLower(id[len("GHSA-"):])
+	}
+	return strings.ToUpper(id)
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
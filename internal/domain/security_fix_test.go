@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSecurityCommit(t *testing.T) {
+	t.Run("Should detect commits of type security and collect their advisories", func(t *testing.T) {
+		fix, ok := ParseSecurityCommit("security(archive): reject paths escaping the target\n\nFixes cve-2024-1234.")
+		assert.True(t, ok)
+		want := SecurityFix{Description: "Reject paths escaping the target", Advisories: []string{"CVE-2024-1234"}}
+		assert.Equal(t, want, fix)
+	})
+	t.Run("Should detect commits with a security footer", func(t *testing.T) {
+		message := "fix: escape the release title\n\nThe title was rendered as HTML.\n\n" +
+			"GHSA: GHSA-JFH8-c2jp-5v3q\nRefs: #12"
+		fix, ok := ParseSecurityCommit(message)
+		assert.True(t, ok)
+		want := SecurityFix{Description: "Escape the release title", Advisories: []string{"GHSA-jfh8-c2jp-5v3q"}}
+		assert.Equal(t, want, fix)
+	})
+	t.Run("Should ignore other commits", func(t *testing.T) {
+		_, ok := ParseSecurityCommit("fix: handle nil\n\nSecurity: discussed in the body, not a footer\n\nRefs: #3")
+		assert.False(t, ok)
+	})
+}
+
+func TestSecurityFixes(t *testing.T) {
+	t.Run("Should merge the commit and pull request of one fix", func(t *testing.T) {
+		fixes := SecurityFixes{}.
+			Add(SecurityFix{
+				Description: "Reject paths escaping the target (#42)",
+				Advisories:  []string{"CVE-2024-1234"},
+			}).
+			Add(NewSecurityFix("security: reject paths escaping the target", "GHSA-jfh8-c2jp-5v3q"))
+		assert.Equal(t, SecurityFixes{{
+			Description: "Reject paths escaping the target (#42)",
+			Advisories:  []string{"CVE-2024-1234", "GHSA-jfh8-c2jp-5v3q"},
+		}}, fixes)
+	})
+	t.Run("Should render the section with advisory links in the changelog style", func(t *testing.T) {
+		fixes := SecurityFixes{{
+			Description: "Reject paths",
+			Advisories:  []string{"CVE-2024-1234", "GHSA-jfh8-c2jp-5v3q"},
+		}}
+		assert.Equal(t, "### 🔒 Security\n\n"+SecurityFixesMarker+"\n\n"+
+			"- Reject paths ([CVE-2024-1234](https://nvd.nist.gov/vuln/detail/CVE-2024-1234), "+
+			"[GHSA-jfh8-c2jp-5v3q](https://github.com/advisories/GHSA-jfh8-c2jp-5v3q))\n", fixes.Markdown(""))
+		assert.Contains(t, fixes.Markdown(ChangelogStylePlain), "### Security\n")
+		assert.Empty(t, SecurityFixes{}.Markdown(""))
+	})
+}
+
+func TestInsertSecuritySection(t *testing.T) {
+	t.Run("Should put the section first and drop the Security group of the release", func(t *testing.T) {
+		document := "## 1.2.0 - 2026-10-16\n\n### 🎉 Features\n\n- Add impact\n\n" +
+			"### 🔒 Security\n\n- Reject paths\n\n### 🐛 Bug Fixes\n\n- Handle nil\n\n" +
+			"## 1.1.0 - 2026-09-01\n\n### 🔒 Security\n\n- Older fix\n"
+		section := "### 🔒 Security\n\n- Reject paths (CVE-2024-1234)\n"
+		assert.Equal(t, "## 1.2.0 - 2026-10-16\n\n### 🔒 Security\n\n- Reject paths (CVE-2024-1234)\n\n"+
+			"### 🎉 Features\n\n- Add impact\n\n### 🐛 Bug Fixes\n\n- Handle nil\n\n"+
+			"## 1.1.0 - 2026-09-01\n\n### 🔒 Security\n\n- Older fix\n", InsertSecuritySection(document, section))
+	})
+	t.Run("Should keep the document without a section", func(t *testing.T) {
+		assert.Equal(t, "## 1.2.0\n", InsertSecuritySection("## 1.2.0\n", ""))
+	})
+}
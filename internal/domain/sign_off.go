@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// passingConclusions are the check run conclusions that meet a required check.
+var passingConclusions = []string{CheckConclusionSuccess, CheckConclusionNeutral, CheckConclusionSkipped}
+
+// ReviewStatus is the review state of a release pull request: the reviewers whose latest review
+// approves it and the outcome of every check run on its head commit.
+type ReviewStatus struct {
+	PullRequest int
+	Approvers   []string          // GitHub logins, sorted
+	Checks      map[string]string // check run name to its conclusion, or its status while it runs
+}
+
+// SignOffPolicy holds back the publication of a release pull request that is not approved by
+// MinApprovals reviewers or whose RequiredChecks did not pass.
+type SignOffPolicy struct {
+	MinApprovals   int
+	RequiredChecks []string
+}
+
+// IsZero reports whether the policy publishes any release pull request.
+func (p SignOffPolicy) IsZero() bool {
+	return p.MinApprovals <= 0 && len(p.RequiredChecks) == 0
+}
+
+// Evaluate lists the requirements of the policy status does not meet, empty when it is signed off.
+// A check passes when it concludes successfully, neutral or skipped.
+func (p SignOffPolicy) Evaluate(status ReviewStatus) []string {
+	var unmet []string
+	if approvals := len(status.Approvers); approvals < p.MinApprovals {
+		unmet = append(unmet, fmt.Sprintf("%d approval(s), signoff_min_approvals is %d", approvals, p.MinApprovals))
+	}
+	for _, name := range p.RequiredChecks {
+		conclusion, ok := status.Checks[name]
+		switch {
+		case !ok:
+			unmet = append(unmet, fmt.Sprintf("check %q did not run", name))
+		case !slices.Contains(passingConclusions, strings.ToLower(conclusion)):
+			unmet = append(unmet, fmt.Sprintf("check %q is %s", name, conclusion))
+		}
+	}
+	return unmet
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignOffPolicy_Evaluate(t *testing.T) {
+	status := ReviewStatus{
+		PullRequest: 42,
+		Approvers:   []string{"alice"},
+		Checks:      map[string]string{"test": "success", "lint": "skipped", "e2e": "failure", "build": "in_progress"},
+	}
+	t.Run("Should sign off a pull request meeting the policy", func(t *testing.T) {
+		policy := SignOffPolicy{MinApprovals: 1, RequiredChecks: []string{"test", "lint"}}
+		assert.Empty(t, policy.Evaluate(status))
+		assert.True(t, SignOffPolicy{}.IsZero())
+		assert.False(t, policy.IsZero())
+	})
+	t.Run("Should list the missing approvals and the checks that did not pass", func(t *testing.T) {
+		policy := SignOffPolicy{MinApprovals: 2, RequiredChecks: []string{"test", "e2e", "build", "docs"}}
+		assert.Equal(t, []string{
+			"1 approval(s), signoff_min_approvals is 2",
+			`check "e2e" is failure`,
+			`check "build" is in_progress`,
+			`check "docs" did not run`,
+		}, policy.Evaluate(status))
+	})
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Values for the signing setting.
+const (
+	SigningNone    = "none"
+	SigningKeyless = "keyless"
+	SigningKey     = "key"
+)
+
+// GitHubActionsOIDCIssuer is the issuer of the identity tokens cosign uses for keyless signing in GitHub Actions.
+const GitHubActionsOIDCIssuer = "https://token.actions.githubusercontent.com"
+
+// KeylessIdentityRegexp returns the certificate identity cosign verify-blob accepts for files signed
+// keylessly by a GitHub Actions workflow of owner/repo.
+func KeylessIdentityRegexp(owner, repo string) string {
+	return fmt.Sprintf("^https://github.com/%s/%s/", owner, repo)
+}
+
+// SignedFile is a release file together with the cosign signature, and for keyless signing the
+// certificate, produced for it.
+type SignedFile struct {
+	Path        string
+	Signature   string
+	Certificate string
+}
+
+// SigningInstructions describes how consumers verify the files signed for a release.
+type SigningInstructions struct {
+	Mode  string
+	Owner string
+	Repo  string
+	Files []SignedFile
+}
+
+// Verification instructions of the signing modes; they double as keys of locale files.
+const (
+	keylessVerificationIntro = "Release files are signed with [cosign](https://github.com/sigstore/cosign) keyless " +
+		"signing. Download a file with its `.sig` and `.pem` assets and run:"
+	keyVerificationIntro = "Release files are signed with [cosign](https://github.com/sigstore/cosign). " +
+		"Download a file with its `.sig` asset and the project's `cosign.pub` and run:"
+)
+
+// RenderMarkdown renders the verification section appended to release notes, or an empty string
+// when nothing was signed.
+func (s SigningInstructions) RenderMarkdown() string {
+	return s.LocalizedMarkdown(Locale{})
+}
+
+// LocalizedMarkdown renders the verification section in the language of locale.
+func (s SigningInstructions) LocalizedMarkdown(locale Locale) string {
+	if len(s.Files) == 0 {
+		return ""
+	}
+	var builder strings.Builder
+	builder.WriteString("### " + locale.T("Verifying this release") + "\n\n")
+	intro := keyVerificationIntro
+	if s.Mode == SigningKeyless {
+		intro = keylessVerificationIntro
+	}
+	builder.WriteString(locale.T(intro) + "\n\n")
+	builder.WriteString("```sh\n")
+	for i, file := range s.Files {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(s.verifyCommand(file))
+		builder.WriteString("\n")
+	}
+	builder.WriteString("```")
+	return builder.String()
+}
+
+func (s SigningInstructions) verifyCommand(file SignedFile) string {
+	name := path.Base(file.Path)
+	if s.Mode == SigningKeyless {
+		return fmt.Sprintf("cosign verify-blob \\\n"+
+			"  --certificate %s \\\n"+
+			"  --signature %s \\\n"+
+			"  --certificate-identity-regexp '%s' \\\n"+
+			"  --certificate-oidc-issuer %s \\\n"+
+			"  %s",
+			path.Base(file.Certificate), path.Base(file.Signature), KeylessIdentityRegexp(s.Owner, s.Repo),
+			GitHubActionsOIDCIssuer, name)
+	}
+	return fmt.Sprintf("cosign verify-blob --key cosign.pub --signature %s %s", path.Base(file.Signature), name)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigningInstructions_RenderMarkdown(t *testing.T) {
+	t.Run("Should render keyless verification commands with the workflow identity", func(t *testing.T) {
+		instructions := SigningInstructions{
+			Mode:  SigningKeyless,
+			Owner: "compozy",
+			Repo:  "releasepr",
+			Files: []SignedFile{{
+				Path:        "dist/checksums.txt",
+				Signature:   "dist/checksums.txt.sig",
+				Certificate: "dist/checksums.txt.pem",
+			}},
+		}
+		assert.Equal(t, "### Verifying this release\n\n"+
+			"Release files are signed with [cosign](https://github.com/sigstore/cosign) keyless signing. "+
+			"Download a file with its `.sig` and `.pem` assets and run:\n\n"+
+			"```sh\n"+
+			"cosign verify-blob \\\n"+
+			"  --certificate checksums.txt.pem \\\n"+
+			"  --signature checksums.txt.sig \\\n"+
+			"  --certificate-identity-regexp '^https://github.com/compozy/releasepr/' \\\n"+
+			"  --certificate-oidc-issuer https://token.actions.githubusercontent.com \\\n"+
+			"  checksums.txt\n"+
+			"```", instructions.RenderMarkdown())
+	})
+	t.Run("Should render key-based verification commands for every file", func(t *testing.T) {
+		instructions := SigningInstructions{
+			Mode: SigningKey,
+			Files: []SignedFile{
+				{Path: "dist/v1.2.0.tag", Signature: "dist/v1.2.0.tag.sig"},
+				{Path: "dist/checksums.txt", Signature: "dist/checksums.txt.sig"},
+			},
+		}
+		markdown := instructions.RenderMarkdown()
+		assert.Contains(t, markdown, "cosign verify-blob --key cosign.pub --signature v1.2.0.tag.sig v1.2.0.tag\n\n")
+		assert.Contains(t, markdown,
+			"cosign verify-blob --key cosign.pub --signature checksums.txt.sig checksums.txt\n")
+		assert.NotContains(t, markdown, "--certificate")
+	})
+	t.Run("Should render nothing when no file was signed", func(t *testing.T) {
+		assert.Empty(t, SigningInstructions{Mode: SigningKeyless}.RenderMarkdown())
+	})
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const submoduleShortSHALength = 7
+
+// SubmoduleUpdate is a change of a submodule pointer in a release. From is empty for a submodule
+// added since the previous release and To is empty for a removed one. Tag names the submodule tag
+// To was moved to, when known.
+type SubmoduleUpdate struct {
+	Path string
+	From string
+	To   string
+	Tag  string
+}
+
+// SubmoduleUpdates lists the submodule pointer changes of a release in path order.
+type SubmoduleUpdates []SubmoduleUpdate
+
+// Merge overlays updates made during the release run, such as submodules moved to their latest
+// tags, onto the changes already committed since the previous release.
+func (u SubmoduleUpdates) Merge(pending SubmoduleUpdates) SubmoduleUpdates {
+	merged := append(SubmoduleUpdates(nil), u...)
+	for _, update := range pending {
+		index := merged.index(update.Path)
+		if index < 0 {
+			merged = append(merged, update)
+			continue
+		}
+		merged[index].To = update.To
+		merged[index].Tag = update.Tag
+	}
+	return merged
+}
+
+// Paths lists the paths of the submodules.
+func (u SubmoduleUpdates) Paths() []string {
+	paths := make([]string, 0, len(u))
+	for _, update := range u {
+		paths = append(paths, update.Path)
+	}
+	return paths
+}
+
+func (u SubmoduleUpdates) index(path string) int {
+	for i, update := range u {
+		if update.Path == path {
+			return i
+		}
+	}
+	return -1
+}
+
+// RenderMarkdown renders the "Component Updates" changelog section, or an empty string when no
+// submodule moved.
+func (u SubmoduleUpdates) RenderMarkdown() string {
+	return u.LocalizedMarkdown(Locale{})
+}
+
+// LocalizedMarkdown renders the "Component Updates" section in the language of locale.
+func (u SubmoduleUpdates) LocalizedMarkdown(locale Locale) string {
+	var lines []string
+	for _, update := range u {
+		switch {
+		case update.From == update.To:
+			continue
+		case update.From == "":
+			lines = append(lines, fmt.Sprintf("- `%s`: "+locale.T("added at %s"), update.Path, update.target()))
+		case update.To == "":
+			lines = append(lines, fmt.Sprintf("- `%s`: %s", update.Path, locale.T("removed")))
+		default:
+			lines = append(lines, fmt.Sprintf("- `%s`: %s → %s", update.Path, shortSHA(update.From), update.target()))
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "### " + locale.T("Component Updates") + "\n\n" + strings.Join(lines, "\n")
+}
+
+func (u SubmoduleUpdate) target() string {
+	if u.Tag == "" {
+		return shortSHA(u.To)
+	}
+	return fmt.Sprintf("%s (%s)", u.Tag, shortSHA(u.To))
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > submoduleShortSHALength {
+		return sha[:submoduleShortSHALength]
+	}
+	return sha
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmoduleUpdates_RenderMarkdown(t *testing.T) {
+	t.Run("Should render moved, added and removed submodules", func(t *testing.T) {
+		updates := SubmoduleUpdates{
+			{Path: "libs/parser", From: "1a2b3c4d5e", To: "5d6e7f8a9b"},
+			{Path: "libs/render", To: "abcdef0123", Tag: "v2.0.0"},
+			{Path: "libs/legacy", From: "0123456789"},
+			{Path: "libs/same", From: "0123456789", To: "0123456789"},
+		}
+		want := "### Component Updates\n\n" +
+			"- `libs/parser`: 1a2b3c4 → 5d6e7f8\n" +
+			"- `libs/render`: added at v2.0.0 (abcdef0)\n" +
+			"- `libs/legacy`: removed"
+		assert.Equal(t, want, updates.RenderMarkdown())
+	})
+	t.Run("Should render nothing without moved submodules", func(t *testing.T) {
+		assert.Empty(t, SubmoduleUpdates{}.RenderMarkdown())
+	})
+}
+
+func TestSubmoduleUpdates_Merge(t *testing.T) {
+	t.Run("Should overlay pending bumps onto committed changes", func(t *testing.T) {
+		committed := SubmoduleUpdates{{Path: "libs/parser", From: "aaa", To: "bbb"}}
+		pending := SubmoduleUpdates{
+			{Path: "libs/parser", From: "bbb", To: "ccc", Tag: "v1.4.0"},
+			{Path: "libs/render", From: "ddd", To: "eee", Tag: "v2.1.0"},
+		}
+		merged := committed.Merge(pending)
+		assert.Equal(t, SubmoduleUpdates{
+			{Path: "libs/parser", From: "aaa", To: "ccc", Tag: "v1.4.0"},
+			{Path: "libs/render", From: "ddd", To: "eee", Tag: "v2.1.0"},
+		}, merged)
+		assert.Equal(t, "bbb", committed[0].To)
+	})
+}
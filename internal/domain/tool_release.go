@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToolChecksumsAsset is the checksum file GoReleaser attaches to every pr-release release, signed
+// keylessly by the release workflow into the ToolChecksumsSignatureAsset and
+// ToolChecksumsCertificateAsset assets.
+const (
+	ToolChecksumsAsset            = "checksums.txt"
+	ToolChecksumsSignatureAsset   = ToolChecksumsAsset + ".sig"
+	ToolChecksumsCertificateAsset = ToolChecksumsAsset + ".pem"
+)
+
+// ToolRelease is a published release of pr-release itself, as self-update reads it.
+type ToolRelease struct {
+	Tag    string
+	Assets []ReleaseAsset
+}
+
+// ReleaseAsset is a file attached to a GitHub release.
+type ReleaseAsset struct {
+	Name string
+	URL  string // browser download URL
+}
+
+// Asset returns the asset named name.
+func (r ToolRelease) Asset(name string) (ReleaseAsset, bool) {
+	for _, asset := range r.Assets {
+		if asset.Name == name {
+			return asset, true
+		}
+	}
+	return ReleaseAsset{}, false
+}
+
+// ToolArchiveName returns the archive a pr-release release publishes for goos/goarch, following the
+// archive name_template of .goreleaser.yml: pr-release_<version>_<os>_<arch> with x86_64 for amd64,
+// zipped on Windows and tar.gz elsewhere.
+func ToolArchiveName(version, goos, goarch string) string {
+	arch := goarch
+	switch goarch {
+	case "amd64":
+		arch = "x86_64"
+	case "386":
+		arch = "i386"
+	}
+	ext := ".tar.gz"
+	if goos == "windows" {
+		ext = ".zip"
+	}
+	return fmt.Sprintf("pr-release_%s_%s_%s%s", strings.TrimPrefix(version, "v"), goos, arch, ext)
+}
+
+// ParseChecksums reads a sha256sum-style file of "<hex digest>  <file name>" lines into a map of
+// file name to lowercase digest. Lines that do not have both fields are skipped.
+func ParseChecksums(data string) map[string]string {
+	checksums := make(map[string]string)
+	for line := range strings.Lines(data) {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		checksums[strings.TrimPrefix(fields[1], "*")] = strings.ToLower(fields[0])
+	}
+	return checksums
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToolArchiveName(t *testing.T) {
+	t.Run("Should follow the GoReleaser archive names", func(t *testing.T) {
+		assert.Equal(t, "pr-release_1.4.0_linux_x86_64.tar.gz", ToolArchiveName("v1.4.0", "linux", "amd64"))
+		assert.Equal(t, "pr-release_1.4.0_darwin_arm64.tar.gz", ToolArchiveName("1.4.0", "darwin", "arm64"))
+		assert.Equal(t, "pr-release_1.4.0_windows_x86_64.zip", ToolArchiveName("v1.4.0", "windows", "amd64"))
+	})
+}
+
+func TestParseChecksums(t *testing.T) {
+	t.Run("Should map file names to digests", func(t *testing.T) {
+		data := "ABC123  pr-release_1.4.0_linux_x86_64.tar.gz\n" +
+			"def456 *pr-release_1.4.0_windows_x86_64.zip\n\nmalformed\n"
+		assert.Equal(t, map[string]string{
+			"pr-release_1.4.0_linux_x86_64.tar.gz": "abc123",
+			"pr-release_1.4.0_windows_x86_64.zip":  "def456",
+		}, ParseChecksums(data))
+	})
+}
+
+func TestToolRelease_Asset(t *testing.T) {
+	t.Run("Should find assets by name", func(t *testing.T) {
+		release := ToolRelease{Tag: "v1.4.0", Assets: []ReleaseAsset{{Name: ToolChecksumsAsset, URL: "https://x/c"}}}
+		asset, ok := release.Asset(ToolChecksumsAsset)
+		assert.True(t, ok)
+		assert.Equal(t, "https://x/c", asset.URL)
+		_, ok = release.Asset("missing")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Tools whose versions tools_lock can pin. Their output formats the changelog and release files,
+// so runners with different versions produce different results.
+const (
+	ToolGitCliff   = "git-cliff"
+	ToolGoReleaser = "goreleaser"
+)
+
+var (
+	pinnableTools      = []string{ToolGitCliff, ToolGoReleaser}
+	toolVersionPattern = regexp.MustCompile(`\d+\.\d+\.\d+`)
+	toolPinPattern     = regexp.MustCompile(`^\d+(\.\d+){0,2}$`)
+)
+
+// PinnableTools returns the tools tools_lock can pin.
+func PinnableTools() []string {
+	return slices.Clone(pinnableTools)
+}
+
+// ToolCheck is the outcome of comparing an installed tool with its pinned version.
+type ToolCheck struct {
+	Tool      string
+	Pinned    string // empty when the tool is not pinned
+	Installed string // empty when the tool is not installed
+}
+
+// OK reports whether the installed version satisfies the pin. Unpinned tools always do.
+func (c ToolCheck) OK() bool {
+	return c.Pinned == "" || ToolVersionMatches(c.Pinned, c.Installed)
+}
+
+// String describes the check for doctor output and warnings.
+func (c ToolCheck) String() string {
+	switch {
+	case c.Installed == "" && c.Pinned == "":
+		return fmt.Sprintf("%s is not installed", c.Tool)
+	case c.Installed == "":
+		return fmt.Sprintf("%s is not installed, tools_lock pins %s", c.Tool, c.Pinned)
+	case c.Pinned == "":
+		return fmt.Sprintf("%s %s (not pinned)", c.Tool, c.Installed)
+	case c.OK():
+		return fmt.Sprintf("%s %s", c.Tool, c.Installed)
+	default:
+		return fmt.Sprintf("%s %s does not match the %s pinned in tools_lock", c.Tool, c.Installed, c.Pinned)
+	}
+}
+
+// ValidateToolPin checks that tool can be pinned and pin is a version such as 2.8.0, or a 2.8 or 2
+// prefix that accepts any patch or minor release.
+func ValidateToolPin(tool, pin string) error {
+	if !slices.Contains(pinnableTools, tool) {
+		return fmt.Errorf("unsupported tool %q: must be one of %s", tool, strings.Join(pinnableTools, ", "))
+	}
+	if !toolPinPattern.MatchString(strings.TrimPrefix(strings.TrimSpace(pin), "v")) {
+		return fmt.Errorf("invalid version %q for %s: must look like 2.8.0 or 2.8", pin, tool)
+	}
+	return nil
+}
+
+// ParseToolVersion extracts the version from the --version output of a tool, such as
+// "git-cliff 2.8.0" or the GitVersion line of goreleaser.
+func ParseToolVersion(output string) (string, error) {
+	version := toolVersionPattern.FindString(output)
+	if version == "" {
+		return "", fmt.Errorf("no version found in %q", strings.TrimSpace(output))
+	}
+	return version, nil
+}
+
+// ToolVersionMatches reports whether installed equals pin, or starts with it when pin leaves out
+// the minor or patch number.
+func ToolVersionMatches(pin, installed string) bool {
+	pin = strings.TrimPrefix(strings.TrimSpace(pin), "v")
+	installed = strings.TrimPrefix(strings.TrimSpace(installed), "v")
+	return installed == pin || strings.HasPrefix(installed, pin+".")
+}
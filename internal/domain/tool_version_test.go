@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseToolVersion(t *testing.T) {
+	t.Run("Should extract the version of git-cliff", func(t *testing.T) {
+		version, err := ParseToolVersion("git-cliff 2.8.0\n")
+		require.NoError(t, err)
+		assert.Equal(t, "2.8.0", version)
+	})
+	t.Run("Should extract the version of goreleaser", func(t *testing.T) {
+		output := "  ____       ____      _\nGitVersion:    2.12.7\nGitCommit:     abc123\n"
+		version, err := ParseToolVersion(output)
+		require.NoError(t, err)
+		assert.Equal(t, "2.12.7", version)
+	})
+	t.Run("Should fail without a version", func(t *testing.T) {
+		_, err := ParseToolVersion("command not found")
+		assert.ErrorContains(t, err, "no version found")
+	})
+}
+
+func TestToolVersionMatches(t *testing.T) {
+	t.Run("Should match an exact pin", func(t *testing.T) {
+		assert.True(t, ToolVersionMatches("2.8.0", "2.8.0"))
+		assert.True(t, ToolVersionMatches("v2.8.0", "2.8.0"))
+	})
+	t.Run("Should match any release of a prefix pin", func(t *testing.T) {
+		assert.True(t, ToolVersionMatches("2.8", "2.8.3"))
+		assert.True(t, ToolVersionMatches("2", "2.12.7"))
+	})
+	t.Run("Should not match other versions", func(t *testing.T) {
+		assert.False(t, ToolVersionMatches("2.8", "2.80.0"))
+		assert.False(t, ToolVersionMatches("2.8.0", "2.8.1"))
+		assert.False(t, ToolVersionMatches("2.8.0", ""))
+	})
+}
+
+func TestValidateToolPin(t *testing.T) {
+	t.Run("Should accept full and prefix versions of pinnable tools", func(t *testing.T) {
+		require.NoError(t, ValidateToolPin(ToolGitCliff, "2.8.0"))
+		require.NoError(t, ValidateToolPin(ToolGoReleaser, "v2.12"))
+	})
+	t.Run("Should reject unknown tools", func(t *testing.T) {
+		assert.ErrorContains(t, ValidateToolPin("cosign", "2.4.0"), "unsupported tool")
+	})
+	t.Run("Should reject malformed versions", func(t *testing.T) {
+		assert.ErrorContains(t, ValidateToolPin(ToolGitCliff, "latest"), "invalid version")
+		assert.ErrorContains(t, ValidateToolPin(ToolGitCliff, ""), "invalid version")
+	})
+}
+
+func TestToolCheck(t *testing.T) {
+	t.Run("Should pass unpinned tools", func(t *testing.T) {
+		check := ToolCheck{Tool: ToolGitCliff, Installed: "2.8.0"}
+		assert.True(t, check.OK())
+		assert.Equal(t, "git-cliff 2.8.0 (not pinned)", check.String())
+	})
+	t.Run("Should fail a pinned tool that is not installed", func(t *testing.T) {
+		check := ToolCheck{Tool: ToolGoReleaser, Pinned: "2.12"}
+		assert.False(t, check.OK())
+		assert.Equal(t, "goreleaser is not installed, tools_lock pins 2.12", check.String())
+	})
+	t.Run("Should describe a mismatch", func(t *testing.T) {
+		check := ToolCheck{Tool: ToolGitCliff, Pinned: "2.8.0", Installed: "2.9.1"}
+		assert.False(t, check.OK())
+		assert.Equal(t, "git-cliff 2.9.1 does not match the 2.8.0 pinned in tools_lock", check.String())
+	})
+}
@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/Masterminds/semver/v3"
 )
 
@@ -36,6 +39,35 @@ func (v *Version) BumpPatch() *Version {
 	return &Version{&newVer}
 }
 
+// Bump increments the component of the version named by level.
+func (v *Version) Bump(level BumpLevel) *Version {
+	switch level {
+	case BumpLevelMajor:
+		return v.BumpMajor()
+	case BumpLevelMinor:
+		return v.BumpMinor()
+	default:
+		return v.BumpPatch()
+	}
+}
+
+// BumpLevelFrom returns the most significant component that changed from previous to v. A nil
+// previous, for the first release, counts from 0.0.0.
+func (v *Version) BumpLevelFrom(previous *Version) BumpLevel {
+	var major, minor uint64
+	if previous != nil {
+		major, minor = previous.Major(), previous.Minor()
+	}
+	switch {
+	case v.Major() != major:
+		return BumpLevelMajor
+	case v.Minor() != minor:
+		return BumpLevelMinor
+	default:
+		return BumpLevelPatch
+	}
+}
+
 // Compare compares two versions.
 func (v *Version) Compare(other *Version) int {
 	return v.Version.Compare(other.Version)
@@ -45,3 +77,19 @@ func (v *Version) Compare(other *Version) int {
 func (v *Version) String() string {
 	return "v" + v.Version.String()
 }
+
+// semverIdentifierInvalid matches characters semver does not allow in build metadata identifiers.
+var semverIdentifierInvalid = regexp.MustCompile(`[^0-9A-Za-z-]+`)
+
+// BuildMetadata joins CI identifiers into semver build metadata such as build.1234.sha.abcdef.
+// Missing identifiers are left out and characters semver does not allow are replaced with hyphens.
+func BuildMetadata(buildNumber, sha string) string {
+	var parts []string
+	if id := semverIdentifierInvalid.ReplaceAllString(strings.TrimSpace(buildNumber), "-"); id != "" {
+		parts = append(parts, "build", id)
+	}
+	if id := semverIdentifierInvalid.ReplaceAllString(strings.TrimSpace(sha), "-"); id != "" {
+		parts = append(parts, "sha", id)
+	}
+	return strings.Join(parts, ".")
+}
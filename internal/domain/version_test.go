@@ -110,3 +110,57 @@ func TestVersion_String(t *testing.T) {
 		assert.Equal(t, "v1.2.3+build123", version.String())
 	})
 }
+
+func TestBuildMetadata(t *testing.T) {
+	t.Run("Should join the build number and commit", func(t *testing.T) {
+		assert.Equal(t, "build.1234.sha.abcdef0", BuildMetadata("1234", "abcdef0"))
+	})
+	t.Run("Should leave out missing identifiers", func(t *testing.T) {
+		assert.Equal(t, "sha.abcdef0", BuildMetadata("", "abcdef0"))
+		assert.Equal(t, "build.42", BuildMetadata(" 42 ", ""))
+		assert.Empty(t, BuildMetadata("", ""))
+	})
+	t.Run("Should produce metadata semver accepts", func(t *testing.T) {
+		metadata := BuildMetadata("nightly_7/2", "abc")
+		assert.Equal(t, "build.nightly-7-2.sha.abc", metadata)
+		version, err := NewVersion("1.2.3+" + metadata)
+		require.NoError(t, err)
+		assert.Equal(t, metadata, version.Metadata())
+	})
+}
+
+func TestVersion_Bump(t *testing.T) {
+	t.Run("Should increment the component named by the level", func(t *testing.T) {
+		version, err := NewVersion("v1.2.3")
+		require.NoError(t, err)
+		assert.Equal(t, "v2.0.0", version.Bump(BumpLevelMajor).String())
+		assert.Equal(t, "v1.3.0", version.Bump(BumpLevelMinor).String())
+		assert.Equal(t, "v1.2.4", version.Bump(BumpLevelPatch).String())
+	})
+}
+
+func TestVersion_BumpLevelFrom(t *testing.T) {
+	t.Run("Should name the most significant component that changed", func(t *testing.T) {
+		previous, err := NewVersion("v1.2.3")
+		require.NoError(t, err)
+		for next, want := range map[string]BumpLevel{
+			"v2.0.0":      BumpLevelMajor,
+			"v1.3.0":      BumpLevelMinor,
+			"v1.2.4":      BumpLevelPatch,
+			"v1.2.4-rc.1": BumpLevelPatch,
+		} {
+			version, err := NewVersion(next)
+			require.NoError(t, err)
+			assert.Equal(t, want, version.BumpLevelFrom(previous), next)
+		}
+		assert.Equal(t, "release:minor", BumpLevelMinor.Label())
+	})
+	t.Run("Should count a first release from 0.0.0", func(t *testing.T) {
+		version, err := NewVersion("v0.1.0")
+		require.NoError(t, err)
+		assert.Equal(t, BumpLevelMinor, version.BumpLevelFrom(nil))
+		version, err = NewVersion("v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, BumpLevelMajor, version.BumpLevelFrom(nil))
+	})
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// WorkflowStep names an optional part of the release PR workflow that can be skipped.
+type WorkflowStep string
+
+const (
+	// StepPackageVersions bumps the version in the root package.json.
+	StepPackageVersions WorkflowStep = "package-versions"
+	// StepChangelog regenerates CHANGELOG.md.
+	StepChangelog WorkflowStep = "changelog"
+	// StepReleaseNotes writes RELEASE_BODY.md and prepends the release to RELEASE_NOTES.md.
+	StepReleaseNotes WorkflowStep = "release-notes"
+	// StepReleaseArtifacts runs the configured release artifact commands.
+	StepReleaseArtifacts WorkflowStep = "release-artifacts"
+	// StepArchiveNotes moves active .release-notes entries into the release archive.
+	StepArchiveNotes WorkflowStep = "archive-notes"
+	// StepPush pushes the release branch.
+	StepPush WorkflowStep = "push"
+	// StepPullRequest creates or updates the release pull request.
+	StepPullRequest WorkflowStep = "pull-request"
+)
+
+// WorkflowSteps lists every skippable step in execution order.
+var WorkflowSteps = []WorkflowStep{
+	StepPackageVersions,
+	StepChangelog,
+	StepReleaseNotes,
+	StepReleaseArtifacts,
+	StepArchiveNotes,
+	StepPush,
+	StepPullRequest,
+}
+
+// skipDependents maps a step to the steps that cannot run without it.
+var skipDependents = map[WorkflowStep][]WorkflowStep{
+	StepReleaseNotes: {StepArchiveNotes},
+	StepPush:         {StepPullRequest},
+}
+
+// SkippedSteps is a validated set of workflow steps to skip.
+type SkippedSteps []WorkflowStep
+
+// ParseSkippedSteps validates step names and checks that no remaining step depends on a skipped one.
+func ParseSkippedSteps(names []string) (SkippedSteps, error) {
+	skipped := make(SkippedSteps, 0, len(names))
+	for _, name := range names {
+		step := WorkflowStep(strings.ToLower(strings.TrimSpace(name)))
+		if step == "" || skipped.Has(step) {
+			continue
+		}
+		if !slices.Contains(WorkflowSteps, step) {
+			return nil, fmt.Errorf("unknown workflow step %q; must be one of: %s", name, workflowStepNames())
+		}
+		skipped = append(skipped, step)
+	}
+	for _, step := range WorkflowSteps {
+		if !skipped.Has(step) {
+			continue
+		}
+		for _, dependent := range skipDependents[step] {
+			if !skipped.Has(dependent) {
+				return nil, fmt.Errorf("skipping %s requires skipping %s as well", step, dependent)
+			}
+		}
+	}
+	return skipped, nil
+}
+
+// Has reports whether the step is skipped.
+func (s SkippedSteps) Has(step WorkflowStep) bool {
+	return slices.Contains(s, step)
+}
+
+func workflowStepNames() string {
+	names := make([]string, len(WorkflowSteps))
+	for i, step := range WorkflowSteps {
+		names[i] = string(step)
+	}
+	return strings.Join(names, ", ")
+}
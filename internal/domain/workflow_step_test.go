@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSkippedSteps(t *testing.T) {
+	t.Run("Should normalize and deduplicate step names", func(t *testing.T) {
+		skipped, err := ParseSkippedSteps([]string{" Changelog", "package-versions", "changelog", ""})
+		require.NoError(t, err)
+		assert.Equal(t, SkippedSteps{StepChangelog, StepPackageVersions}, skipped)
+		assert.True(t, skipped.Has(StepChangelog))
+		assert.False(t, skipped.Has(StepPush))
+	})
+	t.Run("Should reject unknown steps", func(t *testing.T) {
+		_, err := ParseSkippedSteps([]string{"tests"})
+		assert.ErrorContains(t, err, `unknown workflow step "tests"`)
+	})
+	t.Run("Should reject skipping a step that a remaining step depends on", func(t *testing.T) {
+		_, err := ParseSkippedSteps([]string{"push"})
+		assert.ErrorContains(t, err, "skipping push requires skipping pull-request as well")
+		_, err = ParseSkippedSteps([]string{"release-notes"})
+		assert.ErrorContains(t, err, "skipping release-notes requires skipping archive-notes as well")
+	})
+	t.Run("Should accept dependent steps skipped together", func(t *testing.T) {
+		skipped, err := ParseSkippedSteps([]string{"pull-request", "push"})
+		require.NoError(t, err)
+		assert.Len(t, skipped, 2)
+	})
+}
@@ -0,0 +1,208 @@
+// Package gha decodes the GitHub event payloads releasepr reacts to, whether a workflow run reads
+// them from GITHUB_EVENT_PATH or the webhook listener receives them.
+package gha
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Event names, as set in GITHUB_EVENT_NAME and the X-GitHub-Event header.
+const (
+	EventPush              = "push"
+	EventPullRequest       = "pull_request"
+	EventPullRequestTarget = "pull_request_target"
+	EventIssueComment      = "issue_comment"
+)
+
+const branchRefPrefix = "refs/heads/"
+
+// ErrInvalidPayload is returned for payloads that are not JSON or lack the object the event is about.
+var ErrInvalidPayload = errors.New("invalid event payload")
+
+// Repository is the repository an event belongs to.
+type Repository struct {
+	FullName      string `json:"full_name"`
+	DefaultBranch string `json:"default_branch"`
+}
+
+// User is a GitHub account.
+type User struct {
+	Login string `json:"login"`
+}
+
+// Label is a pull request or issue label.
+type Label struct {
+	Name string `json:"name"`
+}
+
+// Branch is the head or base of a pull request. Repo is nil when the head repository of a pull
+// request from a fork was deleted.
+type Branch struct {
+	Ref  string      `json:"ref"`
+	SHA  string      `json:"sha"`
+	Repo *Repository `json:"repo"`
+}
+
+// PullRequest is the pull_request object of pull request events.
+type PullRequest struct {
+	Number         int     `json:"number"`
+	Title          string  `json:"title"`
+	Merged         bool    `json:"merged"`
+	MergeCommitSHA string  `json:"merge_commit_sha"`
+	Labels         []Label `json:"labels"`
+	Head           Branch  `json:"head"`
+	Base           Branch  `json:"base"`
+}
+
+// HasLabel reports whether the pull request carries label, ignoring case.
+func (p *PullRequest) HasLabel(label string) bool {
+	for _, candidate := range p.Labels {
+		if strings.EqualFold(candidate.Name, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// PullRequestEvent is the payload of pull_request and pull_request_target events.
+type PullRequestEvent struct {
+	Action      string       `json:"action"`
+	Number      int          `json:"number"`
+	Repository  Repository   `json:"repository"`
+	PullRequest *PullRequest `json:"pull_request"`
+}
+
+// Issue is the issue object of issue_comment events. Comments on pull requests arrive as comments
+// on the issue backing the pull request, which then carries a pull_request link.
+type Issue struct {
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	PullRequest *struct {
+		URL string `json:"url"`
+	} `json:"pull_request"`
+}
+
+// IsPullRequest reports whether the issue backs a pull request.
+func (i *Issue) IsPullRequest() bool {
+	return i.PullRequest != nil
+}
+
+// Comment is an issue or pull request comment.
+type Comment struct {
+	ID   int64  `json:"id"`
+	Body string `json:"body"`
+	User User   `json:"user"`
+}
+
+// IssueCommentEvent is the payload of issue_comment events.
+type IssueCommentEvent struct {
+	Action     string     `json:"action"`
+	Repository Repository `json:"repository"`
+	Issue      *Issue     `json:"issue"`
+	Comment    *Comment   `json:"comment"`
+}
+
+// CommitAuthor is the author of a pushed commit.
+type CommitAuthor struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+// Commit is a pushed commit.
+type Commit struct {
+	ID      string       `json:"id"`
+	Message string       `json:"message"`
+	Author  CommitAuthor `json:"author"`
+}
+
+// Subject returns the first line of the commit message.
+func (c *Commit) Subject() string {
+	subject, _, _ := strings.Cut(c.Message, "\n")
+	return subject
+}
+
+// PushEvent is the payload of push events. HeadCommit is nil for pushes that delete a ref.
+type PushEvent struct {
+	Ref        string     `json:"ref"`
+	Before     string     `json:"before"`
+	After      string     `json:"after"`
+	Deleted    bool       `json:"deleted"`
+	Repository Repository `json:"repository"`
+	HeadCommit *Commit    `json:"head_commit"`
+}
+
+// Branch returns the pushed branch, or false when the push updated a tag or another ref.
+func (e *PushEvent) Branch() (string, bool) {
+	branch, ok := strings.CutPrefix(e.Ref, branchRefPrefix)
+	return branch, ok && branch != ""
+}
+
+// ParsePullRequestEvent decodes a pull_request or pull_request_target payload.
+func ParsePullRequestEvent(data []byte) (*PullRequestEvent, error) {
+	var event PullRequestEvent
+	if err := decode(data, EventPullRequest, &event); err != nil {
+		return nil, err
+	}
+	if event.PullRequest == nil {
+		return nil, fmt.Errorf("%w: %s payload has no pull_request", ErrInvalidPayload, EventPullRequest)
+	}
+	return &event, nil
+}
+
+// ParseIssueCommentEvent decodes an issue_comment payload.
+func ParseIssueCommentEvent(data []byte) (*IssueCommentEvent, error) {
+	var event IssueCommentEvent
+	if err := decode(data, EventIssueComment, &event); err != nil {
+		return nil, err
+	}
+	if event.Issue == nil || event.Comment == nil {
+		return nil, fmt.Errorf("%w: %s payload has no issue or comment", ErrInvalidPayload, EventIssueComment)
+	}
+	return &event, nil
+}
+
+// ParsePushEvent decodes a push payload.
+func ParsePushEvent(data []byte) (*PushEvent, error) {
+	var event PushEvent
+	if err := decode(data, EventPush, &event); err != nil {
+		return nil, err
+	}
+	if event.Ref == "" {
+		return nil, fmt.Errorf("%w: %s payload has no ref", ErrInvalidPayload, EventPush)
+	}
+	return &event, nil
+}
+
+// PullRequestNumber returns the pull request or issue number of any payload about one, such as
+// pull_request and issue_comment payloads. The pull request wins when both are present.
+func PullRequestNumber(data []byte) (int, error) {
+	var payload struct {
+		PullRequest *struct {
+			Number int `json:"number"`
+		} `json:"pull_request"`
+		Issue *struct {
+			Number int `json:"number"`
+		} `json:"issue"`
+	}
+	if err := decode(data, "event", &payload); err != nil {
+		return 0, err
+	}
+	if payload.PullRequest != nil && payload.PullRequest.Number > 0 {
+		return payload.PullRequest.Number, nil
+	}
+	if payload.Issue != nil && payload.Issue.Number > 0 {
+		return payload.Issue.Number, nil
+	}
+	return 0, fmt.Errorf("%w: payload has no pull request or issue number", ErrInvalidPayload)
+}
+
+func decode(data []byte, event string, v any) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%w: %s payload: %w", ErrInvalidPayload, event, err)
+	}
+	return nil
+}
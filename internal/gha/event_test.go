@@ -0,0 +1,103 @@
+package gha
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return data
+}
+
+func TestParsePullRequestEvent(t *testing.T) {
+	t.Run("Should decode a merged release pull request", func(t *testing.T) {
+		event, err := ParsePullRequestEvent(readFixture(t, "pull_request.json"))
+		require.NoError(t, err)
+		assert.Equal(t, "closed", event.Action)
+		assert.Equal(t, Repository{FullName: "compozy/releasepr", DefaultBranch: "main"}, event.Repository)
+		pr := event.PullRequest
+		assert.Equal(t, 128, pr.Number)
+		assert.True(t, pr.Merged)
+		assert.Equal(t, "9c1f0b6e8d7a4c3b2a1908f7e6d5c4b3a2918070", pr.MergeCommitSHA)
+		assert.Equal(t, "release/v1.4.0", pr.Head.Ref)
+		assert.Equal(t, "main", pr.Base.Ref)
+		assert.True(t, pr.HasLabel("Release"))
+		assert.False(t, pr.HasLabel("bug"))
+	})
+	t.Run("Should expose the head repository of a fork", func(t *testing.T) {
+		event, err := ParsePullRequestEvent(readFixture(t, "pull_request_fork.json"))
+		require.NoError(t, err)
+		require.NotNil(t, event.PullRequest.Head.Repo)
+		assert.Equal(t, "someone/releasepr", event.PullRequest.Head.Repo.FullName)
+		assert.Equal(t, "compozy/releasepr", event.PullRequest.Base.Repo.FullName)
+		assert.Empty(t, event.PullRequest.MergeCommitSHA)
+	})
+	t.Run("Should reject payloads without a pull request", func(t *testing.T) {
+		_, err := ParsePullRequestEvent(readFixture(t, "push.json"))
+		assert.ErrorIs(t, err, ErrInvalidPayload)
+		_, err = ParsePullRequestEvent([]byte("{"))
+		assert.ErrorIs(t, err, ErrInvalidPayload)
+	})
+}
+
+func TestParseIssueCommentEvent(t *testing.T) {
+	t.Run("Should decode a comment on a pull request", func(t *testing.T) {
+		event, err := ParseIssueCommentEvent(readFixture(t, "issue_comment.json"))
+		require.NoError(t, err)
+		assert.Equal(t, "created", event.Action)
+		assert.Equal(t, 131, event.Issue.Number)
+		assert.True(t, event.Issue.IsPullRequest())
+		assert.Equal(t, "/release dry-run", event.Comment.Body)
+		assert.Equal(t, "octocat", event.Comment.User.Login)
+	})
+	t.Run("Should reject payloads without an issue comment", func(t *testing.T) {
+		_, err := ParseIssueCommentEvent(readFixture(t, "pull_request.json"))
+		assert.ErrorIs(t, err, ErrInvalidPayload)
+	})
+}
+
+func TestParsePushEvent(t *testing.T) {
+	t.Run("Should decode a push to the default branch", func(t *testing.T) {
+		event, err := ParsePushEvent(readFixture(t, "push.json"))
+		require.NoError(t, err)
+		branch, ok := event.Branch()
+		assert.True(t, ok)
+		assert.Equal(t, "main", branch)
+		assert.Equal(t, "main", event.Repository.DefaultBranch)
+		require.NotNil(t, event.HeadCommit)
+		assert.Equal(t, "feat: add abort command (#129)", event.HeadCommit.Subject())
+		assert.Equal(t, "octocat", event.HeadCommit.Author.Username)
+	})
+	t.Run("Should not report a branch for tag pushes", func(t *testing.T) {
+		event, err := ParsePushEvent([]byte(`{"ref":"refs/tags/v1.4.0"}`))
+		require.NoError(t, err)
+		_, ok := event.Branch()
+		assert.False(t, ok)
+	})
+	t.Run("Should reject payloads without a ref", func(t *testing.T) {
+		_, err := ParsePushEvent(readFixture(t, "issue_comment.json"))
+		assert.ErrorIs(t, err, ErrInvalidPayload)
+	})
+}
+
+func TestPullRequestNumber(t *testing.T) {
+	t.Run("Should read the number of pull request and issue comment payloads", func(t *testing.T) {
+		number, err := PullRequestNumber(readFixture(t, "pull_request.json"))
+		require.NoError(t, err)
+		assert.Equal(t, 128, number)
+		number, err = PullRequestNumber(readFixture(t, "issue_comment.json"))
+		require.NoError(t, err)
+		assert.Equal(t, 131, number)
+	})
+	t.Run("Should fail for payloads about neither", func(t *testing.T) {
+		_, err := PullRequestNumber(readFixture(t, "push.json"))
+		assert.ErrorIs(t, err, ErrInvalidPayload)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"syscall"
@@ -81,6 +82,28 @@ func buildEncoderConfig(format string) zapcore.EncoderConfig {
 	return encoder
 }
 
+// NewConsoleWriter builds a console logger that writes entries at or above level to w.
+func NewConsoleWriter(w io.Writer, level zapcore.Level) *zap.Logger {
+	encoder := zapcore.NewConsoleEncoder(buildEncoderConfig(formatConsole))
+	return zap.New(zapcore.NewCore(encoder, zapcore.AddSync(w), level))
+}
+
+// Interactive reports whether w is a terminal someone is watching rather than a CI log or a pipe.
+func Interactive(w io.Writer) bool {
+	if isCI() || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
 func isCI() bool {
 	ciEnvVars := []string{
 		"CI",
@@ -0,0 +1,115 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/usecase"
+	"go.uber.org/zap"
+)
+
+const releaseBranchPrefix = "release/"
+
+// AbortConfig selects the release to abort.
+type AbortConfig struct {
+	// Target is a release PR number (42 or #42) or a version (v1.2.0 or 1.2.0).
+	Target string
+}
+
+// Abort is the manual kill switch for a bad release: it closes the open release PR with a comment,
+// deletes the release branch locally and on the remote, and marks the saga sessions of the version
+// rolled back so they are neither resumed nor rolled back again.
+func (o *PRReleaseOrchestrator) Abort(ctx context.Context, cfg AbortConfig) error {
+	if err := ValidateAllowedRepository(ctx); err != nil {
+		return err
+	}
+	version, prNumber, err := o.resolveAbortTarget(ctx, cfg.Target)
+	if err != nil {
+		return err
+	}
+	log := o.logger(ctx).With(zap.String("version", version))
+	branchName := releaseBranchPrefix + version
+	if prNumber != 0 {
+		o.commentAbortedPR(ctx, log, prNumber)
+		if err := o.githubRepo.ClosePR(ctx, prNumber); err != nil {
+			return fmt.Errorf("failed to close PR #%d: %w", prNumber, err)
+		}
+		log.Info("Closed release PR", zap.Int("pr_number", prNumber))
+	}
+	compensator := NewCompensatingActions(o.gitRepo, o.githubRepo, o.fsRepo)
+	if err := compensator.DeleteBranch(ctx, map[string]any{
+		"branch_name":               branchName,
+		"local_created_in_session":  true,
+		"remote_created_in_session": true,
+		"pushed":                    true,
+	}); err != nil {
+		return fmt.Errorf("failed to delete release branch %s: %w", branchName, err)
+	}
+	log.Info("Deleted release branch", zap.String("branch", branchName))
+	return o.markSessionsAborted(ctx, version)
+}
+
+// resolveAbortTarget returns the version and open PR number of target. The PR of a version is looked
+// up against the base of the release channel of the current branch, as pr-release opened it. A
+// version without an open PR returns PR number 0, so leftovers of a failed run can still be cleaned up.
+func (o *PRReleaseOrchestrator) resolveAbortTarget(ctx context.Context, target string) (string, int, error) {
+	target = strings.TrimSpace(target)
+	if prNumber, err := strconv.Atoi(strings.TrimPrefix(target, "#")); err == nil {
+		head, err := o.githubRepo.PullRequestHead(ctx, prNumber)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to look up PR #%d: %w", prNumber, err)
+		}
+		if !strings.HasPrefix(head, releaseBranchPrefix) {
+			return "", 0, fmt.Errorf("PR #%d is not a release PR (head branch %s)", prNumber, head)
+		}
+		return strings.TrimPrefix(head, releaseBranchPrefix), prNumber, nil
+	}
+	parsed, err := domain.NewVersion(target)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid abort target %q: expected a release PR number or a version", target)
+	}
+	version := parsed.String()
+	ctx, err = o.resolveReleaseChannel(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	prNumber, err := o.githubRepo.FindOpenPR(ctx, releaseBranchPrefix+version, releaseBase(ctx))
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to find the release PR of %s: %w", version, err)
+	}
+	return version, prNumber, nil
+}
+
+// markSessionsAborted marks every saga session of version that is not rolled back yet as rolled back.
+func (o *PRReleaseOrchestrator) markSessionsAborted(ctx context.Context, version string) error {
+	sessions, err := o.stateRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list release sessions: %w", err)
+	}
+	for _, state := range sessions {
+		if state.Version != version || state.Status == domain.WorkflowStatusRolledBack {
+			continue
+		}
+		state.Status = domain.WorkflowStatusRolledBack
+		state.Error = "aborted with pr-release abort"
+		if err := o.stateRepo.Save(ctx, state); err != nil {
+			return fmt.Errorf("failed to update session %s: %w", state.SessionID, err)
+		}
+		o.logger(ctx).Info("Marked release session rolled back", zap.String("session_id", state.SessionID))
+	}
+	return nil
+}
+
+// commentAbortedPR explains on the release PR why it is being closed. Failures are only logged.
+func (o *PRReleaseOrchestrator) commentAbortedPR(ctx context.Context, log *zap.Logger, prNumber int) {
+	comment, err := usecase.RenderTemplate(o.fsRepo, usecase.TemplateAbortComment, nil)
+	if err == nil {
+		err = o.githubRepo.AddComment(ctx, prNumber, strings.TrimSpace(comment))
+	}
+	if err != nil {
+		log.Warn("Failed to comment on the release PR", zap.Int("pr_number", prNumber), zap.Error(err))
+	}
+}
@@ -0,0 +1,80 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testAbortComment = "🛑 This release was aborted with `pr-release abort`. " +
+	"The release branch has been deleted; the next release run prepares a fresh pull request."
+
+func TestPRReleaseOrchestrator_Abort(t *testing.T) {
+	t.Run("Should close the PR, delete the branch and mark the sessions rolled back", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		gitRepo := orch.gitRepo.(*mockGitExtendedRepository)
+		githubRepo := orch.githubRepo.(*mockGithubExtendedRepository)
+		stateRepo := new(mockStateRepository)
+		orch.stateRepo = stateRepo
+		githubRepo.On("PullRequestHead", mock.Anything, 42).Return("release/v1.2.0", nil).Once()
+		githubRepo.On("AddComment", mock.Anything, 42, testAbortComment).Return(nil).Once()
+		githubRepo.On("ClosePR", mock.Anything, 42).Return(nil).Once()
+		gitRepo.On("GetCurrentBranch", mock.Anything).Return("main", nil).Once()
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main", "release/v1.2.0"}, nil).Once()
+		gitRepo.On("DeleteBranch", mock.Anything, "release/v1.2.0").Return(nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, "release/v1.2.0").Return(true, nil).Once()
+		gitRepo.On("DeleteRemoteBranch", mock.Anything, "release/v1.2.0").Return(nil).Once()
+		current := &domain.RollbackState{SessionID: "a", Version: "v1.2.0", Status: domain.WorkflowStatusCompleted}
+		other := &domain.RollbackState{SessionID: "b", Version: "v1.1.0", Status: domain.WorkflowStatusFailed}
+		stateRepo.On("List", mock.Anything).Return([]*domain.RollbackState{current, other}, nil).Once()
+		stateRepo.On("Save", mock.Anything, current).Return(nil).Once()
+		require.NoError(t, orch.Abort(ctx, AbortConfig{Target: "#42"}))
+		assert.Equal(t, domain.WorkflowStatusRolledBack, current.Status)
+		assert.Equal(t, domain.WorkflowStatusFailed, other.Status)
+		gitRepo.AssertExpectations(t)
+		githubRepo.AssertExpectations(t)
+		stateRepo.AssertExpectations(t)
+	})
+	t.Run("Should clean up a version without an open PR", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		gitRepo := orch.gitRepo.(*mockGitExtendedRepository)
+		githubRepo := orch.githubRepo.(*mockGithubExtendedRepository)
+		stateRepo := new(mockStateRepository)
+		orch.stateRepo = stateRepo
+		githubRepo.On("FindOpenPR", mock.Anything, "release/v1.2.0", "main").Return(0, nil).Once()
+		gitRepo.On("GetCurrentBranch", mock.Anything).Return("main", nil).Once()
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, "release/v1.2.0").Return(false, nil).Once()
+		stateRepo.On("List", mock.Anything).Return([]*domain.RollbackState{}, nil).Once()
+		require.NoError(t, orch.Abort(ctx, AbortConfig{Target: "1.2.0"}))
+		githubRepo.AssertNotCalled(t, "ClosePR", mock.Anything, mock.Anything)
+		gitRepo.AssertExpectations(t)
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should find the PR of a version against the base of the release channel", func(t *testing.T) {
+		ctx := testReleaseContextWithConfig(t, testChannelsConfig())
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		gitRepo := orch.gitRepo.(*mockGitExtendedRepository)
+		githubRepo := orch.githubRepo.(*mockGithubExtendedRepository)
+		gitRepo.On("GetCurrentBranch", mock.Anything).Return("next", nil).Once()
+		githubRepo.On("FindOpenPR", mock.Anything, "release/v2.0.0-next.1", "next").Return(51, nil).Once()
+		version, prNumber, err := orch.resolveAbortTarget(ctx, "v2.0.0-next.1")
+		require.NoError(t, err)
+		assert.Equal(t, "v2.0.0-next.1", version)
+		assert.Equal(t, 51, prNumber)
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should refuse pull requests that are not release PRs and invalid targets", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		githubRepo := orch.githubRepo.(*mockGithubExtendedRepository)
+		githubRepo.On("PullRequestHead", mock.Anything, 7).Return("feat/export", nil).Once()
+		assert.ErrorContains(t, orch.Abort(ctx, AbortConfig{Target: "7"}), "PR #7 is not a release PR")
+		assert.ErrorContains(t, orch.Abort(ctx, AbortConfig{Target: "next"}), "invalid abort target")
+	})
+}
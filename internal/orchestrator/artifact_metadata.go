@@ -0,0 +1,116 @@
+package orchestrator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+)
+
+// errStaleArtifactMetadata marks a metadata.json left behind by a build of another release.
+var errStaleArtifactMetadata = errors.New("metadata.json describes another release")
+
+// artifactMetadata holds the subset of GoReleaser's metadata.json used by release workflows.
+type artifactMetadata struct {
+	Tag       string                 `json:"tag"`
+	Version   string                 `json:"version"`
+	Artifacts []artifactMetadataItem `json:"artifacts"`
+}
+
+type artifactMetadataItem struct {
+	Name   string                `json:"name"`
+	Path   string                `json:"path"`
+	Type   string                `json:"type"`
+	Goos   string                `json:"goos"`
+	Goarch string                `json:"goarch"`
+	Extra  artifactMetadataExtra `json:"extra"`
+}
+
+type artifactMetadataExtra struct {
+	Checksum string `json:"Checksum"`
+	// Binaries names the binaries packaged in an archive
+	Binaries []string `json:"Binaries"`
+}
+
+// readArtifactMetadata parses a GoReleaser metadata.json file from the provided filesystem.
+func readArtifactMetadata(fsRepo afero.Fs, path string) (*artifactMetadata, error) {
+	file, err := fsRepo.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open metadata.json: %w", err)
+	}
+	defer file.Close()
+	var metadata artifactMetadata
+	if err := json.NewDecoder(file).Decode(&metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata.json: %w", err)
+	}
+	return &metadata, nil
+}
+
+// readOptionalArtifactMetadata returns nil when the metadata file is not configured or absent.
+func readOptionalArtifactMetadata(fsRepo afero.Fs, path string) (*artifactMetadata, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, nil
+	}
+	exists, err := afero.Exists(fsRepo, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect metadata.json: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	return readArtifactMetadata(fsRepo, path)
+}
+
+// readReleaseArtifactMetadata reads the optional metadata file like readOptionalArtifactMetadata and
+// fails with errStaleArtifactMetadata when it was not produced for the release tag.
+func readReleaseArtifactMetadata(fsRepo afero.Fs, path, tag string) (*artifactMetadata, error) {
+	metadata, err := readOptionalArtifactMetadata(fsRepo, path)
+	if err != nil || metadata == nil {
+		return nil, err
+	}
+	if !metadata.describes(tag) {
+		return nil, fmt.Errorf("%w: %s has tag %q and version %q, not %s",
+			errStaleArtifactMetadata, path, metadata.Tag, metadata.Version, tag)
+	}
+	return metadata, nil
+}
+
+// describes reports whether the metadata was produced for the release tag. GoReleaser records the
+// tag of a release build; a snapshot keeps the previous tag, so its version must be the release
+// version, optionally followed by prerelease or build suffixes.
+func (m *artifactMetadata) describes(tag string) bool {
+	if m.Tag == tag {
+		return true
+	}
+	version := strings.TrimPrefix(tag, "v")
+	return m.Version == version || strings.HasPrefix(m.Version, version+"-") ||
+		strings.HasPrefix(m.Version, version+"+")
+}
+
+// ArchiveBuilds returns the unique archive platforms sorted by OS and architecture.
+func (m *artifactMetadata) ArchiveBuilds() []domain.ArtifactBuild {
+	if m == nil {
+		return nil
+	}
+	seen := make(map[domain.ArtifactBuild]struct{})
+	builds := make([]domain.ArtifactBuild, 0)
+	for _, artifact := range m.Artifacts {
+		if artifact.Type != artifactTypeArchive || artifact.Goos == "" || artifact.Goarch == "" {
+			continue
+		}
+		build := domain.ArtifactBuild{OS: artifact.Goos, Arch: artifact.Goarch}
+		if _, ok := seen[build]; ok {
+			continue
+		}
+		seen[build] = struct{}{}
+		builds = append(builds, build)
+	}
+	sort.Slice(builds, func(i, j int) bool {
+		return builds[i].String() < builds[j].String()
+	})
+	return builds
+}
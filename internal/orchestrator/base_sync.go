@@ -0,0 +1,30 @@
+package orchestrator
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// ensureBaseSynced rebases the release branch onto its base when the base moved during the run so the
+// PR is not opened already behind it. The release still proceeds when the branch cannot be synchronized;
+// base_synced=false is reported so CI can decide how to react.
+func (o *PRReleaseOrchestrator) ensureBaseSynced(ctx context.Context, ciOutput bool, branchName string) {
+	base := releaseBase(ctx)
+	log := o.logger(ctx).With(zap.String("branch", branchName), zap.String("base", base))
+	synced, err := o.gitRepo.IsSyncedWithBase(ctx, base)
+	if err != nil {
+		log.Warn("Could not verify the release branch is up to date with its base", zap.Error(err))
+		o.logCI(ctx, ciOutput, zap.Bool("base_synced", false))
+		return
+	}
+	if !synced {
+		log.Info("Base branch moved during the release run, rebasing release branch")
+		if err := o.gitRepo.RebaseOntoBase(ctx, base); err != nil {
+			log.Warn("Failed to rebase the release branch onto its base", zap.Error(err))
+			o.logCI(ctx, ciOutput, zap.Bool("base_synced", false))
+			return
+		}
+	}
+	o.logCI(ctx, ciOutput, zap.Bool("base_synced", true))
+}
@@ -0,0 +1,95 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func baseSyncedOutputs(logs *observer.ObservedLogs) []bool {
+	var outputs []bool
+	for _, entry := range logs.FilterMessage("ci_output").All() {
+		if value, ok := entry.ContextMap()["base_synced"].(bool); ok {
+			outputs = append(outputs, value)
+		}
+	}
+	return outputs
+}
+
+func TestPRReleaseOrchestrator_logPullRequest(t *testing.T) {
+	t.Run("Should report the release PR number and URL in CI output", func(t *testing.T) {
+		ctx, logs, _, orch := newBaseSyncTest(t)
+		orch.logPullRequest(ctx, true, domain.PullRequestRef{Number: 42, URL: "https://example.com/pull/42"})
+		fields := map[string]any{}
+		for _, entry := range logs.FilterMessage("ci_output").All() {
+			for key, value := range entry.ContextMap() {
+				fields[key] = value
+			}
+		}
+		assert.Equal(t, map[string]any{"pr_number": int64(42), "pr_url": "https://example.com/pull/42"}, fields)
+	})
+}
+
+func newBaseSyncTest(
+	t *testing.T,
+) (context.Context, *observer.ObservedLogs, *mockGitExtendedRepository, *PRReleaseOrchestrator) {
+	t.Helper()
+	core, logs := observer.New(zap.InfoLevel)
+	ctx := logger.IntoContext(testReleaseContext(t), zap.New(core))
+	gitRepo := new(mockGitExtendedRepository)
+	orch := NewPRReleaseOrchestrator(
+		gitRepo,
+		new(mockGithubExtendedRepository),
+		afero.NewMemMapFs(),
+		new(mockCliffService),
+		new(mockNpmService),
+	)
+	return ctx, logs, gitRepo, orch
+}
+
+func TestPRReleaseOrchestrator_ensureBaseSynced(t *testing.T) {
+	t.Run("Should not rebase when the branch already contains the base", func(t *testing.T) {
+		ctx, logs, gitRepo, orch := newBaseSyncTest(t)
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(true, nil).Once()
+		orch.ensureBaseSynced(ctx, true, "release/v1.2.0")
+		gitRepo.AssertExpectations(t)
+		gitRepo.AssertNotCalled(t, "RebaseOntoBase", mock.Anything, mock.Anything)
+		assert.Equal(t, []bool{true}, baseSyncedOutputs(logs))
+	})
+	t.Run("Should rebase onto a base that moved during the run", func(t *testing.T) {
+		ctx, logs, gitRepo, orch := newBaseSyncTest(t)
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(false, nil).Once()
+		gitRepo.On("RebaseOntoBase", mock.Anything, "main").Return(nil).Once()
+		orch.ensureBaseSynced(ctx, true, "release/v1.2.0")
+		gitRepo.AssertExpectations(t)
+		assert.Equal(t, []bool{true}, baseSyncedOutputs(logs))
+	})
+	t.Run("Should report base_synced=false when the rebase fails", func(t *testing.T) {
+		ctx, logs, gitRepo, orch := newBaseSyncTest(t)
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(false, nil).Once()
+		gitRepo.On("RebaseOntoBase", mock.Anything, "main").Return(assert.AnError).Once()
+		orch.ensureBaseSynced(ctx, true, "release/v1.2.0")
+		gitRepo.AssertExpectations(t)
+		assert.Equal(t, []bool{false}, baseSyncedOutputs(logs))
+	})
+	t.Run("Should report base_synced=false when the base cannot be fetched", func(t *testing.T) {
+		ctx, logs, gitRepo, orch := newBaseSyncTest(t)
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(false, assert.AnError).Once()
+		orch.ensureBaseSynced(ctx, true, "release/v1.2.0")
+		gitRepo.AssertNotCalled(t, "RebaseOntoBase", mock.Anything, mock.Anything)
+		assert.Equal(t, []bool{false}, baseSyncedOutputs(logs))
+	})
+	t.Run("Should not emit CI output when it is disabled", func(t *testing.T) {
+		ctx, logs, gitRepo, orch := newBaseSyncTest(t)
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(true, nil).Once()
+		orch.ensureBaseSynced(ctx, false, "release/v1.2.0")
+		assert.Empty(t, baseSyncedOutputs(logs))
+	})
+}
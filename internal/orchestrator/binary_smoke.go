@@ -0,0 +1,180 @@
+package orchestrator
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+const (
+	// binarySmokeTimeout bounds a single `<binary> --version` run of the smoke test.
+	binarySmokeTimeout = 30 * time.Second
+	// maxSmokeBinarySize caps the size of a binary extracted for the smoke test.
+	maxSmokeBinarySize = 512 << 20
+)
+
+// stepSmokeTestBinaries runs the snapshot binaries built for the runner's platform with --version and
+// checks they report the snapshot version, catching broken ldflags before the release. Archives of
+// other platforms, without a path or without binaries, or in a format other than tar.gz and zip
+// are skipped.
+func (o *DryRunOrchestrator) stepSmokeTestBinaries(ctx context.Context, cfg DryRunConfig) error {
+	log := o.logger(ctx)
+	metadata, err := readOptionalArtifactMetadata(o.fsRepo, metadataJSONPath)
+	if err != nil {
+		return err
+	}
+	if metadata == nil || metadata.Version == "" {
+		log.Info("Skipping binary smoke test", zap.String("reason", "no snapshot metadata"))
+		return nil
+	}
+	var errs []error
+	for _, artifact := range metadata.Artifacts {
+		if artifact.Type != artifactTypeArchive || artifact.Goos != runtime.GOOS || artifact.Goarch != runtime.GOARCH {
+			continue
+		}
+		if artifact.Path == "" || len(artifact.Extra.Binaries) == 0 || archiveFormat(artifact.Path) == "" {
+			log.Info("Skipping binary smoke test", zap.String("artifact", artifact.Name),
+				zap.String("reason", "no tar.gz or zip archive path with binaries"))
+			continue
+		}
+		o.logStatus(ctx, cfg.CIOutput, "### 🔥 Smoke testing "+artifact.Name)
+		for _, binary := range artifact.Extra.Binaries {
+			if err := o.smokeTestBinary(ctx, artifact.Path, binary, metadata.Version); err != nil {
+				errs = append(errs, fmt.Errorf("%s in %s: %w", binary, artifact.Path, err))
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("binary smoke test failed: %w", err)
+	}
+	return nil
+}
+
+// smokeTestBinary extracts binary from the archive at archivePath and checks `binary --version`
+// prints version.
+func (o *DryRunOrchestrator) smokeTestBinary(ctx context.Context, archivePath, binary, version string) error {
+	dir, err := afero.TempDir(o.fsRepo, "", "pr-release-smoke-")
+	if err != nil {
+		return fmt.Errorf("failed to create smoke test directory: %w", err)
+	}
+	defer o.fsRepo.RemoveAll(dir)
+	binaryPath := filepath.Join(dir, filepath.Base(binary))
+	if err := extractArchiveBinary(o.fsRepo, archivePath, binary, binaryPath); err != nil {
+		return err
+	}
+	runCtx, cancel := context.WithTimeout(ctx, binarySmokeTimeout)
+	defer cancel()
+	output, err := exec.CommandContext(runCtx, binaryPath, "--version").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("--version failed: %w (output: %s)", err, strings.TrimSpace(string(output)))
+	}
+	if !strings.Contains(string(output), strings.TrimPrefix(version, "v")) {
+		return fmt.Errorf("--version printed %q, expected it to contain %s", strings.TrimSpace(string(output)), version)
+	}
+	o.logger(ctx).Info("Binary smoke test passed",
+		zap.String("binary", binary), zap.String("version", version))
+	return nil
+}
+
+// archiveFormat returns "tar.gz" or "zip" for the archives the smoke test can extract, or "".
+func archiveFormat(archivePath string) string {
+	switch {
+	case strings.HasSuffix(archivePath, ".tar.gz"), strings.HasSuffix(archivePath, ".tgz"):
+		return "tar.gz"
+	case strings.HasSuffix(archivePath, ".zip"):
+		return "zip"
+	default:
+		return ""
+	}
+}
+
+// extractArchiveBinary writes the archive entry named binary, at any depth, of the archive at
+// archivePath to target as an executable file, both through fsRepo.
+func extractArchiveBinary(fsRepo afero.Fs, archivePath, binary, target string) error {
+	file, err := fsRepo.Open(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer file.Close()
+	var entry io.ReadCloser
+	if archiveFormat(archivePath) == "zip" {
+		entry, err = findZipEntry(file, binary)
+	} else {
+		entry, err = findTarEntry(file, binary)
+	}
+	if err != nil {
+		return err
+	}
+	defer entry.Close()
+	out, err := fsRepo.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to extract %s: %w", binary, err)
+	}
+	defer out.Close()
+	written, err := io.Copy(out, io.LimitReader(entry, maxSmokeBinarySize+1))
+	if err != nil {
+		return fmt.Errorf("failed to extract %s: %w", binary, err)
+	}
+	if written > maxSmokeBinarySize {
+		return fmt.Errorf("%s is larger than %d bytes", binary, maxSmokeBinarySize)
+	}
+	return out.Close()
+}
+
+// isBinaryEntry reports whether an archive entry is binary, also matching its .exe on Windows.
+func isBinaryEntry(name, binary string) bool {
+	base := path.Base(name)
+	return slices.Contains([]string{binary, binary + ".exe"}, base)
+}
+
+func findTarEntry(file io.Reader, binary string) (io.ReadCloser, error) {
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("binary %s not found in archive", binary)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+		if header.Typeflag == tar.TypeReg && isBinaryEntry(header.Name, binary) {
+			return io.NopCloser(reader), nil
+		}
+	}
+}
+
+func findZipEntry(file afero.File, binary string) (io.ReadCloser, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	reader, err := zip.NewReader(file, info.Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	for _, entry := range reader.File {
+		if !entry.FileInfo().IsDir() && isBinaryEntry(entry.Name, binary) {
+			return entry.Open()
+		}
+	}
+	return nil, fmt.Errorf("binary %s not found in archive", binary)
+}
@@ -0,0 +1,76 @@
+package orchestrator
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSmokeArchive packages a shell script named pr-release printing output as a tar.gz archive of
+// the runner's platform and records it in the snapshot metadata.
+func writeSmokeArchive(t *testing.T, fsRepo afero.Fs, version, output string) {
+	t.Helper()
+	script := []byte("#!/bin/sh\necho '" + output + "'\n")
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	header := &tar.Header{Name: "pr-release/pr-release", Mode: 0o755, Size: int64(len(script))}
+	require.NoError(t, tw.WriteHeader(header))
+	_, err := tw.Write(script)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	require.NoError(t, fsRepo.MkdirAll("dist", 0o755))
+	require.NoError(t, afero.WriteFile(fsRepo, "dist/pr-release.tar.gz", buf.Bytes(), 0o644))
+	metadata := fmt.Sprintf(`{"version":%q,"artifacts":[{"name":"pr-release.tar.gz","type":"Archive",`+
+		`"path":"dist/pr-release.tar.gz","goos":%q,"goarch":%q,"extra":{"Binaries":["pr-release"]}}]}`,
+		version, runtime.GOOS, runtime.GOARCH)
+	writeGoReleaserOutput(t, fsRepo, metadata, false)
+}
+
+func TestDryRunOrchestrator_stepSmokeTestBinaries(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the smoke test binaries are shell scripts")
+	}
+	// The binaries run from the disk, so the worktree is a temporary directory rather than memory
+	newOrchestrator := func(t *testing.T) (*DryRunOrchestrator, afero.Fs) {
+		t.Chdir(t.TempDir())
+		fsRepo := afero.NewOsFs()
+		return NewDryRunOrchestrator(nil, nil, nil, nil, fsRepo, nil), fsRepo
+	}
+	t.Run("Should pass when the binary reports the snapshot version", func(t *testing.T) {
+		orch, fsRepo := newOrchestrator(t)
+		writeSmokeArchive(t, fsRepo, "1.2.0-SNAPSHOT-abc1234", "pr-release version 1.2.0-SNAPSHOT-abc1234")
+		require.NoError(t, orch.stepSmokeTestBinaries(t.Context(), DryRunConfig{}))
+	})
+	t.Run("Should fail when the binary reports another version", func(t *testing.T) {
+		orch, fsRepo := newOrchestrator(t)
+		writeSmokeArchive(t, fsRepo, "1.2.0-SNAPSHOT-abc1234", "pr-release version dev")
+		err := orch.stepSmokeTestBinaries(t.Context(), DryRunConfig{})
+		require.ErrorContains(t, err, "binary smoke test failed")
+		assert.ErrorContains(t, err, `--version printed "pr-release version dev"`)
+	})
+	t.Run("Should skip archives of other platforms or without binaries", func(t *testing.T) {
+		orch, fsRepo := newOrchestrator(t)
+		writeGoReleaserOutput(t, fsRepo, `{"version":"1.2.0","artifacts":[{"type":"Archive","goos":"plan9",`+
+			`"goarch":"arm","path":"dist/x.tar.gz","extra":{"Binaries":["x"]}},{"type":"Archive","goos":"`+
+			runtime.GOOS+`","goarch":"`+runtime.GOARCH+`"}]}`, false)
+		require.NoError(t, orch.stepSmokeTestBinaries(t.Context(), DryRunConfig{}))
+	})
+	t.Run("Should fail when the archive lacks the binary", func(t *testing.T) {
+		orch, fsRepo := newOrchestrator(t)
+		writeSmokeArchive(t, fsRepo, "1.2.0", "1.2.0")
+		metadata := `{"version":"1.2.0","artifacts":[{"type":"Archive","path":"dist/pr-release.tar.gz",` +
+			`"goos":"` + runtime.GOOS + `","goarch":"` + runtime.GOARCH + `","extra":{"Binaries":["other"]}}]}`
+		writeGoReleaserOutput(t, fsRepo, metadata, false)
+		err := orch.stepSmokeTestBinaries(t.Context(), DryRunConfig{})
+		require.ErrorContains(t, err, "binary other not found in archive")
+	})
+}
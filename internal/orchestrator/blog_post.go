@@ -0,0 +1,161 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/telemetry"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// blogPostDateFormat is the front matter date of a blog post draft, whatever release_date_format is.
+const blogPostDateFormat = "2006-01-02"
+
+// BlogPostConfig contains configuration for exporting the blog post draft of a release.
+type BlogPostConfig struct {
+	Version string // Released version whose notes are exported, e.g. v1.4.0
+	DryRun  bool   // Render the draft without committing it or opening the pull request
+}
+
+// BlogPostResult describes the exported draft and, unless it was a dry run, its pull request.
+type BlogPostResult struct {
+	Repo    string // owner/name of blog_repo
+	Path    string
+	Branch  string
+	Content string
+	PR      domain.PullRequestRef
+}
+
+// BlogPostOrchestrator turns the notes of a GitHub release into a blog post draft and opens a pull
+// request adding it to the website repository of blog_repo.
+type BlogPostOrchestrator struct {
+	githubRepo repository.GithubExtendedRepository
+	fsRepo     repository.FileSystemRepository
+	now        func() time.Time
+}
+
+// NewBlogPostOrchestrator creates a new BlogPostOrchestrator.
+func NewBlogPostOrchestrator(
+	githubRepo repository.GithubExtendedRepository,
+	fsRepo repository.FileSystemRepository,
+) *BlogPostOrchestrator {
+	return &BlogPostOrchestrator{
+		githubRepo: githubRepo,
+		fsRepo:     fsRepo,
+		now:        time.Now,
+	}
+}
+
+func (o *BlogPostOrchestrator) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx).Named("orchestrator.blog_post")
+}
+
+// Execute renders the blog post draft of cfg.Version and, unless cfg.DryRun is set, commits it to a
+// blog/<slug> branch of blog_repo and opens or updates the pull request of that branch. Run again, it
+// updates the draft and the pull request in place.
+func (o *BlogPostOrchestrator) Execute(ctx context.Context, cfg BlogPostConfig) (_ BlogPostResult, err error) {
+	ctx, span := telemetry.Start(ctx, "blog_post", attribute.String("release.version", cfg.Version))
+	defer func() { telemetry.End(span, err) }()
+	ctx, cancel := context.WithTimeout(ctx, DefaultWorkflowTimeout)
+	defer cancel()
+	appConfig := config.FromContext(ctx)
+	blogRepo := strings.TrimSpace(appConfig.BlogRepo)
+	if blogRepo == "" {
+		return BlogPostResult{}, errors.New("blog_repo is not set")
+	}
+	version, err := domain.NewVersion(cfg.Version)
+	if err != nil {
+		return BlogPostResult{}, fmt.Errorf("invalid version %q: %w", cfg.Version, err)
+	}
+	post, err := o.blogPost(ctx, version.String())
+	if err != nil {
+		return BlogPostResult{}, err
+	}
+	content, err := o.renderBlogPost(ctx, post)
+	if err != nil {
+		return BlogPostResult{}, err
+	}
+	result := BlogPostResult{
+		Repo:    blogRepo,
+		Path:    path.Join(appConfig.BlogDir, post.Slug+".md"),
+		Branch:  "blog/" + post.Slug,
+		Content: content,
+	}
+	if cfg.DryRun {
+		return result, nil
+	}
+	if err := ValidateAllowedRepository(ctx); err != nil {
+		return result, err
+	}
+	owner, name, _ := strings.Cut(blogRepo, "/")
+	site := o.githubRepo.ForRepository(owner, name)
+	base := appConfig.BlogBaseBranch
+	message := fmt.Sprintf("docs(blog): add %s release post", post.Title)
+	if err := site.CommitFile(ctx, result.Branch, base, result.Path, content, message); err != nil {
+		return result, fmt.Errorf("failed to commit %s to %s: %w", result.Path, blogRepo, err)
+	}
+	body := fmt.Sprintf("Draft blog post generated from the [%s release notes](%s).\n\n"+
+		"Review and edit it before merging.", post.Title, post.ReleaseURL)
+	result.PR, err = site.CreateOrUpdatePR(ctx, result.Branch, base, "Blog post: "+post.Title, body, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to open the blog post pull request on %s: %w", blogRepo, err)
+	}
+	o.logger(ctx).Info("Opened blog post pull request",
+		zap.String("repo", blogRepo), zap.Int("pr_number", result.PR.Number), zap.String("path", result.Path))
+	return result, nil
+}
+
+// blogPost builds the blog post of tag from the notes of its GitHub release, so the post matches the
+// released version whatever the checkout. It is dated in release_timezone from when the release was
+// published, or today for a draft release.
+func (o *BlogPostOrchestrator) blogPost(ctx context.Context, tag string) (domain.BlogPost, error) {
+	appConfig := config.FromContext(ctx)
+	notes, publishedAt, err := o.githubRepo.ReleaseNotes(ctx, tag)
+	if err != nil {
+		return domain.BlogPost{}, fmt.Errorf("failed to read the GitHub release %s: %w", tag, err)
+	}
+	if strings.TrimSpace(notes) == "" {
+		return domain.BlogPost{}, fmt.Errorf("the GitHub release %s has no release notes", tag)
+	}
+	location, err := time.LoadLocation(appConfig.ReleaseTimezone)
+	if err != nil {
+		return domain.BlogPost{}, fmt.Errorf("invalid release_timezone: %w", err)
+	}
+	released := publishedAt
+	if released.IsZero() {
+		released = o.now()
+	}
+	date := released.In(location).Format(blogPostDateFormat)
+	return domain.NewBlogPost(appConfig.GithubOwner, appConfig.GithubRepo, tag, date, notes), nil
+}
+
+// renderBlogPost renders post with blog_template when one is configured, otherwise with the blog post
+// template of the override directory or the built-in one.
+func (o *BlogPostOrchestrator) renderBlogPost(ctx context.Context, post domain.BlogPost) (string, error) {
+	var text string
+	if templatePath := strings.TrimSpace(config.FromContext(ctx).BlogTemplate); templatePath != "" {
+		data, err := afero.ReadFile(o.fsRepo, templatePath)
+		if err != nil {
+			return "", fmt.Errorf("failed to read blog_template %s: %w", templatePath, err)
+		}
+		text = string(data)
+	} else {
+		var err error
+		if text, err = usecase.LoadTemplate(o.fsRepo, usecase.TemplateBlogPost); err != nil {
+			return "", err
+		}
+	}
+	uc := &usecase.RenderBlogPostUseCase{Template: text}
+	return uc.Execute(ctx, post)
+}
@@ -0,0 +1,96 @@
+package orchestrator
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlogPostOrchestrator_Execute(t *testing.T) {
+	const notes = "## 1.4.0 - 2026-07-17\n\n### Features\n\n- Add blog posts\n"
+	publishedAt := time.Date(2026, time.July, 18, 9, 0, 0, 0, time.UTC)
+	newOrchestrator := func(t *testing.T) (*BlogPostOrchestrator, *mockGithubExtendedRepository, afero.Fs) {
+		t.Helper()
+		fsRepo := afero.NewMemMapFs()
+		// RELEASE_BODY.md of the checkout belongs to another release and must not be exported
+		require.NoError(t, afero.WriteFile(fsRepo, ReleaseBodyOutputFile, []byte("## 1.5.0\n\n- Later"), 0o644))
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("ReleaseNotes", mock.Anything, "v1.4.0").Return(notes, publishedAt, nil).Maybe()
+		orch := NewBlogPostOrchestrator(githubRepo, fsRepo)
+		orch.now = func() time.Time { return time.Date(2026, time.October, 16, 9, 0, 0, 0, time.UTC) }
+		return orch, githubRepo, fsRepo
+	}
+	t.Run("Should commit the draft to the blog repository and open a pull request", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.BlogRepo = "compozy/website"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, githubRepo, _ := newOrchestrator(t)
+		site := new(mockGithubExtendedRepository)
+		githubRepo.On("ForRepository", "compozy", "website").Return(site).Once()
+		site.On("CommitFile", mock.Anything, "blog/releasepr-1-4-0", "main", "content/blog/releasepr-1-4-0.md",
+			mock.MatchedBy(func(content string) bool {
+				return assert.Contains(t, content, "date: 2026-07-18\n") &&
+					assert.Contains(t, content, "## Features\n\n- Add blog posts\n")
+			}),
+			"docs(blog): add releasepr v1.4.0 release post").Return(nil).Once()
+		pr := domain.PullRequestRef{Number: 7, URL: "https://github.com/compozy/website/pull/7"}
+		site.On("CreateOrUpdatePR", mock.Anything, "blog/releasepr-1-4-0", "main", "Blog post: releasepr v1.4.0",
+			mock.Anything, []string(nil)).Return(pr, nil).Once()
+		result, err := orch.Execute(ctx, BlogPostConfig{Version: "v1.4.0"})
+		require.NoError(t, err)
+		assert.Equal(t, pr, result.PR)
+		assert.Equal(t, "content/blog/releasepr-1-4-0.md", result.Path)
+		githubRepo.AssertExpectations(t)
+		site.AssertExpectations(t)
+	})
+	t.Run("Should render the configured template without touching GitHub in a dry run", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.BlogRepo = "compozy/website"
+		cfg.BlogTemplate = "docs/blog.md.tmpl"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, githubRepo, fsRepo := newOrchestrator(t)
+		require.NoError(t, afero.WriteFile(fsRepo, cfg.BlogTemplate, []byte("# {{.Title}}\n\n{{.Summary}}"), 0o644))
+		override := path.Join(usecase.TemplateOverrideDir, usecase.TemplateBlogPost)
+		require.NoError(t, afero.WriteFile(fsRepo, override, []byte("override"), 0o644))
+		result, err := orch.Execute(ctx, BlogPostConfig{Version: "v1.4.0", DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, "# releasepr v1.4.0\n\nreleasepr v1.4.0 ships 1 change in Features.\n", result.Content)
+		githubRepo.AssertNotCalled(t, "ForRepository", mock.Anything, mock.Anything)
+	})
+	t.Run("Should fail without blog_repo or release notes", func(t *testing.T) {
+		orch, githubRepo, _ := newOrchestrator(t)
+		_, err := orch.Execute(testReleaseContext(t), BlogPostConfig{Version: "v1.4.0"})
+		assert.ErrorContains(t, err, "blog_repo is not set")
+		cfg := testReleaseConfig()
+		cfg.BlogRepo = "compozy/website"
+		githubRepo.On("ReleaseNotes", mock.Anything, "v1.3.0").Return("", time.Time{}, nil).Once()
+		_, err = orch.Execute(testReleaseContextWithConfig(t, cfg), BlogPostConfig{Version: "v1.3.0"})
+		assert.ErrorContains(t, err, "the GitHub release v1.3.0 has no release notes")
+	})
+	t.Run("Should date the post of a draft release today", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.BlogRepo = "compozy/website"
+		orch, githubRepo, _ := newOrchestrator(t)
+		githubRepo.On("ReleaseNotes", mock.Anything, "v1.5.0").Return(notes, time.Time{}, nil).Once()
+		result, err := orch.Execute(testReleaseContextWithConfig(t, cfg), BlogPostConfig{Version: "v1.5.0", DryRun: true})
+		require.NoError(t, err)
+		assert.Contains(t, result.Content, "date: 2026-10-16\n")
+	})
+	t.Run("Should refuse to publish the post of a repository outside allowed_repositories", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.BlogRepo = "compozy/website"
+		cfg.AllowedRepositories = []string{"compozy/other"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, githubRepo, _ := newOrchestrator(t)
+		_, err := orch.Execute(ctx, BlogPostConfig{Version: "v1.4.0"})
+		require.ErrorContains(t, err, "not in allowed_repositories")
+		githubRepo.AssertNotCalled(t, "ForRepository", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,92 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/service"
+	"go.uber.org/zap"
+)
+
+// publishCrates publishes the Rust crates of cargo_crates, in order, once release is published.
+// Publishing is skipped without a cargo token, and crates with publish = false or whose version the
+// registry already has are left out, so a re-run only publishes what is missing. A publishable crate
+// whose version is not the release version fails before any crate is published, since pr-release does
+// not bump Cargo.toml and the registry would otherwise report the old version as already published.
+func (o *PublishOrchestrator) publishCrates(ctx context.Context, release string) error {
+	cfg := config.FromContext(ctx)
+	if len(cfg.CargoCrates) == 0 {
+		return nil
+	}
+	log := o.logger(ctx)
+	if cfg.CargoToken == "" {
+		log.Warn("Skipping crate publishing", zap.String("reason", "no cargo token (CARGO_REGISTRY_TOKEN)"))
+		return nil
+	}
+	crates := make([]service.CargoCrate, len(cfg.CargoCrates))
+	for i, path := range cfg.CargoCrates {
+		crate, err := o.cargoSvc.ReadCrate(ctx, path)
+		if err != nil {
+			return err
+		}
+		if crate.Publishable && crate.Version != strings.TrimPrefix(release, "v") {
+			return fmt.Errorf("crate %s is at version %s, not the released %s; bump Cargo.toml in the "+
+				"release PR with a version updater", crate.Name, crate.Version, release)
+		}
+		crates[i] = crate
+	}
+	for i, path := range cfg.CargoCrates {
+		crate := crates[i]
+		log := log.With(zap.String("crate", crate.Name), zap.String("crate_version", crate.Version))
+		if !crate.Publishable {
+			log.Info("Skipping crate", zap.String("reason", "publish = false"))
+			continue
+		}
+		err := o.cargoSvc.CheckRegistry(ctx, crate, cfg.CargoRegistry)
+		if errors.Is(err, service.ErrPackageVersionPublished) {
+			log.Info("Skipping crate", zap.String("reason", "version already published"))
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("crate %s: %w", crate.Name, err)
+		}
+		opts := service.CargoPublishOptions{Registry: cfg.CargoRegistry, Token: cfg.CargoToken}
+		if err := o.cargoSvc.Publish(ctx, path, opts); err != nil {
+			return err
+		}
+		log.Info("Published crate")
+	}
+	return nil
+}
+
+// stepValidateCrates packages every crate of cargo_crates with cargo publish --dry-run, so a crate that
+// does not package fails the release PR instead of the publish. The packaged crate is not built: a
+// workspace member cannot be until the siblings it depends on are published.
+func (o *DryRunOrchestrator) stepValidateCrates(ctx context.Context, cfg DryRunConfig) error {
+	o.logStatus(ctx, cfg.CIOutput, "### 🦀 Validating Rust crates")
+	log := o.logger(ctx)
+	var errs []error
+	for _, path := range cfg.CargoCrates {
+		log.Info("Validating crate", zap.String("path", path))
+		crate, err := o.cargoSvc.ReadCrate(ctx, path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !crate.Publishable {
+			continue
+		}
+		opts := service.CargoPublishOptions{Registry: cfg.CargoRegistry, DryRun: true, NoVerify: true}
+		if err := o.cargoSvc.Publish(ctx, path, opts); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", crate.Name, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("crate validation failed: %w", err)
+	}
+	log.Info("Rust crates validated", zap.Int("count", len(cfg.CargoCrates)))
+	return nil
+}
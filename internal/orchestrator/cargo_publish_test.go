@@ -0,0 +1,88 @@
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishOrchestrator_publishCrates(t *testing.T) {
+	core := service.CargoCrate{Name: "acme-core", Version: "1.2.0", Publishable: true}
+	cli := service.CargoCrate{Name: "acme-cli", Version: "1.2.0", Publishable: true}
+	t.Run("Should publish the crates in order, skipping published versions", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.CargoCrates = []string{"crates/core", "crates/cli", "crates/bench"}
+		cfg.CargoRegistry = "internal"
+		cfg.CargoToken = "cargo-token"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		cargoSvc := new(mockCargoService)
+		cargoSvc.On("ReadCrate", mock.Anything, "crates/core").Return(core, nil).Once()
+		cargoSvc.On("CheckRegistry", mock.Anything, core, "internal").
+			Return(fmt.Errorf("acme-core@1.2.0: %w", service.ErrPackageVersionPublished)).Once()
+		cargoSvc.On("ReadCrate", mock.Anything, "crates/cli").Return(cli, nil).Once()
+		cargoSvc.On("CheckRegistry", mock.Anything, cli, "internal").Return(nil).Once()
+		opts := service.CargoPublishOptions{Registry: "internal", Token: "cargo-token"}
+		cargoSvc.On("Publish", mock.Anything, "crates/cli", opts).Return(nil).Once()
+		cargoSvc.On("ReadCrate", mock.Anything, "crates/bench").
+			Return(service.CargoCrate{Name: "acme-bench", Version: "0.1.0"}, nil).Once()
+		orch := &PublishOrchestrator{cargoSvc: cargoSvc}
+		require.NoError(t, orch.publishCrates(ctx, "v1.2.0"))
+		cargoSvc.AssertExpectations(t)
+	})
+	t.Run("Should refuse a crate that was not bumped to the release version", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.CargoCrates = []string{"crates/core", "crates/cli"}
+		cfg.CargoToken = "cargo-token"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		cargoSvc := new(mockCargoService)
+		cargoSvc.On("ReadCrate", mock.Anything, "crates/core").Return(core, nil).Once()
+		cargoSvc.On("ReadCrate", mock.Anything, "crates/cli").Return(cli, nil).Once()
+		orch := &PublishOrchestrator{cargoSvc: cargoSvc}
+		err := orch.publishCrates(ctx, "v1.3.0")
+		require.ErrorContains(t, err, "crate acme-core is at version 1.2.0, not the released v1.3.0")
+		cargoSvc.AssertNotCalled(t, "CheckRegistry", mock.Anything, mock.Anything, mock.Anything)
+		cargoSvc.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should skip publishing without a cargo token", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.CargoCrates = []string{"crates/core"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		cargoSvc := new(mockCargoService)
+		orch := &PublishOrchestrator{cargoSvc: cargoSvc}
+		require.NoError(t, orch.publishCrates(ctx, "v1.2.0"))
+		cargoSvc.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should stop when the registry is not available", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.CargoCrates = []string{"crates/core"}
+		cfg.CargoToken = "cargo-token"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		cargoSvc := new(mockCargoService)
+		cargoSvc.On("ReadCrate", mock.Anything, "crates/core").Return(core, nil).Once()
+		cargoSvc.On("CheckRegistry", mock.Anything, core, "").
+			Return(errors.New("crate registry is not available: command failed")).Once()
+		orch := &PublishOrchestrator{cargoSvc: cargoSvc}
+		err := orch.publishCrates(ctx, "v1.2.0")
+		require.ErrorContains(t, err, "crate acme-core: crate registry is not available")
+		cargoSvc.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDryRunOrchestrator_stepValidateCrates(t *testing.T) {
+	t.Run("Should package every publishable crate with a dry-run publish", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		cargoSvc := new(mockCargoService)
+		crate := service.CargoCrate{Name: "acme-core", Version: "1.2.0", Publishable: true}
+		cargoSvc.On("ReadCrate", mock.Anything, "crates/core").Return(crate, nil).Once()
+		cargoSvc.On("Publish", mock.Anything, "crates/core", service.CargoPublishOptions{DryRun: true, NoVerify: true}).
+			Return(errors.New("failed to publish crate at crates/core: command failed")).Once()
+		orch := &DryRunOrchestrator{cargoSvc: cargoSvc}
+		err := orch.stepValidateCrates(ctx, DryRunConfig{CargoCrates: []string{"crates/core"}})
+		require.ErrorContains(t, err, "crate validation failed: acme-core: failed to publish crate")
+		cargoSvc.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,213 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/compozy/releasepr/internal/telemetry"
+	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// CatchUpConfig contains configuration for the changelog catch-up workflow.
+type CatchUpConfig struct {
+	DryRun bool // Report the releases to backfill without writing CHANGELOG.md
+}
+
+// CatchUpOrchestrator backfills the CHANGELOG.md sections of tagged releases the changelog does not
+// document yet, for repositories that were released by hand or fell behind.
+type CatchUpOrchestrator struct {
+	gitRepo  repository.GitExtendedRepository
+	cliffSvc service.CliffService
+	fsRepo   repository.FileSystemRepository
+}
+
+// NewCatchUpOrchestrator creates a new CatchUpOrchestrator.
+func NewCatchUpOrchestrator(
+	gitRepo repository.GitExtendedRepository,
+	cliffSvc service.CliffService,
+	fsRepo repository.FileSystemRepository,
+) *CatchUpOrchestrator {
+	return &CatchUpOrchestrator{
+		gitRepo:  gitRepo,
+		cliffSvc: cliffSvc,
+		fsRepo:   fsRepo,
+	}
+}
+
+func (o *CatchUpOrchestrator) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx).Named("orchestrator.catch_up")
+}
+
+// releaseBoundary is a version tag missing from CHANGELOG.md.
+type releaseBoundary struct {
+	tag     domain.ReleaseTag
+	version *domain.Version
+}
+
+// Execute renders the changelog section of every version tag missing from CHANGELOG.md, dates it
+// with the tag, and inserts it among the documented releases in version order. It returns the
+// backfilled tags, oldest version first.
+func (o *CatchUpOrchestrator) Execute(ctx context.Context, cfg CatchUpConfig) (_ []domain.ReleaseTag, err error) {
+	ctx, span := telemetry.Start(ctx, "catch_up", attribute.Bool("release.dry_run", cfg.DryRun))
+	defer func() { telemetry.End(span, err) }()
+	ctx, cancel := context.WithTimeout(ctx, ReleaseWorkflowTimeout)
+	defer cancel()
+	if !cfg.DryRun {
+		if err := ValidateAllowedRepository(ctx); err != nil {
+			return nil, err
+		}
+	}
+	log := o.logger(ctx)
+	tags, err := o.gitRepo.ReleaseTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	existing, err := readOptionalFile(o.fsRepo, "CHANGELOG.md")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read changelog: %w", err)
+	}
+	missing := undocumentedReleases(tags, existing)
+	if len(missing) == 0 {
+		log.Info("CHANGELOG.md documents every release tag", zap.Int("tags", len(tags)))
+		return nil, nil
+	}
+	changelog, err := o.renderChangelog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	document := existing
+	if strings.TrimSpace(document) == "" {
+		document = changelogFileHeader
+	}
+	var backfilled []domain.ReleaseTag
+	for _, boundary := range missing {
+		section := changelogVersionSection(changelog, boundary.tag.Name)
+		if section == "" {
+			log.Warn("git-cliff rendered no section for tag", zap.String("tag", boundary.tag.Name))
+			continue
+		}
+		date, err := formatReleaseDate(ctx, boundary.tag.Date)
+		if err != nil {
+			return nil, err
+		}
+		section = stampReleaseDate(section, boundary.tag.Name, date)
+		document = insertChangelogSection(document, boundary.version, section)
+		backfilled = append(backfilled, boundary.tag)
+		log.Info("Backfilled changelog section", zap.String("tag", boundary.tag.Name), zap.String("date", date))
+	}
+	if cfg.DryRun || len(backfilled) == 0 {
+		return backfilled, nil
+	}
+	if err := afero.WriteFile(o.fsRepo, "CHANGELOG.md", []byte(document), FilePermissionsReadWrite); err != nil {
+		return nil, fmt.Errorf("failed to write changelog: %w", err)
+	}
+	return backfilled, nil
+}
+
+// renderChangelog renders the complete changelog for the file audience in the release locale, the
+// source of the backfilled sections.
+func (o *CatchUpOrchestrator) renderChangelog(ctx context.Context) (string, error) {
+	cfg := config.FromContext(ctx)
+	policy, err := changelogMarkdownPolicy(ctx)
+	if err != nil {
+		return "", err
+	}
+	style, err := changelogStyle(ctx)
+	if err != nil {
+		return "", err
+	}
+	filter, err := changelogAudienceFilter(ctx, cfg.ChangelogFileAudience)
+	if err != nil {
+		return "", err
+	}
+	locale, err := releaseLocale(ctx, o.fsRepo)
+	if err != nil {
+		return "", err
+	}
+	changelog, err := o.cliffSvc.GenerateFilteredFullChangelog(ctx, "", filter)
+	if err != nil {
+		return "", fmt.Errorf("failed to build complete changelog: %w", err)
+	}
+	changelog, _ = linkIssues(cfg, locale.LocalizeHeadings(style.Apply(policy.Sanitize(changelog))))
+	return changelog, nil
+}
+
+// undocumentedReleases returns the version tags without a section in the changelog document,
+// oldest version first. Tags that are not versions are left out.
+func undocumentedReleases(tags []domain.ReleaseTag, document string) []releaseBoundary {
+	documented := map[string]bool{}
+	for _, line := range strings.Split(document, "\n") {
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "## ") {
+			documented[normalizeReleaseVersion(headingVersion(trimmed))] = true
+		}
+	}
+	var missing []releaseBoundary
+	for _, tag := range tags {
+		version, err := domain.NewVersion(tag.Name)
+		if err != nil || documented[normalizeReleaseVersion(tag.Name)] {
+			continue
+		}
+		missing = append(missing, releaseBoundary{tag: tag, version: version})
+	}
+	slices.SortStableFunc(missing, func(a, b releaseBoundary) int { return a.version.Compare(b.version) })
+	return missing
+}
+
+// changelogVersionSection returns the section of version in a changelog document, from its heading
+// to the next release heading, or an empty string when the document has no such section.
+func changelogVersionSection(document, version string) string {
+	targetVersion := normalizeReleaseVersion(version)
+	var section []string
+	inSection := false
+	for _, line := range strings.Split(document, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "## ") {
+			if inSection {
+				break
+			}
+			inSection = normalizeReleaseVersion(headingVersion(trimmed)) == targetVersion
+		}
+		if inSection {
+			section = append(section, line)
+		}
+	}
+	return strings.TrimSpace(strings.Join(section, "\n"))
+}
+
+// insertChangelogSection inserts the section of version above the first release heading of a lower
+// version, or at the end of the document when every documented release is newer. Headings that are
+// not versions, such as Unreleased, stay above it.
+func insertChangelogSection(document string, version *domain.Version, section string) string {
+	lines := strings.Split(strings.TrimSpace(document), "\n")
+	insertAt := len(lines)
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "## ") {
+			continue
+		}
+		documented, err := domain.NewVersion(headingVersion(trimmed))
+		if err == nil && documented.Compare(version) < 0 {
+			insertAt = i
+			break
+		}
+	}
+	head := strings.TrimSpace(strings.Join(lines[:insertAt], "\n"))
+	tail := strings.TrimSpace(strings.Join(lines[insertAt:], "\n"))
+	updated := strings.TrimSpace(section) + "\n"
+	if head != "" {
+		updated = head + "\n\n" + updated
+	}
+	if tail != "" {
+		updated += "\n" + tail + "\n"
+	}
+	return updated
+}
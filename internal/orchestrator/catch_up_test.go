@@ -0,0 +1,100 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const catchUpChangelog = "# Changelog\n\n## 1.2.0 - 2026-06-01\n\n- Second\n\n## 1.0.0 - 2026-04-01\n\n- First\n"
+
+const catchUpCliffChangelog = "# Changelog\n\n## Unreleased\n\n- Pending\n\n" +
+	"## 1.3.0 - 2026-07-01\n\n- Third\n\n## 1.2.0 - 2026-06-01\n\n- Second\n\n" +
+	"## 1.1.0 - 2026-05-01\n\n- Between\n\n## 1.0.0 - 2026-04-01\n\n- First"
+
+func catchUpTags() []domain.ReleaseTag {
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 12, 0, 0, 0, time.UTC) }
+	return []domain.ReleaseTag{
+		{Name: "v1.0.0", Date: day(time.April, 1)},
+		{Name: "nightly", Date: day(time.April, 2)},
+		{Name: "v1.1.0", Date: day(time.May, 3)},
+		{Name: "v1.2.0", Date: day(time.June, 1)},
+		{Name: "v1.3.0", Date: day(time.July, 4)},
+	}
+}
+
+func TestCatchUpOrchestrator_Execute(t *testing.T) {
+	t.Run("Should backfill missed releases in version order with their tag dates", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, "CHANGELOG.md", []byte(catchUpChangelog), 0644))
+		gitRepo := new(mockGitExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		gitRepo.On("ReleaseTags", mock.Anything).Return(catchUpTags(), nil).Once()
+		cliffSvc.On("GenerateFilteredFullChangelog", mock.Anything, "", mock.Anything).
+			Return(catchUpCliffChangelog, nil).Once()
+		orch := NewCatchUpOrchestrator(gitRepo, cliffSvc, fsRepo)
+		backfilled, err := orch.Execute(testReleaseContext(t), CatchUpConfig{})
+		require.NoError(t, err)
+		require.Len(t, backfilled, 2)
+		assert.Equal(t, "v1.1.0", backfilled[0].Name)
+		assert.Equal(t, "v1.3.0", backfilled[1].Name)
+		data, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Changelog\n\n## 1.3.0 - 2026-07-04\n\n- Third\n\n## 1.2.0 - 2026-06-01\n\n- Second\n\n"+
+			"## 1.1.0 - 2026-05-03\n\n- Between\n\n## 1.0.0 - 2026-04-01\n\n- First\n", string(data))
+		gitRepo.AssertExpectations(t)
+		cliffSvc.AssertExpectations(t)
+	})
+	t.Run("Should leave CHANGELOG.md untouched in dry-run", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, "CHANGELOG.md", []byte(catchUpChangelog), 0644))
+		gitRepo := new(mockGitExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		gitRepo.On("ReleaseTags", mock.Anything).Return(catchUpTags(), nil).Once()
+		cliffSvc.On("GenerateFilteredFullChangelog", mock.Anything, "", mock.Anything).
+			Return(catchUpCliffChangelog, nil).Once()
+		orch := NewCatchUpOrchestrator(gitRepo, cliffSvc, fsRepo)
+		backfilled, err := orch.Execute(testReleaseContext(t), CatchUpConfig{DryRun: true})
+		require.NoError(t, err)
+		assert.Len(t, backfilled, 2)
+		data, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, catchUpChangelog, string(data))
+	})
+	t.Run("Should not render the changelog when every release is documented", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, "CHANGELOG.md", []byte(catchUpChangelog), 0644))
+		gitRepo := new(mockGitExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		gitRepo.On("ReleaseTags", mock.Anything).Return(catchUpTags()[:1], nil).Once()
+		orch := NewCatchUpOrchestrator(gitRepo, cliffSvc, fsRepo)
+		backfilled, err := orch.Execute(testReleaseContext(t), CatchUpConfig{})
+		require.NoError(t, err)
+		assert.Empty(t, backfilled)
+		cliffSvc.AssertNotCalled(t, "GenerateFilteredFullChangelog", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should refuse to backfill a repository outside allowed_repositories", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.AllowedRepositories = []string{"compozy/other"}
+		gitRepo := new(mockGitExtendedRepository)
+		orch := NewCatchUpOrchestrator(gitRepo, new(mockCliffService), afero.NewMemMapFs())
+		_, err := orch.Execute(testReleaseContextWithConfig(t, cfg), CatchUpConfig{})
+		require.ErrorContains(t, err, "not in allowed_repositories")
+		gitRepo.AssertNotCalled(t, "ReleaseTags", mock.Anything)
+	})
+}
+
+func TestInsertChangelogSection(t *testing.T) {
+	t.Run("Should keep non-version headings above the inserted release", func(t *testing.T) {
+		version, err := domain.NewVersion("v1.1.0")
+		require.NoError(t, err)
+		document := "# Changelog\n\n## Unreleased\n\n- Pending\n"
+		assert.Equal(t, "# Changelog\n\n## Unreleased\n\n- Pending\n\n## 1.1.0\n\n- New\n",
+			insertChangelogSection(document, version, "## 1.1.0\n\n- New"))
+	})
+}
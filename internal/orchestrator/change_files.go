@@ -0,0 +1,126 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/spf13/afero"
+)
+
+// Values for the change_detection setting.
+const (
+	ChangeDetectionCommits     = "commits"
+	ChangeDetectionChangeFiles = "change-files"
+)
+
+// changelogFileHeader starts a CHANGELOG.md created in change-files mode.
+const changelogFileHeader = "# Changelog\n"
+
+// changeFilesMode reports whether releases are driven by pending .changes files instead of commits.
+func changeFilesMode(ctx context.Context) bool {
+	mode := strings.ToLower(strings.TrimSpace(config.FromContext(ctx).ChangeDetection))
+	return mode == ChangeDetectionChangeFiles
+}
+
+func (o *PRReleaseOrchestrator) pendingChangeFiles(ctx context.Context) (domain.ChangeFiles, error) {
+	uc := &usecase.CollectChangeFilesUseCase{FSRepo: o.fsRepo}
+	return uc.Execute(ctx)
+}
+
+// checkChangeFiles reports whether change files are pending, along with the latest tag.
+func (o *PRReleaseOrchestrator) checkChangeFiles(ctx context.Context) (bool, string, error) {
+	latestTag, err := o.gitRepo.LatestTag(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get latest tag: %w", err)
+	}
+	files, err := o.pendingChangeFiles(ctx)
+	if err != nil {
+		return false, latestTag, err
+	}
+	return len(files) > 0, latestTag, nil
+}
+
+// changeFilesChangelog renders the release changelog from the pending change files.
+func (o *PRReleaseOrchestrator) changeFilesChangelog(ctx context.Context, version, date string) (string, error) {
+	files, err := o.pendingChangeFiles(ctx)
+	if err != nil {
+		return "", err
+	}
+	return files.RenderChangelog(version, date), nil
+}
+
+// prependChangelogSection adds the release section to CHANGELOG.md above earlier releases, replacing
+// a section of the same version left by a previous run.
+func (o *PRReleaseOrchestrator) prependChangelogSection(version, section string) error {
+	existing, err := readOptionalFile(o.fsRepo, "CHANGELOG.md")
+	if err != nil {
+		return fmt.Errorf("failed to read changelog: %w", err)
+	}
+	document := removeReleaseNotesVersionSection(existing, version)
+	if document == "" {
+		document = changelogFileHeader
+	}
+	lines := strings.Split(document, "\n")
+	insertAt := len(lines)
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "## ") {
+			insertAt = i
+			break
+		}
+	}
+	head := strings.TrimSpace(strings.Join(lines[:insertAt], "\n"))
+	tail := strings.TrimSpace(strings.Join(lines[insertAt:], "\n"))
+	updated := head + "\n\n" + strings.TrimSpace(section) + "\n"
+	if tail != "" {
+		updated += "\n" + tail + "\n"
+	}
+	if err := afero.WriteFile(o.fsRepo, "CHANGELOG.md", []byte(updated), FilePermissionsReadWrite); err != nil {
+		return fmt.Errorf("failed to write changelog: %w", err)
+	}
+	return nil
+}
+
+// consumesChangeFiles reports whether the release deletes input files: the change files in
+// change-files mode, or the pending notes file written by `note add` in commits mode.
+func (o *PRReleaseOrchestrator) consumesChangeFiles(ctx context.Context) (bool, error) {
+	if changeFilesMode(ctx) {
+		return true, nil
+	}
+	exists, err := afero.Exists(o.fsRepo, domain.PendingNotesFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect %s: %w", domain.PendingNotesFile, err)
+	}
+	return exists, nil
+}
+
+// consumeChangeFiles deletes the change files or pending notes included in the release so the next
+// one starts empty.
+func (o *PRReleaseOrchestrator) consumeChangeFiles(ctx context.Context) (*usecase.ConsumeChangeFilesResult, error) {
+	files, err := o.consumableChangeFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uc := &usecase.ConsumeChangeFilesUseCase{FSRepo: o.fsRepo, GitRepo: o.gitRepo}
+	return uc.Execute(ctx, files)
+}
+
+// consumableChangeFiles returns the files consumeChangeFiles deletes.
+func (o *PRReleaseOrchestrator) consumableChangeFiles(ctx context.Context) (domain.ChangeFiles, error) {
+	if changeFilesMode(ctx) {
+		return o.pendingChangeFiles(ctx)
+	}
+	return domain.ChangeFiles{{SourcePath: domain.PendingNotesFile}}, nil
+}
+
+// consumedChangeFiles lists the deletions the consume step added to the worktree.
+func consumedChangeFiles(result *usecase.ConsumeChangeFilesResult) []string {
+	files := make([]string, 0, len(result.Files))
+	for _, file := range result.Files {
+		files = append(files, file.Path)
+	}
+	return files
+}
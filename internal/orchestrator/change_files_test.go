@@ -0,0 +1,105 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func changeFilesConfig() *config.Config {
+	cfg := testReleaseConfig()
+	cfg.ChangeDetection = ChangeDetectionChangeFiles
+	return cfg
+}
+
+func writeChangeFile(t *testing.T, fsRepo afero.Fs, name, bump, summary string) {
+	t.Helper()
+	content := "---\nbump: " + bump + "\n---\n\n" + summary + "\n"
+	require.NoError(t, afero.WriteFile(fsRepo, ".changes/"+name, []byte(content), 0644))
+}
+
+func TestPRReleaseOrchestrator_changeFiles(t *testing.T) {
+	t.Run("Should report changes only while change files are pending", func(t *testing.T) {
+		ctx := testReleaseContextWithConfig(t, changeFilesConfig())
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		orch.gitRepo.(*mockGitExtendedRepository).On("LatestTag", mock.Anything).Return("v1.2.3", nil)
+		check, err := orch.checkChanges(ctx)
+		require.NoError(t, err)
+		assert.False(t, check.HasChanges)
+		assert.Equal(t, "v1.2.3", check.LatestTag)
+		writeChangeFile(t, fsRepo, "fix.md", "patch", "Fix retries.")
+		check, err = orch.checkChanges(ctx)
+		require.NoError(t, err)
+		assert.True(t, check.HasChanges)
+	})
+	t.Run("Should bump the latest tag by the most significant change file", func(t *testing.T) {
+		ctx := testReleaseContextWithConfig(t, changeFilesConfig())
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		orch.gitRepo.(*mockGitExtendedRepository).On("LatestTag", mock.Anything).Return("v1.2.3", nil)
+		writeChangeFile(t, fsRepo, "fix.md", "patch", "Fix retries.")
+		writeChangeFile(t, fsRepo, "export.md", "minor", "Add export.")
+		version, err := orch.calculateVersion(ctx, "v1.2.3")
+		require.NoError(t, err)
+		assert.Equal(t, "v1.3.0", version)
+	})
+	t.Run("Should build the changelog from change files instead of git-cliff", func(t *testing.T) {
+		ctx := testReleaseContextWithConfig(t, changeFilesConfig())
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		orch.now = func() time.Time { return time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC) }
+		writeChangeFile(t, fsRepo, "export.md", "minor", "Add export.")
+		existing := "# Changelog\n\nNotable changes.\n\n## 1.2.3 - 2026-01-01\n\n### Patch Changes\n\n- Old fix\n"
+		require.NoError(t, afero.WriteFile(fsRepo, "CHANGELOG.md", []byte(existing), 0644))
+		artifacts, err := orch.generateChangelog(ctx, "v1.3.0", "", nil, nil)
+		require.NoError(t, err)
+		section := "## 1.3.0 - 2026-03-04\n\n### Minor Changes\n\n- Add export."
+		assert.Equal(t, section+"\n", artifacts.changelog)
+		data, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Changelog\n\nNotable changes.\n\n"+section+"\n\n"+
+			"## 1.2.3 - 2026-01-01\n\n### Patch Changes\n\n- Old fix\n", string(data))
+		cliffSvc := orch.cliffSvc.(*mockCliffService)
+		cliffSvc.AssertNotCalled(t, "GenerateChangelog", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should replace the section of a previous run of the same version", func(t *testing.T) {
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, orch.prependChangelogSection("v1.3.0", "## 1.3.0\n\n- First"))
+		require.NoError(t, orch.prependChangelogSection("v1.3.0", "## 1.3.0\n\n- Second"))
+		data, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Changelog\n\n## 1.3.0\n\n- Second\n", string(data))
+	})
+	t.Run("Should consume change files and track their deletion", func(t *testing.T) {
+		ctx := testReleaseContextWithConfig(t, changeFilesConfig())
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		writeChangeFile(t, fsRepo, "fix.md", "patch", "Fix retries.")
+		gitRepo := orch.gitRepo.(*mockGitExtendedRepository)
+		gitRepo.On("RemoveFile", mock.Anything, ".changes/fix.md").Return(nil).Once()
+		result, err := orch.consumeChangeFiles(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{".changes/fix.md"}, consumedChangeFiles(result))
+		gitRepo.AssertExpectations(t)
+	})
+	t.Run("Should consume pending notes in commits mode", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		consumes, err := orch.consumesChangeFiles(ctx)
+		require.NoError(t, err)
+		assert.False(t, consumes)
+		require.NoError(t, afero.WriteFile(fsRepo, domain.PendingNotesFile, []byte("- revert: undo cache\n"), 0644))
+		consumes, err = orch.consumesChangeFiles(ctx)
+		require.NoError(t, err)
+		assert.True(t, consumes)
+		gitRepo := orch.gitRepo.(*mockGitExtendedRepository)
+		gitRepo.On("RemoveFile", mock.Anything, domain.PendingNotesFile).Return(nil).Once()
+		result, err := orch.consumeChangeFiles(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{domain.PendingNotesFile}, consumedChangeFiles(result))
+		gitRepo.AssertExpectations(t)
+	})
+}
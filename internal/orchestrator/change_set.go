@@ -0,0 +1,70 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/compozy/releasepr/internal/repository"
+)
+
+// ChangeSet tracks every file a release run modified so the release commit stages exactly those.
+// It is safe for concurrent use because release artifacts are prepared in parallel.
+type ChangeSet struct {
+	mu    sync.Mutex
+	paths []string
+}
+
+// NewChangeSet creates an empty change set.
+func NewChangeSet() *ChangeSet {
+	return &ChangeSet{}
+}
+
+// Track records repository-relative paths, ignoring empty and already tracked ones.
+func (c *ChangeSet) Track(paths ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		path = filepath.ToSlash(filepath.Clean(path))
+		if !slices.Contains(c.paths, path) {
+			c.paths = append(c.paths, path)
+		}
+	}
+}
+
+// Untrack drops the tracked paths match reports and returns them.
+func (c *ChangeSet) Untrack(match func(path string) bool) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var removed []string
+	c.paths = slices.DeleteFunc(c.paths, func(path string) bool {
+		if match(path) {
+			removed = append(removed, path)
+			return true
+		}
+		return false
+	})
+	return removed
+}
+
+// Paths returns the tracked paths in the order they were first recorded.
+func (c *ChangeSet) Paths() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.paths)
+}
+
+// Stage adds every tracked path to the index.
+func (c *ChangeSet) Stage(ctx context.Context, gitRepo repository.GitExtendedRepository) error {
+	for _, path := range c.Paths() {
+		if err := gitRepo.AddFiles(ctx, path); err != nil {
+			return fmt.Errorf("failed to stage %s: %w", path, err)
+		}
+	}
+	return nil
+}
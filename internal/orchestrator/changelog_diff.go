@@ -0,0 +1,65 @@
+package orchestrator
+
+import (
+	"context"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"go.uber.org/zap"
+)
+
+// changelogDiffEnabled reports whether changelog_diff asks for the changes of release PR updates.
+func changelogDiffEnabled(ctx context.Context) bool {
+	mode := config.FromContext(ctx).ChangelogDiff
+	return mode == "log" || mode == "comment"
+}
+
+// previousPRBody returns the number and body of the open release PR from branchName before the run
+// updates it, or 0 when changelog_diff is off or the run opens the PR. Lookup errors are only logged.
+func (o *PRReleaseOrchestrator) previousPRBody(ctx context.Context, branchName string) (int, string) {
+	if !changelogDiffEnabled(ctx) {
+		return 0, ""
+	}
+	prNumber, err := o.githubRepo.FindOpenPR(ctx, branchName, releaseBase(ctx))
+	if err != nil || prNumber == 0 {
+		if err != nil {
+			o.logger(ctx).Warn("Failed to find the release PR to diff the changelog", zap.Error(err))
+		}
+		return 0, ""
+	}
+	body, err := o.githubRepo.PullRequestBody(ctx, prNumber)
+	if err != nil {
+		o.logger(ctx).Warn("Failed to read the release PR body to diff the changelog",
+			zap.Int("pr_number", prNumber), zap.Error(err))
+		return 0, ""
+	}
+	return prNumber, body
+}
+
+// reportChangelogDiff logs the changelog entries the update of release PR prNumber added and removed
+// and, with changelog_diff set to comment, comments them on the PR. Comment errors are only logged.
+func (o *PRReleaseOrchestrator) reportChangelogDiff(
+	ctx context.Context,
+	prNumber int,
+	previousBody, changelog, body string,
+) {
+	if prNumber == 0 {
+		return
+	}
+	diff := domain.DiffChangelog(previousBody, changelog, body)
+	if diff.IsEmpty() {
+		o.logger(ctx).Info("Changelog unchanged since the last release PR update", zap.Int("pr_number", prNumber))
+		return
+	}
+	o.logger(ctx).Info("Changelog changed since the last release PR update",
+		zap.Int("pr_number", prNumber),
+		zap.Strings("added", diff.Added),
+		zap.Strings("removed", diff.Removed),
+	)
+	if config.FromContext(ctx).ChangelogDiff != "comment" {
+		return
+	}
+	if err := o.githubRepo.AddComment(ctx, prNumber, diff.Markdown()); err != nil {
+		o.logger(ctx).Warn("Failed to comment the changelog diff", zap.Int("pr_number", prNumber), zap.Error(err))
+	}
+}
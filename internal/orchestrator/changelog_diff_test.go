@@ -0,0 +1,63 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPRReleaseOrchestrator_changelogDiff(t *testing.T) {
+	previous := "## Changelog\n\n- add export (abc123)\n"
+	changelog := "- add export (abc123)\n- add search (fed789)\n"
+	body := "## Changelog\n\n" + changelog
+	t.Run("Should comment the entries added since the previous PR body", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ChangelogDiff = "comment"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("FindOpenPR", mock.Anything, "release/v1.2.0", "main").Return(42, nil).Once()
+		githubRepo.On("PullRequestBody", mock.Anything, 42).Return(previous, nil).Once()
+		var comment string
+		githubRepo.On("AddComment", mock.Anything, 42, mock.Anything).
+			Run(func(args mock.Arguments) { comment = args.String(2) }).
+			Return(nil).Once()
+		orch := &PRReleaseOrchestrator{githubRepo: githubRepo}
+		number, previousBody := orch.previousPRBody(ctx, "release/v1.2.0")
+		orch.reportChangelogDiff(ctx, number, previousBody, changelog, body)
+		githubRepo.AssertExpectations(t)
+		assert.Contains(t, comment, "**Added**\n\n- add search (fed789)\n")
+		assert.NotContains(t, comment, "Removed")
+	})
+	t.Run("Should only log the diff when changelog_diff is log", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ChangelogDiff = "log"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch := &PRReleaseOrchestrator{githubRepo: githubRepo}
+		orch.reportChangelogDiff(ctx, 42, previous, changelog, body)
+		githubRepo.AssertNotCalled(t, "AddComment", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should not look up the PR when changelog_diff is off", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch := &PRReleaseOrchestrator{githubRepo: githubRepo}
+		number, _ := orch.previousPRBody(ctx, "release/v1.2.0")
+		assert.Zero(t, number)
+		githubRepo.AssertNotCalled(t, "FindOpenPR", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should skip the diff when the previous body cannot be read", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ChangelogDiff = "comment"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("FindOpenPR", mock.Anything, "release/v1.2.0", "main").Return(42, nil).Once()
+		githubRepo.On("PullRequestBody", mock.Anything, 42).Return("", errors.New("forbidden")).Once()
+		orch := &PRReleaseOrchestrator{githubRepo: githubRepo}
+		number, previousBody := orch.previousPRBody(ctx, "release/v1.2.0")
+		orch.reportChangelogDiff(ctx, number, previousBody, changelog, body)
+		githubRepo.AssertExpectations(t)
+		githubRepo.AssertNotCalled(t, "AddComment", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,60 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+)
+
+// ChangelogPreview is the changelog section the next release PR would contain.
+type ChangelogPreview struct {
+	Version   string
+	Changelog string
+}
+
+// PreviewChangelog renders the changelog section of the next release PR for audience, or
+// release_changelog_audience when empty. The version, channel, security section, date and locale are
+// resolved as pr-release does, and the changelog steps run on a copy-on-write layer like Plan, so no
+// file is written.
+func (o *PRReleaseOrchestrator) PreviewChangelog(ctx context.Context, audience string) (*ChangelogPreview, error) {
+	if audience != "" {
+		cfg := *config.FromContext(ctx)
+		cfg.ReleaseChangelogAudience = audience
+		ctx = config.IntoContext(ctx, &cfg)
+	}
+	ctx, err := o.resolveReleaseChannel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	check, err := o.checkChanges(ctx)
+	if errors.Is(err, repository.ErrEmptyRepository) {
+		return nil, errors.New(emptyRepositoryStatus)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to check changes: %w", err)
+	}
+	version, _, err := o.releaseVersion(ctx, check.LatestTag)
+	if err != nil {
+		return nil, err
+	}
+	planner, _ := o.planner(func(domain.PlannedCommand) {})
+	artifacts, err := planner.generateChangelog(ctx, version, check.LatestTag, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the changelog of %s: %w", version, err)
+	}
+	changelog := strings.TrimSpace(artifacts.changelog)
+	if prs := o.mergedPullRequests(ctx, check.LatestTag); len(prs) > 0 {
+		rows := make([]string, 0, len(prs))
+		for _, pr := range prs {
+			rows = append(rows, pr.MarkdownRow())
+		}
+		changelog += "\n\n### Merged Pull Requests\n\n| PR | Title | Author | Labels |\n| --- | --- | --- | --- |\n" +
+			strings.Join(rows, "\n")
+	}
+	return &ChangelogPreview{Version: version, Changelog: changelog}, nil
+}
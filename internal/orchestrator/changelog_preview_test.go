@@ -0,0 +1,31 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRReleaseOrchestrator_PreviewChangelog(t *testing.T) {
+	t.Run("Should render the release changelog without writing files", func(t *testing.T) {
+		fsRepo, _, orch := newPlanTest(t)
+		preview, err := orch.PreviewChangelog(testReleaseContext(t), "")
+		require.NoError(t, err)
+		assert.Equal(t, "v1.2.3", preview.Version)
+		assert.Contains(t, preview.Changelog, "## v1.2.3")
+		assert.Contains(t, preview.Changelog, "- Plan releases")
+		data, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Changelog\n", string(data))
+		exists, err := afero.Exists(fsRepo, ReleaseBodyOutputFile)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+	t.Run("Should reject an unknown audience", func(t *testing.T) {
+		_, _, orch := newPlanTest(t)
+		_, err := orch.PreviewChangelog(testReleaseContext(t), "everyone")
+		require.ErrorContains(t, err, "everyone")
+	})
+}
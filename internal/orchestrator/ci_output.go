@@ -0,0 +1,75 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// envGithubOutput names the file GitHub Actions reads step outputs from.
+const envGithubOutput = "GITHUB_OUTPUT"
+
+// githubOutputDelimiterPrefix starts the heredoc delimiter of multi-line outputs, as in the
+// actions toolkit.
+const githubOutputDelimiterPrefix = "ghadelimiter_"
+
+// logCI reports fields as CI output: they are logged as ci_output and, in GitHub Actions, appended
+// to GITHUB_OUTPUT so later steps can read them as step outputs.
+func (o *PRReleaseOrchestrator) logCI(ctx context.Context, ciOutput bool, fields ...zap.Field) {
+	if !ciOutput {
+		return
+	}
+	o.logger(ctx).Info("ci_output", fields...)
+	o.writeCIOutput(ctx, fields...)
+}
+
+// writeCIOutput appends fields to GITHUB_OUTPUT without logging them, for values such as the
+// changelog that are too large for a log line. A failed write is logged, not returned, as outputs
+// never decide the outcome of the release.
+func (o *PRReleaseOrchestrator) writeCIOutput(ctx context.Context, fields ...zap.Field) {
+	if err := appendGitHubOutput(os.Getenv(envGithubOutput), fields...); err != nil {
+		o.logger(ctx).Warn("Failed to write GitHub Actions outputs", zap.Error(err))
+	}
+}
+
+// appendGitHubOutput appends fields to the GitHub Actions output file at path. It does nothing
+// without a path, outside GitHub Actions.
+func appendGitHubOutput(path string, fields ...zap.Field) error {
+	if path == "" {
+		return nil
+	}
+	enc := zapcore.NewMapObjectEncoder()
+	var b strings.Builder
+	for _, field := range fields {
+		field.AddTo(enc)
+		b.WriteString(formatGitHubOutput(field.Key, fmt.Sprint(enc.Fields[field.Key])))
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", envGithubOutput, err)
+	}
+	if _, err := file.WriteString(b.String()); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write %s: %w", envGithubOutput, err)
+	}
+	return file.Close()
+}
+
+// formatGitHubOutput renders one output entry. Single-line values use name=value; values with line
+// breaks use a heredoc whose random delimiter never occurs in the value, so their content cannot end
+// the entry early or inject other outputs.
+func formatGitHubOutput(name, value string) string {
+	if !strings.ContainsAny(value, "\r\n") {
+		return name + "=" + value + "\n"
+	}
+	delimiter := githubOutputDelimiterPrefix + rand.Text()
+	for strings.Contains(value, delimiter) {
+		delimiter = githubOutputDelimiterPrefix + rand.Text()
+	}
+	return name + "<<" + delimiter + "\n" + value + "\n" + delimiter + "\n"
+}
@@ -0,0 +1,47 @@
+package orchestrator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestFormatGitHubOutput(t *testing.T) {
+	t.Run("Should write single-line values as name=value", func(t *testing.T) {
+		assert.Equal(t, "branch_name=release/v1.2.0\n", formatGitHubOutput("branch_name", "release/v1.2.0"))
+	})
+	t.Run("Should wrap multi-line values in a heredoc", func(t *testing.T) {
+		value := "## v1.2.0\n\n- fix: handle = signs\npr_number=99"
+		output := formatGitHubOutput("changelog", value)
+		header, rest, ok := strings.Cut(output, "\n")
+		require.True(t, ok)
+		name, delimiter, ok := strings.Cut(header, "<<")
+		require.True(t, ok)
+		assert.Equal(t, "changelog", name)
+		assert.True(t, strings.HasPrefix(delimiter, githubOutputDelimiterPrefix))
+		assert.Equal(t, value+"\n"+delimiter+"\n", rest)
+	})
+}
+
+func TestAppendGitHubOutput(t *testing.T) {
+	t.Run("Should append typed fields to the output file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "output")
+		require.NoError(t, os.WriteFile(path, []byte("existing=1\n"), 0o644))
+		require.NoError(t, appendGitHubOutput(path,
+			zap.Int("pr_number", 42),
+			zap.Bool("has_changes", true),
+			zap.String("version", "v1.2.0"),
+		))
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "existing=1\npr_number=42\nhas_changes=true\nversion=v1.2.0\n", string(content))
+	})
+	t.Run("Should do nothing outside GitHub Actions", func(t *testing.T) {
+		require.NoError(t, appendGitHubOutput("", zap.String("version", "v1.2.0")))
+	})
+}
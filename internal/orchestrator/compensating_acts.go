@@ -255,6 +255,16 @@ func (ca *CompensatingActions) RestoreArchivedReleaseNotes(ctx context.Context,
 	return nil
 }
 
+// RestoreChangeFiles writes consumed change files back and stages them again.
+func (ca *CompensatingActions) RestoreChangeFiles(ctx context.Context, rollbackData map[string]any) error {
+	result, err := usecase.ParseConsumeChangeFilesResult(rollbackData)
+	if err != nil {
+		return fmt.Errorf("failed to parse consumed change files rollback data: %w", err)
+	}
+	uc := &usecase.ConsumeChangeFilesUseCase{FSRepo: ca.fsRepo, GitRepo: ca.gitRepo}
+	return uc.Restore(ctx, result)
+}
+
 // ResetCommit idempotently undoes a commit
 func (ca *CompensatingActions) ResetCommit(ctx context.Context, rollbackData map[string]any) error {
 	log := ca.logger(ctx)
@@ -308,8 +318,11 @@ func (ca *CompensatingActions) ClosePullRequest(ctx context.Context, rollbackDat
 		return nil
 	}
 	// Add a comment explaining the rollback
-	comment := "🔄 This pull request was automatically closed due to a rollback of the release workflow."
-	if err := ca.githubRepo.AddComment(ctx, prNumber, comment); err != nil {
+	comment, err := usecase.RenderTemplate(ca.fsRepo, usecase.TemplateRollbackComment, nil)
+	if err == nil {
+		err = ca.githubRepo.AddComment(ctx, prNumber, strings.TrimSpace(comment))
+	}
+	if err != nil {
 		log.Warn("Failed to add rollback comment", zap.Int("pr_number", prNumber), zap.Error(err))
 	}
 	// Close the PR
@@ -321,6 +334,38 @@ func (ca *CompensatingActions) ClosePullRequest(ctx context.Context, rollbackDat
 	return nil
 }
 
+// RestoreTag idempotently moves a force-moved tag back to the commit it pointed to before the release,
+// annotated with its original message or lightweight as it was, pushing it again when the moved tag
+// reached the remote.
+func (ca *CompensatingActions) RestoreTag(ctx context.Context, rollbackData map[string]any) error {
+	tag, ok := rollbackData["tag"].(string)
+	if !ok || tag == "" {
+		return fmt.Errorf("tag not found in rollback data")
+	}
+	previous, ok := rollbackData["previous_sha"].(string)
+	if !ok || previous == "" {
+		return fmt.Errorf("previous_sha not found in rollback data")
+	}
+	msg, _ := rollbackData["message"].(string)
+	annotated, ok := rollbackData["annotated"].(bool)
+	switch {
+	case ok && !annotated:
+		msg = ""
+	case msg == "":
+		msg = fmt.Sprintf("Release %s", tag)
+	}
+	if err := ca.gitRepo.CreateTagForce(ctx, tag, previous, msg); err != nil {
+		return fmt.Errorf("failed to restore tag %s to %s: %w", tag, previous, err)
+	}
+	if pushed, ok := rollbackData["pushed"].(bool); ok && pushed {
+		if err := ca.gitRepo.PushTagForce(ctx, tag); err != nil {
+			return fmt.Errorf("failed to push restored tag %s: %w", tag, err)
+		}
+	}
+	ca.logger(ctx).Info("Restored moved tag", zap.String("tag", tag), zap.String("commit", previous))
+	return nil
+}
+
 // NoOp is a no-operation compensating action for operations that don't need rollback
 func (ca *CompensatingActions) NoOp(_ context.Context, _ map[string]any) error {
 	return nil
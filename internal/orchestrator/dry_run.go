@@ -2,42 +2,66 @@
 package orchestrator
 
 import (
-	"bufio"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
-	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/gha"
 	"github.com/compozy/releasepr/internal/logger"
 	"github.com/compozy/releasepr/internal/repository"
 	"github.com/compozy/releasepr/internal/service"
+	"github.com/compozy/releasepr/internal/telemetry"
+	"github.com/compozy/releasepr/internal/usecase"
 	"github.com/spf13/afero"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
-	githubActionsTrue     = "true"
-	envGithubIssueNumber  = "GITHUB_ISSUE_NUMBER"
-	envGithubEventPath    = "GITHUB_EVENT_PATH"
-	envGithubHeadRef      = "GITHUB_HEAD_REF"
-	envGithubSHA          = "GITHUB_SHA"
-	envGithubActions      = "GITHUB_ACTIONS"
-	metadataJSONPath      = "dist/metadata.json"
-	artifactTypeArchive   = "Archive"
-	releaseHeaderTmplPath = ".goreleaser.release-header.md.tmpl"
-	releaseFooterTmplPath = ".goreleaser.release-footer.md.tmpl"
+	githubActionsTrue    = "true"
+	envGithubIssueNumber = "GITHUB_ISSUE_NUMBER"
+	envGithubEventPath   = "GITHUB_EVENT_PATH"
+	envGithubHeadRef     = "GITHUB_HEAD_REF"
+	envGithubSHA         = "GITHUB_SHA"
+	envGithubRunNumber   = "GITHUB_RUN_NUMBER"
+	envBuildMetadata     = "PR_RELEASE_BUILD_METADATA"
+	shortSHALength       = 7
+	envGithubActions     = "GITHUB_ACTIONS"
+	metadataJSONPath     = "dist/metadata.json"
+	artifactTypeArchive  = "Archive"
 )
 
 // DryRunConfig holds configuration for the dry-run orchestrator
 type DryRunConfig struct {
-	CIOutput bool // Output in CI format
-	DryRun   bool // Always true for this orchestrator, but for consistency
+	CIOutput bool                 // Output in CI format
+	DryRun   bool                 // Always true for this orchestrator, but for consistency
+	ToolsDir string               // NPM workspace directory checked against the registry; empty skips the check
+	Cliff    service.CliffOptions // git-cliff config, workdir and extra args used by the changelog check
+	// BuildMetadata exposes CI build metadata to the GoReleaser snapshot as PR_RELEASE_BUILD_METADATA
+	BuildMetadata bool
+	// ToolsLock pins the git-cliff and goreleaser versions by tool name; empty skips the check
+	ToolsLock map[string]string
+	// ToolsLockAction is "fail" to stop the dry-run on a mismatch with ToolsLock; anything else warns
+	ToolsLockAction string
+	// ReleaseHeaderTemplate and ReleaseFooterTemplate are passed to GoReleaser; empty leaves them out
+	ReleaseHeaderTemplate string
+	ReleaseFooterTemplate string
+	// Report selects how a dry-run in GitHub Actions reports to the release PR: DryRunReportComment,
+	// DryRunReportCheckRun or DryRunReportBoth; empty means a comment
+	Report string
+	// CargoCrates are the Rust crate directories packaged with cargo publish --dry-run; empty skips the check
+	CargoCrates   []string
+	CargoRegistry string
+	// PyPIPackages are the Python package directories built and checked with twine check; empty skips the check
+	PyPIPackages     []string
+	PyPIBuildCommand []string
 }
 
 // DryRunOrchestrator orchestrates the dry-run validation process
@@ -47,6 +71,13 @@ type DryRunOrchestrator struct {
 	cliffSvc      service.CliffService
 	goreleaserSvc service.GoReleaserService // Assuming this exists in service/goreleaser.go
 	fsRepo        afero.Fs
+	npmSvc        service.NpmService
+	// toolVersionSvc reads the installed tool versions compared with DryRunConfig.ToolsLock
+	toolVersionSvc service.ToolVersionService
+	// cargoSvc verifies the crates of DryRunConfig.CargoCrates
+	cargoSvc service.CargoService
+	// pypiSvc builds and checks the packages of DryRunConfig.PyPIPackages
+	pypiSvc service.PyPIService
 }
 
 // NewDryRunOrchestrator creates a new DryRunOrchestrator
@@ -56,13 +87,18 @@ func NewDryRunOrchestrator(
 	cliffSvc service.CliffService,
 	goreleaserSvc service.GoReleaserService,
 	fsRepo afero.Fs,
+	npmSvc service.NpmService,
 ) *DryRunOrchestrator {
 	return &DryRunOrchestrator{
-		gitRepo:       gitRepo,
-		githubRepo:    githubRepo,
-		cliffSvc:      cliffSvc,
-		goreleaserSvc: goreleaserSvc,
-		fsRepo:        fsRepo,
+		gitRepo:        gitRepo,
+		githubRepo:     githubRepo,
+		cliffSvc:       cliffSvc,
+		goreleaserSvc:  goreleaserSvc,
+		fsRepo:         fsRepo,
+		npmSvc:         npmSvc,
+		toolVersionSvc: service.NewToolVersionService(),
+		cargoSvc:       service.NewCargoService(fsRepo),
+		pypiSvc:        service.NewPyPIService(fsRepo),
 	}
 }
 
@@ -71,45 +107,114 @@ func (o *DryRunOrchestrator) logger(ctx context.Context) *zap.Logger {
 }
 
 // Execute runs the dry-run validation
-func (o *DryRunOrchestrator) Execute(ctx context.Context, cfg DryRunConfig) error {
+func (o *DryRunOrchestrator) Execute(ctx context.Context, cfg DryRunConfig) (err error) {
+	ctx, span := telemetry.Start(ctx, "dry-run")
+	defer func() { telemetry.End(span, err) }()
 	ctx, cancel := context.WithTimeout(ctx, DefaultWorkflowTimeout)
 	defer cancel()
-	if err := o.stepValidateChangelog(ctx, cfg); err != nil {
+	if err := o.stepVerifyTools(ctx, cfg); err != nil {
 		return err
 	}
-	if err := o.stepRunGoReleaser(ctx, cfg); err != nil {
-		return err
+	buildMetadata := o.ciBuildMetadata(ctx, cfg)
+	validations, err := o.stepValidate(ctx, cfg, buildMetadata)
+	inGitHubActions := os.Getenv(envGithubActions) == githubActionsTrue
+	if inGitHubActions && reportsCheckRun(cfg.Report) {
+		err = errors.Join(err, o.stepCheckRun(ctx, validations, buildMetadata))
 	}
-	_, err := o.stepExtractVersion(ctx, cfg)
 	if err != nil {
 		return err
 	}
-	// NPM validation of tools/ removed from dry-run pipeline
-	if os.Getenv(envGithubActions) == githubActionsTrue {
-		if err := o.stepCommentPR(ctx, cfg); err != nil {
+	if !inGitHubActions {
+		o.logStatus(ctx, cfg.CIOutput, "Dry-run completed. Review required.")
+	} else if reportsComment(cfg.Report) {
+		if err := o.stepCommentPR(ctx, cfg, buildMetadata); err != nil {
 			return err
 		}
-	} else {
-		o.logStatus(ctx, cfg.CIOutput, "Dry-run completed. Review required.")
 	}
 	o.logStatus(ctx, cfg.CIOutput, "## ✅ Dry-Run Completed Successfully")
 	return nil
 }
 
+// stepValidate runs the changelog check, the GoReleaser snapshot, the NPM checks and, when crates or
+// Python packages are configured, their checks concurrently, as none depends on another. Every validation runs to
+// completion, so a single run reports all the failures of the release PR instead of only the first.
+func (o *DryRunOrchestrator) stepValidate(
+	ctx context.Context,
+	cfg DryRunConfig,
+	buildMetadata string,
+) ([]dryRunValidation, error) {
+	validations := []dryRunValidation{
+		{name: "Changelog", path: o.existingFile(cliffConfigPath(cfg.Cliff))},
+		{name: "GoReleaser snapshot", path: o.existingFile(goreleaserConfigFiles...)},
+		{name: "Version and NPM packages"},
+	}
+	crates, pythonPackages := -1, -1
+	if len(cfg.CargoCrates) > 0 {
+		crates = len(validations)
+		validations = append(validations, dryRunValidation{name: "Rust crates", path: o.existingFile("Cargo.toml")})
+	}
+	if len(cfg.PyPIPackages) > 0 {
+		pythonPackages = len(validations)
+		validations = append(validations, dryRunValidation{name: "Python packages"})
+	}
+	var g errgroup.Group
+	g.Go(func() error {
+		validations[0].err = o.stepValidateChangelog(ctx, cfg)
+		return nil
+	})
+	g.Go(func() error {
+		validations[1].err = o.stepRunGoReleaser(ctx, cfg, buildMetadata)
+		if validations[1].err == nil {
+			validations[1].err = o.stepSmokeTestBinaries(ctx, cfg)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		version, err := o.stepExtractVersion(ctx, cfg)
+		if err != nil {
+			validations[2].err = err
+			return nil
+		}
+		validations[2].err = o.stepValidateNPM(ctx, cfg, version)
+		return nil
+	})
+	if crates >= 0 {
+		g.Go(func() error {
+			validations[crates].err = o.stepValidateCrates(ctx, cfg)
+			return nil
+		})
+	}
+	if pythonPackages >= 0 {
+		g.Go(func() error {
+			validations[pythonPackages].err = o.stepValidatePythonPackages(ctx, cfg)
+			return nil
+		})
+	}
+	_ = g.Wait()
+	errs := make([]error, 0, len(validations))
+	for _, validation := range validations {
+		errs = append(errs, validation.err)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return validations, fmt.Errorf("dry-run validation failed:\n%w", err)
+	}
+	return validations, nil
+}
+
 // stepValidateChangelog validates git-cliff changelog generation
 func (o *DryRunOrchestrator) stepValidateChangelog(ctx context.Context, cfg DryRunConfig) error {
 	o.logStatus(ctx, cfg.CIOutput, "### 📝 Validating Changelog Generation")
-	if err := o.validateCliff(ctx); err != nil {
+	if err := o.validateCliff(ctx, cfg.Cliff); err != nil {
 		return fmt.Errorf("git-cliff validation failed: %w", err)
 	}
 	return nil
 }
 
 // stepRunGoReleaser executes GoReleaser dry-run
-func (o *DryRunOrchestrator) stepRunGoReleaser(ctx context.Context, cfg DryRunConfig) error {
+func (o *DryRunOrchestrator) stepRunGoReleaser(ctx context.Context, cfg DryRunConfig, buildMetadata string) error {
 	o.logStatus(ctx, cfg.CIOutput, "### 🏗️ Running GoReleaser Dry-Run")
 	o.logger(ctx).Info("Running GoReleaser dry-run")
-	if err := o.runGoReleaserDry(ctx); err != nil {
+	if err := o.runGoReleaserDry(ctx, cfg, buildMetadata); err != nil {
 		return fmt.Errorf("GoReleaser dry-run failed: %w", err)
 	}
 	o.logger(ctx).Info("Completed GoReleaser dry-run")
@@ -128,13 +233,65 @@ func (o *DryRunOrchestrator) stepExtractVersion(ctx context.Context, cfg DryRunC
 	return version, nil
 }
 
-// stepValidateNPM validates NPM package versions
-// stepValidateNPM removed: tools/ update/validation is no longer part of the release process
+// stepValidateNPM checks every package in the tools directory can be published at version, so a
+// release does not fail halfway through publishing
+func (o *DryRunOrchestrator) stepValidateNPM(ctx context.Context, cfg DryRunConfig, version string) error {
+	log := o.logger(ctx)
+	if cfg.ToolsDir == "" {
+		return nil
+	}
+	packages, err := o.findNPMPackages(cfg.ToolsDir)
+	if err != nil {
+		return fmt.Errorf("failed to find NPM packages: %w", err)
+	}
+	if len(packages) == 0 {
+		log.Info("Skipping NPM validation", zap.String("reason", "no packages found"), zap.String("dir", cfg.ToolsDir))
+		return nil
+	}
+	var errs []error
+	for _, pkg := range packages {
+		log.Info("Validating NPM package", zap.String("path", pkg), zap.String("version", version))
+		if err := o.npmSvc.ValidatePackageVersion(ctx, pkg, version); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", pkg, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("NPM validation failed: %w", err)
+	}
+	log.Info("NPM packages validated", zap.Int("count", len(packages)))
+	return nil
+}
+
+// findNPMPackages returns the directories directly under toolsDir that contain a package.json
+func (o *DryRunOrchestrator) findNPMPackages(toolsDir string) ([]string, error) {
+	entries, err := afero.ReadDir(o.fsRepo, toolsDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var packages []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(toolsDir, entry.Name())
+		exists, err := afero.Exists(o.fsRepo, filepath.Join(dir, "package.json"))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			packages = append(packages, dir)
+		}
+	}
+	return packages, nil
+}
 
 // stepCommentPR creates PR comment with dry-run results
-func (o *DryRunOrchestrator) stepCommentPR(ctx context.Context, _ DryRunConfig) error {
+func (o *DryRunOrchestrator) stepCommentPR(ctx context.Context, _ DryRunConfig, buildMetadata string) error {
 	o.logger(ctx).Info("Creating PR comment")
-	if err := o.commentOnPR(ctx); err != nil {
+	if err := o.commentOnPR(ctx, buildMetadata); err != nil {
 		return fmt.Errorf("PR comment failed: %w", err)
 	}
 	o.logger(ctx).Info("PR comment created")
@@ -142,19 +299,19 @@ func (o *DryRunOrchestrator) stepCommentPR(ctx context.Context, _ DryRunConfig)
 }
 
 // validateCliff runs git-cliff --unreleased --verbose
-func (o *DryRunOrchestrator) validateCliff(ctx context.Context) error {
+func (o *DryRunOrchestrator) validateCliff(ctx context.Context, cliff service.CliffOptions) error {
 	log := o.logger(ctx)
 	log.Info("Running git-cliff --unreleased --verbose")
-	cmd := exec.CommandContext(ctx, "git-cliff", "--unreleased", "--verbose")
+	cmd := exec.CommandContext(ctx, "git-cliff", cliff.Args("--unreleased", "--verbose")...)
 	// Find the repository root by walking up directories
 	wd, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("failed to get working directory: %w", err)
 	}
-	repoRoot := findRepoRoot(wd)
+	repoRoot := findRepoRoot(o.fsRepo, wd)
 	if repoRoot != "" {
 		// Only run when inside an actual git repository
-		if _, statErr := os.Stat(filepath.Join(repoRoot, ".git")); statErr == nil {
+		if _, statErr := o.fsRepo.Stat(filepath.Join(repoRoot, ".git")); statErr == nil {
 			cmd.Dir = repoRoot
 			log.Info("Running git-cliff from repository root", zap.String("repo_root", repoRoot))
 		} else {
@@ -174,18 +331,53 @@ func (o *DryRunOrchestrator) validateCliff(ctx context.Context) error {
 	return nil
 }
 
+// ciBuildMetadata derives semver build metadata (build.<run>.sha.<commit>) from the CI environment,
+// falling back to HEAD for the commit. It returns an empty string unless enabled.
+func (o *DryRunOrchestrator) ciBuildMetadata(ctx context.Context, cfg DryRunConfig) string {
+	if !cfg.BuildMetadata {
+		return ""
+	}
+	sha := os.Getenv(envGithubSHA)
+	if sha == "" {
+		head, err := o.gitRepo.GetHeadCommit(ctx)
+		if err != nil {
+			o.logger(ctx).Warn("Failed to resolve HEAD for build metadata", zap.Error(err))
+		}
+		sha = head
+	}
+	if len(sha) > shortSHALength {
+		sha = sha[:shortSHALength]
+	}
+	metadata := domain.BuildMetadata(os.Getenv(envGithubRunNumber), sha)
+	o.logger(ctx).Info("Stamping snapshot build metadata", zap.String("build_metadata", metadata))
+	return metadata
+}
+
 // runGoReleaserDry runs goreleaser release --snapshot --skip=publish --clean
-func (o *DryRunOrchestrator) runGoReleaserDry(ctx context.Context) error {
-	return o.goreleaserSvc.Run(
-		ctx,
-		"release",
-		"--snapshot",
-		"--skip=publish",
-		"--clean",
-		"--release-notes="+ReleaseBodyOutputFile,
-		"--release-header-tmpl="+releaseHeaderTmplPath,
-		"--release-footer-tmpl="+releaseFooterTmplPath,
+func (o *DryRunOrchestrator) runGoReleaserDry(ctx context.Context, cfg DryRunConfig, buildMetadata string) error {
+	args := append(
+		[]string{"release", "--snapshot", "--skip=publish", "--clean"},
+		releaseNotesArgs(cfg.ReleaseHeaderTemplate, cfg.ReleaseFooterTemplate)...,
 	)
+	if buildMetadata == "" {
+		return o.goreleaserSvc.Run(ctx, args...)
+	}
+	// The snapshot version_template opts in with {{ index .Env "PR_RELEASE_BUILD_METADATA" }}, as this repo's
+	// .goreleaser.yml does; tags are never affected.
+	return o.goreleaserSvc.RunWithEnv(ctx, []string{envBuildMetadata + "=" + buildMetadata}, args...)
+}
+
+// releaseNotesArgs passes RELEASE_BODY.md to GoReleaser along with the header and footer templates
+// wrapped around it on the release page. An empty template path leaves that block out.
+func releaseNotesArgs(headerTemplate, footerTemplate string) []string {
+	args := []string{"--release-notes=" + ReleaseBodyOutputFile}
+	if headerTemplate = strings.TrimSpace(headerTemplate); headerTemplate != "" {
+		args = append(args, "--release-header-tmpl="+headerTemplate)
+	}
+	if footerTemplate = strings.TrimSpace(footerTemplate); footerTemplate != "" {
+		args = append(args, "--release-footer-tmpl="+footerTemplate)
+	}
+	return args
 }
 
 // extractVersionFromBranch extracts version from GITHUB_HEAD_REF or branch name
@@ -209,80 +401,52 @@ func (o *DryRunOrchestrator) extractVersionFromBranch(ctx context.Context) (stri
 	return version, nil
 }
 
-// validateNPMVersions runs UpdatePackageVersions (idempotent check; since branch may already have updates)
-// validateNPMVersions removed
-
 // commentOnPR reads metadata.json, builds body, adds comment via GithubRepo
-func (o *DryRunOrchestrator) commentOnPR(ctx context.Context) error {
+func (o *DryRunOrchestrator) commentOnPR(ctx context.Context, buildMetadata string) error {
 	prNumber := o.getPRNumber(ctx)
 	if prNumber == 0 {
 		o.logger(ctx).Info("Skipping PR comment", zap.String("reason", "no PR number found"))
 		return nil
 	}
 
-	// Read metadata.json
-	metadataPath := metadataJSONPath
-	file, err := o.fsRepo.Open(metadataPath)
+	metadata, err := readArtifactMetadata(o.fsRepo, metadataJSONPath)
 	if err != nil {
-		return fmt.Errorf("failed to open metadata.json: %w", err)
-	}
-	defer file.Close()
-	var metadata map[string]any
-	if err := json.NewDecoder(bufio.NewReader(file)).Decode(&metadata); err != nil {
-		return fmt.Errorf("failed to parse metadata.json: %w", err)
-	}
-
-	// Build artifacts list (filter Archive types)
-	artifactsList := "Not available."
-	if arts, ok := metadata["artifacts"].([]any); ok {
-		uniqueBuilds := make(map[string]struct{})
-		for _, a := range arts {
-			artMap, ok := a.(map[string]any)
-			if !ok {
-				continue
-			}
-			if artMap["type"] == artifactTypeArchive {
-				goos, ok := artMap["goos"].(string)
-				if !ok {
-					continue
-				}
-				goarch, ok := artMap["goarch"].(string)
-				if !ok {
-					continue
-				}
-				uniqueBuilds[fmt.Sprintf("%s/%s", goos, goarch)] = struct{}{}
-			}
-		}
-		var builds []string
-		for b := range uniqueBuilds {
-			builds = append(builds, fmt.Sprintf("- %s", b))
-		}
-		sort.Strings(builds)
-		artifactsList = strings.Join(builds, "\n")
+		return err
 	}
+	artifactsList := formatArchiveBuilds(metadata)
 
 	// Build comment body
 	sha := os.Getenv(envGithubSHA)
-	if len(sha) > 7 {
-		sha = sha[:7]
+	if len(sha) > shortSHALength {
+		sha = sha[:shortSHALength]
+	}
+	body, err := usecase.RenderTemplate(o.fsRepo, usecase.TemplateDryRunComment, map[string]string{
+		"Version":   metadata.Version,
+		"Commit":    sha,
+		"Build":     buildMetadata,
+		"Artifacts": artifactsList,
+	})
+	if err != nil {
+		return err
 	}
-	body := fmt.Sprintf(`## ✅ Dry-Run Completed Successfully
-
-### 📊 Build Summary
-- **Version**: %s
-- **Commit**: %s
-
-### 📦 Built Artifacts
-%s
-
----
-*This is an automated comment from the release dry-run check.*
-`, metadata["version"], sha, artifactsList)
 
 	// Add comment
 	return o.githubRepo.AddComment(ctx, prNumber, body)
 }
 
+// formatArchiveBuilds lists the archive builds of metadata as markdown bullets
+func formatArchiveBuilds(metadata *artifactMetadata) string {
+	builds := metadata.ArchiveBuilds()
+	if len(builds) == 0 {
+		return "Not available."
+	}
+	lines := make([]string, 0, len(builds))
+	for _, build := range builds {
+		lines = append(lines, fmt.Sprintf("- %s", build))
+	}
+	return strings.Join(lines, "\n")
+}
+
 // getPRNumber retrieves PR number from environment variables or GitHub event payload
 func (o *DryRunOrchestrator) getPRNumber(_ context.Context) int {
 	// Try environment variable first
@@ -293,44 +457,30 @@ func (o *DryRunOrchestrator) getPRNumber(_ context.Context) int {
 	}
 	// Try GitHub event payload as fallback
 	if eventPath := os.Getenv(envGithubEventPath); eventPath != "" {
-		file, err := openGitHubEventPayload(eventPath)
-		if err == nil {
-			defer file.Close()
-			var payload map[string]any
-			if err := json.NewDecoder(file).Decode(&payload); err == nil {
-				// Check for pull_request.number
-				if pr, ok := payload["pull_request"].(map[string]any); ok {
-					if n, ok := pr["number"].(float64); ok {
-						return int(n)
-					}
-				}
-				// Check for issue.number as fallback
-				if issue, ok := payload["issue"].(map[string]any); ok {
-					if n, ok := issue["number"].(float64); ok {
-						return int(n)
-					}
-				}
-			}
+		payload, err := readGitHubEventPayload(o.fsRepo, eventPath)
+		if err != nil {
+			return 0
+		}
+		if prNumber, err := gha.PullRequestNumber(payload); err == nil {
+			return prNumber
 		}
 	}
 	return 0
 }
 
-func openGitHubEventPayload(path string) (*os.File, error) {
+func readGitHubEventPayload(fsRepo afero.Fs, path string) ([]byte, error) {
 	cleanPath, ok := sanitizeGitHubEventPath(path)
 	if !ok {
 		return nil, fmt.Errorf("invalid github event path")
 	}
-	//nolint:gosec // Path is canonicalized and constrained to trusted GitHub Actions event locations.
-	fileInfo, err := os.Stat(cleanPath)
+	fileInfo, err := fsRepo.Stat(cleanPath)
 	if err != nil {
 		return nil, err
 	}
 	if !fileInfo.Mode().IsRegular() {
 		return nil, fmt.Errorf("github event path is not a regular file")
 	}
-	//nolint:gosec // Path is canonicalized and constrained to trusted GitHub Actions event locations.
-	return os.Open(cleanPath)
+	return afero.ReadFile(fsRepo, cleanPath)
 }
 
 // logStatus records orchestrator status messages respecting CI output flags
@@ -343,13 +493,13 @@ func (o *DryRunOrchestrator) logStatus(ctx context.Context, ciOutput bool, messa
 }
 
 // findRepoRoot walks up directories to find the git repository root
-func findRepoRoot(startDir string) string {
+func findRepoRoot(fsRepo afero.Fs, startDir string) string {
 	dir := startDir
 	for {
-		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+		if _, err := fsRepo.Stat(filepath.Join(dir, ".git")); err == nil {
 			return dir
 		}
-		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+		if _, err := fsRepo.Stat(filepath.Join(dir, "go.mod")); err == nil {
 			return dir
 		}
 		parent := filepath.Dir(dir)
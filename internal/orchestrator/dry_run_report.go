@@ -0,0 +1,179 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/gha"
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+// Ways a dry-run in GitHub Actions reports to the release PR, set with dry_run_report.
+const (
+	DryRunReportComment  = "comment"
+	DryRunReportCheckRun = "check-run"
+	DryRunReportBoth     = "both"
+)
+
+// DryRunCheckName is the name of the check run a dry-run creates, which branch protection can require.
+const DryRunCheckName = "Release Dry-Run"
+
+const defaultCliffConfig = "cliff.toml"
+
+// goreleaserConfigFiles are the GoReleaser configuration files, in the order GoReleaser looks for them.
+var goreleaserConfigFiles = []string{".goreleaser.yml", ".goreleaser.yaml", "goreleaser.yml", "goreleaser.yaml"}
+
+// dryRunValidation is the outcome of one dry-run validation. path names the file a failure is
+// annotated on, and stays empty when no file is to blame.
+type dryRunValidation struct {
+	name string
+	path string
+	err  error
+}
+
+func reportsComment(report string) bool {
+	report = strings.ToLower(strings.TrimSpace(report))
+	return report == "" || report == DryRunReportComment || report == DryRunReportBoth
+}
+
+func reportsCheckRun(report string) bool {
+	report = strings.ToLower(strings.TrimSpace(report))
+	return report == DryRunReportCheckRun || report == DryRunReportBoth
+}
+
+// cliffConfigPath returns the git-cliff configuration file the changelog validation reads.
+func cliffConfigPath(cliff service.CliffOptions) string {
+	path := cliff.ConfigPath
+	if path == "" {
+		path = defaultCliffConfig
+	}
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(cliff.WorkDir, path)
+}
+
+// existingFile returns the first of candidates that exists, or an empty string.
+func (o *DryRunOrchestrator) existingFile(candidates ...string) string {
+	for _, candidate := range candidates {
+		if exists, err := afero.Exists(o.fsRepo, candidate); err == nil && exists {
+			return filepath.ToSlash(candidate)
+		}
+	}
+	return ""
+}
+
+// stepCheckRun creates the Release Dry-Run check run on the head commit of the release PR
+func (o *DryRunOrchestrator) stepCheckRun(
+	ctx context.Context,
+	validations []dryRunValidation,
+	buildMetadata string,
+) error {
+	log := o.logger(ctx)
+	headSHA := o.checkRunHeadSHA(ctx)
+	if headSHA == "" {
+		log.Info("Skipping check run", zap.String("reason", "head commit unknown"))
+		return nil
+	}
+	var metadata *artifactMetadata
+	if failedValidations(validations) == 0 {
+		var err error
+		if metadata, err = readArtifactMetadata(o.fsRepo, metadataJSONPath); err != nil {
+			log.Warn("Reporting the check run without artifacts", zap.Error(err))
+		}
+	}
+	run := newDryRunCheckRun(validations, metadata, buildMetadata)
+	run.HeadSHA = headSHA
+	log.Info("Creating check run", zap.String("name", run.Name), zap.String("conclusion", run.Conclusion))
+	if err := o.githubRepo.CreateCheckRun(ctx, run); err != nil {
+		return fmt.Errorf("check run failed: %w", err)
+	}
+	return nil
+}
+
+// checkRunHeadSHA returns the head commit of the pull request the workflow runs for. GITHUB_SHA
+// names the merge commit on pull_request events, which the Checks tab of the PR does not show.
+func (o *DryRunOrchestrator) checkRunHeadSHA(ctx context.Context) string {
+	if eventPath := os.Getenv(envGithubEventPath); eventPath != "" {
+		if payload, err := readGitHubEventPayload(o.fsRepo, eventPath); err == nil {
+			if event, err := gha.ParsePullRequestEvent(payload); err == nil && event.PullRequest.Head.SHA != "" {
+				return event.PullRequest.Head.SHA
+			}
+		}
+	}
+	if sha := os.Getenv(envGithubSHA); sha != "" {
+		return sha
+	}
+	head, err := o.gitRepo.GetHeadCommit(ctx)
+	if err != nil {
+		o.logger(ctx).Warn("Failed to resolve HEAD for the check run", zap.Error(err))
+		return ""
+	}
+	return head
+}
+
+func failedValidations(validations []dryRunValidation) int {
+	failed := 0
+	for _, validation := range validations {
+		if validation.err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// newDryRunCheckRun builds the check run reporting validations: a summary table of every
+// validation, the error output of each failure, and an annotation on the file behind it.
+func newDryRunCheckRun(
+	validations []dryRunValidation,
+	metadata *artifactMetadata,
+	buildMetadata string,
+) domain.CheckRun {
+	run := domain.CheckRun{
+		Name:       DryRunCheckName,
+		Conclusion: domain.CheckConclusionSuccess,
+		Title:      fmt.Sprintf("All %d validations passed", len(validations)),
+	}
+	failed := failedValidations(validations)
+	if failed > 0 {
+		run.Conclusion = domain.CheckConclusionFailure
+		run.Title = fmt.Sprintf("%d of %d validations failed", failed, len(validations))
+	}
+	var summary strings.Builder
+	summary.WriteString("| Validation | Result |\n| --- | --- |\n")
+	var text strings.Builder
+	for _, validation := range validations {
+		if validation.err == nil {
+			fmt.Fprintf(&summary, "| %s | ✅ Passed |\n", validation.name)
+			continue
+		}
+		fmt.Fprintf(&summary, "| %s | ❌ Failed |\n", validation.name)
+		fmt.Fprintf(&text, "### ❌ %s\n\n```\n%s\n```\n\n", validation.name, validation.err)
+		if validation.path != "" {
+			run.Annotations = append(run.Annotations, domain.CheckAnnotation{
+				Path:      validation.path,
+				StartLine: 1,
+				EndLine:   1,
+				Level:     domain.AnnotationLevelFailure,
+				Title:     validation.name + " failed",
+				Message:   validation.err.Error(),
+			})
+		}
+	}
+	if metadata != nil {
+		fmt.Fprintf(&text, "### 📊 Build Summary\n- **Version**: %s\n", metadata.Version)
+		if buildMetadata != "" {
+			fmt.Fprintf(&text, "- **Build**: %s\n", buildMetadata)
+		}
+		fmt.Fprintf(&text, "\n### 📦 Built Artifacts\n%s\n", formatArchiveBuilds(metadata))
+	}
+	run.Summary = summary.String()
+	run.Text = strings.TrimSpace(text.String())
+	return run
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/compozy/releasepr/internal/domain"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -18,8 +19,6 @@ var goreleaserArgs = []string{
 	"--skip=publish",
 	"--clean",
 	"--release-notes=RELEASE_BODY.md",
-	"--release-header-tmpl=.goreleaser.release-header.md.tmpl",
-	"--release-footer-tmpl=.goreleaser.release-footer.md.tmpl",
 }
 
 func toIface(ss []string) []any {
@@ -39,7 +38,7 @@ func TestDryRunOrchestrator_Execute(t *testing.T) {
 		cliffSvc := new(mockCliffService)
 		goreleaserSvc := new(mockGoReleaserService)
 
-		orch := NewDryRunOrchestrator(gitRepo, githubRepo, cliffSvc, goreleaserSvc, fsRepo)
+		orch := NewDryRunOrchestrator(gitRepo, githubRepo, cliffSvc, goreleaserSvc, fsRepo, new(mockNpmService))
 		// Setup expectations
 		goreleaserSvc.On("Run", append([]any{mock.Anything}, toIface(goreleaserArgs)...)...).Return(nil)
 		// Setup test environment
@@ -68,7 +67,7 @@ func TestDryRunOrchestrator_Execute(t *testing.T) {
 		githubRepo := new(mockGithubExtendedRepository)
 		cliffSvc := new(mockCliffService)
 		goreleaserSvc := new(mockGoReleaserService)
-		orch := NewDryRunOrchestrator(gitRepo, githubRepo, cliffSvc, goreleaserSvc, fsRepo)
+		orch := NewDryRunOrchestrator(gitRepo, githubRepo, cliffSvc, goreleaserSvc, fsRepo, new(mockNpmService))
 		t.Setenv("GITHUB_HEAD_REF", "release/v1.1.0")
 		goreleaserSvc.On("Run", append([]any{mock.Anything}, toIface(goreleaserArgs)...)...).
 			Return(errors.New("dry-run failed"))
@@ -76,6 +75,22 @@ func TestDryRunOrchestrator_Execute(t *testing.T) {
 		assert.ErrorContains(t, err, "GoReleaser dry-run failed")
 	})
 
+	t.Run("Should pass the configured release header and footer templates", func(t *testing.T) {
+		goreleaserSvc := new(mockGoReleaserService)
+		orch := NewDryRunOrchestrator(nil, nil, nil, goreleaserSvc, afero.NewMemMapFs(), nil)
+		goreleaserSvc.On("Run", mock.Anything, "release", "--snapshot", "--skip=publish", "--clean",
+			"--release-notes=RELEASE_BODY.md",
+			"--release-header-tmpl=.github/release-header.md.tmpl",
+			"--release-footer-tmpl=.github/release-footer.md.tmpl",
+		).Return(nil).Once()
+		cfg := DryRunConfig{
+			ReleaseHeaderTemplate: ".github/release-header.md.tmpl",
+			ReleaseFooterTemplate: ".github/release-footer.md.tmpl",
+		}
+		require.NoError(t, orch.stepRunGoReleaser(t.Context(), cfg, ""))
+		goreleaserSvc.AssertExpectations(t)
+	})
+
 	t.Run("Should fail when no version found in branch name", func(t *testing.T) {
 		ctx := context.Background()
 		fsRepo := afero.NewMemMapFs()
@@ -83,13 +98,32 @@ func TestDryRunOrchestrator_Execute(t *testing.T) {
 		githubRepo := new(mockGithubExtendedRepository)
 		cliffSvc := new(mockCliffService)
 		goreleaserSvc := new(mockGoReleaserService)
-		orch := NewDryRunOrchestrator(gitRepo, githubRepo, cliffSvc, goreleaserSvc, fsRepo)
+		orch := NewDryRunOrchestrator(gitRepo, githubRepo, cliffSvc, goreleaserSvc, fsRepo, new(mockNpmService))
 		t.Setenv("GITHUB_HEAD_REF", "feature/no-version")
 		goreleaserSvc.On("Run", append([]any{mock.Anything}, toIface(goreleaserArgs)...)...).Return(nil)
 		err := orch.Execute(ctx, DryRunConfig{})
 		assert.ErrorContains(t, err, "no version found in branch name")
 	})
 
+	t.Run("Should report every failed validation at once", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, "tools/cli/package.json", []byte(`{"name":"cli"}`), 0o644))
+		goreleaserSvc := new(mockGoReleaserService)
+		npmSvc := new(mockNpmService)
+		orch := NewDryRunOrchestrator(nil, nil, new(mockCliffService), goreleaserSvc, fsRepo, npmSvc)
+		t.Setenv("GITHUB_HEAD_REF", "release/v1.1.0")
+		goreleaserSvc.On("Run", append([]any{mock.Anything}, toIface(goreleaserArgs)...)...).
+			Return(errors.New("dry-run failed"))
+		npmSvc.On("ValidatePackageVersion", mock.Anything, "tools/cli", "1.1.0").
+			Return(errors.New("already published")).Once()
+		err := orch.Execute(t.Context(), DryRunConfig{ToolsDir: "tools"})
+		assert.ErrorContains(t, err, "dry-run validation failed")
+		assert.ErrorContains(t, err, "GoReleaser dry-run failed")
+		assert.ErrorContains(t, err, "tools/cli: already published")
+		goreleaserSvc.AssertExpectations(t)
+		npmSvc.AssertExpectations(t)
+	})
+
 	t.Run("Should handle invalid metadata.json gracefully", func(t *testing.T) {
 		ctx := context.Background()
 		fsRepo := afero.NewMemMapFs()
@@ -98,7 +132,7 @@ func TestDryRunOrchestrator_Execute(t *testing.T) {
 		githubRepo := new(mockGithubExtendedRepository)
 		cliffSvc := new(mockCliffService)
 		goreleaserSvc := new(mockGoReleaserService)
-		orch := NewDryRunOrchestrator(gitRepo, githubRepo, cliffSvc, goreleaserSvc, fsRepo)
+		orch := NewDryRunOrchestrator(gitRepo, githubRepo, cliffSvc, goreleaserSvc, fsRepo, new(mockNpmService))
 		t.Setenv("GITHUB_HEAD_REF", "release/v1.1.0")
 		t.Setenv("GITHUB_ACTIONS", "true")
 		t.Setenv("GITHUB_ISSUE_NUMBER", "123")
@@ -121,7 +155,7 @@ func TestDryRunOrchestrator_Execute(t *testing.T) {
 		githubRepo := new(mockGithubExtendedRepository)
 		cliffSvc := new(mockCliffService)
 		goreleaserSvc := new(mockGoReleaserService)
-		orch := NewDryRunOrchestrator(gitRepo, githubRepo, cliffSvc, goreleaserSvc, fsRepo)
+		orch := NewDryRunOrchestrator(gitRepo, githubRepo, cliffSvc, goreleaserSvc, fsRepo, new(mockNpmService))
 		// Setup CI environment
 		t.Setenv("GITHUB_HEAD_REF", "release/v2.0.0")
 		t.Setenv("GITHUB_ACTIONS", "true")
@@ -152,6 +186,117 @@ func TestDryRunOrchestrator_Execute(t *testing.T) {
 		githubRepo.AssertExpectations(t)
 	})
 
+	t.Run("Should expose CI build metadata to the snapshot and the PR comment", func(t *testing.T) {
+		ctx := context.Background()
+		fsRepo := afero.NewMemMapFs()
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		orch := NewDryRunOrchestrator(gitRepo, githubRepo, new(mockCliffService), goreleaserSvc, fsRepo,
+			new(mockNpmService))
+		t.Setenv("GITHUB_HEAD_REF", "release/v1.1.0")
+		t.Setenv("GITHUB_ACTIONS", "true")
+		t.Setenv("GITHUB_ISSUE_NUMBER", "123")
+		t.Setenv("GITHUB_RUN_NUMBER", "1234")
+		t.Setenv("GITHUB_SHA", "abcdef0123456789")
+		env := []string{"PR_RELEASE_BUILD_METADATA=build.1234.sha.abcdef0"}
+		goreleaserSvc.On("RunWithEnv", append([]any{mock.Anything, env}, toIface(goreleaserArgs)...)...).
+			Return(nil).Once()
+		writeGoReleaserOutput(t, fsRepo, `{"version":"1.1.0+build.1234.sha.abcdef0","artifacts":[]}`, true)
+		githubRepo.On("AddComment", mock.Anything, 123, mock.MatchedBy(func(body string) bool {
+			return strings.Contains(body, "- **Version**: 1.1.0+build.1234.sha.abcdef0") &&
+				strings.Contains(body, "- **Build**: build.1234.sha.abcdef0")
+		})).Return(nil).Once()
+		err := orch.Execute(ctx, DryRunConfig{BuildMetadata: true})
+		require.NoError(t, err)
+		goreleaserSvc.AssertExpectations(t)
+		githubRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should fall back to HEAD when the CI commit is unknown", func(t *testing.T) {
+		gitRepo := new(mockGitExtendedRepository)
+		orch := NewDryRunOrchestrator(gitRepo, nil, nil, nil, afero.NewMemMapFs(), nil)
+		t.Setenv("GITHUB_RUN_NUMBER", "")
+		t.Setenv("GITHUB_SHA", "")
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("0123456789abcdef", nil).Once()
+		assert.Equal(t, "sha.0123456", orch.ciBuildMetadata(t.Context(), DryRunConfig{BuildMetadata: true}))
+		assert.Empty(t, orch.ciBuildMetadata(t.Context(), DryRunConfig{}))
+		gitRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should report failed validations in a check run instead of a comment", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, ".goreleaser.yml", []byte("version: 2"), 0o644))
+		githubRepo := new(mockGithubExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		orch := NewDryRunOrchestrator(nil, githubRepo, new(mockCliffService), goreleaserSvc, fsRepo,
+			new(mockNpmService))
+		t.Setenv("GITHUB_HEAD_REF", "release/v1.1.0")
+		t.Setenv("GITHUB_ACTIONS", "true")
+		t.Setenv("GITHUB_ISSUE_NUMBER", "123")
+		t.Setenv("GITHUB_EVENT_PATH", "")
+		t.Setenv("GITHUB_SHA", "abc123")
+		goreleaserSvc.On("Run", append([]any{mock.Anything}, toIface(goreleaserArgs)...)...).
+			Return(errors.New("dry-run failed"))
+		githubRepo.On("CreateCheckRun", mock.Anything, mock.MatchedBy(func(run domain.CheckRun) bool {
+			return run.Name == DryRunCheckName && run.HeadSHA == "abc123" &&
+				run.Conclusion == domain.CheckConclusionFailure &&
+				run.Title == "1 of 3 validations failed" &&
+				strings.Contains(run.Summary, "| GoReleaser snapshot | ❌ Failed |") &&
+				strings.Contains(run.Summary, "| Changelog | ✅ Passed |") &&
+				strings.Contains(run.Text, "dry-run failed") &&
+				len(run.Annotations) == 1 && run.Annotations[0].Path == ".goreleaser.yml"
+		})).Return(nil).Once()
+		err := orch.Execute(t.Context(), DryRunConfig{Report: DryRunReportCheckRun})
+		require.ErrorContains(t, err, "GoReleaser dry-run failed")
+		githubRepo.AssertExpectations(t)
+		githubRepo.AssertNotCalled(t, "AddComment", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Should create a check run on the PR head and comment when reporting both", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		githubRepo := new(mockGithubExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		orch := NewDryRunOrchestrator(nil, githubRepo, new(mockCliffService), goreleaserSvc, fsRepo,
+			new(mockNpmService))
+		const eventPath = "/home/runner/work/_temp/_github_workflow/event.json"
+		payload := `{"pull_request":{"number":123,"head":{"ref":"release/v1.1.0","sha":"head456"}}}`
+		require.NoError(t, afero.WriteFile(fsRepo, eventPath, []byte(payload), 0o644))
+		t.Setenv("GITHUB_HEAD_REF", "release/v1.1.0")
+		t.Setenv("GITHUB_ACTIONS", "true")
+		t.Setenv("GITHUB_ISSUE_NUMBER", "123")
+		t.Setenv("GITHUB_EVENT_PATH", eventPath)
+		t.Setenv("GITHUB_SHA", "merge789")
+		goreleaserSvc.On("Run", append([]any{mock.Anything}, toIface(goreleaserArgs)...)...).Return(nil)
+		writeGoReleaserOutput(t, fsRepo, `{"version":"v1.1.0","artifacts":[{"type":"Archive","goos":"linux",`+
+			`"goarch":"amd64"}]}`, true)
+		githubRepo.On("CreateCheckRun", mock.Anything, mock.MatchedBy(func(run domain.CheckRun) bool {
+			return run.HeadSHA == "head456" && run.Conclusion == domain.CheckConclusionSuccess &&
+				run.Title == "All 3 validations passed" && len(run.Annotations) == 0 &&
+				strings.Contains(run.Text, "- linux/amd64")
+		})).Return(nil).Once()
+		githubRepo.On("AddComment", mock.Anything, 123, mock.Anything).Return(nil).Once()
+		require.NoError(t, orch.Execute(t.Context(), DryRunConfig{Report: DryRunReportBoth}))
+		githubRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should fail when the check run cannot be created", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		githubRepo := new(mockGithubExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		orch := NewDryRunOrchestrator(nil, githubRepo, new(mockCliffService), goreleaserSvc, fsRepo,
+			new(mockNpmService))
+		t.Setenv("GITHUB_HEAD_REF", "release/v1.1.0")
+		t.Setenv("GITHUB_ACTIONS", "true")
+		t.Setenv("GITHUB_EVENT_PATH", "")
+		t.Setenv("GITHUB_SHA", "abc123")
+		goreleaserSvc.On("Run", append([]any{mock.Anything}, toIface(goreleaserArgs)...)...).Return(nil)
+		writeGoReleaserOutput(t, fsRepo, `{"version":"v1.1.0","artifacts":[]}`, true)
+		githubRepo.On("CreateCheckRun", mock.Anything, mock.Anything).Return(errors.New("forbidden")).Once()
+		err := orch.Execute(t.Context(), DryRunConfig{Report: DryRunReportCheckRun})
+		require.ErrorContains(t, err, "check run failed: forbidden")
+	})
+
 	// tools NPM validation removed from dry-run pipeline
 }
 
@@ -163,3 +308,81 @@ func writeGoReleaserOutput(t *testing.T, fs afero.Fs, metadata string, withCheck
 		require.NoError(t, afero.WriteFile(fs, "dist/checksums.txt", []byte("checksums"), 0644))
 	}
 }
+
+func TestDryRunOrchestrator_ValidateNPM(t *testing.T) {
+	writePackage := func(t *testing.T, fs afero.Fs, dir string) {
+		t.Helper()
+		require.NoError(t, afero.WriteFile(fs, dir+"/package.json", []byte(`{"name":"pkg"}`), 0o644))
+	}
+	t.Run("Should validate every package in the tools directory", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		writePackage(t, fsRepo, "tools/cli")
+		writePackage(t, fsRepo, "tools/sdk")
+		require.NoError(t, fsRepo.MkdirAll("tools/docs", 0o755))
+		npmSvc := new(mockNpmService)
+		npmSvc.On("ValidatePackageVersion", mock.Anything, "tools/cli", "1.1.0").Return(nil).Once()
+		npmSvc.On("ValidatePackageVersion", mock.Anything, "tools/sdk", "1.1.0").Return(nil).Once()
+		orch := NewDryRunOrchestrator(nil, nil, nil, nil, fsRepo, npmSvc)
+		err := orch.stepValidateNPM(t.Context(), DryRunConfig{ToolsDir: "tools"}, "1.1.0")
+		require.NoError(t, err)
+		npmSvc.AssertExpectations(t)
+	})
+	t.Run("Should report every package that cannot be published", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		writePackage(t, fsRepo, "tools/cli")
+		writePackage(t, fsRepo, "tools/sdk")
+		npmSvc := new(mockNpmService)
+		npmSvc.On("ValidatePackageVersion", mock.Anything, "tools/cli", "1.1.0").
+			Return(errors.New("already published")).Once()
+		npmSvc.On("ValidatePackageVersion", mock.Anything, "tools/sdk", "1.1.0").
+			Return(errors.New("no publish rights")).Once()
+		orch := NewDryRunOrchestrator(nil, nil, nil, nil, fsRepo, npmSvc)
+		err := orch.stepValidateNPM(t.Context(), DryRunConfig{ToolsDir: "tools"}, "1.1.0")
+		assert.ErrorContains(t, err, "NPM validation failed")
+		assert.ErrorContains(t, err, "tools/cli: already published")
+		assert.ErrorContains(t, err, "tools/sdk: no publish rights")
+	})
+	t.Run("Should skip validation without a tools directory", func(t *testing.T) {
+		npmSvc := new(mockNpmService)
+		orch := NewDryRunOrchestrator(nil, nil, nil, nil, afero.NewMemMapFs(), npmSvc)
+		require.NoError(t, orch.stepValidateNPM(t.Context(), DryRunConfig{ToolsDir: "tools"}, "1.1.0"))
+		require.NoError(t, orch.stepValidateNPM(t.Context(), DryRunConfig{}, "1.1.0"))
+		npmSvc.AssertNotCalled(t, "ValidatePackageVersion", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDryRunOrchestrator_getPRNumber(t *testing.T) {
+	const eventPath = "/home/runner/work/_temp/_github_workflow/event.json"
+	t.Run("Should read the PR number from the event payload on the injected filesystem", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, eventPath, []byte(`{"pull_request":{"number":77}}`), 0o644))
+		t.Setenv("GITHUB_ISSUE_NUMBER", "")
+		t.Setenv("GITHUB_EVENT_PATH", eventPath)
+		orch := NewDryRunOrchestrator(nil, nil, nil, nil, fsRepo, new(mockNpmService))
+		assert.Equal(t, 77, orch.getPRNumber(t.Context()))
+	})
+	t.Run("Should fall back to the issue number in the payload", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, eventPath, []byte(`{"issue":{"number":12}}`), 0o644))
+		t.Setenv("GITHUB_ISSUE_NUMBER", "")
+		t.Setenv("GITHUB_EVENT_PATH", eventPath)
+		orch := NewDryRunOrchestrator(nil, nil, nil, nil, fsRepo, new(mockNpmService))
+		assert.Equal(t, 12, orch.getPRNumber(t.Context()))
+	})
+	t.Run("Should return zero when the payload is missing", func(t *testing.T) {
+		t.Setenv("GITHUB_ISSUE_NUMBER", "")
+		t.Setenv("GITHUB_EVENT_PATH", eventPath)
+		orch := NewDryRunOrchestrator(nil, nil, nil, nil, afero.NewMemMapFs(), new(mockNpmService))
+		assert.Zero(t, orch.getPRNumber(t.Context()))
+	})
+}
+
+func TestFindRepoRoot(t *testing.T) {
+	t.Run("Should walk up the injected filesystem to the repository root", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, fsRepo.MkdirAll("/work/repo/.git", 0o755))
+		require.NoError(t, fsRepo.MkdirAll("/work/repo/internal/app", 0o755))
+		assert.Equal(t, "/work/repo", findRepoRoot(fsRepo, "/work/repo/internal/app"))
+		assert.Empty(t, findRepoRoot(fsRepo, "/elsewhere"))
+	})
+}
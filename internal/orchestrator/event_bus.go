@@ -0,0 +1,115 @@
+package orchestrator
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"go.uber.org/zap"
+)
+
+// EventType names something that happened during a release workflow.
+type EventType string
+
+// Events published on the event bus of an orchestrator.
+const (
+	EventStepStarted   EventType = "step.started"
+	EventStepSucceeded EventType = "step.succeeded"
+	EventStepSkipped   EventType = "step.skipped"
+	EventStepFailed    EventType = "step.failed"
+	// EventSessionFailed follows the failed step of a saga session once its rollback settled
+	EventSessionFailed EventType = "session.failed"
+	// EventWorkflowFailed is published once per failed pr-release run, whichever flow it took
+	EventWorkflowFailed EventType = "workflow.failed"
+	// EventReleasePublished follows a public GitHub release and the packages published with it
+	EventReleasePublished EventType = "release.published"
+)
+
+// Event describes something that happened during a release workflow. Fields that do not apply to
+// its type are left empty.
+type Event struct {
+	Type        EventType
+	Time        time.Time
+	Step        string                // step of step events
+	Version     string                // release tag, when known
+	PreviousTag string                // release before Version, for release.published
+	Session     *domain.RollbackState // saga session of step and session events
+	Err         error                 // cause of step.failed, session.failed and workflow.failed
+}
+
+// EventSubscriber handles the events of an EventBus. Delivery never fails a workflow: subscribers log
+// their own errors.
+type EventSubscriber interface {
+	HandleEvent(ctx context.Context, event Event)
+}
+
+// EventSubscriberFunc adapts a function to an EventSubscriber.
+type EventSubscriberFunc func(ctx context.Context, event Event)
+
+// HandleEvent calls f.
+func (f EventSubscriberFunc) HandleEvent(ctx context.Context, event Event) {
+	f(ctx, event)
+}
+
+type eventSubscription struct {
+	id         int
+	subscriber EventSubscriber
+	types      []EventType
+}
+
+// EventBus delivers the events of a workflow to the integrations that subscribe to them, such as the
+// step timeline, failure annotations and issues, or release comments, so a new integration subscribes
+// instead of being wired into orchestrator code. Events are delivered synchronously, in subscription
+// order. A nil EventBus drops every event.
+type EventBus struct {
+	mu            sync.RWMutex
+	subscriptions []eventSubscription
+	nextID        int
+	now           func() time.Time
+}
+
+// NewEventBus creates an EventBus without subscribers.
+func NewEventBus() *EventBus {
+	return &EventBus{now: time.Now}
+}
+
+// Subscribe delivers the events of types, or every event when none is given, to subscriber until the
+// returned function is called.
+func (b *EventBus) Subscribe(subscriber EventSubscriber, types ...EventType) (unsubscribe func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.nextID++
+	id := b.nextID
+	b.subscriptions = append(b.subscriptions, eventSubscription{id: id, subscriber: subscriber, types: types})
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.subscriptions = slices.DeleteFunc(b.subscriptions, func(s eventSubscription) bool { return s.id == id })
+	}
+}
+
+// Publish stamps event with the current time unless it has one and delivers it to its subscribers.
+func (b *EventBus) Publish(ctx context.Context, event Event) {
+	if b == nil {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = b.now()
+	}
+	b.mu.RLock()
+	subscriptions := slices.Clone(b.subscriptions)
+	b.mu.RUnlock()
+	logger.FromContext(ctx).Named("orchestrator.events").Debug("Publishing event",
+		zap.String("type", string(event.Type)),
+		zap.String("step", event.Step),
+		zap.String("version", event.Version),
+	)
+	for _, subscription := range subscriptions {
+		if len(subscription.types) == 0 || slices.Contains(subscription.types, event.Type) {
+			subscription.subscriber.HandleEvent(ctx, event)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBus(t *testing.T) {
+	t.Run("Should deliver events of the subscribed types in subscription order", func(t *testing.T) {
+		bus := NewEventBus()
+		var delivered []string
+		bus.Subscribe(EventSubscriberFunc(func(_ context.Context, event Event) {
+			delivered = append(delivered, "all:"+string(event.Type))
+		}))
+		bus.Subscribe(EventSubscriberFunc(func(_ context.Context, event Event) {
+			delivered = append(delivered, "failures:"+string(event.Type))
+		}), EventStepFailed, EventWorkflowFailed)
+		bus.Publish(t.Context(), Event{Type: EventStepStarted, Step: stepNameCheckChanges})
+		bus.Publish(t.Context(), Event{Type: EventStepFailed, Step: stepNameCheckChanges})
+		assert.Equal(t, []string{"all:step.started", "all:step.failed", "failures:step.failed"}, delivered)
+	})
+	t.Run("Should stop delivering after unsubscribing", func(t *testing.T) {
+		bus := NewEventBus()
+		count := 0
+		unsubscribe := bus.Subscribe(EventSubscriberFunc(func(context.Context, Event) { count++ }))
+		bus.Publish(t.Context(), Event{Type: EventReleasePublished})
+		unsubscribe()
+		bus.Publish(t.Context(), Event{Type: EventReleasePublished})
+		assert.Equal(t, 1, count)
+	})
+	t.Run("Should stamp events without a time", func(t *testing.T) {
+		bus := NewEventBus()
+		now := time.Date(2026, time.July, 18, 9, 0, 0, 0, time.UTC)
+		bus.now = func() time.Time { return now }
+		var stamped []time.Time
+		bus.Subscribe(EventSubscriberFunc(func(_ context.Context, event Event) {
+			stamped = append(stamped, event.Time)
+		}))
+		earlier := now.Add(-time.Minute)
+		bus.Publish(t.Context(), Event{Type: EventStepStarted})
+		bus.Publish(t.Context(), Event{Type: EventStepStarted, Time: earlier})
+		assert.Equal(t, []time.Time{now, earlier}, stamped)
+	})
+	t.Run("Should drop events on a nil bus", func(t *testing.T) {
+		var bus *EventBus
+		assert.NotPanics(t, func() { bus.Publish(t.Context(), Event{Type: EventWorkflowFailed}) })
+	})
+}
@@ -0,0 +1,184 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+)
+
+// Names of the release PR workflow steps, shared by the legacy and saga flows.
+const (
+	stepNameValidateEnvironment = "Validate Environment"
+	stepNameCheckChanges        = "Check Changes"
+	stepNameCalculateVersion    = "Calculate Version"
+	stepNameCreateBranch        = "Create Release Branch"
+	stepNamePackageVersions     = "Update Package Versions"
+	stepNameChangelog           = "Generate Changelog"
+	stepNameReleaseArtifacts    = "Prepare Release Artifacts"
+	stepNameArchiveNotes        = "Archive Release Notes"
+	stepNameConsumeChangeFiles  = "Consume Change Files"
+	stepNameCommitChanges       = "Commit Changes"
+	stepNameReleaseSize         = "Check Release Size"
+	stepNamePushBranch          = "Push Branch"
+	stepNameCreatePR            = "Create Pull Request"
+)
+
+// StepError attributes a release workflow failure to the step that produced it.
+type StepError struct {
+	Step string
+	Err  error
+}
+
+// Error returns the message of the underlying error.
+func (e *StepError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// stepFailed attributes err to step, keeping the innermost attribution when err already has one.
+func stepFailed(step string, err error) error {
+	var stepErr *StepError
+	if err == nil || errors.As(err, &stepErr) {
+		return err
+	}
+	return &StepError{Step: step, Err: err}
+}
+
+// failureClass groups failures that share a cause and a remediation.
+type failureClass struct {
+	name string
+	hint string
+	file string
+}
+
+// stepFailureClasses are the classes of failures that are not explained by the error itself.
+var stepFailureClasses = map[string]failureClass{
+	stepNameValidateEnvironment: {
+		name: "configuration",
+		hint: "Set GITHUB_TOKEN (or github_token) in the workflow environment.",
+	},
+	stepNameCheckChanges: {
+		name: "git-history",
+		hint: "Check out the full history and tags, e.g. actions/checkout with fetch-depth: 0.",
+	},
+	stepNameCalculateVersion: {
+		name: "versioning",
+		hint: "Check that commits follow Conventional Commits, git-cliff is installed and existing tags are semver.",
+	},
+	stepNameCreateBranch: {
+		name: "git",
+		hint: "Check that the release branch name is free and the working tree is clean.",
+	},
+	stepNamePackageVersions: {
+		name: "package-versions",
+		hint: "Check that the version file named in the error is valid, or skip the step with --skip package-versions.",
+	},
+	stepNameChangelog: {
+		name: "changelog",
+		hint: "Check that git-cliff is installed and cliff_config points to a valid configuration.",
+		file: "CHANGELOG.md",
+	},
+	stepNameReleaseArtifacts: {
+		name: "release-artifacts",
+		hint: "Run the failing release_artifacts command locally; its output is in the job log.",
+	},
+	stepNameArchiveNotes: {
+		name: "release-notes",
+		hint: "Check the files in .release-notes, or skip the step with --skip archive-notes.",
+	},
+	stepNameConsumeChangeFiles: {
+		name: "change-files",
+		hint: "Check that every file in .changes has a bump of major, minor or patch and a summary.",
+	},
+	stepNameCommitChanges: {
+		name: "git",
+		hint: "Check that the git user can commit and no hook rejects the release commit.",
+	},
+	stepNameReleaseSize: {
+		name: "release-size",
+		hint: "Add generated paths to release_pr_exclude, or raise release_pr_max_files / release_pr_max_lines.",
+	},
+	stepNamePushBranch: {
+		name: "git-push",
+		hint: "Check that the token can push release branches (contents: write) and no branch rule blocks it.",
+	},
+	stepNameCreatePR: {
+		name: "github",
+		hint: "Check that the token can open pull requests (pull-requests: write).",
+	},
+}
+
+var unknownFailureClass = failureClass{
+	name: "unknown",
+	hint: "See the job log for the full error.",
+}
+
+// classifyFailure returns the class of err, preferring what the error reports over the failing step.
+func classifyFailure(step string, err error) failureClass {
+	class, ok := stepFailureClasses[step]
+	if !ok {
+		class = unknownFailureClass
+	}
+	var apiErr *repository.GitHubAPIError
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		class.name = "timeout"
+		class.hint = "Retry the job; raise git_push_timeout_minutes if pushes are slow."
+	case !errors.As(err, &apiErr):
+	case apiErr.RateLimited():
+		class.name = "github-rate-limit"
+		class.hint = "Wait for the GitHub rate limit to reset, or use a token with a higher quota."
+	case apiErr.StatusCode == http.StatusUnauthorized:
+		class.name = "github-auth"
+		class.hint = "The GitHub token was rejected; check that it is set and has not expired."
+	case apiErr.StatusCode == http.StatusForbidden:
+		class.name = "github-permission"
+		class.hint = "Grant the token contents: write and pull-requests: write permissions."
+	case apiErr.StatusCode == http.StatusNotFound:
+		class.name = "github-not-found"
+		class.hint = "Check github_owner and github_repo, and that the token can access the repository."
+	case apiErr.Retryable():
+		class.name = "github-unavailable"
+		class.hint = "GitHub could not be reached; retry the job, and check proxy_url and ca_bundle behind a proxy."
+	}
+	return class
+}
+
+// failureAnnotation describes err as a GitHub Actions error annotation.
+func failureAnnotation(err error) domain.Annotation {
+	var step string
+	var stepErr *StepError
+	if errors.As(err, &stepErr) {
+		step = stepErr.Step
+	}
+	class := classifyFailure(step, err)
+	title := fmt.Sprintf("Release PR failed (%s)", class.name)
+	if step != "" {
+		title = fmt.Sprintf("Release PR failed at %s (%s)", step, class.name)
+	}
+	return domain.Annotation{
+		Level:   domain.AnnotationError,
+		File:    class.file,
+		Title:   title,
+		Message: fmt.Sprintf("%v\n\nRemediation: %s", err, class.hint),
+	}
+}
+
+// annotateFailure prints err as a workflow command so it shows up in the PR checks UI when running
+// in GitHub Actions.
+func annotateFailure(out io.Writer, err error) {
+	if err == nil || os.Getenv(envGithubActions) != githubActionsTrue {
+		return
+	}
+	_, _ = fmt.Fprintln(out, failureAnnotation(err).String())
+}
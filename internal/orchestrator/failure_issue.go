@@ -0,0 +1,120 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"go.uber.org/zap"
+)
+
+// failureIssueTitle is the title of the issue that tracks the failed runs of a release.
+func failureIssueTitle(version string) string {
+	return "Release failure: " + version
+}
+
+// failureReporter reports a failed run in the failure issue of its release. It follows the step
+// events of the run, with or without a saga, for the version, the saga session and whether a step past
+// the read-only checks completed, and reports on workflow.failed, after a saga settled its rollback so
+// the issue reports its outcome.
+type failureReporter struct {
+	o       *PRReleaseOrchestrator
+	cfg     PRReleaseConfig
+	state   *domain.RollbackState
+	mutated bool
+}
+
+// HandleEvent implements EventSubscriber.
+func (r *failureReporter) HandleEvent(ctx context.Context, event Event) {
+	switch {
+	case event.Session != nil:
+		r.state = event.Session
+	case event.Version != "":
+		r.state = &domain.RollbackState{Version: event.Version, BranchName: "release/" + event.Version}
+	}
+	switch event.Type {
+	case EventStepSucceeded:
+		if event.Step != stepNameCheckChanges && event.Step != stepNameCalculateVersion {
+			r.mutated = true
+		}
+	case EventWorkflowFailed:
+		// Steps that were skipped or failed before completing left nothing behind to report
+		if r.mutated && r.state != nil {
+			r.o.reportFailure(ctx, r.cfg, r.state, event.Err)
+		}
+	}
+}
+
+// reportFailure opens or updates the failure issue of the release when failure_issue is enabled and
+// the run is not a dry run. Reporting errors are only logged.
+func (o *PRReleaseOrchestrator) reportFailure(
+	ctx context.Context,
+	cfg PRReleaseConfig,
+	state *domain.RollbackState,
+	runErr error,
+) {
+	appConfig := config.FromContext(ctx)
+	if !appConfig.FailureIssue || cfg.DryRun || state.Version == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), RollbackTimeout)
+	defer cancel()
+	var assignees []string
+	if captain := strings.TrimPrefix(strings.TrimSpace(appConfig.ReleaseCaptain), "@"); captain != "" {
+		assignees = append(assignees, captain)
+	}
+	title := failureIssueTitle(state.Version)
+	number, err := o.githubRepo.CreateOrUpdateIssue(ctx, title, failureIssueBody(cfg, state, runErr), assignees)
+	if err != nil {
+		o.logger(ctx).Warn("Failed to report the release failure", zap.String("title", title), zap.Error(err))
+		return
+	}
+	o.logCI(ctx, cfg.CIOutput, zap.String("action", "failure_issue"), zap.Int("issue_number", number))
+	o.logger(ctx).Info("Reported the release failure", zap.Int("issue_number", number))
+}
+
+// failureIssueBody describes the failure, the rollback outcome and the steps to recover from it.
+func failureIssueBody(cfg PRReleaseConfig, state *domain.RollbackState, runErr error) string {
+	var step string
+	var stepErr *StepError
+	if errors.As(runErr, &stepErr) {
+		step = stepErr.Step
+	}
+	class := classifyFailure(step, runErr)
+	var b strings.Builder
+	fmt.Fprintf(&b, "The release PR run for %s failed", state.Version)
+	if step != "" {
+		fmt.Fprintf(&b, " at **%s**", step)
+	}
+	fmt.Fprintf(&b, " (%s).\n\n```\n%v\n```\n\n", class.name, runErr)
+	if state.SessionID != "" {
+		fmt.Fprintf(&b, "- Session: `%s`\n", state.SessionID)
+	}
+	if state.BranchName != "" {
+		fmt.Fprintf(&b, "- Branch: `%s`\n", state.BranchName)
+	}
+	switch {
+	case state.Status == domain.WorkflowStatusRolledBack:
+		b.WriteString("- Rollback: completed, the changes of the run were undone\n")
+	case cfg.EnableRollback:
+		b.WriteString("- Rollback: failed, some changes of the run are left in place\n")
+	default:
+		b.WriteString("- Rollback: disabled, the changes of the run are left in place\n")
+	}
+	for _, cleanup := range state.ManualCleanup {
+		fmt.Fprintf(&b, "- Needs manual cleanup: **%s** (%s)\n", cleanup.Step, cleanup.Error)
+	}
+	b.WriteString("\n### Remediation\n\n")
+	fmt.Fprintf(&b, "1. %s\n", class.hint)
+	if cfg.EnableRollback && state.Status != domain.WorkflowStatusRolledBack {
+		fmt.Fprintf(&b, "2. Inspect the session with `pr-release state list` and undo what remains with "+
+			"`pr-release pr-release --rollback --session-id %s`.\n", state.SessionID)
+	} else {
+		b.WriteString("2. Check that no release branch or pull request of the run is left behind.\n")
+	}
+	b.WriteString("3. Re-run the release workflow; this issue is updated if it fails again.\n")
+	return b.String()
+}
@@ -0,0 +1,148 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func failedReleaseState(status domain.WorkflowStatus, ops ...domain.OperationType) *domain.RollbackState {
+	state := domain.NewRollbackState("abc123")
+	state.Version = "v1.2.0"
+	state.BranchName = "release/v1.2.0"
+	for _, op := range ops {
+		state.AddOperation(op)
+		state.MarkOperationStarted(op)
+		state.MarkOperationCompleted(op, nil)
+	}
+	state.Status = status
+	return state
+}
+
+func TestPRReleaseOrchestrator_reportFailure(t *testing.T) {
+	runErr := stepFailed(stepNamePushBranch, errors.New("step 'Push Branch' failed: remote rejected"))
+	t.Run("Should open the failure issue assigned to the release captain", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.FailureIssue = true
+		cfg.ReleaseCaptain = "@octocat"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		var body string
+		githubRepo.
+			On("CreateOrUpdateIssue", mock.Anything, "Release failure: v1.2.0", mock.Anything, []string{"octocat"}).
+			Run(func(args mock.Arguments) { body = args.String(2) }).
+			Return(12, nil).Once()
+		orch := &PRReleaseOrchestrator{githubRepo: githubRepo}
+		state := failedReleaseState(domain.WorkflowStatusFailed,
+			domain.OperationTypeCheckChanges, domain.OperationTypeCreateBranch, domain.OperationTypePushBranch)
+		orch.reportFailure(ctx, PRReleaseConfig{EnableRollback: true}, state, runErr)
+		githubRepo.AssertExpectations(t)
+		assert.Contains(t, body, "failed at **Push Branch** (git-push)")
+		assert.Contains(t, body, "remote rejected")
+		assert.Contains(t, body, "- Session: `abc123`")
+		assert.Contains(t, body, "- Rollback: failed")
+		assert.Contains(t, body, "`pr-release pr-release --rollback --session-id abc123`")
+	})
+	t.Run("Should report a completed rollback", func(t *testing.T) {
+		body := failureIssueBody(PRReleaseConfig{EnableRollback: true},
+			failedReleaseState(domain.WorkflowStatusRolledBack, domain.OperationTypeCreateBranch), runErr)
+		assert.Contains(t, body, "- Rollback: completed")
+		assert.NotContains(t, body, "--rollback")
+	})
+	t.Run("Should list the steps a best-effort rollback left for manual cleanup", func(t *testing.T) {
+		state := failedReleaseState(domain.WorkflowStatusFailed, domain.OperationTypePushBranch)
+		state.AddManualCleanup(domain.OperationTypePushBranch, "Push Branch", errors.New("permission denied"))
+		body := failureIssueBody(PRReleaseConfig{EnableRollback: true}, state, runErr)
+		assert.Contains(t, body, "- Needs manual cleanup: **Push Branch** (permission denied)")
+	})
+	t.Run("Should not report failures before the repository changed or in dry runs", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.FailureIssue = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch := &PRReleaseOrchestrator{githubRepo: githubRepo}
+		readOnly := &failureReporter{o: orch}
+		readOnly.HandleEvent(ctx, Event{Type: EventStepSucceeded, Step: stepNameCheckChanges})
+		readOnly.HandleEvent(ctx, Event{Type: EventStepSucceeded, Step: stepNameCalculateVersion, Version: "v1.2.0"})
+		readOnly.HandleEvent(ctx, Event{Type: EventWorkflowFailed, Err: runErr})
+		dryRun := &failureReporter{o: orch, cfg: PRReleaseConfig{DryRun: true}}
+		dryRun.HandleEvent(ctx, Event{Type: EventStepSucceeded, Step: stepNameCreateBranch, Version: "v1.2.0"})
+		dryRun.HandleEvent(ctx, Event{Type: EventWorkflowFailed, Err: runErr})
+		githubRepo.AssertNotCalled(t, "CreateOrUpdateIssue", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should report a failed run without rollback support", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.FailureIssue = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		t.Setenv("GITHUB_TOKEN", "test-token")
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		gitRepo.On("LatestTag", mock.Anything).Return("v1.1.0", nil).Times(2)
+		gitRepo.On("CommitsSinceTag", mock.Anything, "v1.1.0").Return(1, nil).Once()
+		nextVersion, err := domain.NewVersion("v1.2.0")
+		require.NoError(t, err)
+		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.1.0").Return(nextVersion, nil).Times(2)
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, "release/v1.2.0").Return(false, nil).Once()
+		gitRepo.On("CreateBranch", mock.Anything, "release/v1.2.0").Return(nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "release/v1.2.0").Return(nil).Once()
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.2.0", "release").
+			Return("", errors.New("changelog failed")).Once()
+		var body string
+		githubRepo.On("CreateOrUpdateIssue", mock.Anything, "Release failure: v1.2.0", mock.Anything, []string(nil)).
+			Run(func(args mock.Arguments) { body = args.String(2) }).
+			Return(12, nil).Once()
+		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, afero.NewMemMapFs(), cliffSvc, new(mockNpmService))
+		err = orch.Execute(ctx, PRReleaseConfig{})
+		require.ErrorContains(t, err, "changelog failed")
+		githubRepo.AssertExpectations(t)
+		assert.Contains(t, body, "failed at **Generate Changelog**")
+		assert.Contains(t, body, "- Branch: `release/v1.2.0`")
+		assert.Contains(t, body, "- Rollback: disabled")
+		assert.NotContains(t, body, "- Session:")
+	})
+	t.Run("Should not report a release failing right after the version calculation", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.FailureIssue = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		t.Setenv("GITHUB_TOKEN", "test-token")
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		stateRepo := new(mockStateRepository)
+		stateRepo.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+		gitRepo.On("GetCurrentBranch", mock.Anything).Return("main", nil).Once()
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Once()
+		gitRepo.On("LatestTag", mock.Anything).Return("v1.1.0", nil).Times(2)
+		gitRepo.On("CommitsSinceTag", mock.Anything, "v1.1.0").Return(1, nil).Once()
+		nextVersion, err := domain.NewVersion("v1.2.0")
+		require.NoError(t, err)
+		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.1.0").Return(nextVersion, nil).Times(2)
+		gitRepo.On("ListLocalBranches", mock.Anything).Return(nil, errors.New("index locked"))
+		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, afero.NewMemMapFs(), cliffSvc, new(mockNpmService))
+		orch.stateRepo = stateRepo
+		err = orch.Execute(ctx, PRReleaseConfig{EnableRollback: true})
+		require.ErrorContains(t, err, "failed to list local branches")
+		githubRepo.AssertNotCalled(t, "CreateOrUpdateIssue", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		gitRepo.AssertExpectations(t)
+		cliffSvc.AssertExpectations(t)
+	})
+	t.Run("Should keep the run error when the issue cannot be reported", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.FailureIssue = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("CreateOrUpdateIssue", mock.Anything, mock.Anything, mock.Anything, []string(nil)).
+			Return(0, errors.New("forbidden")).Once()
+		orch := &PRReleaseOrchestrator{githubRepo: githubRepo}
+		state := failedReleaseState(domain.WorkflowStatusFailed, domain.OperationTypeCreateBranch)
+		require.NotPanics(t, func() { orch.reportFailure(ctx, PRReleaseConfig{}, state, runErr) })
+		githubRepo.AssertExpectations(t)
+	})
+}
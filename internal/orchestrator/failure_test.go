@@ -0,0 +1,108 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMain keeps failing test releases from printing workflow commands, which would otherwise become
+// annotations on this repository's own CI runs, and keeps the step timeline off when tests run in a terminal.
+func TestMain(m *testing.M) {
+	os.Unsetenv(envGithubActions)
+	interactiveTerminal = func(io.Writer) bool { return false }
+	os.Exit(m.Run())
+}
+
+func TestFailureAnnotation(t *testing.T) {
+	t.Run("Should name the failing step, its file and the remediation", func(t *testing.T) {
+		err := stepFailed(stepNameChangelog, errors.New("failed to generate changelog: git-cliff not found"))
+		annotation := failureAnnotation(err)
+		assert.Equal(t, domain.Annotation{
+			Level: domain.AnnotationError,
+			File:  "CHANGELOG.md",
+			Title: "Release PR failed at Generate Changelog (changelog)",
+			Message: "failed to generate changelog: git-cliff not found\n\n" +
+				"Remediation: Check that git-cliff is installed and cliff_config points to a valid configuration.",
+		}, annotation)
+	})
+	t.Run("Should classify GitHub API failures over the step default", func(t *testing.T) {
+		cases := map[string]*repository.GitHubAPIError{
+			"github-auth":        {Operation: "create pull request", StatusCode: http.StatusUnauthorized},
+			"github-permission":  {Operation: "create pull request", StatusCode: http.StatusForbidden},
+			"github-not-found":   {Operation: "create pull request", StatusCode: http.StatusNotFound},
+			"github-unavailable": {Operation: "create pull request", StatusCode: http.StatusBadGateway},
+			"github-rate-limit":  {Operation: "create pull request", StatusCode: http.StatusTooManyRequests},
+		}
+		for class, apiErr := range cases {
+			err := stepFailed(stepNameCreatePR, fmt.Errorf("failed to create pull request: %w", apiErr))
+			assert.Equal(t, "Release PR failed at Create Pull Request ("+class+")", failureAnnotation(err).Title)
+		}
+	})
+	t.Run("Should classify timeouts and unattributed failures", func(t *testing.T) {
+		err := stepFailed(stepNamePushBranch, fmt.Errorf("failed to push branch: %w", context.DeadlineExceeded))
+		assert.Equal(t, "Release PR failed at Push Branch (timeout)", failureAnnotation(err).Title)
+		annotation := failureAnnotation(errors.New("failed to write release manifest"))
+		assert.Equal(t, "Release PR failed (unknown)", annotation.Title)
+		assert.Empty(t, annotation.File)
+	})
+	t.Run("Should keep the innermost step attribution", func(t *testing.T) {
+		err := stepFailed(stepNamePushBranch, stepFailed(stepNameCommitChanges, assert.AnError))
+		var stepErr *StepError
+		require.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, stepNameCommitChanges, stepErr.Step)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.NoError(t, stepFailed(stepNamePushBranch, nil))
+	})
+}
+
+func TestPRReleaseOrchestrator_annotateFailure(t *testing.T) {
+	t.Run("Should print an error annotation when a step fails in GitHub Actions", func(t *testing.T) {
+		t.Setenv(envGithubActions, githubActionsTrue)
+		t.Setenv("GITHUB_TOKEN", "ghp_test")
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("LatestTag", mock.Anything).Return("", assert.AnError).Once()
+		orch := NewPRReleaseOrchestrator(
+			gitRepo,
+			new(mockGithubExtendedRepository),
+			afero.NewMemMapFs(),
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		var out bytes.Buffer
+		orch.annotations = &out
+		err := orch.Execute(testReleaseContext(t), PRReleaseConfig{})
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, "::error title=Release PR failed at Check Changes (git-history)::"+
+			"failed to check changes: failed to get latest tag: "+assert.AnError.Error()+"%0A%0A"+
+			"Remediation: Check out the full history and tags, e.g. actions/checkout with fetch-depth: 0.\n",
+			out.String())
+	})
+	t.Run("Should not print annotations outside GitHub Actions", func(t *testing.T) {
+		t.Setenv("GITHUB_TOKEN", "")
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			afero.NewMemMapFs(),
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		var out bytes.Buffer
+		orch.annotations = &out
+		err := orch.Execute(testReleaseContext(t), PRReleaseConfig{})
+		require.ErrorContains(t, err, "environment validation failed")
+		assert.Empty(t, out.String())
+	})
+}
@@ -0,0 +1,89 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+// snapshotFiles are the files the release run itself rewrites before committing.
+var snapshotFiles = []string{
+	"CHANGELOG.md",
+	ReleaseBodyOutputFile,
+	ReleaseNotesOutputFile,
+	"package.json",
+	mavenPOMFile,
+	gradlePropertiesFile,
+	readmeFile,
+	goModFile,
+}
+
+// fileSnapshot holds the content files had before a legacy run rewrote them. A nil entry marks a
+// file that did not exist. The saga flow restores files through its compensators instead.
+type fileSnapshot map[string][]byte
+
+// takeFileSnapshot reads paths into memory.
+func takeFileSnapshot(fsRepo repository.FileSystemRepository, paths []string) (fileSnapshot, error) {
+	snapshot := make(fileSnapshot, len(paths))
+	for _, path := range paths {
+		data, err := afero.ReadFile(fsRepo, path)
+		if os.IsNotExist(err) {
+			snapshot[path] = nil
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot %s: %w", path, err)
+		}
+		snapshot[path] = data
+	}
+	return snapshot, nil
+}
+
+// restoreFileSnapshot puts the worktree back after a legacy run failed before its release commit.
+// Snapshotted files get their content back; other changed files, such as those written by custom
+// updaters or release artifact commands, are restored from HEAD. Restoring is best effort:
+// failures are logged so the error of the run stays the one reported.
+func (o *PRReleaseOrchestrator) restoreFileSnapshot(ctx context.Context, snapshot fileSnapshot, changes *ChangeSet) {
+	log := o.logger(ctx)
+	for path, original := range snapshot {
+		if err := restoreSnapshotFile(o.fsRepo, path, original); err != nil {
+			log.Warn("Failed to restore file", zap.String("file", path), zap.Error(err))
+		}
+	}
+	for _, path := range changes.Paths() {
+		if _, snapshotted := snapshot[path]; snapshotted {
+			continue
+		}
+		status, err := o.gitRepo.GetFileStatus(ctx, path)
+		if err != nil || status == "clean" {
+			continue
+		}
+		if err := o.gitRepo.RestoreFile(ctx, path); err != nil {
+			log.Warn("Failed to restore file", zap.String("file", path), zap.Error(err))
+		}
+	}
+	log.Info("Restored files modified by the failed release run")
+}
+
+func restoreSnapshotFile(fsRepo repository.FileSystemRepository, path string, original []byte) error {
+	current, err := afero.ReadFile(fsRepo, path)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if original == nil {
+		if !exists {
+			return nil
+		}
+		return fsRepo.Remove(path)
+	}
+	if exists && bytes.Equal(current, original) {
+		return nil
+	}
+	return afero.WriteFile(fsRepo, path, original, FilePermissionsReadWrite)
+}
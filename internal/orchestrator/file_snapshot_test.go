@@ -0,0 +1,36 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRReleaseOrchestrator_restoreFileSnapshot(t *testing.T) {
+	t.Run("Should restore snapshotted files and check out other changed files", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, afero.WriteFile(fsRepo, "package.json", []byte(`{"version":"1.1.0"}`), 0644))
+		snapshot, err := takeFileSnapshot(fsRepo, []string{"package.json", "CHANGELOG.md"})
+		require.NoError(t, err)
+		require.NoError(t, afero.WriteFile(fsRepo, "package.json", []byte(`{"version":"1.2.0"}`), 0644))
+		require.NoError(t, afero.WriteFile(fsRepo, "CHANGELOG.md", []byte("## 1.2.0\n"), 0644))
+		gitRepo := orch.gitRepo.(*mockGitExtendedRepository)
+		gitRepo.On("GetFileStatus", mock.Anything, "charts/app/Chart.yaml").Return("modified", nil).Once()
+		gitRepo.On("GetFileStatus", mock.Anything, "docs/install.md").Return("clean", nil).Once()
+		gitRepo.On("RestoreFile", mock.Anything, "charts/app/Chart.yaml").Return(nil).Once()
+		changes := NewChangeSet()
+		changes.Track("package.json", "CHANGELOG.md", "charts/app/Chart.yaml", "docs/install.md")
+		orch.restoreFileSnapshot(ctx, snapshot, changes)
+		data, err := afero.ReadFile(fsRepo, "package.json")
+		require.NoError(t, err)
+		assert.Equal(t, `{"version":"1.1.0"}`, string(data))
+		exists, err := afero.Exists(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.False(t, exists)
+		gitRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,77 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/gha"
+	"github.com/spf13/afero"
+)
+
+const (
+	envGithubEventName       = "GITHUB_EVENT_NAME"
+	forkDryRunAnnotationName = "Release PR downgraded to dry run"
+)
+
+// forkPullRequest reports whether the run was triggered by a pull request event from a fork, and the
+// fork's name. GitHub gives those runs a read-only GITHUB_TOKEN, except for pull_request_target.
+func forkPullRequest(fsRepo afero.Fs) (string, bool) {
+	if os.Getenv(envGithubActions) != githubActionsTrue {
+		return "", false
+	}
+	event := os.Getenv(envGithubEventName)
+	if !strings.HasPrefix(event, gha.EventPullRequest) || event == gha.EventPullRequestTarget {
+		return "", false
+	}
+	data, err := readGitHubEventPayload(fsRepo, os.Getenv(envGithubEventPath))
+	if err != nil {
+		return "", false
+	}
+	payload, err := gha.ParsePullRequestEvent(data)
+	if err != nil {
+		return "", false
+	}
+	head := payload.PullRequest.Head.Repo
+	if head == nil {
+		// The fork was deleted after the PR was opened.
+		return "unknown fork", true
+	}
+	var base string
+	if repo := payload.PullRequest.Base.Repo; repo != nil {
+		base = repo.FullName
+	}
+	if strings.EqualFold(head.FullName, base) {
+		return "", false
+	}
+	return head.FullName, true
+}
+
+// guardForkPullRequest downgrades a run on a fork's pull request to a dry run, so it does not fail on
+// its first push with the read-only token. With strict enabled the run is refused instead. A rollback
+// is left as is.
+func (o *PRReleaseOrchestrator) guardForkPullRequest(
+	ctx context.Context,
+	cfg PRReleaseConfig,
+) (PRReleaseConfig, error) {
+	fork, ok := forkPullRequest(o.fsRepo)
+	if !ok || cfg.DryRun || cfg.Rollback {
+		return cfg, nil
+	}
+	if config.FromContext(ctx).Strict {
+		return cfg, stepFailed(stepNameValidateEnvironment, fmt.Errorf(
+			"refusing to run on a pull request from fork %s: its GITHUB_TOKEN is read-only, so the release "+
+				"branch cannot be pushed; run pr-release on push or workflow_dispatch, "+
+				"or disable strict to fall back to a dry run", fork))
+	}
+	notice := fmt.Sprintf("Pull request from fork %s has a read-only GITHUB_TOKEN; "+
+		"running as a dry run without commit, push or pull request.", fork)
+	o.logger(ctx).Warn(notice)
+	annotation := domain.Annotation{Level: domain.AnnotationNotice, Title: forkDryRunAnnotationName, Message: notice}
+	_, _ = fmt.Fprintln(o.annotations, annotation.String())
+	cfg.DryRun = true
+	return cfg, nil
+}
@@ -0,0 +1,102 @@
+package orchestrator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	forkEventPath    = "/home/runner/work/_temp/_github_workflow/event.json"
+	forkEventPayload = `{"pull_request":{"head":{"repo":{"full_name":"someone/releasepr"}},` +
+		`"base":{"repo":{"full_name":"compozy/releasepr"}}}}`
+)
+
+func setForkEvent(t *testing.T, fsRepo afero.Fs, event, payload string) {
+	t.Helper()
+	require.NoError(t, afero.WriteFile(fsRepo, forkEventPath, []byte(payload), 0o644))
+	t.Setenv("GITHUB_ACTIONS", "true")
+	t.Setenv("GITHUB_EVENT_NAME", event)
+	t.Setenv("GITHUB_EVENT_PATH", forkEventPath)
+}
+
+func TestForkPullRequest(t *testing.T) {
+	t.Run("Should detect pull requests from forks", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		setForkEvent(t, fsRepo, "pull_request", forkEventPayload)
+		fork, ok := forkPullRequest(fsRepo)
+		assert.True(t, ok)
+		assert.Equal(t, "someone/releasepr", fork)
+	})
+	t.Run("Should treat a deleted head repository as a fork", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		setForkEvent(t, fsRepo, "pull_request", `{"pull_request":{"head":{"repo":null}}}`)
+		_, ok := forkPullRequest(fsRepo)
+		assert.True(t, ok)
+	})
+	t.Run("Should ignore same-repository pull requests", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		payload := `{"pull_request":{"head":{"repo":{"full_name":"compozy/releasepr"}},` +
+			`"base":{"repo":{"full_name":"compozy/releasepr"}}}}`
+		setForkEvent(t, fsRepo, "pull_request", payload)
+		_, ok := forkPullRequest(fsRepo)
+		assert.False(t, ok)
+	})
+	t.Run("Should ignore pull_request_target and other events", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		setForkEvent(t, fsRepo, "pull_request_target", forkEventPayload)
+		_, ok := forkPullRequest(fsRepo)
+		assert.False(t, ok)
+		t.Setenv("GITHUB_EVENT_NAME", "push")
+		_, ok = forkPullRequest(fsRepo)
+		assert.False(t, ok)
+	})
+	t.Run("Should ignore runs outside GitHub Actions", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		setForkEvent(t, fsRepo, "pull_request", forkEventPayload)
+		t.Setenv("GITHUB_ACTIONS", "")
+		_, ok := forkPullRequest(fsRepo)
+		assert.False(t, ok)
+	})
+}
+
+func TestPRReleaseOrchestrator_guardForkPullRequest(t *testing.T) {
+	t.Run("Should downgrade fork pull requests to a dry run with a notice", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		var out bytes.Buffer
+		orch.annotations = &out
+		setForkEvent(t, fsRepo, "pull_request", forkEventPayload)
+		cfg, err := orch.guardForkPullRequest(ctx, PRReleaseConfig{})
+		require.NoError(t, err)
+		assert.True(t, cfg.DryRun)
+		assert.Contains(t, out.String(), "::notice title=Release PR downgraded to dry run::")
+		assert.Contains(t, out.String(), "someone/releasepr")
+	})
+	t.Run("Should refuse fork pull requests in strict mode", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.Strict = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		var out bytes.Buffer
+		orch.annotations = &out
+		setForkEvent(t, fsRepo, "pull_request", forkEventPayload)
+		_, err := orch.guardForkPullRequest(ctx, PRReleaseConfig{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "read-only")
+		var stepErr *StepError
+		require.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, stepNameValidateEnvironment, stepErr.Step)
+		assert.Empty(t, out.String())
+	})
+	t.Run("Should leave other runs untouched", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		cfg, err := orch.guardForkPullRequest(ctx, PRReleaseConfig{})
+		require.NoError(t, err)
+		assert.False(t, cfg.DryRun)
+	})
+}
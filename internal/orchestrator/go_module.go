@@ -0,0 +1,208 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+// Values for the go_module_major_bump setting.
+const (
+	GoModuleMajorBumpWarn    = "warn"
+	GoModuleMajorBumpFail    = "fail"
+	GoModuleMajorBumpRewrite = "rewrite"
+	GoModuleMajorBumpIgnore  = "ignore"
+)
+
+const goModFile = "go.mod"
+
+// goModulePathChange is the module path rename a release version requires.
+type goModulePathChange struct {
+	from string
+	to   string
+}
+
+// goModuleMajorBumpMode returns the configured go_module_major_bump mode, defaulting to warn.
+func goModuleMajorBumpMode(ctx context.Context) string {
+	mode := strings.ToLower(strings.TrimSpace(config.FromContext(ctx).GoModuleMajorBump))
+	if mode == "" {
+		return GoModuleMajorBumpWarn
+	}
+	return mode
+}
+
+// goModulePathChange reports the module path rename needed when version bumps the major version of
+// latestTag, or nil when the root go.mod is absent or already matches the major version. Releases
+// within a major version never require a rename, so modules that stayed on a path without the /vN
+// suffix keep releasing patches and minors.
+func (o *PRReleaseOrchestrator) goModulePathChange(version, latestTag string) (*goModulePathChange, error) {
+	ver, err := domain.NewVersion(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse version: %w", err)
+	}
+	if latest, err := domain.NewVersion(latestTag); err == nil && latest.Major() >= ver.Major() {
+		return nil, nil
+	}
+	content, err := readOptionalFile(o.fsRepo, goModFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", goModFile, err)
+	}
+	if content == "" {
+		return nil, nil
+	}
+	modulePath, err := domain.ParseGoModulePath(content)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := domain.GoModulePathForMajor(modulePath, ver.Major())
+	if err != nil {
+		return nil, err
+	}
+	if expected == modulePath {
+		return nil, nil
+	}
+	return &goModulePathChange{from: modulePath, to: expected}, nil
+}
+
+// checkGoModuleMajor flags a major bump from latestTag that go.mod does not reflect. Tagging v2+
+// without the /vN module suffix makes the release unusable for Go consumers, so it is reported as a
+// warning, or stops the release when go_module_major_bump is fail.
+func (o *PRReleaseOrchestrator) checkGoModuleMajor(ctx context.Context, version, latestTag string) error {
+	mode := goModuleMajorBumpMode(ctx)
+	if mode == GoModuleMajorBumpIgnore {
+		return nil
+	}
+	change, err := o.goModulePathChange(version, latestTag)
+	if err != nil || change == nil {
+		return err
+	}
+	switch mode {
+	case GoModuleMajorBumpRewrite:
+		o.logger(ctx).Info("Release requires a new Go module path",
+			zap.String("version", version),
+			zap.String("from", change.from),
+			zap.String("to", change.to),
+		)
+		return nil
+	case GoModuleMajorBumpWarn:
+		o.logger(ctx).Warn("Release requires a new Go module path; rename the module and its imports, "+
+			"or set go_module_major_bump to rewrite",
+			zap.String("version", version),
+			zap.String("from", change.from),
+			zap.String("to", change.to),
+		)
+		return nil
+	}
+	return fmt.Errorf(
+		"release %s requires Go module path %s but go.mod declares %s; "+
+			"rename the module and its imports, or set go_module_major_bump to %q (or %q to release anyway)",
+		version, change.to, change.from, GoModuleMajorBumpRewrite, GoModuleMajorBumpIgnore,
+	)
+}
+
+// updateGoModulePath renames the module in go.mod and rewrites its imports in every Go file of the
+// module when version bumps the major version of latestTag and go_module_major_bump is rewrite.
+// Vendored code, testdata and nested modules are left alone.
+func (o *PRReleaseOrchestrator) updateGoModulePath(
+	ctx context.Context,
+	version, latestTag string,
+) ([]string, error) {
+	if goModuleMajorBumpMode(ctx) != GoModuleMajorBumpRewrite {
+		return nil, nil
+	}
+	change, err := o.goModulePathChange(version, latestTag)
+	if err != nil || change == nil {
+		return nil, err
+	}
+	var files []string
+	err = afero.Walk(o.fsRepo, ".", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return o.skipGoModuleDir(path, info.Name())
+		}
+		if path != goModFile && !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		changed, err := o.rewriteGoModuleFile(path, change)
+		if err != nil {
+			return err
+		}
+		if changed {
+			files = append(files, filepath.ToSlash(path))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to rewrite Go module path: %w", err)
+	}
+	o.logger(ctx).Info("Rewrote Go module path",
+		zap.String("from", change.from),
+		zap.String("to", change.to),
+		zap.Int("files", len(files)),
+	)
+	return files, nil
+}
+
+// skipGoModuleDir skips directories that do not belong to the root module's own sources.
+func (o *PRReleaseOrchestrator) skipGoModuleDir(path, name string) error {
+	if path == "." {
+		return nil
+	}
+	if name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") {
+		return filepath.SkipDir
+	}
+	nested, err := afero.Exists(o.fsRepo, filepath.Join(path, goModFile))
+	if err != nil {
+		return err
+	}
+	if nested {
+		return filepath.SkipDir
+	}
+	return nil
+}
+
+// rewriteGoModuleFile rewrites the module directive of go.mod or the quoted import paths of a Go file.
+func (o *PRReleaseOrchestrator) rewriteGoModuleFile(path string, change *goModulePathChange) (bool, error) {
+	data, err := afero.ReadFile(o.fsRepo, path)
+	if err != nil {
+		return false, err
+	}
+	content := string(data)
+	var rewritten string
+	if path == goModFile {
+		rewritten = rewriteGoModuleDirective(content, change)
+	} else {
+		rewritten = strings.NewReplacer(
+			`"`+change.from+`"`, `"`+change.to+`"`,
+			`"`+change.from+`/`, `"`+change.to+`/`,
+		).Replace(content)
+	}
+	if rewritten == content {
+		return false, nil
+	}
+	if err := afero.WriteFile(o.fsRepo, path, []byte(rewritten), FilePermissionsReadWrite); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func rewriteGoModuleDirective(content string, change *goModulePathChange) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "module" {
+			lines[i] = strings.Replace(line, change.from, change.to, 1)
+			break
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,144 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGoModuleOrchestrator(t *testing.T) (*PRReleaseOrchestrator, afero.Fs) {
+	t.Helper()
+	fsRepo := afero.NewMemMapFs()
+	files := map[string]string{
+		"go.mod":                   "module github.com/compozy/widgets\n\ngo 1.25\n",
+		"main.go":                  "package main\n\nimport \"github.com/compozy/widgets/internal/app\"\n",
+		"internal/app/app.go":      "package app\n\nimport \"github.com/compozy/widgetsextra\"\n",
+		"vendor/x/x.go":            "package x\n\nimport \"github.com/compozy/widgets/internal/app\"\n",
+		"tools/go.mod":             "module github.com/compozy/widgets/tools\n",
+		"tools/main.go":            "package main\n\nimport \"github.com/compozy/widgets/internal/app\"\n",
+		"docs/usage.md":            "go get github.com/compozy/widgets\n",
+		"internal/app/app_test.go": "package app\n\nimport _ \"github.com/compozy/widgets\"\n",
+	}
+	for path, content := range files {
+		require.NoError(t, afero.WriteFile(fsRepo, path, []byte(content), 0o644))
+	}
+	orch := NewPRReleaseOrchestrator(
+		new(mockGitExtendedRepository),
+		new(mockGithubExtendedRepository),
+		fsRepo,
+		new(mockCliffService),
+		new(mockNpmService),
+	)
+	return orch, fsRepo
+}
+
+func TestPRReleaseOrchestrator_checkGoModuleMajor(t *testing.T) {
+	t.Run("Should fail with guidance when a release crosses a major boundary", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.GoModuleMajorBump = GoModuleMajorBumpFail
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newTestGoModuleOrchestrator(t)
+		err := orch.checkGoModuleMajor(ctx, "v2.0.0", "v1.9.0")
+		assert.ErrorContains(t, err, "requires Go module path github.com/compozy/widgets/v2")
+		assert.ErrorContains(t, err, `set go_module_major_bump to "rewrite"`)
+	})
+	t.Run("Should only warn about a major bump by default", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newTestGoModuleOrchestrator(t)
+		assert.NoError(t, orch.checkGoModuleMajor(ctx, "v2.0.0", "v1.9.0"))
+	})
+	t.Run("Should accept versions that match the module path", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.GoModuleMajorBump = GoModuleMajorBumpFail
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newTestGoModuleOrchestrator(t)
+		assert.NoError(t, orch.checkGoModuleMajor(ctx, "v1.9.0", "v1.8.0"))
+	})
+	t.Run("Should accept releases within a major version the module path never followed", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.GoModuleMajorBump = GoModuleMajorBumpFail
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newTestGoModuleOrchestrator(t)
+		assert.NoError(t, orch.checkGoModuleMajor(ctx, "v2.3.1", "v2.3.0"))
+		assert.NoError(t, orch.checkGoModuleMajor(ctx, "v2.4.0", "v2.3.1"))
+	})
+	t.Run("Should fail on a first release past v1 without the module suffix", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.GoModuleMajorBump = GoModuleMajorBumpFail
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newTestGoModuleOrchestrator(t)
+		assert.ErrorContains(t, orch.checkGoModuleMajor(ctx, "v2.0.0", ""), "requires Go module path")
+	})
+	t.Run("Should allow the bump when configured to rewrite or ignore", func(t *testing.T) {
+		for _, mode := range []string{GoModuleMajorBumpRewrite, GoModuleMajorBumpIgnore} {
+			cfg := testReleaseConfig()
+			cfg.GoModuleMajorBump = mode
+			ctx := testReleaseContextWithConfig(t, cfg)
+			orch, _ := newTestGoModuleOrchestrator(t)
+			assert.NoError(t, orch.checkGoModuleMajor(ctx, "v2.0.0", "v1.9.0"), mode)
+		}
+	})
+	t.Run("Should ignore repositories without go.mod", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.GoModuleMajorBump = GoModuleMajorBumpFail
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			afero.NewMemMapFs(),
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		assert.NoError(t, orch.checkGoModuleMajor(ctx, "v2.0.0", "v1.9.0"))
+	})
+}
+
+func TestPRReleaseOrchestrator_updateGoModulePath(t *testing.T) {
+	t.Run("Should rewrite go.mod and the module's own imports", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.GoModuleMajorBump = GoModuleMajorBumpRewrite
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, fsRepo := newTestGoModuleOrchestrator(t)
+		files, err := orch.updateGoModulePath(ctx, "v2.0.0", "v1.9.0")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"go.mod", "main.go", "internal/app/app_test.go"}, files)
+		assertFileContent(t, fsRepo, "go.mod", "module github.com/compozy/widgets/v2\n\ngo 1.25\n")
+		assertFileContent(t, fsRepo, "main.go",
+			"package main\n\nimport \"github.com/compozy/widgets/v2/internal/app\"\n")
+		assertFileContent(t, fsRepo, "internal/app/app_test.go",
+			"package app\n\nimport _ \"github.com/compozy/widgets/v2\"\n")
+		assertFileContent(t, fsRepo, "internal/app/app.go",
+			"package app\n\nimport \"github.com/compozy/widgetsextra\"\n")
+		assertFileContent(t, fsRepo, "vendor/x/x.go",
+			"package x\n\nimport \"github.com/compozy/widgets/internal/app\"\n")
+		assertFileContent(t, fsRepo, "tools/main.go",
+			"package main\n\nimport \"github.com/compozy/widgets/internal/app\"\n")
+	})
+	t.Run("Should leave the module path alone within a major version", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.GoModuleMajorBump = GoModuleMajorBumpRewrite
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, fsRepo := newTestGoModuleOrchestrator(t)
+		files, err := orch.updateGoModulePath(ctx, "v2.3.1", "v2.3.0")
+		require.NoError(t, err)
+		assert.Empty(t, files)
+		assertFileContent(t, fsRepo, "go.mod", "module github.com/compozy/widgets\n\ngo 1.25\n")
+	})
+	t.Run("Should leave files untouched unless configured to rewrite", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newTestGoModuleOrchestrator(t)
+		files, err := orch.updateGoModulePath(ctx, "v2.0.0", "v1.9.0")
+		require.NoError(t, err)
+		assert.Empty(t, files)
+		assertFileContent(t, fsRepo, "go.mod", "module github.com/compozy/widgets\n\ngo 1.25\n")
+	})
+}
+
+func assertFileContent(t *testing.T, fsRepo afero.Fs, path, expected string) {
+	t.Helper()
+	data, err := afero.ReadFile(fsRepo, path)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(data), path)
+}
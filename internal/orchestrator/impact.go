@@ -0,0 +1,174 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// ImpactConfig contains configuration for the release impact analysis.
+type ImpactConfig struct {
+	Version    string // Released version the downstream repositories are compared with, e.g. v1.4.0
+	FileIssues bool   // Open or update a tracking issue in every downstream repository that should update
+}
+
+// ImpactOrchestrator finds the downstream repositories of downstream_repos that use the released
+// package, so the release can be followed by their updates.
+type ImpactOrchestrator struct {
+	githubRepo repository.GithubExtendedRepository
+	fsRepo     repository.FileSystemRepository
+}
+
+// NewImpactOrchestrator creates a new ImpactOrchestrator.
+func NewImpactOrchestrator(
+	githubRepo repository.GithubExtendedRepository,
+	fsRepo repository.FileSystemRepository,
+) *ImpactOrchestrator {
+	return &ImpactOrchestrator{
+		githubRepo: githubRepo,
+		fsRepo:     fsRepo,
+	}
+}
+
+func (o *ImpactOrchestrator) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx).Named("orchestrator.impact")
+}
+
+// Execute looks up the released package in every downstream repository and returns the repositories
+// that use it, in configuration order. A repository with a manifest is outdated when the manifest pins
+// a lower version than cfg.Version; one searched with code search is always listed as outdated, as its
+// version is unknown. A repository that cannot be checked does not stop the others: its error is
+// returned along with the usages found.
+func (o *ImpactOrchestrator) Execute(ctx context.Context, cfg ImpactConfig) (_ []domain.DownstreamUsage, err error) {
+	ctx, span := telemetry.Start(ctx, "impact", attribute.String("release.version", cfg.Version))
+	defer func() { telemetry.End(span, err) }()
+	ctx, cancel := context.WithTimeout(ctx, DefaultWorkflowTimeout)
+	defer cancel()
+	released, err := domain.NewVersion(cfg.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", cfg.Version, err)
+	}
+	if cfg.FileIssues {
+		if err := ValidateAllowedRepository(ctx); err != nil {
+			return nil, err
+		}
+	}
+	pkg, err := o.releasedPackage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log := o.logger(ctx).With(zap.String("package", pkg))
+	var usages []domain.DownstreamUsage
+	var errs []error
+	for _, downstream := range config.FromContext(ctx).DownstreamRepos {
+		usage, found, err := o.downstreamUsage(ctx, downstream, pkg, released)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", downstream.Repo, err))
+			continue
+		}
+		if !found {
+			log.Info("Downstream repository does not use the package", zap.String("downstream", downstream.Repo))
+			continue
+		}
+		if cfg.FileIssues && usage.Outdated {
+			usage.Issue, err = o.fileTrackingIssue(ctx, downstream.Repo, pkg, cfg.Version, usage)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", downstream.Repo, err))
+			}
+		}
+		usages = append(usages, usage)
+	}
+	return usages, errors.Join(errs...)
+}
+
+// releasedPackage returns impact_package, or the module path of the root go.mod when it is not set.
+func (o *ImpactOrchestrator) releasedPackage(ctx context.Context) (string, error) {
+	if pkg := strings.TrimSpace(config.FromContext(ctx).ImpactPackage); pkg != "" {
+		return pkg, nil
+	}
+	content, err := readOptionalFile(o.fsRepo, goModFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", goModFile, err)
+	}
+	if content == "" {
+		return "", fmt.Errorf("impact_package is not set and there is no %s to read the module path from", goModFile)
+	}
+	return domain.ParseGoModulePath(content)
+}
+
+// downstreamUsage reads how downstream uses pkg, from its manifest or with code search, and reports
+// false when it does not use it.
+func (o *ImpactOrchestrator) downstreamUsage(
+	ctx context.Context,
+	downstream config.DownstreamRepoConfig,
+	pkg string,
+	released *domain.Version,
+) (domain.DownstreamUsage, bool, error) {
+	githubRepo := o.downstreamRepository(downstream.Repo)
+	usage := domain.DownstreamUsage{Repo: downstream.Repo, Outdated: true}
+	if downstream.Manifest == "" {
+		paths, err := githubRepo.SearchCode(ctx, fmt.Sprintf("%q", pkg))
+		if err != nil {
+			return usage, false, err
+		}
+		usage.Files = paths
+		return usage, len(paths) > 0, nil
+	}
+	content, exists, err := githubRepo.FileContent(ctx, downstream.Manifest)
+	if err != nil || !exists {
+		return usage, false, err
+	}
+	version, referenced := domain.PinnedVersion(content, pkg)
+	if !referenced {
+		return usage, false, nil
+	}
+	usage.Files = []string{downstream.Manifest}
+	usage.Version = version
+	if pinned, err := domain.NewVersion(version); err == nil {
+		usage.Outdated = pinned.Compare(released) < 0
+	}
+	return usage, true, nil
+}
+
+// downstreamRepository returns the GitHub repository of an owner/name slug of downstream_repos.
+func (o *ImpactOrchestrator) downstreamRepository(repo string) repository.GithubExtendedRepository {
+	owner, name, _ := strings.Cut(repo, "/")
+	return o.githubRepo.ForRepository(strings.TrimSpace(owner), strings.TrimSpace(name))
+}
+
+// fileTrackingIssue opens or updates the issue asking repo to update pkg to version.
+func (o *ImpactOrchestrator) fileTrackingIssue(
+	ctx context.Context,
+	repo, pkg, version string,
+	usage domain.DownstreamUsage,
+) (int, error) {
+	appConfig := config.FromContext(ctx)
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s/%s %s](%s) was released", appConfig.GithubOwner, appConfig.GithubRepo, version,
+		domain.ReleaseURL(appConfig.GithubOwner, appConfig.GithubRepo, version))
+	if usage.Version != "" {
+		fmt.Fprintf(&b, "; this repository uses `%s` %s", pkg, usage.Version)
+	} else {
+		fmt.Fprintf(&b, "; this repository references `%s`", pkg)
+	}
+	b.WriteString(".\n\nFiles to update:\n\n")
+	for _, file := range usage.Files {
+		fmt.Fprintf(&b, "- `%s`\n", file)
+	}
+	title := fmt.Sprintf("Update %s to %s", pkg, version)
+	number, err := o.downstreamRepository(repo).CreateOrUpdateIssue(ctx, title, b.String(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to file tracking issue: %w", err)
+	}
+	o.logger(ctx).Info("Filed tracking issue", zap.String("downstream", repo), zap.Int("issue_number", number))
+	return number, nil
+}
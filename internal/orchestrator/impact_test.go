@@ -0,0 +1,96 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpactOrchestrator_Execute(t *testing.T) {
+	const goMod = "module github.com/compozy/releasepr\n\ngo 1.25\n"
+	t.Run("Should list the downstream repositories that use an older version", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.DownstreamRepos = []config.DownstreamRepoConfig{
+			{Repo: "compozy/cli", Manifest: "go.mod"},
+			{Repo: "compozy/agent", Manifest: "go.mod"},
+			{Repo: "compozy/docs"},
+			{Repo: "compozy/web"},
+		}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, "go.mod", []byte(goMod), 0o644))
+		cli, agent, docs, web := new(mockGithubExtendedRepository), new(mockGithubExtendedRepository),
+			new(mockGithubExtendedRepository), new(mockGithubExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("ForRepository", "compozy", "cli").Return(cli)
+		githubRepo.On("ForRepository", "compozy", "agent").Return(agent)
+		githubRepo.On("ForRepository", "compozy", "docs").Return(docs)
+		githubRepo.On("ForRepository", "compozy", "web").Return(web)
+		cli.On("FileContent", mock.Anything, "go.mod").
+			Return("require github.com/compozy/releasepr v1.1.0\n", true, nil).Once()
+		agent.On("FileContent", mock.Anything, "go.mod").
+			Return("require github.com/compozy/releasepr v1.2.0\n", true, nil).Once()
+		docs.On("SearchCode", mock.Anything, `"github.com/compozy/releasepr"`).
+			Return([]string{"examples/main.go"}, nil).Once()
+		web.On("SearchCode", mock.Anything, `"github.com/compozy/releasepr"`).Return(nil, nil).Once()
+		orch := NewImpactOrchestrator(githubRepo, fsRepo)
+		usages, err := orch.Execute(ctx, ImpactConfig{Version: "v1.2.0"})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.DownstreamUsage{
+			{Repo: "compozy/cli", Files: []string{"go.mod"}, Version: "v1.1.0", Outdated: true},
+			{Repo: "compozy/agent", Files: []string{"go.mod"}, Version: "v1.2.0"},
+			{Repo: "compozy/docs", Files: []string{"examples/main.go"}, Outdated: true},
+		}, usages)
+	})
+	t.Run("Should file tracking issues and keep checking after a failing repository", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ImpactPackage = "@compozy/sdk"
+		cfg.DownstreamRepos = []config.DownstreamRepoConfig{
+			{Repo: "compozy/private", Manifest: "package.json"},
+			{Repo: "compozy/web", Manifest: "package.json"},
+		}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		private, web := new(mockGithubExtendedRepository), new(mockGithubExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("ForRepository", "compozy", "private").Return(private)
+		githubRepo.On("ForRepository", "compozy", "web").Return(web)
+		private.On("FileContent", mock.Anything, "package.json").Return("", false, errors.New("forbidden")).Once()
+		web.On("FileContent", mock.Anything, "package.json").
+			Return(`{"dependencies": {"@compozy/sdk": "^1.1.0"}}`, true, nil).Once()
+		var body string
+		web.On("CreateOrUpdateIssue", mock.Anything, "Update @compozy/sdk to v1.2.0", mock.Anything, []string(nil)).
+			Run(func(args mock.Arguments) { body = args.String(2) }).
+			Return(9, nil).Once()
+		orch := NewImpactOrchestrator(githubRepo, afero.NewMemMapFs())
+		usages, err := orch.Execute(ctx, ImpactConfig{Version: "v1.2.0", FileIssues: true})
+		require.ErrorContains(t, err, "compozy/private: forbidden")
+		require.Len(t, usages, 1)
+		assert.Equal(t, 9, usages[0].Issue)
+		assert.Contains(t, body, "this repository uses `@compozy/sdk` 1.1.0")
+		assert.Contains(t, body, "- `package.json`")
+		web.AssertExpectations(t)
+	})
+	t.Run("Should require a package when there is no go.mod", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch := NewImpactOrchestrator(new(mockGithubExtendedRepository), afero.NewMemMapFs())
+		_, err := orch.Execute(ctx, ImpactConfig{Version: "v1.2.0"})
+		require.ErrorContains(t, err, "impact_package is not set")
+	})
+	t.Run("Should refuse to file issues for a repository outside allowed_repositories", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.AllowedRepositories = []string{"compozy/other"}
+		cfg.DownstreamRepos = []config.DownstreamRepoConfig{{Repo: "compozy/cli", Manifest: "go.mod"}}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch := NewImpactOrchestrator(githubRepo, afero.NewMemMapFs())
+		_, err := orch.Execute(ctx, ImpactConfig{Version: "v1.2.0", FileIssues: true})
+		require.ErrorContains(t, err, "not in allowed_repositories")
+		githubRepo.AssertNotCalled(t, "ForRepository", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,60 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+const (
+	mavenPOMFile         = "pom.xml"
+	gradlePropertiesFile = "gradle.properties"
+)
+
+// jvmVersionFile pairs a root build file with the function rewriting its version.
+type jvmVersionFile struct {
+	path    string
+	rewrite func(content, version string) (string, bool, error)
+}
+
+var jvmVersionFiles = []jvmVersionFile{
+	{path: mavenPOMFile, rewrite: domain.SetMavenProjectVersion},
+	{path: gradlePropertiesFile, rewrite: domain.SetGradlePropertiesVersion},
+}
+
+// updateJVMVersions bumps the project version of the root pom.xml and gradle.properties when present
+// and returns the files it wrote. A file that declares no version of its own is left alone.
+func (o *PRReleaseOrchestrator) updateJVMVersions(ctx context.Context, version string) ([]string, error) {
+	versionWithoutV := strings.TrimPrefix(version, "v")
+	var files []string
+	for _, file := range jvmVersionFiles {
+		content, err := readOptionalFile(o.fsRepo, file.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read root %s: %w", file.path, err)
+		}
+		if content == "" {
+			continue
+		}
+		updated, found, err := file.rewrite(content, versionWithoutV)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			o.logger(ctx).Warn("Root build file declares no version; leaving it unchanged",
+				zap.String("file", file.path))
+			continue
+		}
+		if updated == content {
+			continue
+		}
+		if err := afero.WriteFile(o.fsRepo, file.path, []byte(updated), FilePermissionsReadWrite); err != nil {
+			return nil, fmt.Errorf("failed to write root %s: %w", file.path, err)
+		}
+		files = append(files, file.path)
+	}
+	return files, nil
+}
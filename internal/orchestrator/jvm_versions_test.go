@@ -0,0 +1,51 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRReleaseOrchestrator_updateJVMVersions(t *testing.T) {
+	t.Run("Should bump pom.xml and gradle.properties and report both", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		pom := "<project>\n  <artifactId>billing</artifactId>\n  <version>1.1.0</version>\n</project>\n"
+		require.NoError(t, afero.WriteFile(fsRepo, "pom.xml", []byte(pom), 0644))
+		require.NoError(t, afero.WriteFile(fsRepo, "gradle.properties", []byte("version=1.1.0\n"), 0644))
+
+		files, err := orch.updatePackageVersions(ctx, "v1.2.0", "v1.1.0")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pom.xml", "gradle.properties"}, files)
+		data, err := afero.ReadFile(fsRepo, "pom.xml")
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "  <version>1.2.0</version>\n")
+		data, err = afero.ReadFile(fsRepo, "gradle.properties")
+		require.NoError(t, err)
+		assert.Equal(t, "version=1.2.0\n", string(data))
+	})
+
+	t.Run("Should leave build files without a version untouched", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, afero.WriteFile(fsRepo, "gradle.properties", []byte("org.gradle.caching=true\n"), 0644))
+
+		files, err := orch.updateJVMVersions(ctx, "v1.2.0")
+
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+
+	t.Run("Should fail on a malformed pom.xml", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, afero.WriteFile(fsRepo, "pom.xml", []byte("<project><version>"), 0644))
+
+		_, err := orch.updateJVMVersions(ctx, "v1.2.0")
+
+		assert.ErrorContains(t, err, "failed to parse pom.xml")
+	})
+}
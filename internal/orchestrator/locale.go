@@ -0,0 +1,38 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+)
+
+// releaseLocale returns the configured locale of generated release notes, defaulting to English,
+// extended with the translations of release_locale_file, such as the names of custom git-cliff groups.
+func releaseLocale(ctx context.Context, fsRepo afero.Fs) (domain.Locale, error) {
+	cfg := config.FromContext(ctx)
+	tag := strings.TrimSpace(cfg.ReleaseLocale)
+	if tag == "" {
+		tag = domain.DefaultLocale
+	}
+	locale, err := domain.LoadLocale(tag)
+	if err != nil {
+		return domain.Locale{}, err
+	}
+	path := strings.TrimSpace(cfg.ReleaseLocaleFile)
+	if path == "" {
+		return locale, nil
+	}
+	data, err := afero.ReadFile(fsRepo, path)
+	if err != nil {
+		return domain.Locale{}, fmt.Errorf("failed to read release_locale_file %s: %w", path, err)
+	}
+	messages, err := domain.ParseLocaleMessages(data)
+	if err != nil {
+		return domain.Locale{}, fmt.Errorf("invalid release_locale_file %s: %w", path, err)
+	}
+	return locale.WithMessages(messages), nil
+}
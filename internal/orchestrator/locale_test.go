@@ -0,0 +1,65 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseLocale(t *testing.T) {
+	t.Run("Should default to English", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseLocale = ""
+		locale, err := releaseLocale(testReleaseContextWithConfig(t, cfg), afero.NewMemMapFs())
+		require.NoError(t, err)
+		assert.Equal(t, "en", locale.Tag)
+	})
+	t.Run("Should extend the locale with the repository locale file", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseLocale = "pt-BR"
+		cfg.ReleaseLocaleFile = ".github/locale.yaml"
+		fsRepo := afero.NewMemMapFs()
+		content := []byte("Features: Novidades\nInfrastructure: Infraestrutura\n")
+		require.NoError(t, afero.WriteFile(fsRepo, ".github/locale.yaml", content, FilePermissionsReadWrite))
+		locale, err := releaseLocale(testReleaseContextWithConfig(t, cfg), fsRepo)
+		require.NoError(t, err)
+		assert.Equal(t, "Novidades", locale.T("Features"))
+		assert.Equal(t, "Infraestrutura", locale.T("Infrastructure"))
+		assert.Equal(t, "Correções de bugs", locale.T("Bug Fixes"))
+	})
+	t.Run("Should fail on a missing or invalid locale file", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseLocaleFile = "locale.yaml"
+		fsRepo := afero.NewMemMapFs()
+		_, err := releaseLocale(testReleaseContextWithConfig(t, cfg), fsRepo)
+		require.ErrorContains(t, err, "failed to read release_locale_file locale.yaml")
+		require.NoError(t, afero.WriteFile(fsRepo, "locale.yaml", []byte("- Features\n"), FilePermissionsReadWrite))
+		_, err = releaseLocale(testReleaseContextWithConfig(t, cfg), fsRepo)
+		require.ErrorContains(t, err, "invalid release_locale_file locale.yaml")
+	})
+}
+
+func TestPRReleaseOrchestrator_generateChangelogLocale(t *testing.T) {
+	t.Run("Should translate the headings of the changelog and release notes", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseLocale = "es"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		changelog := "## v1.1.0\n\n### 🐛 Bug Fixes\n- Fix the parser"
+		cliffSvc := new(mockCliffService)
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.1.0", "release").Return(changelog, nil).Once()
+		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.1.0").Return("# Changelog\n\n"+changelog, nil).Once()
+		orch := NewPRReleaseOrchestrator(new(mockGitExtendedRepository), new(mockGithubExtendedRepository), fsRepo,
+			cliffSvc, new(mockNpmService))
+		artifacts, err := orch.generateChangelog(ctx, "v1.1.0", "v1.0.0", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "## v1.1.0\n\n### 🐛 Corrección de errores\n- Fix the parser", artifacts.changelog)
+		fullChangelog, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Registro de cambios\n\n"+artifacts.changelog, string(fullChangelog))
+		cliffSvc.AssertExpectations(t)
+	})
+}
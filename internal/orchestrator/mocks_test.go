@@ -2,8 +2,11 @@ package orchestrator
 
 import (
 	"context"
+	"time"
 
 	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/service"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -15,14 +18,49 @@ func (m *mockGitExtendedRepository) LatestTag(ctx context.Context) (string, erro
 	args := m.Called(ctx)
 	return args.String(0), args.Error(1)
 }
+func (m *mockGitExtendedRepository) CommitMessagesSinceTag(ctx context.Context, tag string) ([]string, error) {
+	args := m.Called(ctx, tag)
+	messages, _ := args.Get(0).([]string)
+	return messages, args.Error(1)
+}
 func (m *mockGitExtendedRepository) CommitsSinceTag(ctx context.Context, tag string) (int, error) {
 	args := m.Called(ctx, tag)
 	return args.Int(0), args.Error(1)
 }
+func (m *mockGitExtendedRepository) CommitSubjectsSinceTag(ctx context.Context, tag string) ([]string, error) {
+	args := m.Called(ctx, tag)
+	if subjects := args.Get(0); subjects != nil {
+		return subjects.([]string), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
 func (m *mockGitExtendedRepository) TagExists(ctx context.Context, tag string) (bool, error) {
 	args := m.Called(ctx, tag)
 	return args.Bool(0), args.Error(1)
 }
+func (m *mockGitExtendedRepository) TagCommit(ctx context.Context, tag string) (string, error) {
+	args := m.Called(ctx, tag)
+	return args.String(0), args.Error(1)
+}
+func (m *mockGitExtendedRepository) ReleaseTags(ctx context.Context) ([]domain.ReleaseTag, error) {
+	args := m.Called(ctx)
+	if tags := args.Get(0); tags != nil {
+		return tags.([]domain.ReleaseTag), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+func (m *mockGitExtendedRepository) TagAnnotation(ctx context.Context, tag string) (string, bool, error) {
+	args := m.Called(ctx, tag)
+	return args.String(0), args.Bool(1), args.Error(2)
+}
+func (m *mockGitExtendedRepository) CreateTagForce(ctx context.Context, tag, commit, msg string) error {
+	args := m.Called(ctx, tag, commit, msg)
+	return args.Error(0)
+}
+func (m *mockGitExtendedRepository) PushTagForce(ctx context.Context, tag string) error {
+	args := m.Called(ctx, tag)
+	return args.Error(0)
+}
 func (m *mockGitExtendedRepository) CreateBranch(ctx context.Context, name string) error {
 	args := m.Called(ctx, name)
 	return args.Error(0)
@@ -77,6 +115,10 @@ func (m *mockGitExtendedRepository) MoveFile(ctx context.Context, from, to strin
 	args := m.Called(ctx, from, to)
 	return args.Error(0)
 }
+func (m *mockGitExtendedRepository) RemoveFile(ctx context.Context, path string) error {
+	args := m.Called(ctx, path)
+	return args.Error(0)
+}
 func (m *mockGitExtendedRepository) RestoreFile(ctx context.Context, path string) error {
 	args := m.Called(ctx, path)
 	return args.Error(0)
@@ -85,6 +127,10 @@ func (m *mockGitExtendedRepository) ResetHard(ctx context.Context, ref string) e
 	args := m.Called(ctx, ref)
 	return args.Error(0)
 }
+func (m *mockGitExtendedRepository) SyncBranch(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
 func (m *mockGitExtendedRepository) GetHeadCommit(ctx context.Context) (string, error) {
 	args := m.Called(ctx)
 	return args.String(0), args.Error(1)
@@ -96,8 +142,8 @@ func (m *mockGitExtendedRepository) ListLocalBranches(ctx context.Context) ([]st
 	}
 	return nil, args.Error(1)
 }
-func (m *mockGitExtendedRepository) ListRemoteBranches(ctx context.Context) ([]string, error) {
-	args := m.Called(ctx)
+func (m *mockGitExtendedRepository) ListRemoteBranches(ctx context.Context, prefix string) ([]string, error) {
+	args := m.Called(ctx, prefix)
 	if branches := args.Get(0); branches != nil {
 		return branches.([]string), args.Error(1)
 	}
@@ -111,6 +157,36 @@ func (m *mockGitExtendedRepository) GetFileStatus(ctx context.Context, path stri
 	args := m.Called(ctx, path)
 	return args.String(0), args.Error(1)
 }
+func (m *mockGitExtendedRepository) IsSyncedWithBase(ctx context.Context, base string) (bool, error) {
+	args := m.Called(ctx, base)
+	return args.Bool(0), args.Error(1)
+}
+func (m *mockGitExtendedRepository) RebaseOntoBase(ctx context.Context, base string) error {
+	args := m.Called(ctx, base)
+	return args.Error(0)
+}
+func (m *mockGitExtendedRepository) HeadCommitDiff(ctx context.Context) ([]domain.FileDiffStat, error) {
+	args := m.Called(ctx)
+	stats, _ := args.Get(0).([]domain.FileDiffStat)
+	return stats, args.Error(1)
+}
+
+func (m *mockGitExtendedRepository) SubmoduleUpdates(ctx context.Context, tag string) (domain.SubmoduleUpdates, error) {
+	args := m.Called(ctx, tag)
+	updates, _ := args.Get(0).(domain.SubmoduleUpdates)
+	return updates, args.Error(1)
+}
+
+func (m *mockGitExtendedRepository) BumpSubmodules(ctx context.Context) (domain.SubmoduleUpdates, error) {
+	args := m.Called(ctx)
+	updates, _ := args.Get(0).(domain.SubmoduleUpdates)
+	return updates, args.Error(1)
+}
+
+func (m *mockGitExtendedRepository) ReleaseStats(ctx context.Context, tag string) (domain.ReleaseStats, error) {
+	args := m.Called(ctx, tag)
+	return args.Get(0).(domain.ReleaseStats), args.Error(1)
+}
 
 // Mock for GithubExtendedRepository
 type mockGithubExtendedRepository struct{ mock.Mock }
@@ -127,9 +203,9 @@ func (m *mockGithubExtendedRepository) CreateOrUpdatePR(
 	ctx context.Context,
 	head, base, title, body string,
 	labels []string,
-) error {
+) (domain.PullRequestRef, error) {
 	args := m.Called(ctx, head, base, title, body, labels)
-	return args.Error(0)
+	return args.Get(0).(domain.PullRequestRef), args.Error(1)
 }
 func (m *mockGithubExtendedRepository) AddComment(ctx context.Context, prNumber int, body string) error {
 	args := m.Called(ctx, prNumber, body)
@@ -143,6 +219,126 @@ func (m *mockGithubExtendedRepository) GetPRStatus(ctx context.Context, prNumber
 	args := m.Called(ctx, prNumber)
 	return args.String(0), args.Error(1)
 }
+func (m *mockGithubExtendedRepository) UploadReleaseAsset(ctx context.Context, tag, path string) error {
+	args := m.Called(ctx, tag, path)
+	return args.Error(0)
+}
+func (m *mockGithubExtendedRepository) AppendReleaseNotes(ctx context.Context, tag, markdown string) error {
+	args := m.Called(ctx, tag, markdown)
+	return args.Error(0)
+}
+func (m *mockGithubExtendedRepository) MarkSecurityRelease(ctx context.Context, tag string) error {
+	args := m.Called(ctx, tag)
+	return args.Error(0)
+}
+func (m *mockGithubExtendedRepository) RequestReviewers(
+	ctx context.Context,
+	head, base string,
+	reviewers domain.Reviewers,
+) error {
+	args := m.Called(ctx, head, base, reviewers)
+	return args.Error(0)
+}
+func (m *mockGithubExtendedRepository) FindOpenPR(ctx context.Context, head, base string) (int, error) {
+	args := m.Called(ctx, head, base)
+	return args.Int(0), args.Error(1)
+}
+func (m *mockGithubExtendedRepository) PullRequestHead(ctx context.Context, prNumber int) (string, error) {
+	args := m.Called(ctx, prNumber)
+	return args.String(0), args.Error(1)
+}
+func (m *mockGithubExtendedRepository) PullRequestBody(ctx context.Context, prNumber int) (string, error) {
+	args := m.Called(ctx, prNumber)
+	return args.String(0), args.Error(1)
+}
+func (m *mockGithubExtendedRepository) ReleaseContributors(ctx context.Context, base, head string) ([]string, error) {
+	args := m.Called(ctx, base, head)
+	if contributors, ok := args.Get(0).([]string); ok {
+		return contributors, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+func (m *mockGithubExtendedRepository) MergedPullRequests(
+	ctx context.Context,
+	base, head string,
+) ([]domain.PullRequest, error) {
+	args := m.Called(ctx, base, head)
+	if prs, ok := args.Get(0).([]domain.PullRequest); ok {
+		return prs, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+func (m *mockGithubExtendedRepository) CreateOrUpdateIssue(
+	ctx context.Context,
+	title, body string,
+	assignees []string,
+) (int, error) {
+	args := m.Called(ctx, title, body, assignees)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockGithubExtendedRepository) ForRepository(owner, repo string) repository.GithubExtendedRepository {
+	args := m.Called(owner, repo)
+	return args.Get(0).(repository.GithubExtendedRepository)
+}
+
+func (m *mockGithubExtendedRepository) FileContent(ctx context.Context, path string) (string, bool, error) {
+	args := m.Called(ctx, path)
+	return args.String(0), args.Bool(1), args.Error(2)
+}
+
+func (m *mockGithubExtendedRepository) CommitFile(
+	ctx context.Context,
+	branch, base, path, content, message string,
+) error {
+	args := m.Called(ctx, branch, base, path, content, message)
+	return args.Error(0)
+}
+
+func (m *mockGithubExtendedRepository) PublishRelease(ctx context.Context, tag string) error {
+	args := m.Called(ctx, tag)
+	return args.Error(0)
+}
+
+func (m *mockGithubExtendedRepository) ReleasePublishedAt(ctx context.Context, tag string) (time.Time, bool, error) {
+	args := m.Called(ctx, tag)
+	return args.Get(0).(time.Time), args.Bool(1), args.Error(2)
+}
+
+func (m *mockGithubExtendedRepository) ReleaseNotes(ctx context.Context, tag string) (string, time.Time, error) {
+	args := m.Called(ctx, tag)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
+}
+
+func (m *mockGithubExtendedRepository) SearchCode(ctx context.Context, query string) ([]string, error) {
+	args := m.Called(ctx, query)
+	paths, _ := args.Get(0).([]string)
+	return paths, args.Error(1)
+}
+
+func (m *mockGithubExtendedRepository) ReviewStatus(ctx context.Context, commit string) (domain.ReviewStatus, error) {
+	args := m.Called(ctx, commit)
+	return args.Get(0).(domain.ReviewStatus), args.Error(1)
+}
+
+func (m *mockGithubExtendedRepository) FindMilestone(ctx context.Context, title string) (int, error) {
+	args := m.Called(ctx, title)
+	return args.Int(0), args.Error(1)
+}
+func (m *mockGithubExtendedRepository) EnsureLabels(ctx context.Context, labels []domain.Label) error {
+	args := m.Called(ctx, labels)
+	return args.Error(0)
+}
+
+func (m *mockGithubExtendedRepository) CreateCheckRun(ctx context.Context, run domain.CheckRun) error {
+	args := m.Called(ctx, run)
+	return args.Error(0)
+}
+func (m *mockGithubExtendedRepository) TokenPermissions(ctx context.Context) ([]domain.PermissionCheck, error) {
+	args := m.Called(ctx)
+	checks, _ := args.Get(0).([]domain.PermissionCheck)
+	return checks, args.Error(1)
+}
 
 // Mock for CliffService
 type mockCliffService struct{ mock.Mock }
@@ -169,6 +365,33 @@ func (m *mockCliffService) GenerateFullChangelog(ctx context.Context, version st
 	return "# Mock changelog\n", nil
 }
 
+func (m *mockCliffService) GenerateFilteredChangelog(
+	ctx context.Context,
+	version, mode string,
+	filter domain.CommitFilter,
+) (string, error) {
+	args := m.Called(ctx, version, mode, filter)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockCliffService) GenerateFilteredFullChangelog(
+	ctx context.Context,
+	version string,
+	filter domain.CommitFilter,
+) (string, error) {
+	args := m.Called(ctx, version, filter)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockCliffService) GenerateRangeChangelog(
+	ctx context.Context,
+	from, to string,
+	filter domain.CommitFilter,
+) (string, error) {
+	args := m.Called(ctx, from, to, filter)
+	return args.String(0), args.Error(1)
+}
+
 // Mock for NpmService
 type mockNpmService struct{ mock.Mock }
 
@@ -177,6 +400,58 @@ func (m *mockNpmService) Publish(ctx context.Context, path string) error {
 	return args.Error(0)
 }
 
+func (m *mockNpmService) ValidatePackageVersion(ctx context.Context, path, version string) error {
+	args := m.Called(ctx, path, version)
+	return args.Error(0)
+}
+
+func (m *mockNpmService) AddDistTag(ctx context.Context, path, version, tag string) error {
+	args := m.Called(ctx, path, version, tag)
+	return args.Error(0)
+}
+
+type mockCargoService struct{ mock.Mock }
+
+func (m *mockCargoService) ReadCrate(ctx context.Context, path string) (service.CargoCrate, error) {
+	args := m.Called(ctx, path)
+	return args.Get(0).(service.CargoCrate), args.Error(1)
+}
+
+func (m *mockCargoService) CheckRegistry(ctx context.Context, crate service.CargoCrate, registry string) error {
+	args := m.Called(ctx, crate, registry)
+	return args.Error(0)
+}
+
+func (m *mockCargoService) Publish(ctx context.Context, path string, opts service.CargoPublishOptions) error {
+	args := m.Called(ctx, path, opts)
+	return args.Error(0)
+}
+
+type mockPyPIService struct{ mock.Mock }
+
+func (m *mockPyPIService) Build(ctx context.Context, path, outDir string, command []string) ([]string, error) {
+	args := m.Called(ctx, path, outDir, command)
+	dists, _ := args.Get(0).([]string)
+	return dists, args.Error(1)
+}
+
+func (m *mockPyPIService) Check(ctx context.Context, dists []string) error {
+	args := m.Called(ctx, dists)
+	return args.Error(0)
+}
+
+func (m *mockPyPIService) Upload(ctx context.Context, dists []string, opts service.PyPIUploadOptions) error {
+	args := m.Called(ctx, dists, opts)
+	return args.Error(0)
+}
+
+type mockToolVersionService struct{ mock.Mock }
+
+func (m *mockToolVersionService) InstalledVersion(ctx context.Context, tool string) (string, error) {
+	args := m.Called(ctx, tool)
+	return args.String(0), args.Error(1)
+}
+
 // Mock for GoReleaserService
 type mockGoReleaserService struct{ mock.Mock }
 
@@ -189,6 +464,27 @@ func (m *mockGoReleaserService) Run(ctx context.Context, args ...string) error {
 	return result.Error(0)
 }
 
+func (m *mockGoReleaserService) RunWithEnv(ctx context.Context, env []string, args ...string) error {
+	callArgs := []any{ctx, env}
+	for _, a := range args {
+		callArgs = append(callArgs, a)
+	}
+	result := m.Called(callArgs...)
+	return result.Error(0)
+}
+
+type mockCosignService struct{ mock.Mock }
+
+func (m *mockCosignService) SignBlob(ctx context.Context, path, key string) (domain.SignedFile, error) {
+	args := m.Called(ctx, path, key)
+	return args.Get(0).(domain.SignedFile), args.Error(1)
+}
+
+func (m *mockCosignService) VerifyBlob(ctx context.Context, signed domain.SignedFile, owner, repo string) error {
+	args := m.Called(ctx, signed, owner, repo)
+	return args.Error(0)
+}
+
 // Mock for StateRepository
 type mockStateRepository struct{ mock.Mock }
 
@@ -222,3 +518,42 @@ func (m *mockStateRepository) Exists(ctx context.Context, sessionID string) (boo
 	args := m.Called(ctx, sessionID)
 	return args.Bool(0), args.Error(1)
 }
+
+func (m *mockStateRepository) List(ctx context.Context) ([]*domain.RollbackState, error) {
+	args := m.Called(ctx)
+	if states := args.Get(0); states != nil {
+		return states.([]*domain.RollbackState), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockStateRepository) ListByStatus(
+	ctx context.Context,
+	status domain.WorkflowStatus,
+) ([]*domain.RollbackState, error) {
+	args := m.Called(ctx, status)
+	if states := args.Get(0); states != nil {
+		return states.([]*domain.RollbackState), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockStateRepository) Prune(ctx context.Context, cutoff time.Time) (int, error) {
+	args := m.Called(ctx, cutoff)
+	return args.Int(0), args.Error(1)
+}
+
+type mockToolReleaseRepository struct{ mock.Mock }
+
+func (m *mockToolReleaseRepository) LatestRelease(ctx context.Context) (domain.ToolRelease, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(domain.ToolRelease), args.Error(1)
+}
+
+func (m *mockToolReleaseRepository) DownloadAsset(ctx context.Context, url string) ([]byte, error) {
+	args := m.Called(ctx, url)
+	if data := args.Get(0); data != nil {
+		return data.([]byte), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
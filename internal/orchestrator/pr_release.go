@@ -3,31 +3,42 @@ package orchestrator
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"slices"
 	"strings"
 	"time"
 
+	"github.com/compozy/releasepr/internal/config"
 	"github.com/compozy/releasepr/internal/domain"
 	"github.com/compozy/releasepr/internal/logger"
 	"github.com/compozy/releasepr/internal/repository"
 	"github.com/compozy/releasepr/internal/service"
+	"github.com/compozy/releasepr/internal/telemetry"
 	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/compozy/releasepr/pkg/version"
 	"github.com/sethvargo/go-retry"
 	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
+// emptyRepositoryStatus is reported instead of a release when the repository has no commit yet.
+const emptyRepositoryStatus = "Repository has no commits yet; nothing to release"
+
 // PRReleaseConfig contains configuration for PR release workflow.
 type PRReleaseConfig struct {
 	ForceRelease   bool
 	DryRun         bool
 	CIOutput       bool
-	SkipPR         bool   // For testing without PR creation
-	EnableRollback bool   // Enable saga-based rollback support
-	Rollback       bool   // Perform rollback of failed session
-	SessionID      string // Session ID for rollback operations
+	SkipPR         bool     // For testing without PR creation
+	EnableRollback bool     // Enable saga-based rollback support
+	Rollback       bool     // Perform rollback of failed session
+	SessionID      string   // Session ID for rollback operations
+	SkipSteps      []string // Workflow steps to skip in addition to the configured skip_steps
 }
 
 // PRReleaseOrchestrator orchestrates the entire PR release workflow.
@@ -39,11 +50,20 @@ type PRReleaseOrchestrator struct {
 	npmSvc         service.NpmService
 	stateRepo      repository.StateRepository
 	artifactRunner releaseArtifactCommandRunner
+	updaterRunner  versionUpdaterRunner
+	now            func() time.Time
+	annotations    io.Writer
+	terminal       io.Writer
+	events         *EventBus
 }
 
 type releaseArtifacts struct {
 	changelog    string
 	releaseNotes string
+	date         string
+	closedIssues []int
+	links        domain.ReleaseLinks
+	files        []string
 }
 
 // NewPRReleaseOrchestrator creates a new PR release orchestrator.
@@ -56,7 +76,7 @@ func NewPRReleaseOrchestrator(
 ) *PRReleaseOrchestrator {
 	// Initialize state repository for rollback support
 	stateRepo := repository.NewJSONStateRepository(fsRepo, ".release-state")
-	return &PRReleaseOrchestrator{
+	o := &PRReleaseOrchestrator{
 		gitRepo:        gitRepo,
 		githubRepo:     githubRepo,
 		fsRepo:         fsRepo,
@@ -64,18 +84,32 @@ func NewPRReleaseOrchestrator(
 		npmSvc:         npmSvc,
 		stateRepo:      stateRepo,
 		artifactRunner: defaultReleaseArtifactCommandRunner,
+		updaterRunner:  defaultVersionUpdaterRunner,
+		now:            time.Now,
+		annotations:    os.Stdout,
+		terminal:       os.Stdout,
 	}
+	o.SetEventBus(NewEventBus())
+	return o
 }
 
-func (o *PRReleaseOrchestrator) logger(ctx context.Context) *zap.Logger {
-	return logger.FromContext(ctx).Named("orchestrator.pr_release")
+// SetEventBus publishes the steps and failures of the workflow on events, which may be shared with
+// other orchestrators, and subscribes the step spans and failure annotations to it.
+func (o *PRReleaseOrchestrator) SetEventBus(events *EventBus) {
+	o.events = events
+	events.Subscribe(newStepTracer(), EventStepStarted, EventStepSucceeded, EventStepSkipped, EventStepFailed)
+	events.Subscribe(EventSubscriberFunc(func(_ context.Context, event Event) {
+		annotateFailure(o.annotations, event.Err)
+	}), EventWorkflowFailed)
 }
 
-func (o *PRReleaseOrchestrator) logCI(ctx context.Context, ciOutput bool, fields ...zap.Field) {
-	if !ciOutput {
-		return
-	}
-	o.logger(ctx).Info("ci_output", fields...)
+// Events returns the event bus the workflow publishes its steps and failures on.
+func (o *PRReleaseOrchestrator) Events() *EventBus {
+	return o.events
+}
+
+func (o *PRReleaseOrchestrator) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx).Named("orchestrator.pr_release")
 }
 
 func (o *PRReleaseOrchestrator) logStatus(ctx context.Context, ciOutput bool, message string) {
@@ -86,12 +120,43 @@ func (o *PRReleaseOrchestrator) logStatus(ctx context.Context, ciOutput bool, me
 	o.logger(ctx).Info(message)
 }
 
-// Execute runs the complete PR release workflow.
+// Execute runs the complete PR release workflow. A failure is published as a workflow.failed event,
+// which in GitHub Actions is reported as an error annotation naming the failing step and how to fix it,
+// and in the failure issue of the release when failure_issue is enabled.
 func (o *PRReleaseOrchestrator) Execute(ctx context.Context, cfg PRReleaseConfig) error {
+	ctx, span := telemetry.Start(ctx, "pr-release",
+		attribute.Bool("release.dry_run", cfg.DryRun),
+		attribute.Bool("release.rollback", cfg.Rollback),
+	)
+	reporter := &failureReporter{o: o}
+	unsubscribe := o.events.Subscribe(reporter, EventStepSucceeded, EventWorkflowFailed)
+	defer unsubscribe()
+	cfg, err := o.guardForkPullRequest(ctx, cfg)
+	if err == nil {
+		reporter.cfg = cfg
+		err = o.execute(ctx, cfg)
+	}
+	telemetry.End(span, err)
+	if err != nil {
+		o.events.Publish(ctx, Event{Type: EventWorkflowFailed, Err: err})
+	}
+	return err
+}
+
+func (o *PRReleaseOrchestrator) execute(ctx context.Context, cfg PRReleaseConfig) error {
 	// Handle rollback operation
 	if cfg.Rollback {
 		return o.performRollback(ctx, cfg.SessionID)
 	}
+	if !cfg.DryRun {
+		if err := ValidateAllowedRepository(ctx); err != nil {
+			return stepFailed(stepNameValidateEnvironment, err)
+		}
+	}
+	ctx, err := o.resolveReleaseChannel(ctx)
+	if err != nil {
+		return err
+	}
 
 	// Normal execution with optional rollback support
 	if cfg.EnableRollback {
@@ -102,82 +167,221 @@ func (o *PRReleaseOrchestrator) Execute(ctx context.Context, cfg PRReleaseConfig
 	return o.executeLegacy(ctx, cfg)
 }
 
-// executeLegacy runs the workflow without rollback support (original implementation)
-func (o *PRReleaseOrchestrator) executeLegacy(ctx context.Context, cfg PRReleaseConfig) error {
+// executeLegacy runs the workflow without rollback support (original implementation). It publishes
+// the same step events as the saga.
+func (o *PRReleaseOrchestrator) executeLegacy(ctx context.Context, cfg PRReleaseConfig) (err error) {
 	// Add timeout to match workflow (default 60 minutes for jobs)
 	ctx, cancel := context.WithTimeout(ctx, DefaultWorkflowTimeout)
 	defer cancel()
+	steps := newStepProgress(o.events)
+	defer func() { steps.finish(ctx, err) }()
 	// Validate required environment variables for GitHub operations
 	if err := ValidateEnvironmentVariables(ctx, []string{"GITHUB_TOKEN"}); err != nil {
-		return fmt.Errorf("environment validation failed: %w", err)
+		return stepFailed(stepNameValidateEnvironment, fmt.Errorf("environment validation failed: %w", err))
+	}
+	skipped, err := skippedSteps(ctx, cfg)
+	if err != nil {
+		return err
 	}
 	// Step 1: Check for changes
-	hasChanges, latestTag, err := o.checkChanges(ctx)
+	steps.start(ctx, stepNameCheckChanges)
+	check, err := o.checkChanges(ctx)
+	if errors.Is(err, repository.ErrEmptyRepository) {
+		o.logCI(ctx, cfg.CIOutput, zap.Bool("has_changes", false))
+		o.logStatus(ctx, cfg.CIOutput, emptyRepositoryStatus)
+		return nil
+	}
 	if err != nil {
-		return fmt.Errorf("failed to check changes: %w", err)
+		return stepFailed(stepNameCheckChanges, fmt.Errorf("failed to check changes: %w", err))
 	}
-	o.logCI(ctx, cfg.CIOutput, zap.Bool("has_changes", hasChanges))
+	latestTag := check.LatestTag
+	o.logCI(ctx, cfg.CIOutput, zap.Bool("has_changes", check.HasChanges))
 	o.logCI(ctx, cfg.CIOutput, zap.String("latest_tag", latestTag))
-	if !hasChanges && !cfg.ForceRelease {
-		o.logStatus(ctx, cfg.CIOutput, "No changes detected since last release")
+	if check.Reason != "" {
+		o.logCI(ctx, cfg.CIOutput, zap.String("skip_reason", check.Reason))
+	}
+	if !check.HasChanges && !cfg.ForceRelease {
+		o.logStatus(ctx, cfg.CIOutput, noChangesStatus(check.Reason))
 		return nil
 	}
 	// Step 2: Calculate version and prepare branch
-	version, branchName, err := o.prepareRelease(ctx, latestTag, cfg.CIOutput)
+	version, branchName, remoteExists, err := o.prepareRelease(ctx, latestTag, cfg, steps)
 	if err != nil {
 		return err
 	}
 	// Step 3: Update code and create PR
-	return o.updateAndCreatePR(ctx, version, branchName, latestTag, cfg)
+	return o.updateAndCreatePR(ctx, version, branchName, latestTag, remoteExists, cfg, skipped, steps)
 }
 
-// prepareRelease calculates version and creates the release branch
+// skippedSteps merges the configured skip_steps with the steps skipped for this run.
+func skippedSteps(ctx context.Context, cfg PRReleaseConfig) (domain.SkippedSteps, error) {
+	skipped, err := domain.ParseSkippedSteps(slices.Concat(config.FromContext(ctx).SkipSteps, cfg.SkipSteps))
+	if err != nil {
+		return nil, fmt.Errorf("invalid skipped steps: %w", err)
+	}
+	return skipped, nil
+}
+
+// logSkippedStep reports a workflow step that was skipped by configuration.
+func (o *PRReleaseOrchestrator) logSkippedStep(ctx context.Context, step domain.WorkflowStep) {
+	o.logger(ctx).Info("Skipping workflow step", zap.String("step", string(step)))
+}
+
+// prepareRelease calculates version and creates the release branch, replacing a release branch it
+// may reuse. It reports whether the branch exists on the remote, where the push then replaces it.
 func (o *PRReleaseOrchestrator) prepareRelease(
 	ctx context.Context,
 	latestTag string,
-	ciOutput bool,
-) (string, string, error) {
+	cfg PRReleaseConfig,
+	steps *stepProgress,
+) (version, branchName string, remoteExists bool, err error) {
+	steps.start(ctx, stepNameCalculateVersion)
+	version, branchName, err = o.releaseVersion(ctx, latestTag)
+	if err != nil {
+		return "", "", false, err
+	}
+	steps.setVersion(version)
+	o.logCI(ctx, cfg.CIOutput, zap.String("version", version), zap.String("branch_name", branchName))
+	steps.start(ctx, stepNameCreateBranch)
+	localExists, remoteExists, err := o.checkReleaseBranch(ctx, branchName, cfg.ForceRelease)
+	if err != nil {
+		return "", "", false, stepFailed(stepNameCreateBranch, err)
+	}
+	if localExists {
+		if err := o.gitRepo.DeleteBranch(ctx, branchName); err != nil {
+			return "", "", false, stepFailed(stepNameCreateBranch,
+				fmt.Errorf("failed to delete local branch %s: %w", branchName, err))
+		}
+	}
+	if err := o.createReleaseBranch(ctx, branchName); err != nil {
+		return "", "", false, stepFailed(stepNameCreateBranch, fmt.Errorf("failed to create release branch: %w", err))
+	}
+	if err := o.gitRepo.CheckoutBranch(ctx, branchName); err != nil {
+		return "", "", false, stepFailed(stepNameCreateBranch,
+			fmt.Errorf("failed to checkout release branch: %w", err))
+	}
+	return version, branchName, remoteExists, nil
+}
+
+// checkReleaseBranch checks the local and remote release branch before it is created. An existing
+// branch is reused, rebuilt from the base, when it backs the open release PR or the run is forced;
+// otherwise it was left behind by an earlier run and the error names the commands that remove it.
+func (o *PRReleaseOrchestrator) checkReleaseBranch(
+	ctx context.Context,
+	branchName string,
+	force bool,
+) (localExists, remoteExists bool, err error) {
+	localExists, remoteExists, err = o.checkBranchExistence(ctx, branchName)
+	if err != nil || (!localExists && !remoteExists) || force {
+		return localExists, remoteExists, err
+	}
+	if remoteExists {
+		prNumber, err := o.githubRepo.FindOpenPR(ctx, branchName, releaseBase(ctx))
+		if err != nil {
+			return false, false, fmt.Errorf("failed to find the release PR of branch %s: %w", branchName, err)
+		}
+		if prNumber != 0 {
+			o.logger(ctx).Info("Reusing release branch of the open release PR",
+				zap.String("branch", branchName),
+				zap.Int("pr_number", prNumber))
+			return localExists, remoteExists, nil
+		}
+	}
+	return false, false, leftoverBranchError(ctx, branchName, localExists, remoteExists)
+}
+
+// leftoverBranchError reports a release branch without an open release PR, with the commands that
+// delete it. Retrying cannot remove the branch, so the error is permanent.
+func leftoverBranchError(ctx context.Context, branchName string, localExists, remoteExists bool) error {
+	var places, commands []string
+	if localExists {
+		places = append(places, "locally")
+		commands = append(commands, "git branch -D "+branchName)
+	}
+	if remoteExists {
+		remote := config.FromContext(ctx).GitRemote
+		places = append(places, "on remote "+remote)
+		commands = append(commands, fmt.Sprintf("git push %s --delete %s", remote, branchName))
+	}
+	return permanentStepError{fmt.Errorf("release branch %s already exists %s without an open release PR, "+
+		"likely left behind by an earlier run; delete it with `%s`, or re-run with --force to replace it",
+		branchName, strings.Join(places, " and "), strings.Join(commands, " && "))}
+}
+
+// releaseVersion calculates and validates the version to release and the name of its release branch.
+func (o *PRReleaseOrchestrator) releaseVersion(ctx context.Context, latestTag string) (string, string, error) {
 	version, err := o.calculateVersion(ctx, latestTag)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to calculate version: %w", err)
+		return "", "", stepFailed(stepNameCalculateVersion, fmt.Errorf("failed to calculate version: %w", err))
 	}
 	// Validate version format
 	if err := ValidateVersion(version); err != nil {
-		return "", "", fmt.Errorf("invalid version: %w", err)
+		return "", "", stepFailed(stepNameCalculateVersion, fmt.Errorf("invalid version: %w", err))
+	}
+	if err := o.checkGoModuleMajor(ctx, version, latestTag); err != nil {
+		return "", "", stepFailed(stepNameCalculateVersion, err)
 	}
-	o.logCI(ctx, ciOutput, zap.String("version", version))
 	branchName := fmt.Sprintf("release/%s", version)
 	// Validate branch name
 	if err := ValidateBranchName(branchName); err != nil {
-		return "", "", fmt.Errorf("invalid branch name: %w", err)
-	}
-	if err := o.createReleaseBranch(ctx, branchName); err != nil {
-		return "", "", fmt.Errorf("failed to create release branch: %w", err)
-	}
-	if err := o.gitRepo.CheckoutBranch(ctx, branchName); err != nil {
-		return "", "", fmt.Errorf("failed to checkout release branch: %w", err)
+		return "", "", stepFailed(stepNameCreateBranch, fmt.Errorf("invalid branch name: %w", err))
 	}
 	return version, branchName, nil
 }
 
-// updateAndCreatePR updates versions, changelog and creates the PR
+// updateAndCreatePR updates versions, changelog and creates the PR. A failure before the release
+// commit restores the files the run modified.
 func (o *PRReleaseOrchestrator) updateAndCreatePR(
 	ctx context.Context,
 	version, branchName, latestTag string,
+	remoteExists bool,
 	cfg PRReleaseConfig,
-) error {
-	if err := o.updatePackageVersions(ctx, version); err != nil {
-		return fmt.Errorf("failed to update package versions: %w", err)
+	skipped domain.SkippedSteps,
+	steps *stepProgress,
+) (err error) {
+	snapshot, err := takeFileSnapshot(o.fsRepo, snapshotFiles)
+	if err != nil {
+		return err
+	}
+	changes := NewChangeSet()
+	committed := false
+	defer func() {
+		if err != nil && !committed {
+			o.restoreFileSnapshot(ctx, snapshot, changes)
+		}
+	}()
+	steps.start(ctx, stepNameReleaseArtifacts)
+	if skipped.Has(domain.StepPackageVersions) {
+		o.logSkippedStep(ctx, domain.StepPackageVersions)
+	} else {
+		packageFiles, err := o.updatePackageVersions(ctx, version, latestTag)
+		if err != nil {
+			return stepFailed(stepNamePackageVersions, fmt.Errorf("failed to update package versions: %w", err))
+		}
+		changes.Track(packageFiles...)
 	}
 
-	artifacts, err := o.generateChangelog(ctx, version)
+	var bumped domain.SubmoduleUpdates
+	if !cfg.DryRun {
+		bumped, err = o.bumpSubmodules(ctx)
+		changes.Track(bumped.Paths()...)
+		if err != nil {
+			return stepFailed(stepNameChangelog, err)
+		}
+	}
+	artifacts, err := o.generateChangelog(ctx, version, latestTag, bumped, skipped)
 	if err != nil {
-		return fmt.Errorf("failed to generate changelog: %w", err)
+		return stepFailed(stepNameChangelog, fmt.Errorf("failed to generate changelog: %w", err))
+	}
+	changes.Track(artifacts.files...)
+	if cfg.CIOutput {
+		o.writeCIOutput(ctx, zap.String("changelog", artifacts.changelog))
 	}
-	artifactResult, err := o.runReleaseArtifactCommands(ctx, version, branchName, latestTag)
+	artifactResult, err := o.releaseArtifactCommands(ctx, version, branchName, latestTag, skipped)
 	if err != nil {
-		return err
+		return stepFailed(stepNameReleaseArtifacts, err)
 	}
+	changes.Track(artifactResult.files()...)
 
 	// Dry-run: stop here so no commit, push or PR is made.
 	if cfg.DryRun {
@@ -185,48 +389,167 @@ func (o *PRReleaseOrchestrator) updateAndCreatePR(
 			fmt.Sprintf("🛈 Dry-run complete – release %s prepared locally (no commit/push/PR).", version))
 		return nil
 	}
-	if _, err := o.archiveReleaseNotes(ctx, version); err != nil {
-		return fmt.Errorf("failed to archive release notes: %w", err)
+	if skipped.Has(domain.StepArchiveNotes) {
+		o.logSkippedStep(ctx, domain.StepArchiveNotes)
+		steps.skip(ctx, stepNameArchiveNotes)
+	} else {
+		steps.start(ctx, stepNameArchiveNotes)
+		archived, err := o.archiveReleaseNotes(ctx, version)
+		if err != nil {
+			return stepFailed(stepNameArchiveNotes, fmt.Errorf("failed to archive release notes: %w", err))
+		}
+		changes.Track(archivedReleaseNoteFiles(archived)...)
 	}
-
-	if err := o.commitChanges(ctx, version, artifactResult.addPatterns); err != nil {
-		return fmt.Errorf("failed to commit changes: %w", err)
+	steps.start(ctx, stepNameConsumeChangeFiles)
+	consumes, err := o.consumesChangeFiles(ctx)
+	if err != nil {
+		return stepFailed(stepNameConsumeChangeFiles, err)
 	}
-	if err := o.gitRepo.PushBranch(ctx, branchName); err != nil {
-		return fmt.Errorf("failed to push branch: %w", err)
+	if consumes {
+		consumed, err := o.consumeChangeFiles(ctx)
+		if err != nil {
+			return stepFailed(stepNameConsumeChangeFiles, fmt.Errorf("failed to consume change files: %w", err))
+		}
+		changes.Track(consumedChangeFiles(consumed)...)
+	}
+
+	steps.start(ctx, stepNameCommitChanges)
+	if err := o.commitChanges(ctx, version, changes); err != nil {
+		return stepFailed(stepNameCommitChanges, fmt.Errorf("failed to commit changes: %w", err))
+	}
+	committed = true
+	if err := o.checkReleaseSize(ctx); err != nil {
+		return stepFailed(stepNameReleaseSize, err)
+	}
+	if skipped.Has(domain.StepPush) {
+		o.logSkippedStep(ctx, domain.StepPush)
+		steps.skip(ctx, stepNamePushBranch)
+	} else {
+		steps.start(ctx, stepNamePushBranch)
+		o.ensureBaseSynced(ctx, cfg.CIOutput, branchName)
+		push := o.gitRepo.PushBranch
+		if remoteExists {
+			push = o.gitRepo.PushBranchForce
+		}
+		if err := push(ctx, branchName); err != nil {
+			return stepFailed(stepNamePushBranch, fmt.Errorf("failed to push branch: %w", err))
+		}
 	}
-	if !cfg.SkipPR {
-		if err := o.createPullRequest(
+	var pr domain.PullRequestRef
+	if cfg.SkipPR || skipped.Has(domain.StepPullRequest) {
+		steps.skip(ctx, stepNameCreatePR)
+	} else {
+		steps.start(ctx, stepNameCreatePR)
+		pr, err = o.createPullRequest(
 			ctx,
 			version,
 			artifacts.changelog,
 			artifacts.releaseNotes,
+			artifacts.date,
 			branchName,
-		); err != nil {
-			return fmt.Errorf("failed to create pull request: %w", err)
+			artifacts.closedIssues,
+			artifacts.links,
+		)
+		if err != nil {
+			return stepFailed(stepNameCreatePR, fmt.Errorf("failed to create pull request: %w", err))
 		}
+		o.logPullRequest(ctx, cfg.CIOutput, pr)
+		o.requestReviews(ctx, branchName, changes.Paths())
+	}
+	if err := o.writeManifest(ctx, version, latestTag, pr.Number); err != nil {
+		return err
 	}
 	o.logStatus(ctx, cfg.CIOutput, fmt.Sprintf("✅ Release PR workflow completed for version %s", version))
 	return nil
 }
 
-func (o *PRReleaseOrchestrator) checkChanges(ctx context.Context) (bool, string, error) {
+// writeManifest records the prepared release commit, released after latestTag, in the release manifest.
+func (o *PRReleaseOrchestrator) writeManifest(ctx context.Context, version, latestTag string, prNumber int) error {
+	ver, err := domain.NewVersion(version)
+	if err != nil {
+		return fmt.Errorf("failed to parse version: %w", err)
+	}
+	if _, err := writeReleaseManifest(ctx, o.gitRepo, o.fsRepo, releaseManifestInput{
+		version:     ver,
+		previousTag: latestTag,
+		prNumber:    prNumber,
+	}); err != nil {
+		return fmt.Errorf("failed to write release manifest: %w", err)
+	}
+	return nil
+}
+
+// checkChanges reports whether there is something to release since the latest tag. A repository
+// without any commit fails with repository.ErrEmptyRepository, which the flows report as nothing to
+// release yet rather than an error.
+func (o *PRReleaseOrchestrator) checkChanges(ctx context.Context) (usecase.ChangesCheck, error) {
+	check, err := o.checkPendingChanges(ctx)
+	if err != nil || check.LatestTag != "" {
+		return check, err
+	}
+	if _, err := o.gitRepo.GetHeadCommit(ctx); errors.Is(err, repository.ErrEmptyRepository) {
+		return usecase.ChangesCheck{}, err
+	}
+	return check, nil
+}
+
+func (o *PRReleaseOrchestrator) checkPendingChanges(ctx context.Context) (usecase.ChangesCheck, error) {
+	if changeFilesMode(ctx) {
+		hasChanges, latestTag, err := o.checkChangeFiles(ctx)
+		return usecase.ChangesCheck{HasChanges: hasChanges, LatestTag: latestTag}, err
+	}
+	strategy, err := o.versionStrategy(ctx, "")
+	if err != nil {
+		return usecase.ChangesCheck{}, err
+	}
 	uc := &usecase.CheckChangesUseCase{
-		GitRepo:  o.gitRepo,
-		CliffSvc: o.cliffSvc,
+		GitRepo:   o.gitRepo,
+		CliffSvc:  o.cliffSvc,
+		Strategy:  strategy,
+		Threshold: config.FromContext(ctx).ReleaseThreshold(),
 	}
-	return uc.Execute(ctx)
+	return uc.Check(ctx)
 }
 
-func (o *PRReleaseOrchestrator) calculateVersion(ctx context.Context, _ string) (string, error) {
+// noChangesStatus is the status of a run that has nothing to release, naming the release threshold
+// the changes did not meet.
+func noChangesStatus(reason string) string {
+	if reason == "" {
+		return "No changes detected since last release"
+	}
+	return "Release threshold not met: " + reason
+}
+
+func (o *PRReleaseOrchestrator) calculateVersion(ctx context.Context, latestTag string) (string, error) {
+	var bump domain.BumpLevel
+	if changeFilesMode(ctx) {
+		files, err := o.pendingChangeFiles(ctx)
+		if err != nil {
+			return "", err
+		}
+		bump = files.Bump()
+	}
+	strategy, err := o.versionStrategy(ctx, bump)
+	if err != nil {
+		return "", err
+	}
 	uc := &usecase.CalculateVersionUseCase{
 		GitRepo:  o.gitRepo,
 		CliffSvc: o.cliffSvc,
+		Strategy: strategy,
 	}
 	version, err := uc.Execute(ctx)
 	if err != nil {
 		return "", err
 	}
+	latest, err := domain.NewVersion(latestTag)
+	if err != nil {
+		latest = nil
+	}
+	version, err = releaseChannel(ctx).PrereleaseVersion(version, latest)
+	if err != nil {
+		return "", fmt.Errorf("failed to version the %s channel release: %w", releaseChannel(ctx).Name, err)
+	}
 	return version.String(), nil
 }
 
@@ -237,54 +560,186 @@ func (o *PRReleaseOrchestrator) createReleaseBranch(ctx context.Context, branchN
 	return uc.Execute(ctx, branchName)
 }
 
-func (o *PRReleaseOrchestrator) updatePackageVersions(_ context.Context, version string) error {
+// updatePackageVersions bumps the root package.json, pom.xml and gradle.properties when present, the
+// versions pinned in README.md, runs the custom version updaters and returns the files they wrote.
+func (o *PRReleaseOrchestrator) updatePackageVersions(
+	ctx context.Context,
+	version, latestTag string,
+) ([]string, error) {
+	files, err := o.updatePackageJSON(version, releaseChannel(ctx))
+	if err != nil {
+		return nil, err
+	}
+	jvmFiles, err := o.updateJVMVersions(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	readmeFiles, err := o.updateReadmeVersion(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	moduleFiles, err := o.updateGoModulePath(ctx, version, latestTag)
+	if err != nil {
+		return nil, err
+	}
+	updaterFiles, err := o.runVersionUpdaters(ctx, latestTag, version)
+	if err != nil {
+		return nil, err
+	}
+	updated := slices.Concat(files, jvmFiles, readmeFiles, moduleFiles, updaterFiles)
+	if len(updated) == 0 {
+		o.logger(ctx).Info("No version files found; package versions left unchanged",
+			zap.String("version", version))
+	}
+	return updated, nil
+}
+
+// updatePackageJSON bumps the version of the root package.json when one exists.
+func (o *PRReleaseOrchestrator) updatePackageJSON(version string, channel domain.ReleaseChannel) ([]string, error) {
 	// Update root package.json version (tools/ update removed)
 	versionWithoutV := strings.TrimPrefix(version, "v")
 	// Try to update package.json via fsRepo when present; skip silently if absent
 	exists, err := afero.Exists(o.fsRepo, "package.json")
 	if err != nil {
-		return fmt.Errorf("failed to check root package.json: %w", err)
+		return nil, fmt.Errorf("failed to check root package.json: %w", err)
 	}
-	if exists {
-		data, err := afero.ReadFile(o.fsRepo, "package.json")
-		if err != nil {
-			return fmt.Errorf("failed to read root package.json: %w", err)
-		}
-		// Use map to preserve all existing fields
-		var pkg map[string]any
-		if err := json.Unmarshal(data, &pkg); err != nil {
-			return fmt.Errorf("failed to parse root package.json: %w", err)
-		}
-		// Update only the version field
-		pkg["version"] = versionWithoutV
-		newData, err := json.MarshalIndent(pkg, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to serialize root package.json: %w", err)
-		}
-		// Add trailing newline to match standard JSON formatting
-		newData = append(newData, '\n')
-		if err := afero.WriteFile(o.fsRepo, "package.json", newData, FilePermissionsReadWrite); err != nil {
-			return fmt.Errorf("failed to write root package.json: %w", err)
+	if !exists {
+		return nil, nil
+	}
+	data, err := afero.ReadFile(o.fsRepo, "package.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read root package.json: %w", err)
+	}
+	// Use map to preserve all existing fields
+	var pkg map[string]any
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("failed to parse root package.json: %w", err)
+	}
+	// Update only the version field and the dist-tag npm publishes the release channel with
+	pkg["version"] = versionWithoutV
+	setPublishDistTag(pkg, channel)
+	newData, err := json.MarshalIndent(pkg, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize root package.json: %w", err)
+	}
+	// Add trailing newline to match standard JSON formatting
+	newData = append(newData, '\n')
+	if err := afero.WriteFile(o.fsRepo, "package.json", newData, FilePermissionsReadWrite); err != nil {
+		return nil, fmt.Errorf("failed to write root package.json: %w", err)
+	}
+	return []string{"package.json"}, nil
+}
+
+// setPublishDistTag sets publishConfig.tag, the dist-tag npm publish uses, to the release channel.
+// Releases on latest only reset a tag left over from another channel.
+func setPublishDistTag(pkg map[string]any, channel domain.ReleaseChannel) {
+	publishConfig, _ := pkg["publishConfig"].(map[string]any)
+	if channel.IsLatest() {
+		if _, ok := publishConfig["tag"]; ok {
+			publishConfig["tag"] = channel.Name
 		}
+		return
 	}
-	return nil
+	if publishConfig == nil {
+		publishConfig = map[string]any{}
+		pkg["publishConfig"] = publishConfig
+	}
+	publishConfig["tag"] = channel.Name
+}
+
+// changelogMarkdownPolicy builds the sanitization policy for commit-derived changelog content.
+func changelogMarkdownPolicy(ctx context.Context) (domain.MarkdownPolicy, error) {
+	policy, err := domain.ParseMarkdownPolicy(config.FromContext(ctx).ChangelogMarkdownAllowlist)
+	if err != nil {
+		return domain.MarkdownPolicy{}, fmt.Errorf("invalid changelog markdown allowlist: %w", err)
+	}
+	return policy, nil
+}
+
+// changelogStyle returns the changelog_style profile retitling the groups of generated changelogs.
+func changelogStyle(ctx context.Context) (domain.ChangelogStyle, error) {
+	style, err := domain.ParseChangelogStyle(config.FromContext(ctx).ChangelogStyle)
+	if err != nil {
+		return "", fmt.Errorf("invalid changelog_style: %w", err)
+	}
+	return style, nil
 }
 
+// linkIssues links the issue references of a sanitized changelog when issue_links is enabled and
+// returns the issues it closes.
+func linkIssues(cfg *config.Config, changelog string) (string, []int) {
+	if !cfg.IssueLinks {
+		return changelog, nil
+	}
+	return domain.LinkIssueReferences(changelog, cfg.GithubOwner, cfg.GithubRepo)
+}
+
+// changelogAudienceFilter returns the commit filter for the configured audience of a changelog document.
+func changelogAudienceFilter(ctx context.Context, audience string) (domain.CommitFilter, error) {
+	return config.FromContext(ctx).AudienceFilter(audience)
+}
+
+// generateChangelog renders the release changelog, listing the submodules bumped by the run, and writes
+// the changelog documents that are not skipped.
 func (o *PRReleaseOrchestrator) generateChangelog(
 	ctx context.Context,
-	version string,
+	version, latestTag string,
+	bumped domain.SubmoduleUpdates,
+	skipped domain.SkippedSteps,
 ) (*releaseArtifacts, error) {
-	uc := &usecase.GenerateChangelogUseCase{
-		CliffSvc: o.cliffSvc,
+	policy, err := changelogMarkdownPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	style, err := changelogStyle(ctx)
+	if err != nil {
+		return nil, err
 	}
-	changelog, err := uc.Execute(ctx, version, "release")
+	cfg := config.FromContext(ctx)
+	releaseFilter, err := changelogAudienceFilter(ctx, cfg.ReleaseChangelogAudience)
 	if err != nil {
 		return nil, err
 	}
-	fullChangelog, err := o.cliffSvc.GenerateFullChangelog(ctx, version)
+	fileFilter, err := changelogAudienceFilter(ctx, cfg.ChangelogFileAudience)
 	if err != nil {
-		return nil, fmt.Errorf("failed to build complete changelog: %w", err)
+		return nil, err
+	}
+	date, err := formatReleaseDate(ctx, o.now())
+	if err != nil {
+		return nil, err
+	}
+	locale, err := releaseLocale(ctx, o.fsRepo)
+	if err != nil {
+		return nil, err
+	}
+	var changelog string
+	if changeFilesMode(ctx) {
+		changelog, err = o.changeFilesChangelog(ctx, version, date)
+		changelog = style.Apply(policy.Sanitize(changelog))
+	} else {
+		uc := &usecase.GenerateChangelogUseCase{
+			CliffSvc: o.cliffSvc,
+			Policy:   &policy,
+			Filter:   releaseFilter,
+			Style:    style,
+		}
+		changelog, err = uc.Execute(ctx, version, "release")
+	}
+	if err != nil {
+		return nil, err
 	}
+	fixes, err := o.securityFixes(ctx, latestTag)
+	if err != nil {
+		return nil, err
+	}
+	changelog = domain.InsertSecuritySection(changelog, fixes.Markdown(style))
+	changelog, closedIssues := linkIssues(cfg, locale.LocalizeHeadings(changelog))
+	components, err := o.componentUpdates(ctx, latestTag, bumped)
+	if err != nil {
+		return nil, err
+	}
+	componentSection := components.LocalizedMarkdown(locale)
+	changelog = insertComponentUpdates(changelog, version, componentSection)
 	collectUC := &usecase.CollectReleaseNotesUseCase{
 		FSRepo: o.fsRepo,
 	}
@@ -292,15 +747,82 @@ func (o *PRReleaseOrchestrator) generateChangelog(
 	if err != nil {
 		return nil, fmt.Errorf("failed to collect release notes: %w", err)
 	}
+	releaseNotes := locale.LocalizeHeadings(collection.RenderMarkdown())
+	artifacts := &releaseArtifacts{
+		changelog:    stampReleaseDate(changelog, version, date),
+		releaseNotes: appendReleaseStats(releaseNotes, o.releaseStatsFooter(ctx, latestTag, locale)),
+		date:         date,
+		closedIssues: closedIssues,
+		links:        o.releaseLinks(ctx, version, latestTag),
+	}
+	if skipped.Has(domain.StepChangelog) {
+		o.logSkippedStep(ctx, domain.StepChangelog)
+	} else {
+		if changeFilesMode(ctx) {
+			err = o.prependChangelogSection(version, artifacts.changelog)
+		} else {
+			err = o.writeFullChangelog(ctx, version, date, fileFilter, policy, style, locale, componentSection)
+		}
+		if err != nil {
+			return nil, err
+		}
+		artifacts.files = append(artifacts.files, "CHANGELOG.md")
+	}
+	if skipped.Has(domain.StepReleaseNotes) {
+		o.logSkippedStep(ctx, domain.StepReleaseNotes)
+		return artifacts, nil
+	}
+	if err := o.writeReleaseNotes(ctx, version, artifacts); err != nil {
+		return nil, err
+	}
+	artifacts.files = append(artifacts.files, ReleaseBodyOutputFile, ReleaseNotesOutputFile)
+	return artifacts, nil
+}
+
+// writeFullChangelog regenerates CHANGELOG.md for the file audience in the release locale, adding
+// the component updates section to this release.
+func (o *PRReleaseOrchestrator) writeFullChangelog(
+	ctx context.Context,
+	version, date string,
+	filter domain.CommitFilter,
+	policy domain.MarkdownPolicy,
+	style domain.ChangelogStyle,
+	locale domain.Locale,
+	componentSection string,
+) error {
+	var fullChangelog string
+	var err error
+	if filter.IsZero() {
+		fullChangelog, err = o.cliffSvc.GenerateFullChangelog(ctx, version)
+	} else {
+		fullChangelog, err = o.cliffSvc.GenerateFilteredFullChangelog(ctx, version, filter)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to build complete changelog: %w", err)
+	}
+	fullChangelog = locale.LocalizeHeadings(style.Apply(policy.Sanitize(fullChangelog)))
+	fullChangelog, _ = linkIssues(config.FromContext(ctx), fullChangelog)
+	fullChangelog = stampReleaseDate(insertComponentUpdates(fullChangelog, version, componentSection), version, date)
 	if err := afero.WriteFile(o.fsRepo, "CHANGELOG.md", []byte(fullChangelog), FilePermissionsReadWrite); err != nil {
-		return nil, fmt.Errorf("failed to write changelog: %w", err)
+		return fmt.Errorf("failed to write changelog: %w", err)
 	}
+	return nil
+}
+
+// writeReleaseNotes writes RELEASE_BODY.md and prepends the release to the historical RELEASE_NOTES.md.
+func (o *PRReleaseOrchestrator) writeReleaseNotes(
+	ctx context.Context,
+	version string,
+	artifacts *releaseArtifacts,
+) error {
 	previousReleaseNotes, err := readOptionalFile(o.fsRepo, ReleaseNotesOutputFile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read existing release notes: %w", err)
+		return fmt.Errorf("failed to read existing release notes: %w", err)
+	}
+	releaseBodyDocument, err := o.releaseBodyDocument(ctx, version, artifacts)
+	if err != nil {
+		return err
 	}
-	releaseNotes := collection.RenderMarkdown()
-	releaseBodyDocument := buildReleaseBodyDocument(changelog, releaseNotes)
 	releaseNotesDocument := buildHistoricalReleaseNotesDocument(version, releaseBodyDocument, previousReleaseNotes)
 	if err := afero.WriteFile(
 		o.fsRepo,
@@ -308,7 +830,7 @@ func (o *PRReleaseOrchestrator) generateChangelog(
 		[]byte(releaseBodyDocument),
 		FilePermissionsReadWrite,
 	); err != nil {
-		return nil, fmt.Errorf("failed to write release body: %w", err)
+		return fmt.Errorf("failed to write release body: %w", err)
 	}
 	if err := afero.WriteFile(
 		o.fsRepo,
@@ -316,46 +838,48 @@ func (o *PRReleaseOrchestrator) generateChangelog(
 		[]byte(releaseNotesDocument),
 		FilePermissionsReadWrite,
 	); err != nil {
-		return nil, fmt.Errorf("failed to write release notes: %w", err)
+		return fmt.Errorf("failed to write release notes: %w", err)
 	}
-	return &releaseArtifacts{
-		changelog:    changelog,
-		releaseNotes: releaseNotes,
-	}, nil
+	return nil
 }
 
-func (o *PRReleaseOrchestrator) commitChanges(ctx context.Context, version string, extraAddPatterns []string) error {
+// commitChanges stages exactly the files tracked in changes and creates the release commit. A release
+// that changed no file, such as a Go module without version files whose document steps are skipped,
+// still gets its release commit, empty, so the release PR can be opened and merged.
+func (o *PRReleaseOrchestrator) commitChanges(ctx context.Context, version string, changes *ChangeSet) error {
 	// Configure git
 	user := "github-actions[bot]"
 	email := "github-actions[bot]@users.noreply.github.com"
 	if err := o.gitRepo.ConfigureUser(ctx, user, email); err != nil {
 		return fmt.Errorf("failed to configure git user: %w", err)
 	}
-	// Add files
-	filesToAdd := []string{
-		"CHANGELOG.md",
-		ReleaseBodyOutputFile,
-		ReleaseNotesOutputFile,
-		"package.json",
-		"package-lock.json",
+	if err := o.excludeReleaseFiles(ctx, changes); err != nil {
+		return err
 	}
-	gitKeepExists, err := afero.Exists(o.fsRepo, ReleaseNotesGitKeepPath)
-	if err != nil {
-		return fmt.Errorf("failed to inspect release notes gitkeep: %w", err)
+	if len(changes.Paths()) == 0 {
+		o.logger(ctx).Info("No release files changed; creating an empty release commit",
+			zap.String("version", version))
 	}
-	if gitKeepExists {
-		filesToAdd = append(filesToAdd, ReleaseNotesGitKeepPath)
+	if err := changes.Stage(ctx, o.gitRepo); err != nil {
+		return err
 	}
-	filesToAdd = appendUniqueReleaseFiles(filesToAdd, extraAddPatterns)
-	for _, pattern := range filesToAdd {
-		// Use git add with pattern, ignore errors for missing files
-		if err := o.gitRepo.AddFiles(ctx, pattern); err != nil {
-			return fmt.Errorf("failed to add files: %w", err)
-		}
+	return o.gitRepo.Commit(ctx, releaseCommitMessage(version))
+}
+
+func releaseCommitMessage(version string) string {
+	return fmt.Sprintf("release: prepare release %s", version)
+}
+
+// archivedReleaseNoteFiles lists the files the archive step added to the worktree.
+func archivedReleaseNoteFiles(result *usecase.ArchiveReleaseNotesResult) []string {
+	files := make([]string, 0, len(result.Moves)+1)
+	for _, move := range result.Moves {
+		files = append(files, move.To)
 	}
-	// Commit if there are changes
-	message := fmt.Sprintf("release: prepare release %s", version)
-	return o.gitRepo.Commit(ctx, message)
+	if result.GitKeepCreated {
+		files = append(files, ReleaseNotesGitKeepPath)
+	}
+	return files
 }
 
 func (o *PRReleaseOrchestrator) archiveReleaseNotes(
@@ -447,34 +971,119 @@ func normalizeReleaseVersion(version string) string {
 
 func (o *PRReleaseOrchestrator) createPullRequest(
 	ctx context.Context,
-	version, changelog, releaseNotes, branchName string,
-) error {
+	version, changelog, releaseNotes, date, branchName string,
+	closedIssues []int,
+	links domain.ReleaseLinks,
+) (domain.PullRequestRef, error) {
 	// Create domain version object
 	ver, err := domain.NewVersion(version)
 	if err != nil {
-		return fmt.Errorf("failed to parse version: %w", err)
+		return domain.PullRequestRef{}, fmt.Errorf("failed to parse version: %w", err)
 	}
+	artifacts := o.previewArtifacts(ctx, ver.String())
 	// Create domain release object for PR body preparation
 	release := &domain.Release{
 		Version:      ver,
 		Changelog:    changelog,
 		ReleaseNotes: releaseNotes,
+		Date:         date,
+		Artifacts:    artifacts,
+		ClosedIssues: closedIssues,
+		Links:        links,
+	}
+	release.MergedPullRequests = o.mergedPullRequests(ctx, links.PreviousTag)
+	cfg := config.FromContext(ctx)
+	prBodyTemplate, err := o.readTemplate(templateKeyPRBody, cfg.PRBodyTemplate)
+	if err != nil {
+		return domain.PullRequestRef{}, err
+	}
+	uc := &usecase.PreparePRBodyUseCase{
+		Template: prBodyTemplate,
+		Header:   cfg.PRBodyHeader,
+		Footer:   cfg.PRBodyFooter,
+		FSRepo:   o.fsRepo,
 	}
-	uc := &usecase.PreparePRBodyUseCase{}
 	body, err := uc.Execute(ctx, release)
 	if err != nil {
-		return fmt.Errorf("failed to prepare PR body: %w", err)
+		return domain.PullRequestRef{}, fmt.Errorf("failed to prepare PR body: %w", err)
 	}
-	title := fmt.Sprintf("release: Release %s", version)
-	labels := []string{"release-pending", "automated"}
+	title := releasePRTitle(version)
+	labels := releasePRLabels(version, links.PreviousTag, changelog+"\n"+releaseNotes)
+	o.ensurePRLabels(ctx, labels)
+	previousNumber, previousBody := o.previousPRBody(ctx, branchName)
 	// Create/Update PR with retry for network failures
-	return retry.Do(
+	base := releaseBase(ctx)
+	var pr domain.PullRequestRef
+	err = retry.Do(
 		ctx,
 		retry.WithMaxRetries(DefaultRetryCount, retry.NewExponential(DefaultRetryDelay)),
 		func(ctx context.Context) error {
-			return o.githubRepo.CreateOrUpdatePR(ctx, branchName, "main", title, body, labels)
+			var err error
+			pr, err = o.githubRepo.CreateOrUpdatePR(ctx, branchName, base, title, body, labels)
+			return retryableGitHubError(err)
 		},
 	)
+	if err != nil {
+		return pr, err
+	}
+	o.reportChangelogDiff(ctx, previousNumber, previousBody, changelog, body)
+	return pr, nil
+}
+
+// logPullRequest reports the number and URL of the release PR, as pr_number and pr_url in CI output.
+func (o *PRReleaseOrchestrator) logPullRequest(ctx context.Context, ciOutput bool, pr domain.PullRequestRef) {
+	if ciOutput {
+		o.logCI(ctx, ciOutput, zap.Int("pr_number", pr.Number))
+		o.logCI(ctx, ciOutput, zap.String("pr_url", pr.URL))
+		return
+	}
+	o.logger(ctx).Info("Release pull request ready", zap.Int("pr_number", pr.Number), zap.String("pr_url", pr.URL))
+}
+
+// releasePRTitle returns the title of the release PR of version.
+func releasePRTitle(version string) string {
+	return fmt.Sprintf("release: Release %s", version)
+}
+
+// releasePRLabels returns the labels of the release PR: release-pending, automated, the
+// release:major, release:minor or release:patch label of the bump from previousTag to version and the
+// compat:breaking or compat:safe label of the release, which also weighs the breaking changes notes mark.
+func releasePRLabels(version, previousTag, notes string) []string {
+	labels := []string{"release-pending", "automated"}
+	next, err := domain.NewVersion(version)
+	if err != nil {
+		return labels
+	}
+	var previous *domain.Version
+	if previousTag != "" {
+		if previous, err = domain.NewVersion(previousTag); err != nil {
+			return labels
+		}
+	}
+	compat := domain.AssessCompatibility(previous, next, notes)
+	return append(labels, next.BumpLevelFrom(previous).Label(), compat.Level.Label())
+}
+
+// ensurePRLabels creates the release PR labels a fresh repository lacks, with the colors and
+// descriptions of pr_labels. Labels are cosmetic: failures are logged and the PR is still created.
+func (o *PRReleaseOrchestrator) ensurePRLabels(ctx context.Context, labels []string) {
+	definitions := domain.ResolveLabels(labels, config.FromContext(ctx).Labels())
+	if err := o.githubRepo.EnsureLabels(ctx, definitions); err != nil {
+		o.logger(ctx).Warn("Failed to create missing labels", zap.Strings("labels", labels), zap.Error(err))
+	}
+}
+
+// previewArtifacts loads the artifact matrix of version from a prior dry-run, if one is available.
+// Unreadable metadata and metadata of another build, such as a timestamped snapshot, are logged and
+// skipped so the release PR is rendered without the artifacts table.
+func (o *PRReleaseOrchestrator) previewArtifacts(ctx context.Context, version string) []domain.ArtifactBuild {
+	path := config.FromContext(ctx).ArtifactMetadataPath
+	metadata, err := readReleaseArtifactMetadata(o.fsRepo, path, version)
+	if err != nil {
+		o.logger(ctx).Warn("Skipping artifacts preview", zap.String("path", path), zap.Error(err))
+		return nil
+	}
+	return metadata.ArchiveBuilds()
 }
 
 // executeWithSaga runs the workflow with saga-based rollback support
@@ -485,7 +1094,12 @@ func (o *PRReleaseOrchestrator) executeWithSaga(ctx context.Context, cfg PRRelea
 
 	// Validate required environment variables
 	if err := ValidateEnvironmentVariables(ctx, []string{"GITHUB_TOKEN"}); err != nil {
-		return fmt.Errorf("environment validation failed: %w", err)
+		return stepFailed(stepNameValidateEnvironment, fmt.Errorf("environment validation failed: %w", err))
+	}
+
+	skipped, err := skippedSteps(ctx, cfg)
+	if err != nil {
+		return err
 	}
 
 	// Initialize saga with current branch info
@@ -494,8 +1108,18 @@ func (o *PRReleaseOrchestrator) executeWithSaga(ctx context.Context, cfg PRRelea
 		return err
 	}
 
+	// On an interactive terminal, show a step timeline instead of the info logs
+	if timeline := o.startTimeline(ctx, cfg); timeline != nil {
+		unsubscribe := o.events.Subscribe(timeline,
+			EventStepStarted, EventStepSucceeded, EventStepSkipped, EventStepFailed)
+		err = o.buildAndExecuteWorkflow(timeline.quietContext(ctx), saga, cfg, skipped)
+		unsubscribe()
+		timeline.Finish(err)
+		return err
+	}
+
 	// Build and execute workflow steps
-	if err := o.buildAndExecuteWorkflow(ctx, saga, cfg); err != nil {
+	if err := o.buildAndExecuteWorkflow(ctx, saga, cfg, skipped); err != nil {
 		return err
 	}
 
@@ -505,6 +1129,8 @@ func (o *PRReleaseOrchestrator) executeWithSaga(ctx context.Context, cfg PRRelea
 // initializeSaga creates and configures the saga executor
 func (o *PRReleaseOrchestrator) initializeSaga(ctx context.Context) (*SagaExecutor, error) {
 	saga := NewSagaExecutor(o.stateRepo, true)
+	saga.SetBestEffort(bestEffortRollback(ctx))
+	saga.SetEventBus(o.events)
 
 	// Get current branch for rollback
 	originalBranch, err := o.gitRepo.GetCurrentBranch(ctx)
@@ -512,29 +1138,63 @@ func (o *PRReleaseOrchestrator) initializeSaga(ctx context.Context) (*SagaExecut
 		return nil, fmt.Errorf("failed to get current branch: %w", err)
 	}
 	saga.SetOriginalBranch(originalBranch)
+	saga.SetBaseBranch(releaseBase(ctx))
+	startCommit, err := o.gitRepo.GetHeadCommit(ctx)
+	if err != nil && !errors.Is(err, repository.ErrEmptyRepository) {
+		return nil, fmt.Errorf("failed to get head commit: %w", err)
+	}
+	saga.SetOrigin(version.Version, startCommit, config.FromContext(ctx).Hash())
 	return saga, nil
 }
 
+// warnSessionDrift warns when a session was recorded by a different releasepr binary or under a
+// different effective configuration than the one resuming or rolling it back.
+func (o *PRReleaseOrchestrator) warnSessionDrift(ctx context.Context, state *domain.RollbackState) {
+	if state.ToolVersion != "" && state.ToolVersion != version.Version {
+		o.logger(ctx).Warn("Session was created by a different releasepr version",
+			zap.String("session_id", state.SessionID),
+			zap.String("session_version", state.ToolVersion),
+			zap.String("current_version", version.Version),
+		)
+	}
+	if state.ConfigHash == "" {
+		return
+	}
+	if current := config.FromContext(ctx).Hash(); state.ConfigHash != current {
+		o.logger(ctx).Warn("Session was created with a different configuration",
+			zap.String("session_id", state.SessionID),
+			zap.String("session_config_hash", state.ConfigHash),
+			zap.String("current_config_hash", current),
+		)
+	}
+}
+
 // buildAndExecuteWorkflow builds all workflow steps and executes the saga
 func (o *PRReleaseOrchestrator) buildAndExecuteWorkflow(
 	ctx context.Context,
 	saga *SagaExecutor,
 	cfg PRReleaseConfig,
+	skipped domain.SkippedSteps,
 ) error {
 	compensator := NewCompensatingActions(o.gitRepo, o.githubRepo, o.fsRepo)
 	originalBranch := saga.GetState().OriginalBranch
+	saga.SetDryRun(cfg.DryRun)
+	saga.SetRunFlags(cfg.ForceRelease, cfg.SkipPR, cfg.SkipSteps)
 
 	// Shared workflow context
 	wctx := &workflowContext{
 		originalBranch: originalBranch,
+		changes:        NewChangeSet(),
+		skipped:        skipped,
 	}
 
 	// Add all workflow steps
 	o.addCheckChangesStep(saga, cfg, compensator, wctx)
 	o.addCalculateVersionStep(saga, cfg, compensator, wctx)
 	o.addCreateBranchStep(saga, cfg, compensator, wctx, originalBranch)
-	o.addPrepareReleaseArtifactsStep(saga, compensator, wctx)
+	o.addPrepareReleaseArtifactsStep(saga, cfg, compensator, wctx)
 	o.addArchiveReleaseNotesStep(saga, cfg, compensator, wctx)
+	o.addConsumeChangeFilesStep(saga, cfg, compensator, wctx)
 	o.addCommitChangesStep(saga, cfg, compensator, wctx)
 	o.addPushBranchStep(saga, cfg, compensator, wctx)
 	o.addCreatePRStep(saga, cfg, compensator, wctx)
@@ -543,26 +1203,50 @@ func (o *PRReleaseOrchestrator) buildAndExecuteWorkflow(
 	if err := saga.Execute(ctx); err != nil {
 		return fmt.Errorf("workflow failed: %w", err)
 	}
+	if wctx.version != "" && cfg.DryRun {
+		o.logDryRunPlan(ctx, cfg.CIOutput, wctx.version, saga.GetState().Plan)
+		return nil
+	}
+	if wctx.version != "" {
+		if err := o.writeManifest(ctx, wctx.version, wctx.latestTag, wctx.prNumber); err != nil {
+			return err
+		}
+	}
 
 	o.logStatus(ctx, cfg.CIOutput, fmt.Sprintf("✅ Release PR workflow completed for version %s", wctx.version))
 	return nil
 }
 
+// logDryRunPlan reports the mutating operations a saga dry run skipped, in the order they would run.
+func (o *PRReleaseOrchestrator) logDryRunPlan(ctx context.Context, ciOutput bool, version string, plan []string) {
+	o.logStatus(ctx, ciOutput,
+		fmt.Sprintf("🛈 Dry-run complete – release %s planned (no branch, commit, push or PR).", version))
+	for _, action := range plan {
+		o.logStatus(ctx, ciOutput, "→ Would: "+action)
+	}
+}
+
 // workflowContext holds shared state for workflow execution
 type workflowContext struct {
-	version                    string
-	branchName                 string
-	hasChanges                 bool
-	latestTag                  string
-	prNumber                   int
-	createdInSession           bool
-	localCreatedInSession      bool
-	remoteCreatedInSession     bool
-	remoteExisted              bool
-	changelog                  string
-	releaseNotes               string
-	originalBranch             string
-	releaseArtifactAddPatterns []string
+	version                string
+	branchName             string
+	hasChanges             bool
+	skipReason             string
+	emptyRepository        bool
+	latestTag              string
+	prNumber               int
+	createdInSession       bool
+	localCreatedInSession  bool
+	remoteCreatedInSession bool
+	remoteExisted          bool
+	changelog              string
+	releaseNotes           string
+	releaseDate            string
+	closedIssues           []int
+	releaseLinks           domain.ReleaseLinks
+	originalBranch         string
+	changes                *ChangeSet
+	skipped                domain.SkippedSteps
 }
 
 // Workflow step methods
@@ -573,16 +1257,24 @@ func (o *PRReleaseOrchestrator) addCheckChangesStep(
 	wctx *workflowContext,
 ) {
 	saga.AddStep(SagaStep{
-		Name: "Check Changes",
+		Name: stepNameCheckChanges,
 		Type: domain.OperationTypeCheckChanges,
 		Execute: func(ctx context.Context) (map[string]any, error) {
-			var err error
-			wctx.hasChanges, wctx.latestTag, err = o.checkChanges(ctx)
+			check, err := o.checkChanges(ctx)
+			wctx.hasChanges, wctx.latestTag, wctx.skipReason = check.HasChanges, check.LatestTag, check.Reason
+			if errors.Is(err, repository.ErrEmptyRepository) {
+				wctx.emptyRepository = true
+				o.logStatus(ctx, cfg.CIOutput, emptyRepositoryStatus)
+				err = nil
+			}
 			if err != nil {
 				return nil, fmt.Errorf("failed to check changes: %w", err)
 			}
 			o.logCI(ctx, cfg.CIOutput, zap.Bool("has_changes", wctx.hasChanges))
 			o.logCI(ctx, cfg.CIOutput, zap.String("latest_tag", wctx.latestTag))
+			if wctx.skipReason != "" {
+				o.logCI(ctx, cfg.CIOutput, zap.String("skip_reason", wctx.skipReason))
+			}
 			return map[string]any{
 				"has_changes": wctx.hasChanges,
 				"latest_tag":  wctx.latestTag,
@@ -599,11 +1291,14 @@ func (o *PRReleaseOrchestrator) addCalculateVersionStep(
 	wctx *workflowContext,
 ) {
 	saga.AddStep(SagaStep{
-		Name: "Calculate Version",
+		Name: stepNameCalculateVersion,
 		Type: domain.OperationTypeCalculateVersion,
 		Execute: func(ctx context.Context) (map[string]any, error) {
+			if wctx.emptyRepository {
+				return map[string]any{"skip": true}, nil
+			}
 			if !wctx.hasChanges && !cfg.ForceRelease {
-				o.logStatus(ctx, cfg.CIOutput, "No changes detected since last release")
+				o.logStatus(ctx, cfg.CIOutput, noChangesStatus(wctx.skipReason))
 				return map[string]any{"skip": true}, nil
 			}
 			o.logger(ctx).Info("Calculating version", zap.String("latest_tag", wctx.latestTag))
@@ -617,6 +1312,10 @@ func (o *PRReleaseOrchestrator) addCalculateVersionStep(
 				o.logger(ctx).Error("Invalid version", zap.String("version", wctx.version), zap.Error(err))
 				return nil, fmt.Errorf("invalid version: %w", err)
 			}
+			if err := o.checkGoModuleMajor(ctx, wctx.version, wctx.latestTag); err != nil {
+				o.logger(ctx).Error("Go module path does not match version", zap.Error(err))
+				return nil, err
+			}
 			o.logger(ctx).Info("Calculated version", zap.String("version", wctx.version))
 			o.logCI(ctx, cfg.CIOutput, zap.String("version", wctx.version))
 			saga.SetVersion(wctx.version)
@@ -634,7 +1333,7 @@ func (o *PRReleaseOrchestrator) addCreateBranchStep(
 	originalBranch string,
 ) {
 	saga.AddStep(SagaStep{
-		Name: "Create Release Branch",
+		Name: stepNameCreateBranch,
 		Type: domain.OperationTypeCreateBranch,
 		Execute: func(ctx context.Context) (map[string]any, error) {
 			if wctx.version == "" {
@@ -644,10 +1343,15 @@ func (o *PRReleaseOrchestrator) addCreateBranchStep(
 			if err != nil {
 				return nil, err
 			}
-			branchExists, remoteExists, err := o.checkBranchExistence(ctx, branchName)
+			o.logCI(ctx, cfg.CIOutput, zap.String("branch_name", branchName))
+			branchExists, remoteExists, err := o.checkReleaseBranch(ctx, branchName, cfg.ForceRelease)
 			if err != nil {
 				return nil, err
 			}
+			if cfg.DryRun {
+				wctx.remoteExisted = remoteExists
+				return saga.PlanAction(plannedBranchAction(branchName, branchExists, cfg.ForceRelease)), nil
+			}
 			if cfg.ForceRelease {
 				branchExists, err = o.refreshLocalBranch(
 					ctx,
@@ -686,6 +1390,17 @@ func (o *PRReleaseOrchestrator) addCreateBranchStep(
 	})
 }
 
+// plannedBranchAction describes what the create branch step would do to the local release branch.
+func plannedBranchAction(branchName string, branchExists, force bool) string {
+	switch {
+	case branchExists && force:
+		return fmt.Sprintf("Recreate local branch %s and check it out", branchName)
+	case branchExists:
+		return fmt.Sprintf("Check out existing branch %s", branchName)
+	}
+	return fmt.Sprintf("Create branch %s and check it out", branchName)
+}
+
 func (o *PRReleaseOrchestrator) prepareBranchName(
 	ctx context.Context,
 	saga *SagaExecutor,
@@ -710,10 +1425,7 @@ func (o *PRReleaseOrchestrator) checkBranchExistence(ctx context.Context, branch
 	defer cancel()
 	remoteExists, err := o.gitRepo.RemoteBranchExists(checkCtx, branchName)
 	if err != nil {
-		o.logger(ctx).Warn("Failed to check remote branch, assuming it doesn't exist",
-			zap.String("branch", branchName),
-			zap.Error(err))
-		remoteExists = false
+		return false, false, fmt.Errorf("failed to check remote branch %s: %w", branchName, err)
 	}
 	return branchExists, remoteExists, nil
 }
@@ -762,22 +1474,46 @@ func (o *PRReleaseOrchestrator) logBranchStatus(
 
 func (o *PRReleaseOrchestrator) addPrepareReleaseArtifactsStep(
 	saga *SagaExecutor,
+	cfg PRReleaseConfig,
 	compensator *CompensatingActions,
 	wctx *workflowContext,
 ) {
 	saga.AddStep(SagaStep{
-		Name: "Prepare Release Artifacts",
+		Name: stepNameReleaseArtifacts,
 		Type: domain.OperationTypeUpdatePackages,
 		Execute: func(ctx context.Context) (map[string]any, error) {
 			if wctx.version == "" {
 				return map[string]any{"skip": true}, nil
 			}
 			o.logger(ctx).Info("Preparing release artifacts", zap.String("version", wctx.version))
+			// A dry run renders onto an overlay so the worktree is left as it was
+			renderer := o
+			if cfg.DryRun {
+				renderer, _ = o.planner(func(command domain.PlannedCommand) {
+					saga.PlanAction("Run " + command.Command)
+				})
+			}
+			var bumped domain.SubmoduleUpdates
+			if !cfg.DryRun {
+				var err error
+				bumped, err = o.bumpSubmodules(ctx)
+				wctx.changes.Track(bumped.Paths()...)
+				if err != nil {
+					return nil, err
+				}
+			}
 			g, gctx := errgroup.WithContext(ctx)
 			var artifacts *releaseArtifacts
+			var packageFiles []string
 			g.Go(func() error {
+				if wctx.skipped.Has(domain.StepPackageVersions) {
+					o.logSkippedStep(gctx, domain.StepPackageVersions)
+					return nil
+				}
 				o.logger(gctx).Info("Updating package versions", zap.String("version", wctx.version))
-				if err := o.updatePackageVersions(gctx, wctx.version); err != nil {
+				var err error
+				packageFiles, err = renderer.updatePackageVersions(gctx, wctx.version, wctx.latestTag)
+				if err != nil {
 					o.logger(gctx).Error("Failed to update package versions", zap.Error(err))
 					return fmt.Errorf("failed to update package versions: %w", err)
 				}
@@ -787,7 +1523,7 @@ func (o *PRReleaseOrchestrator) addPrepareReleaseArtifactsStep(
 			g.Go(func() error {
 				o.logger(gctx).Info("Generating changelog", zap.String("version", wctx.version))
 				var err error
-				artifacts, err = o.generateChangelog(gctx, wctx.version)
+				artifacts, err = renderer.generateChangelog(gctx, wctx.version, wctx.latestTag, bumped, wctx.skipped)
 				if err != nil {
 					o.logger(gctx).Error("Failed to generate changelog", zap.Error(err))
 					return fmt.Errorf("failed to generate changelog: %w", err)
@@ -798,22 +1534,29 @@ func (o *PRReleaseOrchestrator) addPrepareReleaseArtifactsStep(
 			if err := g.Wait(); err != nil {
 				return nil, err
 			}
-			artifactResult, err := o.runReleaseArtifactCommands(ctx, wctx.version, wctx.branchName, wctx.latestTag)
+			artifactResult, err := renderer.releaseArtifactCommands(
+				ctx,
+				wctx.version,
+				wctx.branchName,
+				wctx.latestTag,
+				wctx.skipped,
+			)
 			if err != nil {
 				return nil, err
 			}
 			wctx.changelog = artifacts.changelog
+			if cfg.CIOutput {
+				o.writeCIOutput(ctx, zap.String("changelog", wctx.changelog))
+			}
 			wctx.releaseNotes = artifacts.releaseNotes
-			wctx.releaseArtifactAddPatterns = artifactResult.addPatterns
+			wctx.releaseDate = artifacts.date
+			wctx.closedIssues = artifacts.closedIssues
+			wctx.releaseLinks = artifacts.links
+			wctx.changes.Track(packageFiles...)
+			wctx.changes.Track(artifacts.files...)
+			wctx.changes.Track(artifactResult.files()...)
 			o.logger(ctx).Info("Release artifacts prepared successfully", zap.String("version", wctx.version))
-			modifiedFiles := []string{
-				"package.json",
-				"package-lock.json",
-				"CHANGELOG.md",
-				ReleaseBodyOutputFile,
-				ReleaseNotesOutputFile,
-			}
-			modifiedFiles = append(modifiedFiles, artifactResult.modifiedFiles...)
+			modifiedFiles := slices.Concat(bumped.Paths(), packageFiles, artifacts.files, artifactResult.modifiedFiles)
 			return map[string]any{
 				"modified_files": modifiedFiles,
 				"created_files":  artifactResult.createdFiles,
@@ -832,24 +1575,72 @@ func (o *PRReleaseOrchestrator) addArchiveReleaseNotesStep(
 	wctx *workflowContext,
 ) {
 	saga.AddStep(SagaStep{
-		Name: "Archive Release Notes",
+		Name: stepNameArchiveNotes,
 		Type: domain.OperationTypeArchiveNotes,
 		Execute: func(ctx context.Context) (map[string]any, error) {
-			if wctx.version == "" || cfg.DryRun {
+			if wctx.version == "" {
+				return map[string]any{"skip": true}, nil
+			}
+			if wctx.skipped.Has(domain.StepArchiveNotes) {
+				o.logSkippedStep(ctx, domain.StepArchiveNotes)
 				return map[string]any{"skip": true}, nil
 			}
+			if cfg.DryRun {
+				return saga.PlanAction(fmt.Sprintf("Archive active release notes for %s", wctx.version)), nil
+			}
 			o.logger(ctx).Info("Archiving release notes", zap.String("version", wctx.version))
 			result, err := o.archiveReleaseNotes(ctx, wctx.version)
 			if err != nil {
 				o.logger(ctx).Error("Failed to archive release notes", zap.Error(err))
 				return nil, fmt.Errorf("failed to archive release notes: %w", err)
 			}
+			wctx.changes.Track(archivedReleaseNoteFiles(result)...)
 			return result.ToRollbackData(), nil
 		},
 		Compensate: compensator.RestoreArchivedReleaseNotes,
 	})
 }
 
+func (o *PRReleaseOrchestrator) addConsumeChangeFilesStep(
+	saga *SagaExecutor,
+	cfg PRReleaseConfig,
+	compensator *CompensatingActions,
+	wctx *workflowContext,
+) {
+	saga.AddStep(SagaStep{
+		Name: stepNameConsumeChangeFiles,
+		Type: domain.OperationTypeConsumeChanges,
+		Execute: func(ctx context.Context) (map[string]any, error) {
+			if wctx.version == "" {
+				return map[string]any{"skip": true}, nil
+			}
+			consumes, err := o.consumesChangeFiles(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if !consumes {
+				return map[string]any{"skip": true}, nil
+			}
+			if cfg.DryRun {
+				files, err := o.consumableChangeFiles(ctx)
+				if err != nil {
+					return nil, err
+				}
+				return saga.PlanAction("Delete " + strings.Join(files.Paths(), ", ")), nil
+			}
+			o.logger(ctx).Info("Consuming change files", zap.String("version", wctx.version))
+			result, err := o.consumeChangeFiles(ctx)
+			if err != nil {
+				o.logger(ctx).Error("Failed to consume change files", zap.Error(err))
+				return nil, fmt.Errorf("failed to consume change files: %w", err)
+			}
+			wctx.changes.Track(consumedChangeFiles(result)...)
+			return result.ToRollbackData(), nil
+		},
+		Compensate: compensator.RestoreChangeFiles,
+	})
+}
+
 func (o *PRReleaseOrchestrator) addCommitChangesStep(
 	saga *SagaExecutor,
 	cfg PRReleaseConfig,
@@ -857,18 +1648,29 @@ func (o *PRReleaseOrchestrator) addCommitChangesStep(
 	wctx *workflowContext,
 ) {
 	saga.AddStep(SagaStep{
-		Name: "Commit Changes",
+		Name: stepNameCommitChanges,
 		Type: domain.OperationTypeCommitChanges,
 		Execute: func(ctx context.Context) (map[string]any, error) {
-			if wctx.version == "" || cfg.DryRun {
+			if wctx.version == "" {
 				return map[string]any{"skip": true}, nil
 			}
+			if cfg.DryRun {
+				if len(wctx.changes.Paths()) == 0 {
+					return saga.PlanAction(fmt.Sprintf("Create the empty release commit %q",
+						releaseCommitMessage(wctx.version))), nil
+				}
+				return saga.PlanAction(fmt.Sprintf("Commit %s as %q",
+					strings.Join(wctx.changes.Paths(), ", "), releaseCommitMessage(wctx.version))), nil
+			}
 			o.logger(ctx).Info("Committing changes", zap.String("version", wctx.version))
-			if err := o.commitChanges(ctx, wctx.version, wctx.releaseArtifactAddPatterns); err != nil {
+			if err := o.commitChanges(ctx, wctx.version, wctx.changes); err != nil {
 				o.logger(ctx).Error("Failed to commit changes", zap.Error(err))
 				return nil, fmt.Errorf("failed to commit changes: %w", err)
 			}
 			o.logger(ctx).Info("Committed changes", zap.String("version", wctx.version))
+			if err := o.checkReleaseSize(ctx); err != nil {
+				return nil, stepFailed(stepNameReleaseSize, err)
+			}
 			return map[string]any{
 				"commit_sha": "HEAD",
 			}, nil
@@ -877,26 +1679,6 @@ func (o *PRReleaseOrchestrator) addCommitChangesStep(
 	})
 }
 
-func appendUniqueReleaseFiles(files []string, extra []string) []string {
-	seen := make(map[string]struct{}, len(files)+len(extra))
-	result := make([]string, 0, len(files)+len(extra))
-	for _, file := range files {
-		if _, ok := seen[file]; ok {
-			continue
-		}
-		seen[file] = struct{}{}
-		result = append(result, file)
-	}
-	for _, file := range extra {
-		if _, ok := seen[file]; ok {
-			continue
-		}
-		seen[file] = struct{}{}
-		result = append(result, file)
-	}
-	return result
-}
-
 func (o *PRReleaseOrchestrator) addPushBranchStep(
 	saga *SagaExecutor,
 	cfg PRReleaseConfig,
@@ -904,12 +1686,23 @@ func (o *PRReleaseOrchestrator) addPushBranchStep(
 	wctx *workflowContext,
 ) {
 	saga.AddStep(SagaStep{
-		Name: "Push Branch",
+		Name: stepNamePushBranch,
 		Type: domain.OperationTypePushBranch,
 		Execute: func(ctx context.Context) (map[string]any, error) {
-			if wctx.version == "" || cfg.DryRun {
+			if wctx.version == "" {
+				return map[string]any{"skip": true}, nil
+			}
+			if wctx.skipped.Has(domain.StepPush) {
+				o.logSkippedStep(ctx, domain.StepPush)
 				return map[string]any{"skip": true}, nil
 			}
+			if cfg.DryRun && wctx.remoteExisted {
+				return saga.PlanAction(fmt.Sprintf("Force push branch %s", wctx.branchName)), nil
+			}
+			if cfg.DryRun {
+				return saga.PlanAction(fmt.Sprintf("Push branch %s", wctx.branchName)), nil
+			}
+			o.ensureBaseSynced(ctx, cfg.CIOutput, wctx.branchName)
 			// Use force push when the remote branch already existed to update the automated release PR branch.
 			var err error
 			if wctx.remoteExisted {
@@ -944,12 +1737,21 @@ func (o *PRReleaseOrchestrator) addCreatePRStep(
 	wctx *workflowContext,
 ) {
 	saga.AddStep(SagaStep{
-		Name: "Create Pull Request",
+		Name: stepNameCreatePR,
 		Type: domain.OperationTypeCreatePR,
 		Execute: func(ctx context.Context) (map[string]any, error) {
-			if wctx.version == "" || cfg.SkipPR || cfg.DryRun {
+			if wctx.version == "" || cfg.SkipPR {
 				return map[string]any{"skip": true}, nil
 			}
+			if wctx.skipped.Has(domain.StepPullRequest) {
+				o.logSkippedStep(ctx, domain.StepPullRequest)
+				return map[string]any{"skip": true}, nil
+			}
+			if cfg.DryRun {
+				labels := releasePRLabels(wctx.version, wctx.latestTag, wctx.changelog+"\n"+wctx.releaseNotes)
+				return saga.PlanAction(fmt.Sprintf("Create or update pull request %q from %s with labels %s",
+					releasePRTitle(wctx.version), wctx.branchName, strings.Join(labels, ", "))), nil
+			}
 			o.logger(ctx).Info("Preparing pull request", zap.String("version", wctx.version))
 			changelog := wctx.changelog
 			ver, err := domain.NewVersion(wctx.version)
@@ -957,30 +1759,51 @@ func (o *PRReleaseOrchestrator) addCreatePRStep(
 				o.logger(ctx).Error("Failed to parse version", zap.String("version", wctx.version), zap.Error(err))
 				return nil, fmt.Errorf("failed to parse version: %w", err)
 			}
+			artifacts := o.previewArtifacts(ctx, ver.String())
 			release := &domain.Release{
 				Version:      ver,
 				Changelog:    changelog,
 				ReleaseNotes: wctx.releaseNotes,
+				Date:         wctx.releaseDate,
+				Artifacts:    artifacts,
+				ClosedIssues: wctx.closedIssues,
+				Links:        wctx.releaseLinks,
+			}
+			release.MergedPullRequests = o.mergedPullRequests(ctx, wctx.latestTag)
+			appConfig := config.FromContext(ctx)
+			prBodyTemplate, err := o.readTemplate(templateKeyPRBody, appConfig.PRBodyTemplate)
+			if err != nil {
+				return nil, err
+			}
+			uc := &usecase.PreparePRBodyUseCase{
+				Template: prBodyTemplate,
+				Header:   appConfig.PRBodyHeader,
+				Footer:   appConfig.PRBodyFooter,
+				FSRepo:   o.fsRepo,
 			}
-			uc := &usecase.PreparePRBodyUseCase{}
 			body, err := uc.Execute(ctx, release)
 			if err != nil {
 				o.logger(ctx).Error("Failed to prepare PR body", zap.Error(err))
 				return nil, fmt.Errorf("failed to prepare PR body: %w", err)
 			}
-			title := fmt.Sprintf("release: Release %s", wctx.version)
-			labels := []string{"release-pending", "automated"}
+			title := releasePRTitle(wctx.version)
+			labels := releasePRLabels(wctx.version, wctx.latestTag, changelog+"\n"+wctx.releaseNotes)
 			o.logger(ctx).Info("Creating or updating pull request",
 				zap.String("branch", wctx.branchName),
-				zap.String("base", "main"),
+				zap.String("base", releaseBase(ctx)),
 				zap.String("title", title),
 				zap.Strings("labels", labels),
 			)
+			o.ensurePRLabels(ctx, labels)
+			previousNumber, previousBody := o.previousPRBody(ctx, wctx.branchName)
+			var pr domain.PullRequestRef
 			err = retry.Do(
 				ctx,
 				retry.WithMaxRetries(DefaultRetryCount, retry.NewExponential(DefaultRetryDelay)),
 				func(ctx context.Context) error {
-					return o.githubRepo.CreateOrUpdatePR(ctx, wctx.branchName, "main", title, body, labels)
+					var err error
+					pr, err = o.githubRepo.CreateOrUpdatePR(ctx, wctx.branchName, releaseBase(ctx), title, body, labels)
+					return retryableGitHubError(err)
 				},
 			)
 			if err != nil {
@@ -988,17 +1811,97 @@ func (o *PRReleaseOrchestrator) addCreatePRStep(
 				return nil, fmt.Errorf("failed to create or update PR from %s to main: %w", wctx.branchName, err)
 			}
 			o.logger(ctx).Info("Created or updated pull request", zap.String("branch", wctx.branchName))
-			wctx.prNumber = 0 // Placeholder since CreateOrUpdatePR doesn't return PR number
+			o.reportChangelogDiff(ctx, previousNumber, previousBody, changelog, body)
+			wctx.prNumber = pr.Number
+			saga.SetPullRequest(pr)
+			o.logPullRequest(ctx, cfg.CIOutput, pr)
+			o.requestReviews(ctx, wctx.branchName, wctx.changes.Paths())
 			return map[string]any{
-				"pr_number": wctx.prNumber,
+				"pr_number": pr.Number,
+				"pr_url":    pr.URL,
 			}, nil
 		},
 		Compensate: compensator.ClosePullRequest,
 	})
 }
 
+// SetStateRepository replaces the JSON state repository the orchestrator records sessions in.
+func (o *PRReleaseOrchestrator) SetStateRepository(stateRepo repository.StateRepository) {
+	o.stateRepo = stateRepo
+}
+
+// Sessions returns the recorded release sessions, most recently started first.
+func (o *PRReleaseOrchestrator) Sessions(ctx context.Context) ([]*domain.RollbackState, error) {
+	return o.stateRepo.List(ctx)
+}
+
+// Session returns the recorded state of a single release session.
+func (o *PRReleaseOrchestrator) Session(ctx context.Context, sessionID string) (*domain.RollbackState, error) {
+	return o.stateRepo.Load(ctx, sessionID)
+}
+
+// Rollback rolls back a release session; the latest session is used when sessionID is empty.
+func (o *PRReleaseOrchestrator) Rollback(ctx context.Context, sessionID string) error {
+	return o.performRollback(ctx, sessionID)
+}
+
+// Resume re-runs the release workflow for a failed or rolled back session from its original branch,
+// against the base branch and with the flags the session recorded. It refuses sessions created under a
+// different configuration. Steps reconcile with what already exists (release branch, pull request), and
+// the run is recorded as a new session.
+func (o *PRReleaseOrchestrator) Resume(ctx context.Context, sessionID string) error {
+	if err := ValidateAllowedRepository(ctx); err != nil {
+		return err
+	}
+	state, err := o.stateRepo.Load(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+	switch state.Status {
+	case domain.WorkflowStatusFailed, domain.WorkflowStatusRolledBack:
+	default:
+		return fmt.Errorf("session %s cannot be resumed from status %s", sessionID, state.Status)
+	}
+	if current := config.FromContext(ctx).Hash(); state.ConfigHash != "" && state.ConfigHash != current {
+		return fmt.Errorf(
+			"session %s was created with a different configuration (%s, now %s); restore it or start a new release",
+			sessionID, state.ConfigHash, current,
+		)
+	}
+	o.warnSessionDrift(ctx, state)
+	if state.OriginalBranch != "" {
+		if err := o.gitRepo.CheckoutBranch(ctx, state.OriginalBranch); err != nil {
+			return fmt.Errorf("failed to checkout original branch %s: %w", state.OriginalBranch, err)
+		}
+	}
+	ctx, err = o.resumeReleaseChannel(ctx, state)
+	if err != nil {
+		return err
+	}
+	o.logger(ctx).Info("Resuming release session",
+		zap.String("session_id", sessionID),
+		zap.String("version", state.Version),
+		zap.String("base", releaseBase(ctx)),
+	)
+	return o.executeWithSaga(ctx, resumeConfig(state))
+}
+
+// resumeConfig reapplies the flags a session ran with to its resumed run.
+func resumeConfig(state *domain.RollbackState) PRReleaseConfig {
+	return PRReleaseConfig{
+		ForceRelease:   state.ForceRelease,
+		DryRun:         state.DryRun,
+		SkipPR:         state.SkipPR,
+		EnableRollback: true,
+		SkipSteps:      state.SkipSteps,
+	}
+}
+
 // performRollback rolls back a failed release session
 func (o *PRReleaseOrchestrator) performRollback(ctx context.Context, sessionID string) error {
+	if err := ValidateAllowedRepository(ctx); err != nil {
+		return err
+	}
 	if sessionID == "" {
 		// Load the latest session if no ID provided
 		state, err := o.stateRepo.LoadLatest(ctx)
@@ -1013,6 +1916,9 @@ func (o *PRReleaseOrchestrator) performRollback(ctx context.Context, sessionID s
 	if err != nil {
 		return fmt.Errorf("failed to load saga: %w", err)
 	}
+	o.warnSessionDrift(ctx, saga.GetState())
+
+	saga.SetBestEffort(bestEffortRollback(ctx))
 
 	// Create compensating actions handler
 	compensator := NewCompensatingActions(o.gitRepo, o.githubRepo, o.fsRepo)
@@ -1030,6 +1936,11 @@ func (o *PRReleaseOrchestrator) performRollback(ctx context.Context, sessionID s
 	return nil
 }
 
+// bestEffortRollback reports whether rollback_mode asks rollback to attempt every compensation.
+func bestEffortRollback(ctx context.Context) bool {
+	return config.FromContext(ctx).RollbackMode == "best-effort"
+}
+
 // rebuildSagaSteps rebuilds the saga steps with compensating actions
 func (o *PRReleaseOrchestrator) rebuildSagaSteps(saga *SagaExecutor, compensator *CompensatingActions) {
 	// Map operation types to compensating actions
@@ -1040,6 +1951,7 @@ func (o *PRReleaseOrchestrator) rebuildSagaSteps(saga *SagaExecutor, compensator
 		domain.OperationTypeUpdatePackages:    compensator.RestoreFiles,
 		domain.OperationTypeGenerateChangelog: compensator.RestoreFiles,
 		domain.OperationTypeArchiveNotes:      compensator.RestoreArchivedReleaseNotes,
+		domain.OperationTypeConsumeChanges:    compensator.RestoreChangeFiles,
 		domain.OperationTypeCommitChanges:     compensator.ResetCommit,
 		domain.OperationTypePushBranch:        compensator.DeleteBranch,
 		domain.OperationTypeCreatePR:          compensator.ClosePullRequest,
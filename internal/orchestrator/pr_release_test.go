@@ -10,12 +10,15 @@ import (
 	"github.com/compozy/releasepr/internal/config"
 	"github.com/compozy/releasepr/internal/domain"
 	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/pkg/version"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func testReleaseContext(t *testing.T) context.Context {
@@ -32,9 +35,13 @@ func testReleaseConfig() *config.Config {
 	cfg := config.DefaultConfig()
 	cfg.GithubOwner = "compozy"
 	cfg.GithubRepo = "releasepr"
+	cfg.ReleaseManifestPath = ""
 	return cfg
 }
 
+// testReleasePR is the release PR the GitHub mocks report as created or updated.
+var testReleasePR = domain.PullRequestRef{Number: 42, URL: "https://github.com/compozy/releasepr/pull/42"}
+
 func TestPRReleaseOrchestrator_generateChangelog(t *testing.T) {
 	t.Run("Should write release body and preserve historical release notes", func(t *testing.T) {
 		ctx := testReleaseContext(t)
@@ -58,7 +65,7 @@ Only this release needs these notes.
 		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.1.0", "release").Return(scopedChangelog, nil).Once()
 		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.1.0").Return(fullChangelog, nil).Once()
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
-		artifacts, err := orch.generateChangelog(ctx, "v1.1.0")
+		artifacts, err := orch.generateChangelog(ctx, "v1.1.0", "", nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, scopedChangelog, artifacts.changelog)
 		assert.Contains(t, artifacts.releaseNotes, "Only this release needs these notes.")
@@ -84,6 +91,97 @@ Only this release needs these notes.
 		cliffSvc.AssertExpectations(t)
 	})
 
+	t.Run("Should sanitize hostile commit messages in generated changelogs", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		npmSvc := new(mockNpmService)
+		hostile := "## v1.2.0\n\n### Features\n- add <script>alert(1)</script> [x](javascript:alert(1)) ``` open"
+		expected := "## v1.2.0\n\n### Features\n- add &lt;script>alert(1)&lt;/script> x \\`\\`\\` open"
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.2.0", "release").Return(hostile, nil).Once()
+		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.2.0").Return("# Changelog\n\n"+hostile, nil).Once()
+		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
+		artifacts, err := orch.generateChangelog(ctx, "v1.2.0", "", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, artifacts.changelog)
+		changelogData, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Changelog\n\n"+expected, string(changelogData))
+		cliffSvc.AssertExpectations(t)
+	})
+
+	t.Run("Should link issue references and collect closed issues when enabled", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.IssueLinks = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		cliffSvc := new(mockCliffService)
+		scopedChangelog := "## v1.2.0\n\n### Bug Fixes\n- Retry pushes (#12), fixes GH-7"
+		linked := "## v1.2.0\n\n### Bug Fixes\n" +
+			"- Retry pushes ([#12](https://github.com/compozy/releasepr/issues/12)), " +
+			"fixes [GH-7](https://github.com/compozy/releasepr/issues/7)"
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.2.0", "release").Return(scopedChangelog, nil).Once()
+		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.2.0").
+			Return("# Changelog\n\n"+scopedChangelog, nil).Once()
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			fsRepo,
+			cliffSvc,
+			new(mockNpmService),
+		)
+		artifacts, err := orch.generateChangelog(ctx, "v1.2.0", "", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, linked, artifacts.changelog)
+		assert.Equal(t, []int{7}, artifacts.closedIssues)
+		changelogData, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Changelog\n\n"+linked, string(changelogData))
+	})
+
+	t.Run("Should render the public changelog for the configured audience", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseChangelogAudience = "public"
+		cfg.PublicExcludeScopes = []string{"internal"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		cliffSvc := new(mockCliffService)
+		publicFilter := domain.CommitFilter{
+			ExcludeTypes:  []string{"chore", "ci", "test"},
+			ExcludeScopes: []string{"internal"},
+		}
+		cliffSvc.On("GenerateFilteredChangelog", mock.Anything, "v1.3.0", "release", publicFilter).
+			Return("## v1.3.0\n\n### Features\n- Public", nil).Once()
+		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.3.0").
+			Return("# Changelog\n\n## v1.3.0\n\n- Public\n- chore: internal", nil).Once()
+		orch := NewPRReleaseOrchestrator(nil, nil, fsRepo, cliffSvc, nil)
+		artifacts, err := orch.generateChangelog(ctx, "v1.3.0", "", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "## v1.3.0\n\n### Features\n- Public", artifacts.changelog)
+		changelogData, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Contains(t, string(changelogData), "chore: internal")
+		cliffSvc.AssertExpectations(t)
+	})
+
+	t.Run("Should write the public changelog file for the configured audience", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ChangelogFileAudience = "public"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		cliffSvc := new(mockCliffService)
+		publicFilter := domain.CommitFilter{ExcludeTypes: []string{"chore", "ci", "test"}}
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.3.0", "release").Return("## v1.3.0", nil).Once()
+		cliffSvc.On("GenerateFilteredFullChangelog", mock.Anything, "v1.3.0", publicFilter).
+			Return("# Changelog\n\n## v1.3.0", nil).Once()
+		orch := NewPRReleaseOrchestrator(nil, nil, fsRepo, cliffSvc, nil)
+		_, err := orch.generateChangelog(ctx, "v1.3.0", "", nil, nil)
+		require.NoError(t, err)
+		cliffSvc.AssertExpectations(t)
+	})
+
 	t.Run("Should use scoped changelog when manual notes are absent", func(t *testing.T) {
 		ctx := testReleaseContext(t)
 		fsRepo := afero.NewMemMapFs()
@@ -96,7 +194,7 @@ Only this release needs these notes.
 		cliffSvc.On("GenerateChangelog", mock.Anything, "v2.0.0", "release").Return(scopedChangelog, nil).Once()
 		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v2.0.0").Return(fullChangelog, nil).Once()
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
-		artifacts, err := orch.generateChangelog(ctx, "v2.0.0")
+		artifacts, err := orch.generateChangelog(ctx, "v2.0.0", "", nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, scopedChangelog, artifacts.changelog)
 		assert.Empty(t, artifacts.releaseNotes)
@@ -126,7 +224,7 @@ Only this release needs these notes.
 		cliffSvc.On("GenerateChangelog", mock.Anything, "v2.0.0", "release").Return(scopedChangelog, nil).Once()
 		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v2.0.0").Return(fullChangelog, nil).Once()
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
-		_, err := orch.generateChangelog(ctx, "v2.0.0")
+		_, err := orch.generateChangelog(ctx, "v2.0.0", "", nil, nil)
 		require.NoError(t, err)
 		releaseNotesData, err := afero.ReadFile(fsRepo, "RELEASE_NOTES.md")
 		require.NoError(t, err)
@@ -137,6 +235,63 @@ Only this release needs these notes.
 		assert.NotContains(t, releaseNotesDocument, "- Old content")
 		cliffSvc.AssertExpectations(t)
 	})
+	t.Run("Should leave CHANGELOG.md untouched when the changelog step is skipped", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		cliffSvc := new(mockCliffService)
+		require.NoError(t, afero.WriteFile(fsRepo, "CHANGELOG.md", []byte("# Hand-written\n"), 0644))
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.4.0", "release").Return("## v1.4.0", nil).Once()
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			fsRepo,
+			cliffSvc,
+			new(mockNpmService),
+		)
+		artifacts, err := orch.generateChangelog(ctx, "v1.4.0", "", nil, domain.SkippedSteps{domain.StepChangelog})
+		require.NoError(t, err)
+		assert.Equal(t, []string{ReleaseBodyOutputFile, ReleaseNotesOutputFile}, artifacts.files)
+		changelogData, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Hand-written\n", string(changelogData))
+		cliffSvc.AssertNotCalled(t, "GenerateFullChangelog", mock.Anything, mock.Anything)
+	})
+	t.Run("Should render release notes without writing them when the step is skipped", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		cliffSvc := new(mockCliffService)
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.4.0", "release").Return("## v1.4.0", nil).Once()
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			fsRepo,
+			cliffSvc,
+			new(mockNpmService),
+		)
+		skipped := domain.SkippedSteps{domain.StepChangelog, domain.StepReleaseNotes}
+		artifacts, err := orch.generateChangelog(ctx, "v1.4.0", "", nil, skipped)
+		require.NoError(t, err)
+		assert.Equal(t, "## v1.4.0", artifacts.changelog)
+		assert.Empty(t, artifacts.files)
+		exists, err := afero.Exists(fsRepo, ReleaseBodyOutputFile)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestSkippedSteps(t *testing.T) {
+	t.Run("Should merge configured and requested steps", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.SkipSteps = []string{"changelog"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		skipped, err := skippedSteps(ctx, PRReleaseConfig{SkipSteps: []string{"package-versions"}})
+		require.NoError(t, err)
+		assert.Equal(t, domain.SkippedSteps{domain.StepChangelog, domain.StepPackageVersions}, skipped)
+	})
+	t.Run("Should reject steps whose dependents still run", func(t *testing.T) {
+		_, err := skippedSteps(testReleaseContext(t), PRReleaseConfig{SkipSteps: []string{"push"}})
+		assert.ErrorContains(t, err, "skipping push requires skipping pull-request as well")
+	})
 }
 
 func TestPRReleaseOrchestrator_releaseArtifactCommands(t *testing.T) {
@@ -178,7 +333,7 @@ func TestPRReleaseOrchestrator_releaseArtifactCommands(t *testing.T) {
 		result, err := orch.runReleaseArtifactCommands(ctx, "v1.2.3", "release/v1.2.3", "v1.2.2")
 
 		require.NoError(t, err)
-		assert.Equal(t, []string{"packages/site/content/blog/changelog/*.mdx"}, result.addPatterns)
+		assert.Equal(t, []string{"packages/site/content/blog/changelog/v1.2.3.mdx"}, result.files())
 		assert.Empty(t, result.modifiedFiles)
 		assert.Equal(t, []string{"packages/site/content/blog/changelog/v1.2.3.mdx"}, result.createdFiles)
 		assert.Equal(t, "v1.2.3", gotEnv["PR_RELEASE_VERSION"])
@@ -191,6 +346,46 @@ func TestPRReleaseOrchestrator_releaseArtifactCommands(t *testing.T) {
 		assert.NotEmpty(t, gotEnv["PR_RELEASE_DATE"])
 	})
 
+	t.Run("Should add the resolved env of each command to the release environment", func(t *testing.T) {
+		t.Setenv("SITE_DEPLOY_TOKEN", "site-token")
+		cfg := testReleaseConfig()
+		cfg.ReleaseArtifacts = []config.ReleaseArtifactCommand{
+			{
+				Name:    "site-changelog",
+				Command: "bun",
+				Add:     []string{"site/*.mdx"},
+				Env:     []config.EnvVar{{Name: "GITHUB_TOKEN", Value: "${SITE_DEPLOY_TOKEN}"}},
+			},
+			{Name: "docs", Command: "make", Add: []string{"site/*.mdx"}},
+		}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, "site/v1.2.3.mdx", []byte("generated"), 0644))
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			fsRepo,
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		gotEnv := map[string]map[string]string{}
+		orch.artifactRunner = func(
+			_ context.Context,
+			command *config.ReleaseArtifactCommand,
+			env map[string]string,
+		) error {
+			gotEnv[command.Name] = env
+			return nil
+		}
+
+		_, err := orch.runReleaseArtifactCommands(ctx, "v1.2.3", "release/v1.2.3", "v1.2.2")
+
+		require.NoError(t, err)
+		assert.Equal(t, "site-token", gotEnv["site-changelog"]["GITHUB_TOKEN"])
+		assert.Equal(t, "v1.2.3", gotEnv["site-changelog"]["PR_RELEASE_VERSION"])
+		assert.NotContains(t, gotEnv["docs"], "GITHUB_TOKEN")
+	})
+
 	t.Run("Should remove newly generated artifact files during rollback", func(t *testing.T) {
 		ctx := testReleaseContext(t)
 		fsRepo := afero.NewMemMapFs()
@@ -233,6 +428,8 @@ func TestPRReleaseOrchestrator_ExecuteReleaseArtifacts(t *testing.T) {
 		nextVersion, err := domain.NewVersion("v1.2.3")
 		require.NoError(t, err)
 		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.2.2").Return(nextVersion, nil).Times(2)
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, "release/v1.2.3").Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, "release/v1.2.3").Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, "release/v1.2.3").Return(nil).Once()
 		changelog := "## v1.2.3\n\n### Features\n- Generate site changelog"
@@ -284,6 +481,8 @@ func TestPRReleaseOrchestrator_ExecuteReleaseArtifacts(t *testing.T) {
 		nextVersion, err := domain.NewVersion("v1.2.3")
 		require.NoError(t, err)
 		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.2.2").Return(nextVersion, nil).Times(2)
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, "release/v1.2.3").Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, "release/v1.2.3").Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, "release/v1.2.3").Return(nil).Once()
 		changelog := "## v1.2.3\n\n### Features\n- Generate site changelog"
@@ -297,11 +496,18 @@ func TestPRReleaseOrchestrator_ExecuteReleaseArtifacts(t *testing.T) {
 		) error {
 			return errors.New("generator failed")
 		}
+		require.NoError(t, afero.WriteFile(fsRepo, "CHANGELOG.md", []byte("# Changelog\n\nLocal edits\n"), 0644))
 
 		err = orch.Execute(ctx, PRReleaseConfig{})
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "release artifact \"site-changelog\" failed")
+		data, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Changelog\n\nLocal edits\n", string(data))
+		exists, err := afero.Exists(fsRepo, ReleaseBodyOutputFile)
+		require.NoError(t, err)
+		assert.False(t, exists)
 		gitRepo.AssertExpectations(t)
 		githubRepo.AssertExpectations(t)
 		cliffSvc.AssertExpectations(t)
@@ -333,6 +539,8 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 
 		// Setup expectations for createReleaseBranch
 		branchName := "release/v1.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
 
@@ -349,16 +557,17 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		gitRepo.On("AddFiles", mock.Anything, "CHANGELOG.md").Return(nil).Once()
 		gitRepo.On("AddFiles", mock.Anything, "RELEASE_BODY.md").Return(nil).Once()
 		gitRepo.On("AddFiles", mock.Anything, "RELEASE_NOTES.md").Return(nil).Once()
-		gitRepo.On("AddFiles", mock.Anything, "package.json").Return(nil).Once()
-		gitRepo.On("AddFiles", mock.Anything, "package-lock.json").Return(nil).Once()
 		// tools/* updates removed
 		gitRepo.On("Commit", mock.Anything, "release: prepare release v1.1.0").Return(nil).Once()
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(true, nil).Once()
 		gitRepo.On("PushBranch", mock.Anything, branchName).Return(nil).Once()
+		githubRepo.On("EnsureLabels", mock.Anything, domain.ResolveLabels(
+			[]string{"release-pending", "automated", "release:minor", "compat:safe"}, nil)).Return(nil).Once()
 		githubRepo.On("CreateOrUpdatePR", mock.Anything, branchName, "main", "release: Release v1.1.0",
 			mock.MatchedBy(func(body string) bool {
 				return strings.Contains(body, "Release v1.1.0") && strings.Contains(body, "### Features")
 			}),
-			[]string{"release-pending", "automated"}).Return(nil).Once()
+			[]string{"release-pending", "automated", "release:minor", "compat:safe"}).Return(testReleasePR, nil).Once()
 
 		// Create orchestrator and execute
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
@@ -404,8 +613,12 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		stateRepo := new(mockStateRepository)
 
 		t.Setenv("GITHUB_TOKEN", "test-token")
-		stateRepo.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+		var saved *domain.RollbackState
+		stateRepo.On("Save", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*domain.RollbackState)
+		}).Return(nil).Maybe()
 		gitRepo.On("GetCurrentBranch", mock.Anything).Return("main", nil).Once()
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Once()
 		gitRepo.On("LatestTag", mock.Anything).Return("v1.0.0", nil).Times(2)
 		gitRepo.On("CommitsSinceTag", mock.Anything, "v1.0.0").Return(10, nil).Once()
 		nextVersion, _ := domain.NewVersion("v1.1.0")
@@ -425,9 +638,11 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.1.0").Return(fullChangelog, nil).Once()
 
 		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
-		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(5)
+		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(3)
 		gitRepo.On("Commit", mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(true, nil).Once()
 		gitRepo.On("PushBranchForce", mock.Anything, branchName).Return(nil).Once()
+		githubRepo.On("EnsureLabels", mock.Anything, mock.Anything).Return(nil).Once()
 		githubRepo.On(
 			"CreateOrUpdatePR",
 			mock.Anything,
@@ -437,8 +652,8 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 			mock.MatchedBy(func(body string) bool {
 				return strings.Contains(body, "Release v1.1.0") && strings.Contains(body, "### Fixes")
 			}),
-			[]string{"release-pending", "automated"},
-		).Return(nil).Once()
+			[]string{"release-pending", "automated", "release:minor", "compat:safe"},
+		).Return(testReleasePR, nil).Once()
 
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
 		orch.stateRepo = stateRepo
@@ -452,6 +667,15 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 
 		gitRepo.AssertNotCalled(t, "DeleteRemoteBranch", mock.Anything, branchName)
 		gitRepo.AssertNotCalled(t, "PushBranch", mock.Anything, branchName)
+		require.NotNil(t, saved)
+		assert.Equal(t, testReleasePR.Number, saved.PRNumber)
+		assert.Equal(t, testReleasePR.URL, saved.PRURL)
+		assert.Equal(t, version.Version, saved.ToolVersion)
+		assert.Equal(t, "abc123", saved.StartCommit)
+		assert.Equal(t, config.FromContext(ctx).Hash(), saved.ConfigHash)
+		operation := saved.Operations[len(saved.Operations)-1]
+		assert.Equal(t, domain.OperationTypeCreatePR, operation.Type)
+		assert.Equal(t, testReleasePR.Number, operation.RollbackData["pr_number"])
 		gitRepo.AssertExpectations(t)
 		githubRepo.AssertExpectations(t)
 		cliffSvc.AssertExpectations(t)
@@ -512,6 +736,8 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 
 		// Setup remaining expectations for forced release
 		branchName := "release/v1.0.1"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
 
@@ -519,11 +745,13 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.0.1", "release").Return(changelog, nil).Once()
 
 		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
-		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(5)
+		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(3)
 		gitRepo.On("Commit", mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(true, nil).Once()
 		gitRepo.On("PushBranch", mock.Anything, branchName).Return(nil).Once()
+		githubRepo.On("EnsureLabels", mock.Anything, mock.Anything).Return(nil).Once()
 		githubRepo.On("CreateOrUpdatePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return(nil).
+			Return(testReleasePR, nil).
 			Once()
 
 		// Create orchestrator and execute with force flag
@@ -619,6 +847,8 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.0.0").Return(nextVersion, nil).Times(2)
 
 		branchName := "release/v1.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
 
@@ -660,6 +890,8 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.0.0").Return(nextVersion, nil).Times(2)
 
 		branchName := "release/v1.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
 
@@ -667,14 +899,16 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.1.0", "release").Return(changelog, nil).Once()
 
 		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
-		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(5)
+		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(3)
 		gitRepo.On("Commit", mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(true, nil).Once()
 		gitRepo.On("PushBranch", mock.Anything, branchName).Return(nil).Once()
 
 		// Fail on PR creation (use mock.Anything for context)
 		// Note: The retry might not be happening for non-retryable errors
+		githubRepo.On("EnsureLabels", mock.Anything, mock.Anything).Return(nil)
 		githubRepo.On("CreateOrUpdatePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return(errors.New("GitHub API error")).
+			Return(domain.PullRequestRef{}, errors.New("GitHub API error")).
 			Once()
 
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
@@ -709,6 +943,8 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.0.0").Return(nextVersion, nil).Times(2)
 
 		branchName := "release/v1.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
 
@@ -716,8 +952,9 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.1.0", "release").Return(changelog, nil).Once()
 
 		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
-		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(5)
+		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(3)
 		gitRepo.On("Commit", mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(true, nil).Once()
 		gitRepo.On("PushBranch", mock.Anything, branchName).Return(nil).Once()
 
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
@@ -790,6 +1027,7 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 
 		// Setup expectations for initial release (no tags, use mock.Anything for context)
 		gitRepo.On("LatestTag", mock.Anything).Return("", nil).Once() // No tags exist
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Once()
 
 		// For calculateVersion when no tag exists (use mock.Anything for context)
 		gitRepo.On("LatestTag", mock.Anything).Return("", nil).Once()
@@ -797,6 +1035,8 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("CalculateNextVersion", mock.Anything, "v0.0.0").Return(initialVersion, nil).Once()
 
 		branchName := "release/v0.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
 
@@ -804,11 +1044,13 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("GenerateChangelog", mock.Anything, "v0.1.0", "release").Return(changelog, nil).Once()
 
 		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
-		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(5)
+		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(3)
 		gitRepo.On("Commit", mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(true, nil).Once()
 		gitRepo.On("PushBranch", mock.Anything, branchName).Return(nil).Once()
+		githubRepo.On("EnsureLabels", mock.Anything, mock.Anything).Return(nil).Once()
 		githubRepo.On("CreateOrUpdatePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return(nil).
+			Return(testReleasePR, nil).
 			Once()
 
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
@@ -822,6 +1064,32 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.AssertExpectations(t)
 	})
 
+	t.Run("Should report nothing to release for a repository without commits", func(t *testing.T) {
+		for _, cfg := range []PRReleaseConfig{{ForceRelease: true}, {ForceRelease: true, EnableRollback: true}} {
+			ctx := testReleaseContext(t)
+			gitRepo := new(mockGitExtendedRepository)
+			cliffSvc := new(mockCliffService)
+			stateRepo := new(mockStateRepository)
+			t.Setenv("GITHUB_TOKEN", "test-token")
+			stateRepo.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+			gitRepo.On("GetCurrentBranch", mock.Anything).Return("main", nil).Maybe()
+			gitRepo.On("LatestTag", mock.Anything).Return("", nil).Once()
+			gitRepo.On("GetHeadCommit", mock.Anything).Return("", repository.ErrEmptyRepository).Maybe()
+			orch := NewPRReleaseOrchestrator(
+				gitRepo,
+				new(mockGithubExtendedRepository),
+				afero.NewMemMapFs(),
+				cliffSvc,
+				new(mockNpmService),
+			)
+			orch.stateRepo = stateRepo
+			err := orch.Execute(ctx, cfg)
+			require.NoError(t, err)
+			gitRepo.AssertExpectations(t)
+			cliffSvc.AssertNotCalled(t, "CalculateNextVersion", mock.Anything, mock.Anything)
+		}
+	})
+
 	// NOTE: tools/ update tests removed (tools updates are no longer part of the pipeline)
 
 	t.Run("Should handle error when creating release branch fails", func(t *testing.T) {
@@ -843,6 +1111,8 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 
 		// Fail on branch creation (use mock.Anything for context)
 		branchName := "release/v1.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(errors.New("branch already exists")).Once()
 
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
@@ -880,6 +1150,8 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.0.0").Return(nextVersion, nil).Times(2)
 
 		branchName := "release/v1.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
 
@@ -887,7 +1159,7 @@ func TestPRReleaseOrchestrator_Execute(t *testing.T) {
 		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.1.0", "release").Return(changelog, nil).Once()
 
 		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
-		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(5)
+		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(3)
 		// Fail on commit (use mock.Anything for context)
 		gitRepo.On("Commit", mock.Anything, mock.Anything).Return(errors.New("nothing to commit")).Once()
 
@@ -957,6 +1229,7 @@ func TestPRReleaseOrchestrator_RollbackOnFailure(t *testing.T) {
 		// Setup expectations for initial saga setup and branch operations
 		// GetCurrentBranch is called: initial setup, create branch, and during rollback
 		gitRepo.On("GetCurrentBranch", mock.Anything).Return("main", nil).Times(3)
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Maybe()
 
 		// State saves - Allow any state saves during execution
 		stateRepo.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
@@ -1066,13 +1339,15 @@ func TestPRReleaseOrchestrator_RollbackOnFailure(t *testing.T) {
 			// May be called multiple times with retries
 
 		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
-		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(5)
+		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(3)
 		gitRepo.On("Commit", mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(true, nil).Once()
 		gitRepo.On("PushBranch", mock.Anything, branchName).Return(nil).Once()
 
 		// PR creation fails
+		githubRepo.On("EnsureLabels", mock.Anything, mock.Anything).Return(nil)
 		githubRepo.On("CreateOrUpdatePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return(errors.New("GitHub API error")).
+			Return(domain.PullRequestRef{}, errors.New("GitHub API error")).
 			Maybe()
 
 			// May be called multiple times with retries
@@ -1095,7 +1370,8 @@ func TestPRReleaseOrchestrator_RollbackOnFailure(t *testing.T) {
 		gitRepo.On("GetCurrentBranch", mock.Anything).
 			Return(branchName, nil).
 			Maybe()
-			// Additional calls during rollback
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Maybe()
+		// Additional calls during rollback
 		gitRepo.On("ResetHard", mock.Anything, "HEAD~1").Return(nil).Once()
 		gitRepo.On("RestoreFile", mock.Anything, "CHANGELOG.md").Return(nil).Maybe()
 		gitRepo.On("RestoreFile", mock.Anything, "RELEASE_NOTES.md").Return(nil).Maybe()
@@ -1170,6 +1446,7 @@ func TestPRReleaseOrchestrator_RollbackOnFailure(t *testing.T) {
 			Return(true, nil).
 			Maybe()
 		gitRepo.On("GetCurrentBranch", mock.Anything).Return(branchName, nil).Times(2)
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Maybe()
 		gitRepo.On("RestoreFile", mock.Anything, mock.Anything).Return(nil).Maybe()
 
 		// Rollback also fails - make checkout operations fail during rollback
@@ -1219,6 +1496,8 @@ func TestPRReleaseOrchestrator_DisabledRollback(t *testing.T) {
 		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.0.0").Return(nextVersion, nil).Times(2)
 
 		branchName := "release/v1.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
 
@@ -1226,12 +1505,14 @@ func TestPRReleaseOrchestrator_DisabledRollback(t *testing.T) {
 		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.1.0", "release").Return(changelog, nil).Once()
 
 		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
-		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(5)
+		gitRepo.On("AddFiles", mock.Anything, mock.Anything).Return(nil).Times(3)
 		gitRepo.On("Commit", mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("IsSyncedWithBase", mock.Anything, "main").Return(true, nil).Once()
 		gitRepo.On("PushBranch", mock.Anything, branchName).Return(nil).Once()
 
+		githubRepo.On("EnsureLabels", mock.Anything, mock.Anything).Return(nil).Once()
 		githubRepo.On("CreateOrUpdatePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return(nil).
+			Return(testReleasePR, nil).
 			Once()
 
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
@@ -1268,6 +1549,8 @@ func TestPRReleaseOrchestrator_DisabledRollback(t *testing.T) {
 		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.0.0").Return(nextVersion, nil).Times(2)
 
 		branchName := "release/v1.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
 
@@ -1276,6 +1559,14 @@ func TestPRReleaseOrchestrator_DisabledRollback(t *testing.T) {
 			Return("", errors.New("changelog failed")).Once()
 
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
+		var steps []string
+		var failed Event
+		orch.Events().Subscribe(EventSubscriberFunc(func(_ context.Context, event Event) {
+			steps = append(steps, string(event.Type)+" "+event.Step)
+			if event.Type == EventStepFailed {
+				failed = event
+			}
+		}), EventStepStarted, EventStepSucceeded, EventStepSkipped, EventStepFailed)
 		cfg := PRReleaseConfig{
 			EnableRollback: false,
 		}
@@ -1283,6 +1574,14 @@ func TestPRReleaseOrchestrator_DisabledRollback(t *testing.T) {
 		err := orch.Execute(ctx, cfg)
 		require.Error(t, err)
 		assert.ErrorContains(t, err, "changelog failed")
+		assert.Equal(t, []string{
+			"step.started Check Changes", "step.succeeded Check Changes",
+			"step.started Calculate Version", "step.succeeded Calculate Version",
+			"step.started Create Release Branch", "step.succeeded Create Release Branch",
+			"step.started Prepare Release Artifacts", "step.failed Prepare Release Artifacts",
+		}, steps)
+		assert.Equal(t, "v1.1.0", failed.Version)
+		assert.ErrorContains(t, failed.Err, "changelog failed")
 
 		// Verify no rollback operations were performed
 		gitRepo.AssertNotCalled(t, "DeleteBranch", mock.Anything, branchName)
@@ -1306,23 +1605,111 @@ func TestPRReleaseOrchestrator_prepareRelease(t *testing.T) {
 
 		// Setup branch creation expectations (use mock.Anything for context)
 		branchName := "release/v1.0.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(false, nil).Once()
 		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
 		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
 
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
 
 		// This should succeed with valid branch name
-		version, resultBranch, err := orch.prepareRelease(ctx, "v1.0.0", false)
+		version, resultBranch, remoteExists, err := orch.prepareRelease(ctx, "v1.0.0", PRReleaseConfig{}, nil)
 
 		require.NoError(t, err)
 		assert.Equal(t, "v1.0.0", version)
 		assert.Equal(t, branchName, resultBranch)
+		assert.False(t, remoteExists)
 		// Verify the branch name is within limits
 		assert.LessOrEqual(t, len(resultBranch), 255)
 
 		gitRepo.AssertExpectations(t)
 		cliffSvc.AssertExpectations(t)
 	})
+
+	t.Run("Should reuse the release branch of the open release PR", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		gitRepo.On("LatestTag", mock.Anything).Return("v1.0.0", nil).Once()
+		nextVersion, _ := domain.NewVersion("v1.1.0")
+		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.0.0").Return(nextVersion, nil).Once()
+		branchName := "release/v1.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main", branchName}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(true, nil).Once()
+		githubRepo.On("FindOpenPR", mock.Anything, branchName, "main").Return(42, nil).Once()
+		gitRepo.On("DeleteBranch", mock.Anything, branchName).Return(nil).Once()
+		gitRepo.On("CreateBranch", mock.Anything, branchName).Return(nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, branchName).Return(nil).Once()
+		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, afero.NewMemMapFs(), cliffSvc, new(mockNpmService))
+
+		_, resultBranch, remoteExists, err := orch.prepareRelease(ctx, "v1.0.0", PRReleaseConfig{}, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, branchName, resultBranch)
+		assert.True(t, remoteExists)
+		gitRepo.AssertExpectations(t)
+		githubRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should fail with the cleanup command for a leftover remote branch", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		gitRepo.On("LatestTag", mock.Anything).Return("v1.0.0", nil).Once()
+		nextVersion, _ := domain.NewVersion("v1.1.0")
+		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.0.0").Return(nextVersion, nil).Once()
+		branchName := "release/v1.1.0"
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, branchName).Return(true, nil).Once()
+		githubRepo.On("FindOpenPR", mock.Anything, branchName, "main").Return(0, nil).Once()
+		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, afero.NewMemMapFs(), cliffSvc, new(mockNpmService))
+
+		_, _, _, err := orch.prepareRelease(ctx, "v1.0.0", PRReleaseConfig{}, nil)
+
+		require.ErrorContains(t, err, "release branch release/v1.1.0 already exists on remote origin")
+		require.ErrorContains(t, err, "`git push origin --delete release/v1.1.0`")
+		var stepErr *StepError
+		require.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, stepNameCreateBranch, stepErr.Step)
+		gitRepo.AssertNotCalled(t, "CreateBranch", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPRReleaseOrchestrator_checkBranchExistence(t *testing.T) {
+	t.Run("Should fail when the remote branch cannot be checked", func(t *testing.T) {
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, "release/v1.1.0").
+			Return(false, errors.New("authentication required")).Once()
+		orch := NewPRReleaseOrchestrator(gitRepo, new(mockGithubExtendedRepository), afero.NewMemMapFs(),
+			new(mockCliffService), new(mockNpmService))
+		_, _, err := orch.checkBranchExistence(testReleaseContext(t), "release/v1.1.0")
+		require.ErrorContains(t, err, "failed to check remote branch release/v1.1.0: authentication required")
+	})
+}
+
+func TestPRReleaseOrchestrator_addCreateBranchStep(t *testing.T) {
+	t.Run("Should refuse a leftover release branch at once", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main", "release/v1.1.0"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, "release/v1.1.0").Return(false, nil).Once()
+		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, afero.NewMemMapFs(),
+			new(mockCliffService), new(mockNpmService))
+		saga := NewSagaExecutor(new(MockStateRepository), false)
+		compensator := NewCompensatingActions(gitRepo, githubRepo, orch.fsRepo)
+		orch.addCreateBranchStep(saga, PRReleaseConfig{}, compensator, &workflowContext{version: "v1.1.0"}, "main")
+
+		err := saga.Execute(ctx)
+
+		require.ErrorContains(t, err, "release branch release/v1.1.0 already exists locally")
+		require.ErrorContains(t, err, "`git branch -D release/v1.1.0`")
+		gitRepo.AssertExpectations(t)
+		gitRepo.AssertNotCalled(t, "CreateBranch", mock.Anything, mock.Anything)
+	})
 }
 
 func TestPRReleaseOrchestrator_commitChanges(t *testing.T) {
@@ -1339,22 +1726,19 @@ func TestPRReleaseOrchestrator_commitChanges(t *testing.T) {
 
 		gitRepo.On("ConfigureUser", ctx, expectedUser, expectedEmail).Return(nil).Once()
 		gitRepo.On("AddFiles", ctx, "CHANGELOG.md").Return(nil).Once()
-		gitRepo.On("AddFiles", ctx, "RELEASE_BODY.md").Return(nil).Once()
-		gitRepo.On("AddFiles", ctx, "RELEASE_NOTES.md").Return(nil).Once()
-		gitRepo.On("AddFiles", ctx, "package.json").Return(nil).Once()
-		gitRepo.On("AddFiles", ctx, "package-lock.json").Return(nil).Once()
-		// no tools files added
 		gitRepo.On("Commit", ctx, "release: prepare release v1.2.0").Return(nil).Once()
 
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
+		changes := NewChangeSet()
+		changes.Track("CHANGELOG.md")
 
-		err := orch.commitChanges(ctx, "v1.2.0", nil)
+		err := orch.commitChanges(ctx, "v1.2.0", changes)
 		require.NoError(t, err)
 
 		gitRepo.AssertExpectations(t)
 	})
 
-	t.Run("Should add all required files in correct order", func(t *testing.T) {
+	t.Run("Should stage exactly the tracked files in the order they were modified", func(t *testing.T) {
 		ctx := testReleaseContext(t)
 		fsRepo := afero.NewMemMapFs()
 		gitRepo := new(mockGitExtendedRepository)
@@ -1362,7 +1746,6 @@ func TestPRReleaseOrchestrator_commitChanges(t *testing.T) {
 		cliffSvc := new(mockCliffService)
 		npmSvc := new(mockNpmService)
 
-		// Ensure files are added in the expected order
 		var addedFiles []string
 		gitRepo.On("ConfigureUser", ctx, mock.Anything, mock.Anything).Return(nil).Once()
 		gitRepo.On("AddFiles", ctx, mock.Anything).Run(func(args mock.Arguments) {
@@ -1372,57 +1755,376 @@ func TestPRReleaseOrchestrator_commitChanges(t *testing.T) {
 		gitRepo.On("Commit", ctx, mock.Anything).Return(nil).Once()
 
 		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
+		changes := NewChangeSet()
+		changes.Track("package.json")
+		changes.Track("CHANGELOG.md", "RELEASE_BODY.md", "RELEASE_NOTES.md")
+		changes.Track("./packages/site/content/blog/changelog/v1.2.0.mdx", "CHANGELOG.md")
 
-		err := orch.commitChanges(ctx, "v1.2.0", nil)
+		err := orch.commitChanges(ctx, "v1.2.0", changes)
 		require.NoError(t, err)
 
-		// Verify files were added in correct order
 		assert.Equal(t, []string{
+			"package.json",
 			"CHANGELOG.md",
 			"RELEASE_BODY.md",
 			"RELEASE_NOTES.md",
-			"package.json",
-			"package-lock.json",
-			// tools removed
+			"packages/site/content/blog/changelog/v1.2.0.mdx",
 		}, addedFiles)
 
 		gitRepo.AssertExpectations(t)
 	})
 
-	t.Run("Should stage configured release artifact paths after core release files", func(t *testing.T) {
+	t.Run("Should create an empty release commit when nothing was modified", func(t *testing.T) {
 		ctx := testReleaseContext(t)
-		fsRepo := afero.NewMemMapFs()
 		gitRepo := new(mockGitExtendedRepository)
-		githubRepo := new(mockGithubExtendedRepository)
-		cliffSvc := new(mockCliffService)
-		npmSvc := new(mockNpmService)
+		gitRepo.On("ConfigureUser", ctx, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("Commit", ctx, "release: prepare release v1.2.0").Return(nil).Once()
 
-		var addedFiles []string
+		orch := NewPRReleaseOrchestrator(gitRepo, nil, afero.NewMemMapFs(), nil, nil)
+
+		err := orch.commitChanges(ctx, "v1.2.0", NewChangeSet())
+		require.NoError(t, err)
+
+		gitRepo.AssertNotCalled(t, "AddFiles", mock.Anything, mock.Anything)
+		gitRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should report the file that could not be staged", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
 		gitRepo.On("ConfigureUser", ctx, mock.Anything, mock.Anything).Return(nil).Once()
-		gitRepo.On("AddFiles", ctx, mock.Anything).Run(func(args mock.Arguments) {
-			pattern := args.Get(1).(string)
-			addedFiles = append(addedFiles, pattern)
-		}).Return(nil).Times(6)
-		gitRepo.On("Commit", ctx, mock.Anything).Return(nil).Once()
+		gitRepo.On("AddFiles", ctx, "CHANGELOG.md").Return(errors.New("index locked")).Once()
 
-		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, npmSvc)
+		orch := NewPRReleaseOrchestrator(gitRepo, nil, afero.NewMemMapFs(), nil, nil)
+		changes := NewChangeSet()
+		changes.Track("CHANGELOG.md")
+
+		err := orch.commitChanges(ctx, "v1.2.0", changes)
+		require.ErrorContains(t, err, "failed to stage CHANGELOG.md: index locked")
 
-		err := orch.commitChanges(
-			ctx,
-			"v1.2.0",
-			[]string{"packages/site/content/blog/changelog/*.mdx"},
+		gitRepo.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPRReleaseOrchestrator_previewArtifacts(t *testing.T) {
+	t.Run("Should return archive builds from dry-run metadata", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		metadata := `{"version":"1.2.3","artifacts":[
+			{"type":"Archive","goos":"linux","goarch":"amd64"},
+			{"type":"Binary","goos":"linux","goarch":"amd64"},
+			{"type":"Archive","goos":"darwin","goarch":"arm64"},
+			{"type":"Archive","goos":"linux","goarch":"amd64"}
+		]}`
+		require.NoError(t, afero.WriteFile(fsRepo, "dist/metadata.json", []byte(metadata), 0644))
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			fsRepo,
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		builds := orch.previewArtifacts(ctx, "v1.2.3")
+		assert.Equal(t, []domain.ArtifactBuild{
+			{OS: "darwin", Arch: "arm64"},
+			{OS: "linux", Arch: "amd64"},
+		}, builds)
+	})
+	t.Run("Should return nil when metadata is absent or invalid", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			fsRepo,
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		builds := orch.previewArtifacts(ctx, "v1.2.3")
+		assert.Nil(t, builds)
+		require.NoError(t, afero.WriteFile(fsRepo, "dist/metadata.json", []byte("{invalid"), 0644))
+		builds = orch.previewArtifacts(ctx, "v1.2.3")
+		assert.Nil(t, builds)
+	})
+	t.Run("Should accept snapshot metadata of the release version", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		metadata := `{"tag":"v1.2.2","version":"1.2.3-SNAPSHOT-abcdef0",` +
+			`"artifacts":[{"type":"Archive","goos":"linux","goarch":"amd64"}]}`
+		require.NoError(t, afero.WriteFile(fsRepo, "dist/metadata.json", []byte(metadata), 0644))
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			fsRepo,
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		builds := orch.previewArtifacts(ctx, "v1.2.3")
+		assert.Equal(t, []domain.ArtifactBuild{{OS: "linux", Arch: "amd64"}}, builds)
+	})
+	t.Run("Should skip stale metadata of another release", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		metadata := `{"tag":"v1.2.2","version":"1.2.2","artifacts":[{"type":"Archive","goos":"linux","goarch":"amd64"}]}`
+		require.NoError(t, afero.WriteFile(fsRepo, "dist/metadata.json", []byte(metadata), 0644))
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			fsRepo,
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		assert.Nil(t, orch.previewArtifacts(ctx, "v1.2.3"))
+	})
+	t.Run("Should render the PR without artifacts for timestamped snapshot metadata", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		metadata := `{"tag":"v1.2.2","version":"0.0.0-1760655600",` +
+			`"artifacts":[{"type":"Archive","goos":"linux","goarch":"amd64"}]}`
+		require.NoError(t, afero.WriteFile(fsRepo, "dist/metadata.json", []byte(metadata), 0644))
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("EnsureLabels", mock.Anything, mock.Anything).Return(nil)
+		githubRepo.On("CreateOrUpdatePR", mock.Anything, "release/v1.2.3", "main", "release: Release v1.2.3",
+			mock.MatchedBy(func(body string) bool {
+				return !strings.Contains(body, "Build Artifacts")
+			}), mock.Anything).Return(domain.PullRequestRef{Number: 7}, nil)
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			githubRepo,
+			fsRepo,
+			new(mockCliffService),
+			new(mockNpmService),
 		)
+		pr, err := orch.createPullRequest(ctx, "v1.2.3", "## Changes", "", "2026-10-16", "release/v1.2.3", nil,
+			domain.ReleaseLinks{})
 		require.NoError(t, err)
+		assert.Equal(t, 7, pr.Number)
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should skip preview when metadata path is disabled", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ArtifactMetadataPath = ""
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		metadata := `{"artifacts":[{"type":"Archive","goos":"linux","goarch":"amd64"}]}`
+		require.NoError(t, afero.WriteFile(fsRepo, "dist/metadata.json", []byte(metadata), 0644))
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			fsRepo,
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		builds := orch.previewArtifacts(ctx, "v1.2.3")
+		assert.Nil(t, builds)
+	})
+}
 
-		assert.Equal(t, []string{
-			"CHANGELOG.md",
-			"RELEASE_BODY.md",
-			"RELEASE_NOTES.md",
-			"package.json",
-			"package-lock.json",
-			"packages/site/content/blog/changelog/*.mdx",
-		}, addedFiles)
+func TestPRReleaseOrchestrator_Resume(t *testing.T) {
+	t.Run("Should refuse to resume sessions that did not fail", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		stateRepo := new(mockStateRepository)
+		state := domain.NewRollbackState("session-1")
+		state.Status = domain.WorkflowStatusCompleted
+		stateRepo.On("Load", mock.Anything, "session-1").Return(state, nil).Once()
+		orch := NewPRReleaseOrchestrator(
+			gitRepo,
+			new(mockGithubExtendedRepository),
+			afero.NewMemMapFs(),
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		orch.stateRepo = stateRepo
+		err := orch.Resume(ctx, "session-1")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be resumed from status completed")
+		gitRepo.AssertNotCalled(t, "CheckoutBranch", mock.Anything, mock.Anything)
+		stateRepo.AssertExpectations(t)
+	})
+	t.Run("Should return to the original branch before resuming", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		t.Setenv("GITHUB_TOKEN", "")
+		gitRepo := new(mockGitExtendedRepository)
+		stateRepo := new(mockStateRepository)
+		state := domain.NewRollbackState("session-1")
+		state.Status = domain.WorkflowStatusRolledBack
+		state.OriginalBranch = "main"
+		stateRepo.On("Load", mock.Anything, "session-1").Return(state, nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "main").Return(nil).Once()
+		orch := NewPRReleaseOrchestrator(
+			gitRepo,
+			new(mockGithubExtendedRepository),
+			afero.NewMemMapFs(),
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		orch.stateRepo = stateRepo
+		err := orch.Resume(ctx, "session-1")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "environment validation failed")
+		gitRepo.AssertExpectations(t)
+	})
+	t.Run("Should warn when the session was created by another version", func(t *testing.T) {
+		core, logs := observer.New(zap.WarnLevel)
+		ctx := logger.IntoContext(testReleaseContext(t), zap.New(core))
+		t.Setenv("GITHUB_TOKEN", "")
+		stateRepo := new(mockStateRepository)
+		state := domain.NewRollbackState("session-1")
+		state.Status = domain.WorkflowStatusFailed
+		state.ToolVersion = "v0.0.1"
+		state.ConfigHash = config.FromContext(ctx).Hash()
+		stateRepo.On("Load", mock.Anything, "session-1").Return(state, nil).Once()
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			afero.NewMemMapFs(),
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		orch.stateRepo = stateRepo
+		err := orch.Resume(ctx, "session-1")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "environment validation failed")
+		assert.Equal(t, 1, logs.FilterMessage("Session was created by a different releasepr version").Len())
+	})
+	t.Run("Should refuse sessions created with a different configuration", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		stateRepo := new(mockStateRepository)
+		state := domain.NewRollbackState("session-1")
+		state.Status = domain.WorkflowStatusFailed
+		state.OriginalBranch = "main"
+		state.ConfigHash = "sha256:previous"
+		stateRepo.On("Load", mock.Anything, "session-1").Return(state, nil).Once()
+		orch := NewPRReleaseOrchestrator(
+			gitRepo,
+			new(mockGithubExtendedRepository),
+			afero.NewMemMapFs(),
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		orch.stateRepo = stateRepo
+		err := orch.Resume(ctx, "session-1")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "was created with a different configuration (sha256:previous")
+		gitRepo.AssertNotCalled(t, "CheckoutBranch", mock.Anything, mock.Anything)
+	})
+	t.Run("Should reapply the flags the session ran with", func(t *testing.T) {
+		state := domain.NewRollbackState("session-1")
+		state.ForceRelease = true
+		state.SkipPR = true
+		state.SkipSteps = []string{"npm"}
+		assert.Equal(t, PRReleaseConfig{
+			ForceRelease:   true,
+			SkipPR:         true,
+			EnableRollback: true,
+			SkipSteps:      []string{"npm"},
+		}, resumeConfig(state))
+	})
+	t.Run("Should not warn when the session matches the current version and config", func(t *testing.T) {
+		core, logs := observer.New(zap.WarnLevel)
+		ctx := logger.IntoContext(testReleaseContext(t), zap.New(core))
+		state := domain.NewRollbackState("session-1")
+		state.ToolVersion = version.Version
+		state.ConfigHash = config.FromContext(ctx).Hash()
+		orch := &PRReleaseOrchestrator{}
+		orch.warnSessionDrift(ctx, state)
+		assert.Zero(t, logs.Len())
+	})
+}
+
+func TestReleasePRLabels(t *testing.T) {
+	t.Run("Should label the release PR with its bump level and compatibility", func(t *testing.T) {
+		assert.Equal(t, []string{"release-pending", "automated", "release:major", "compat:breaking"},
+			releasePRLabels("v2.0.0", "v1.4.2", ""))
+		assert.Equal(t, []string{"release-pending", "automated", "release:patch", "compat:safe"},
+			releasePRLabels("v1.4.3", "v1.4.2", ""))
+		assert.Equal(t, []string{"release-pending", "automated", "release:minor", "compat:safe"},
+			releasePRLabels("v0.1.0", "", ""))
+	})
+	t.Run("Should label breaking changes of the notes within the version range as breaking", func(t *testing.T) {
+		notes := "### Features\n\n- **BREAKING:** rename the config keys\n"
+		assert.Equal(t, []string{"release-pending", "automated", "release:minor", "compat:breaking"},
+			releasePRLabels("v1.5.0", "v1.4.2", notes))
+	})
+	t.Run("Should keep the fixed labels for unparsable versions", func(t *testing.T) {
+		assert.Equal(t, []string{"release-pending", "automated"}, releasePRLabels("v1.4.3", "nightly", ""))
+	})
+}
 
+func TestPRReleaseOrchestrator_ensurePRLabels(t *testing.T) {
+	t.Run("Should create missing labels with the configured colors", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.PRLabels = []config.LabelConfig{{Name: "automated", Color: "#000000", Description: "Bot PR"}}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("EnsureLabels", mock.Anything, []domain.Label{
+			{Name: "automated", Color: "000000", Description: "Bot PR"},
+		}).Return(nil).Once()
+		orch := &PRReleaseOrchestrator{githubRepo: githubRepo}
+		orch.ensurePRLabels(ctx, []string{"automated"})
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should not fail when labels cannot be created", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("EnsureLabels", mock.Anything, mock.Anything).Return(errors.New("forbidden")).Once()
+		orch := &PRReleaseOrchestrator{githubRepo: githubRepo}
+		assert.NotPanics(t, func() { orch.ensurePRLabels(ctx, []string{"automated"}) })
+		githubRepo.AssertExpectations(t)
+	})
+}
+
+func TestPRReleaseOrchestrator_SagaDryRun(t *testing.T) {
+	t.Run("Should plan mutating steps instead of touching branches", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		packageJSON := "{\n  \"name\": \"tool\",\n  \"version\": \"1.0.0\"\n}\n"
+		require.NoError(t, afero.WriteFile(fsRepo, "package.json", []byte(packageJSON), 0644))
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		stateRepo := new(mockStateRepository)
+		t.Setenv("GITHUB_TOKEN", "test-token")
+		var saved *domain.RollbackState
+		stateRepo.On("Save", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { saved = args.Get(1).(*domain.RollbackState) }).
+			Return(nil)
+		gitRepo.On("GetCurrentBranch", mock.Anything).Return("main", nil).Once()
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Maybe()
+		gitRepo.On("LatestTag", mock.Anything).Return("v1.0.0", nil).Times(2)
+		gitRepo.On("CommitsSinceTag", mock.Anything, "v1.0.0").Return(3, nil).Once()
+		nextVersion, err := domain.NewVersion("v1.1.0")
+		require.NoError(t, err)
+		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.0.0").Return(nextVersion, nil).Times(2)
+		gitRepo.On("ListLocalBranches", mock.Anything).Return([]string{"main"}, nil).Once()
+		gitRepo.On("RemoteBranchExists", mock.Anything, "release/v1.1.0").Return(true, nil).Once()
+		githubRepo.On("FindOpenPR", mock.Anything, "release/v1.1.0", "main").Return(42, nil).Once()
+		changelog := "## v1.1.0\n\n### Features\n- New feature"
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.1.0", "release").Return(changelog, nil).Once()
+		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.1.0").Return("# Changelog\n\n"+changelog, nil).Once()
+		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, new(mockNpmService))
+		orch.stateRepo = stateRepo
+		err = orch.Execute(ctx, PRReleaseConfig{DryRun: true, EnableRollback: true})
+		require.NoError(t, err)
 		gitRepo.AssertExpectations(t)
+		githubRepo.AssertExpectations(t)
+		require.NotNil(t, saved)
+		assert.True(t, saved.DryRun)
+		require.Len(t, saved.Plan, 5)
+		assert.Equal(t, "Create branch release/v1.1.0 and check it out", saved.Plan[0])
+		assert.Equal(t, "Archive active release notes for v1.1.0", saved.Plan[1])
+		assert.Contains(t, saved.Plan[2], `as "release: prepare release v1.1.0"`)
+		assert.Equal(t, "Force push branch release/v1.1.0", saved.Plan[3])
+		assert.Contains(t, saved.Plan[4], "with labels release-pending, automated, release:minor")
+		content, err := afero.ReadFile(fsRepo, "package.json")
+		require.NoError(t, err)
+		assert.Equal(t, packageJSON, string(content))
+		for _, path := range []string{"CHANGELOG.md", "RELEASE_BODY.md", "RELEASE_NOTES.md"} {
+			exists, err := afero.Exists(fsRepo, path)
+			require.NoError(t, err)
+			assert.False(t, exists, path)
+		}
 	})
 }
@@ -0,0 +1,197 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/compozy/releasepr/internal/telemetry"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// PromoteConfig contains configuration for the prerelease promotion workflow.
+type PromoteConfig struct {
+	Tag         string // Prerelease tag to promote, e.g. v1.4.0-rc.2
+	SkipPublish bool   // Tag and push without running GoReleaser
+	ForceTag    bool   // Move the final tag when it already exists
+	SkipSoak    bool   // Promote before promote_soak_hours have passed since the prerelease
+}
+
+// PromoteOrchestrator promotes an existing prerelease tag to a final release.
+type PromoteOrchestrator struct {
+	gitRepo       repository.GitExtendedRepository
+	githubRepo    repository.GithubExtendedRepository
+	cliffSvc      service.CliffService
+	goreleaserSvc service.GoReleaserService
+	fsRepo        repository.FileSystemRepository
+	now           func() time.Time
+}
+
+// NewPromoteOrchestrator creates a new PromoteOrchestrator.
+func NewPromoteOrchestrator(
+	gitRepo repository.GitExtendedRepository,
+	githubRepo repository.GithubExtendedRepository,
+	cliffSvc service.CliffService,
+	goreleaserSvc service.GoReleaserService,
+	fsRepo repository.FileSystemRepository,
+) *PromoteOrchestrator {
+	return &PromoteOrchestrator{
+		gitRepo:       gitRepo,
+		githubRepo:    githubRepo,
+		cliffSvc:      cliffSvc,
+		goreleaserSvc: goreleaserSvc,
+		fsRepo:        fsRepo,
+		now:           time.Now,
+	}
+}
+
+func (o *PromoteOrchestrator) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx).Named("orchestrator.promote")
+}
+
+// Execute re-tags the prerelease commit as the final version, regenerates the
+// consolidated release notes, and publishes the release.
+func (o *PromoteOrchestrator) Execute(ctx context.Context, cfg PromoteConfig) (err error) {
+	ctx, span := telemetry.Start(ctx, "promote", attribute.String("release.prerelease", cfg.Tag))
+	defer func() { telemetry.End(span, err) }()
+	ctx, cancel := context.WithTimeout(ctx, ReleaseWorkflowTimeout)
+	defer cancel()
+	if err := ValidateAllowedRepository(ctx); err != nil {
+		return err
+	}
+	finalTag, err := promotedTag(cfg.Tag)
+	if err != nil {
+		return err
+	}
+	log := o.logger(ctx).With(zap.String("prerelease", cfg.Tag), zap.String("version", finalTag))
+	previous, err := o.validateTags(ctx, cfg.Tag, finalTag, cfg.ForceTag)
+	if err != nil {
+		return err
+	}
+	if err := o.checkSoakPeriod(ctx, cfg.Tag, cfg.SkipSoak); err != nil {
+		return err
+	}
+	log.Info("Checking out prerelease tag")
+	if err := o.gitRepo.CheckoutBranch(ctx, cfg.Tag); err != nil {
+		return fmt.Errorf("failed to checkout prerelease tag %s: %w", cfg.Tag, err)
+	}
+	policy, err := changelogMarkdownPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	filter, err := changelogAudienceFilter(ctx, config.FromContext(ctx).ReleaseChangelogAudience)
+	if err != nil {
+		return err
+	}
+	style, err := changelogStyle(ctx)
+	if err != nil {
+		return err
+	}
+	uc := &usecase.GenerateChangelogUseCase{CliffSvc: o.cliffSvc, Policy: &policy, Filter: filter, Style: style}
+	changelog, err := uc.Execute(ctx, finalTag, "promotion")
+	if err != nil {
+		return fmt.Errorf("failed to generate consolidated changelog: %w", err)
+	}
+	date, err := formatReleaseDate(ctx, o.now())
+	if err != nil {
+		return err
+	}
+	changelog = stampReleaseDate(changelog, finalTag, date)
+	if err := afero.WriteFile(
+		o.fsRepo,
+		ReleaseBodyOutputFile,
+		[]byte(buildReleaseBodyDocument(changelog, "")),
+		FilePermissionsReadWrite,
+	); err != nil {
+		return fmt.Errorf("failed to write release body: %w", err)
+	}
+	err = tagAndPublish(
+		ctx,
+		log,
+		o.gitRepo,
+		o.goreleaserSvc,
+		o.fsRepo,
+		finalTag,
+		previous,
+		oneShotPublishMode(cfg.SkipPublish),
+	)
+	if err != nil {
+		return err
+	}
+	log.Info("Promoted prerelease tag")
+	return nil
+}
+
+// validateTags ensures the prerelease tag exists and the final tag has not been released yet, unless
+// force allows moving it. It returns the commit an existing final tag points to.
+func (o *PromoteOrchestrator) validateTags(
+	ctx context.Context,
+	prereleaseTag, finalTag string,
+	force bool,
+) (string, error) {
+	exists, err := o.gitRepo.TagExists(ctx, prereleaseTag)
+	if err != nil {
+		return "", fmt.Errorf("failed to check tag %s: %w", prereleaseTag, err)
+	}
+	if !exists {
+		return "", fmt.Errorf("prerelease tag %s does not exist", prereleaseTag)
+	}
+	return existingTagCommit(ctx, o.gitRepo, finalTag, force)
+}
+
+// checkSoakPeriod enforces promote_soak_hours: the GitHub release of the prerelease must have been
+// published at least that long ago, so it had time to be tried before it becomes final. skip, from
+// --skip-soak, overrides the check.
+func (o *PromoteOrchestrator) checkSoakPeriod(ctx context.Context, tag string, skip bool) error {
+	hours := config.FromContext(ctx).PromoteSoakHours
+	if hours == 0 {
+		return nil
+	}
+	if skip {
+		o.logger(ctx).Warn("Skipping the prerelease soak period", zap.String("prerelease", tag), zap.Int("hours", hours))
+		return nil
+	}
+	publishedAt, published, err := o.githubRepo.ReleasePublishedAt(ctx, tag)
+	if err != nil {
+		return fmt.Errorf("failed to read the release of %s: %w", tag, err)
+	}
+	if !published {
+		return fmt.Errorf("prerelease %s has no published GitHub release to start its %dh soak period; "+
+			"publish it first or pass --skip-soak", tag, hours)
+	}
+	soak := time.Duration(hours) * time.Hour
+	if elapsed := o.now().Sub(publishedAt); elapsed < soak {
+		return fmt.Errorf("prerelease %s was published %s ago but promote_soak_hours requires %dh; "+
+			"promote after %s or pass --skip-soak",
+			tag, elapsed.Truncate(time.Minute), hours, publishedAt.Add(soak).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// promotedTag returns the final release tag for a prerelease tag.
+func promotedTag(tag string) (string, error) {
+	version, err := domain.NewVersion(tag)
+	if err != nil {
+		return "", fmt.Errorf("invalid prerelease tag %q: %w", tag, err)
+	}
+	if version.Prerelease() == "" {
+		return "", fmt.Errorf("tag %s is not a prerelease", tag)
+	}
+	final, err := version.SetPrerelease("")
+	if err != nil {
+		return "", fmt.Errorf("failed to strip prerelease from %s: %w", tag, err)
+	}
+	final, err = final.SetMetadata("")
+	if err != nil {
+		return "", fmt.Errorf("failed to strip metadata from %s: %w", tag, err)
+	}
+	return (&domain.Version{Version: &final}).String(), nil
+}
@@ -0,0 +1,182 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromotedTag(t *testing.T) {
+	t.Run("Should strip prerelease and metadata", func(t *testing.T) {
+		tag, err := promotedTag("v1.4.0-rc.2+build.7")
+		require.NoError(t, err)
+		assert.Equal(t, "v1.4.0", tag)
+	})
+	t.Run("Should reject final release tags", func(t *testing.T) {
+		_, err := promotedTag("v1.4.0")
+		assert.ErrorContains(t, err, "is not a prerelease")
+	})
+}
+
+func TestPromoteOrchestrator_Execute(t *testing.T) {
+	t.Run("Should re-tag prerelease commit and publish consolidated notes", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		gitRepo := new(mockGitExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		goreleaserSvc := new(mockGoReleaserService)
+		gitRepo.On("TagExists", mock.Anything, "v1.4.0-rc.2").Return(true, nil).Once()
+		gitRepo.On("TagExists", mock.Anything, "v1.4.0").Return(false, nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "v1.4.0-rc.2").Return(nil).Once()
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.4.0", "promotion").
+			Return("### Features\n- rc.1 feature\n- rc.2 feature", nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v1.4.0", "Release v1.4.0\n\n### Features\n- rc.1 feature\n- rc.2 feature").
+			Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v1.4.0").Return(nil).Once()
+		goreleaserSvc.On(
+			"Run",
+			mock.Anything,
+			"release",
+			"--clean",
+			"--release-notes=RELEASE_BODY.md",
+			"--release-header-tmpl=.goreleaser.release-header.md.tmpl",
+			"--release-footer-tmpl=.goreleaser.release-footer.md.tmpl",
+		).Return(nil).Once()
+		orch := NewPromoteOrchestrator(gitRepo, nil, cliffSvc, goreleaserSvc, fsRepo)
+		err := orch.Execute(ctx, PromoteConfig{Tag: "v1.4.0-rc.2"})
+		require.NoError(t, err)
+		body, err := afero.ReadFile(fsRepo, "RELEASE_BODY.md")
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "- rc.2 feature")
+		gitRepo.AssertExpectations(t)
+		cliffSvc.AssertExpectations(t)
+		goreleaserSvc.AssertExpectations(t)
+	})
+	t.Run("Should skip publish when requested", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		goreleaserSvc := new(mockGoReleaserService)
+		gitRepo.On("TagExists", mock.Anything, "v2.0.0-beta.1").Return(true, nil).Once()
+		gitRepo.On("TagExists", mock.Anything, "v2.0.0").Return(false, nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "v2.0.0-beta.1").Return(nil).Once()
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v2.0.0", "promotion").Return("notes", nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v2.0.0", "Release v2.0.0\n\nnotes").Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v2.0.0").Return(nil).Once()
+		orch := NewPromoteOrchestrator(gitRepo, nil, cliffSvc, goreleaserSvc, afero.NewMemMapFs())
+		err := orch.Execute(ctx, PromoteConfig{Tag: "v2.0.0-beta.1", SkipPublish: true})
+		require.NoError(t, err)
+		goreleaserSvc.AssertNotCalled(t, "Run")
+		gitRepo.AssertExpectations(t)
+	})
+	t.Run("Should refuse to promote when final tag already exists", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("TagExists", mock.Anything, "v1.4.0-rc.2").Return(true, nil).Once()
+		gitRepo.On("TagExists", mock.Anything, "v1.4.0").Return(true, nil).Once()
+		orch := NewPromoteOrchestrator(
+			gitRepo,
+			nil,
+			new(mockCliffService),
+			new(mockGoReleaserService),
+			afero.NewMemMapFs(),
+		)
+		err := orch.Execute(ctx, PromoteConfig{Tag: "v1.4.0-rc.2"})
+		assert.ErrorContains(t, err, "tag v1.4.0 already exists")
+		gitRepo.AssertNotCalled(t, "CreateTag", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should surface push failures", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		gitRepo.On("TagExists", mock.Anything, "v1.4.0-rc.2").Return(true, nil).Once()
+		gitRepo.On("TagExists", mock.Anything, "v1.4.0").Return(false, nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "v1.4.0-rc.2").Return(nil).Once()
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.4.0", "promotion").Return("notes", nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v1.4.0", "Release v1.4.0\n\nnotes").Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v1.4.0").Return(errors.New("rejected")).Once()
+		orch := NewPromoteOrchestrator(gitRepo, nil, cliffSvc, new(mockGoReleaserService), afero.NewMemMapFs())
+		err := orch.Execute(ctx, PromoteConfig{Tag: "v1.4.0-rc.2"})
+		assert.ErrorContains(t, err, "failed to push tag v1.4.0")
+	})
+	t.Run("Should move an existing final tag when forced", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		cliffSvc := new(mockCliffService)
+		gitRepo.On("TagExists", mock.Anything, "v1.4.0-rc.2").Return(true, nil).Once()
+		gitRepo.On("TagExists", mock.Anything, "v1.4.0").Return(true, nil).Once()
+		gitRepo.On("TagCommit", mock.Anything, "v1.4.0").Return("0ld5ha", nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "v1.4.0-rc.2").Return(nil).Once()
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.4.0", "promotion").Return("notes", nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("TagAnnotation", mock.Anything, "v1.4.0").Return("Release v1.4.0", true, nil).Once()
+		gitRepo.On("CreateTagForce", mock.Anything, "v1.4.0", "", "Release v1.4.0\n\nnotes").Return(nil).Once()
+		gitRepo.On("PushTagForce", mock.Anything, "v1.4.0").Return(nil).Once()
+		orch := NewPromoteOrchestrator(gitRepo, nil, cliffSvc, new(mockGoReleaserService), afero.NewMemMapFs())
+		err := orch.Execute(ctx, PromoteConfig{Tag: "v1.4.0-rc.2", SkipPublish: true, ForceTag: true})
+		require.NoError(t, err)
+		gitRepo.AssertExpectations(t)
+		gitRepo.AssertNotCalled(t, "CreateTag", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should refuse to promote a repository outside allowed_repositories", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.AllowedRepositories = []string{"compozy/other"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		orch := NewPromoteOrchestrator(gitRepo, nil, new(mockCliffService), new(mockGoReleaserService), afero.NewMemMapFs())
+		err := orch.Execute(ctx, PromoteConfig{Tag: "v1.4.0-rc.2"})
+		require.ErrorContains(t, err, "not in allowed_repositories")
+		gitRepo.AssertNotCalled(t, "TagExists", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPromoteOrchestrator_checkSoakPeriod(t *testing.T) {
+	publishedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	newSoakOrchestrator := func(githubRepo *mockGithubExtendedRepository, now time.Time) *PromoteOrchestrator {
+		orch := NewPromoteOrchestrator(nil, githubRepo, nil, nil, afero.NewMemMapFs())
+		orch.now = func() time.Time { return now }
+		return orch
+	}
+	soakContext := func(t *testing.T) context.Context {
+		cfg := testReleaseConfig()
+		cfg.PromoteSoakHours = 48
+		return testReleaseContextWithConfig(t, cfg)
+	}
+	t.Run("Should refuse to promote a prerelease still soaking", func(t *testing.T) {
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("ReleasePublishedAt", mock.Anything, "v1.4.0-rc.2").Return(publishedAt, true, nil).Once()
+		orch := newSoakOrchestrator(githubRepo, publishedAt.Add(30*time.Hour))
+		err := orch.checkSoakPeriod(soakContext(t), "v1.4.0-rc.2", false)
+		require.ErrorContains(t, err, "was published 30h0m0s ago but promote_soak_hours requires 48h")
+		assert.ErrorContains(t, err, "promote after 2026-10-03T12:00:00Z")
+	})
+	t.Run("Should promote once the soak period has passed", func(t *testing.T) {
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("ReleasePublishedAt", mock.Anything, "v1.4.0-rc.2").Return(publishedAt, true, nil).Once()
+		orch := newSoakOrchestrator(githubRepo, publishedAt.Add(48*time.Hour))
+		require.NoError(t, orch.checkSoakPeriod(soakContext(t), "v1.4.0-rc.2", false))
+	})
+	t.Run("Should refuse a prerelease without a published release", func(t *testing.T) {
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("ReleasePublishedAt", mock.Anything, "v1.4.0-rc.2").Return(time.Time{}, false, nil).Once()
+		orch := newSoakOrchestrator(githubRepo, publishedAt)
+		err := orch.checkSoakPeriod(soakContext(t), "v1.4.0-rc.2", false)
+		assert.ErrorContains(t, err, "has no published GitHub release")
+	})
+	t.Run("Should skip the check when overridden or not configured", func(t *testing.T) {
+		githubRepo := new(mockGithubExtendedRepository)
+		orch := newSoakOrchestrator(githubRepo, publishedAt)
+		require.NoError(t, orch.checkSoakPeriod(soakContext(t), "v1.4.0-rc.2", true))
+		require.NoError(t, orch.checkSoakPeriod(testReleaseContext(t), "v1.4.0-rc.2", false))
+		githubRepo.AssertNotCalled(t, "ReleasePublishedAt", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,393 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/compozy/releasepr/internal/telemetry"
+	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// PublishConfig contains configuration for publishing a merged release.
+type PublishConfig struct {
+	Version     string // Release version, e.g. v1.4.0
+	Ref         string // Commit or branch to tag, typically the release PR merge commit
+	SkipPublish bool   // Tag and push without running GoReleaser
+	ForceTag    bool   // Move the release tag when it already exists
+}
+
+// publishMode selects what happens to a release once its tag is pushed.
+type publishMode string
+
+const (
+	// publishRelease publishes the GitHub release with GoReleaser.
+	publishRelease publishMode = "release"
+	// publishDraft uploads the artifacts to a draft GitHub release that publish finalize publishes.
+	publishDraft publishMode = "draft"
+	// publishSkipped only pushes the tag.
+	publishSkipped publishMode = "skipped"
+)
+
+// oneShotPublishMode returns the mode of a one-phase publish, which --skip-publish reduces to the tag.
+func oneShotPublishMode(skipPublish bool) publishMode {
+	if skipPublish {
+		return publishSkipped
+	}
+	return publishRelease
+}
+
+// PublishOrchestrator tags a merged release and publishes it with the committed release body.
+type PublishOrchestrator struct {
+	gitRepo       repository.GitExtendedRepository
+	goreleaserSvc service.GoReleaserService
+	githubRepo    repository.GithubExtendedRepository
+	fsRepo        afero.Fs
+	cosignSvc     service.CosignService
+	// cargoSvc publishes the crates of cargo_crates
+	cargoSvc service.CargoService
+	// pypiSvc builds and uploads the packages of pypi_packages
+	pypiSvc service.PyPIService
+	// npmSvc moves the npm dist-tag when a staged release is finalized
+	npmSvc service.NpmService
+	events *EventBus
+}
+
+// NewPublishOrchestrator creates a new PublishOrchestrator.
+func NewPublishOrchestrator(
+	gitRepo repository.GitExtendedRepository,
+	goreleaserSvc service.GoReleaserService,
+	githubRepo repository.GithubExtendedRepository,
+	fsRepo afero.Fs,
+	cosignSvc service.CosignService,
+) *PublishOrchestrator {
+	o := &PublishOrchestrator{
+		gitRepo:       gitRepo,
+		goreleaserSvc: goreleaserSvc,
+		githubRepo:    githubRepo,
+		fsRepo:        fsRepo,
+		cosignSvc:     cosignSvc,
+		cargoSvc:      service.NewCargoService(fsRepo),
+		pypiSvc:       service.NewPyPIService(fsRepo),
+		npmSvc:        service.NewNpmService(fsRepo),
+	}
+	o.SetEventBus(NewEventBus())
+	return o
+}
+
+// SetEventBus announces published releases on events, which may be shared with other orchestrators,
+// and subscribes the security release marker and the released item comments to it.
+func (o *PublishOrchestrator) SetEventBus(events *EventBus) {
+	o.events = events
+	events.Subscribe(EventSubscriberFunc(func(ctx context.Context, event Event) {
+		o.markSecurityRelease(ctx, event.Version)
+	}), EventReleasePublished)
+	events.Subscribe(EventSubscriberFunc(func(ctx context.Context, event Event) {
+		o.commentReleasedItems(ctx, event.PreviousTag, event.Version)
+	}), EventReleasePublished)
+}
+
+// Events returns the event bus published releases are announced on.
+func (o *PublishOrchestrator) Events() *EventBus {
+	return o.events
+}
+
+func (o *PublishOrchestrator) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx).Named("orchestrator.publish")
+}
+
+// Execute checks out the merged release, creates and pushes its tag, publishes the release and,
+// when signing is configured, signs it.
+func (o *PublishOrchestrator) Execute(ctx context.Context, cfg PublishConfig) (err error) {
+	ctx, span := telemetry.Start(ctx, "publish", attribute.String("release.version", cfg.Version))
+	defer func() { telemetry.End(span, err) }()
+	ctx, cancel := context.WithTimeout(ctx, ReleaseWorkflowTimeout)
+	defer cancel()
+	release, err := o.prepareRelease(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	tag := release.version.String()
+	previousTag, err := o.previousReleaseTag(ctx, cfg.SkipPublish)
+	if err != nil {
+		return err
+	}
+	log := o.logger(ctx).With(zap.String("version", tag), zap.String("ref", cfg.Ref))
+	mode := oneShotPublishMode(cfg.SkipPublish)
+	err = tagAndPublish(
+		ctx,
+		log,
+		o.gitRepo,
+		o.goreleaserSvc,
+		o.fsRepo,
+		tag,
+		release.previousCommit,
+		mode,
+	)
+	if err != nil {
+		return err
+	}
+	if err := o.recordRelease(ctx, release.version, release.signOff, mode); err != nil {
+		return err
+	}
+	if !cfg.SkipPublish {
+		if err := o.completeRelease(ctx, previousTag, tag); err != nil {
+			return err
+		}
+	}
+	if err := o.signRelease(ctx, tag, cfg.SkipPublish); err != nil {
+		return fmt.Errorf("release %s was published but signing failed: %w", tag, err)
+	}
+	return nil
+}
+
+// preparedRelease is a merged release checked out and cleared for tagging.
+type preparedRelease struct {
+	version *domain.Version
+	// previousCommit is the commit an existing tag points to, empty when the tag is new
+	previousCommit string
+	signOff        *domain.ManifestSignOff
+}
+
+// prepareRelease checks that the release may be tagged, checks out its ref and verifies its sign-off.
+func (o *PublishOrchestrator) prepareRelease(ctx context.Context, cfg PublishConfig) (preparedRelease, error) {
+	if err := ValidateAllowedRepository(ctx); err != nil {
+		return preparedRelease{}, err
+	}
+	version, err := domain.NewVersion(cfg.Version)
+	if err != nil {
+		return preparedRelease{}, fmt.Errorf("invalid release version %q: %w", cfg.Version, err)
+	}
+	previous, err := existingTagCommit(ctx, o.gitRepo, version.String(), cfg.ForceTag)
+	if err != nil {
+		return preparedRelease{}, err
+	}
+	if cfg.Ref != "" {
+		if err := o.gitRepo.CheckoutBranch(ctx, cfg.Ref); err != nil {
+			return preparedRelease{}, fmt.Errorf("failed to checkout %s: %w", cfg.Ref, err)
+		}
+	}
+	signOff, err := o.verifySignOff(ctx)
+	if err != nil {
+		return preparedRelease{}, err
+	}
+	return preparedRelease{version: version, previousCommit: previous, signOff: signOff}, nil
+}
+
+// completeRelease runs the steps that follow a public GitHub release: it publishes the crates and
+// Python packages, then publishes the release.published event its subscribers, such as the security
+// release marker and the released pull request comments, react to.
+func (o *PublishOrchestrator) completeRelease(ctx context.Context, previousTag, tag string) error {
+	if err := o.publishCrates(ctx, tag); err != nil {
+		return fmt.Errorf("release %s was published but its crates were not: %w", tag, err)
+	}
+	if err := o.publishPythonPackages(ctx); err != nil {
+		return fmt.Errorf("release %s was published but its Python packages were not: %w", tag, err)
+	}
+	o.events.Publish(ctx, Event{Type: EventReleasePublished, Version: tag, PreviousTag: previousTag})
+	return nil
+}
+
+// previousReleaseTag returns the latest tag before the release is tagged, the base of the pull
+// requests release comments go to. It is only looked up when release comments are posted.
+func (o *PublishOrchestrator) previousReleaseTag(ctx context.Context, skipPublish bool) (string, error) {
+	if skipPublish || !config.FromContext(ctx).ReleaseCommentPRs {
+		return "", nil
+	}
+	tag, err := o.gitRepo.LatestTag(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get previous release tag: %w", err)
+	}
+	return tag, nil
+}
+
+// recordRelease writes the release manifest with the sign-off of the release and its compatibility
+// with the tag before it and, when configured, attaches it and the release notes to the GitHub
+// release. Only a release published by mode is marked as published in the manifest.
+func (o *PublishOrchestrator) recordRelease(
+	ctx context.Context,
+	version *domain.Version,
+	signOff *domain.ManifestSignOff,
+	mode publishMode,
+) error {
+	tag := version.String()
+	cfg := config.FromContext(ctx)
+	input := releaseManifestInput{version: version, signOff: signOff, published: mode == publishRelease}
+	if signOff != nil {
+		input.prNumber = signOff.PullRequest
+	}
+	if strings.TrimSpace(cfg.ReleaseManifestPath) != "" {
+		previousTag, err := o.releaseTagBefore(ctx, tag)
+		if err != nil {
+			return fmt.Errorf("release %s was tagged but its manifest could not be written: %w", tag, err)
+		}
+		input.previousTag = previousTag
+	}
+	path, err := writeReleaseManifest(ctx, o.gitRepo, o.fsRepo, input)
+	if err != nil {
+		return fmt.Errorf("release %s was tagged but its manifest could not be written: %w", tag, err)
+	}
+	if mode == publishSkipped {
+		return nil
+	}
+	if path != "" && cfg.AttachReleaseManifest {
+		if err := o.githubRepo.UploadReleaseAsset(ctx, tag, path); err != nil {
+			return fmt.Errorf("failed to attach release manifest to %s: %w", tag, err)
+		}
+	}
+	if cfg.AttachReleaseNotes {
+		if err := o.githubRepo.UploadReleaseAsset(ctx, tag, ReleaseNotesOutputFile); err != nil {
+			return fmt.Errorf("failed to attach release notes to %s: %w", tag, err)
+		}
+	}
+	return nil
+}
+
+// existingTagCommit returns the commit tag points to, or an empty string when it does not exist yet.
+// An existing tag is only moved when force is set.
+func existingTagCommit(
+	ctx context.Context,
+	gitRepo repository.GitExtendedRepository,
+	tag string,
+	force bool,
+) (string, error) {
+	exists, err := gitRepo.TagExists(ctx, tag)
+	if err != nil {
+		return "", fmt.Errorf("failed to check tag %s: %w", tag, err)
+	}
+	if !exists {
+		return "", nil
+	}
+	if !force {
+		return "", fmt.Errorf("tag %s already exists, pass --force-tag to move it", tag)
+	}
+	commit, err := gitRepo.TagCommit(ctx, tag)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve tag %s: %w", tag, err)
+	}
+	return commit, nil
+}
+
+// tagAndPublish creates and pushes the release tag at HEAD, annotated with the changelog of
+// RELEASE_BODY.md, then publishes RELEASE_BODY.md with GoReleaser. A non-empty previous is the commit
+// an existing tag points to: the tag is moved, and restored there when pushing or publishing fails.
+// mode selects whether GoReleaser publishes the release, creates a draft of it or does not run.
+func tagAndPublish(
+	ctx context.Context,
+	log *zap.Logger,
+	gitRepo repository.GitExtendedRepository,
+	goreleaserSvc service.GoReleaserService,
+	fsRepo repository.FileSystemRepository,
+	tag, previous string,
+	mode publishMode,
+) error {
+	if err := gitRepo.ConfigureUser(
+		ctx,
+		"github-actions[bot]",
+		"github-actions[bot]@users.noreply.github.com",
+	); err != nil {
+		return fmt.Errorf("failed to configure git user: %w", err)
+	}
+	msg, err := releaseTagMessage(fsRepo, tag)
+	if err != nil {
+		return err
+	}
+	rollbackData, err := pushReleaseTag(ctx, log, gitRepo, tag, previous, msg)
+	if err != nil {
+		return err
+	}
+	log.Info("Pushed release tag")
+	if mode == publishSkipped {
+		log.Info("Skipping publish", zap.String("reason", "skip-publish flag set"))
+		return nil
+	}
+	cfg := config.FromContext(ctx)
+	args := append(
+		[]string{"release", "--clean"},
+		releaseNotesArgs(cfg.ReleaseHeaderTemplate, cfg.ReleaseFooterTemplate)...,
+	)
+	env := publishChannelEnv(ctx, gitRepo)
+	if mode == publishDraft {
+		args = append(args, "--draft")
+		env = append(env, envReleaseStaged+"="+githubActionsTrue)
+	}
+	if len(env) > 0 {
+		err = goreleaserSvc.RunWithEnv(ctx, env, args...)
+	} else {
+		err = goreleaserSvc.Run(ctx, args...)
+	}
+	if err != nil {
+		return restoreMovedTag(ctx, gitRepo, rollbackData, fmt.Errorf("failed to publish release %s: %w", tag, err))
+	}
+	if mode == publishDraft {
+		log.Info("Staged draft release")
+		return nil
+	}
+	log.Info("Published release")
+	return nil
+}
+
+// pushReleaseTag creates and pushes the release tag at HEAD, annotated with msg. When previous is set
+// the existing tag is force-moved, and the returned rollback data records the commit and annotation
+// restoreMovedTag puts it back with.
+func pushReleaseTag(
+	ctx context.Context,
+	log *zap.Logger,
+	gitRepo repository.GitExtendedRepository,
+	tag, previous, msg string,
+) (map[string]any, error) {
+	if previous == "" {
+		if err := gitRepo.CreateTag(ctx, tag, msg); err != nil {
+			return nil, fmt.Errorf("failed to create tag %s: %w", tag, err)
+		}
+		if err := gitRepo.PushTag(ctx, tag); err != nil {
+			return nil, fmt.Errorf("failed to push tag %s: %w", tag, err)
+		}
+		return nil, nil
+	}
+	log.Warn("Moving existing release tag", zap.String("previous_sha", previous))
+	original, annotated, err := gitRepo.TagAnnotation(ctx, tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tag %s: %w", tag, err)
+	}
+	rollbackData := map[string]any{
+		"tag":          tag,
+		"previous_sha": previous,
+		"message":      original,
+		"annotated":    annotated,
+		"pushed":       false,
+	}
+	if err := gitRepo.CreateTagForce(ctx, tag, "", msg); err != nil {
+		return nil, fmt.Errorf("failed to move tag %s: %w", tag, err)
+	}
+	if err := gitRepo.PushTagForce(ctx, tag); err != nil {
+		return nil, restoreMovedTag(ctx, gitRepo, rollbackData, fmt.Errorf("failed to push tag %s: %w", tag, err))
+	}
+	rollbackData["pushed"] = true
+	return rollbackData, nil
+}
+
+// restoreMovedTag puts a moved tag back on its previous commit after cause, a failed release step.
+// Without rollback data the tag was created by this release and cause is returned as is.
+func restoreMovedTag(
+	ctx context.Context,
+	gitRepo repository.GitExtendedRepository,
+	rollbackData map[string]any,
+	cause error,
+) error {
+	if rollbackData == nil {
+		return cause
+	}
+	if err := NewCompensatingActions(gitRepo, nil, nil).RestoreTag(ctx, rollbackData); err != nil {
+		return errors.Join(cause, err)
+	}
+	return cause
+}
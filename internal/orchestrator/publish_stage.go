@@ -0,0 +1,138 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/telemetry"
+	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// envReleaseStaged tells GoReleaser templates that the release is staged as a draft, so npm
+// publishing can use a dist-tag other than latest until the release is finalized.
+const envReleaseStaged = "PR_RELEASE_STAGED"
+
+// PublishFinalizeConfig contains configuration for finalizing a staged release.
+type PublishFinalizeConfig struct {
+	Version string // Staged release version, e.g. v1.4.0
+}
+
+// Stage runs the first phase of a two-phase publish. It tags the merged release like Execute, but
+// GoReleaser uploads the artifacts to a draft GitHub release, which stays hidden until Finalize
+// publishes it. Nothing goes to package registries yet.
+func (o *PublishOrchestrator) Stage(ctx context.Context, cfg PublishConfig) (err error) {
+	ctx, span := telemetry.Start(ctx, "publish-stage", attribute.String("release.version", cfg.Version))
+	defer func() { telemetry.End(span, err) }()
+	ctx, cancel := context.WithTimeout(ctx, ReleaseWorkflowTimeout)
+	defer cancel()
+	release, err := o.prepareRelease(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	tag := release.version.String()
+	log := o.logger(ctx).With(zap.String("version", tag), zap.String("ref", cfg.Ref))
+	err = tagAndPublish(
+		ctx,
+		log,
+		o.gitRepo,
+		o.goreleaserSvc,
+		o.fsRepo,
+		tag,
+		release.previousCommit,
+		publishDraft,
+	)
+	if err != nil {
+		return err
+	}
+	if err := o.recordRelease(ctx, release.version, release.signOff, publishDraft); err != nil {
+		return err
+	}
+	if err := o.signRelease(ctx, tag, false); err != nil {
+		return fmt.Errorf("release %s was staged but signing failed: %w", tag, err)
+	}
+	log.Info("Release staged as a draft; review it, then run publish finalize")
+	return nil
+}
+
+// Finalize runs the second phase of a two-phase publish: it publishes the draft GitHub release that
+// Stage created, points the npm dist-tag of the release channel at the version and then runs the
+// steps of a public release, such as publishing crates and Python packages.
+func (o *PublishOrchestrator) Finalize(ctx context.Context, cfg PublishFinalizeConfig) (err error) {
+	ctx, span := telemetry.Start(ctx, "publish-finalize", attribute.String("release.version", cfg.Version))
+	defer func() { telemetry.End(span, err) }()
+	ctx, cancel := context.WithTimeout(ctx, ReleaseWorkflowTimeout)
+	defer cancel()
+	if err := ValidateAllowedRepository(ctx); err != nil {
+		return err
+	}
+	version, err := domain.NewVersion(cfg.Version)
+	if err != nil {
+		return fmt.Errorf("invalid release version %q: %w", cfg.Version, err)
+	}
+	tag := version.String()
+	if err := o.gitRepo.CheckoutBranch(ctx, tag); err != nil {
+		return fmt.Errorf("failed to checkout staged release %s: %w", tag, err)
+	}
+	previousTag, err := o.tagBefore(ctx, tag)
+	if err != nil {
+		return err
+	}
+	if err := o.githubRepo.PublishRelease(ctx, tag); err != nil {
+		return fmt.Errorf("failed to publish staged release %s: %w", tag, err)
+	}
+	if err := o.moveNpmDistTag(ctx, tag); err != nil {
+		return fmt.Errorf("release %s was published but its npm dist-tag was not moved: %w", tag, err)
+	}
+	if err := o.completeRelease(ctx, previousTag, tag); err != nil {
+		return err
+	}
+	o.logger(ctx).Info("Finalized staged release", zap.String("version", tag))
+	return nil
+}
+
+// tagBefore returns the release tag preceding tag, the base of the pull requests release comments go
+// to. It is only looked up when release comments are posted.
+func (o *PublishOrchestrator) tagBefore(ctx context.Context, tag string) (string, error) {
+	if !config.FromContext(ctx).ReleaseCommentPRs {
+		return "", nil
+	}
+	return o.releaseTagBefore(ctx, tag)
+}
+
+// releaseTagBefore returns the tag created before tag, or an empty string for the first release.
+func (o *PublishOrchestrator) releaseTagBefore(ctx context.Context, tag string) (string, error) {
+	tags, err := o.gitRepo.ReleaseTags(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list release tags: %w", err)
+	}
+	previous := ""
+	for _, releaseTag := range tags {
+		if releaseTag.Name == tag {
+			break
+		}
+		previous = releaseTag.Name
+	}
+	return previous, nil
+}
+
+// moveNpmDistTag points the dist-tag of the release channel, latest without release channels, at the
+// version of the root package.json. Repositories without a package.json have nothing to move.
+func (o *PublishOrchestrator) moveNpmDistTag(ctx context.Context, tag string) error {
+	exists, err := afero.Exists(o.fsRepo, "package.json")
+	if err != nil {
+		return fmt.Errorf("failed to check package.json: %w", err)
+	}
+	if !exists {
+		return nil
+	}
+	channel, _ := publishReleaseChannel(ctx, o.gitRepo)
+	if err := o.npmSvc.AddDistTag(ctx, ".", tag, channel.Name); err != nil {
+		return err
+	}
+	o.logger(ctx).Info("Moved npm dist-tag", zap.String("version", tag), zap.String("dist_tag", channel.Name))
+	return nil
+}
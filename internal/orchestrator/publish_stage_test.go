@@ -0,0 +1,88 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishOrchestrator_Stage(t *testing.T) {
+	t.Run("Should tag the release and publish it as a draft", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(false, nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "abc123").Return(nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v1.2.0", "Release v1.2.0").Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v1.2.0").Return(nil).Once()
+		goreleaserSvc.On(
+			"RunWithEnv",
+			mock.Anything,
+			[]string{"PR_RELEASE_STAGED=true"},
+			"release",
+			"--clean",
+			"--release-notes=RELEASE_BODY.md",
+			"--release-header-tmpl=.goreleaser.release-header.md.tmpl",
+			"--release-footer-tmpl=.goreleaser.release-footer.md.tmpl",
+			"--draft",
+		).Return(nil).Once()
+		orch := newTestPublishOrchestrator(gitRepo, goreleaserSvc)
+		require.NoError(t, orch.Stage(ctx, PublishConfig{Version: "1.2.0", Ref: "abc123"}))
+		gitRepo.AssertExpectations(t)
+		goreleaserSvc.AssertExpectations(t)
+		orch.githubRepo.(*mockGithubExtendedRepository).AssertNotCalled(t, "PublishRelease", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPublishOrchestrator_Finalize(t *testing.T) {
+	t.Run("Should publish the draft release and move the npm dist-tag", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		npmSvc := new(mockNpmService)
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, "package.json", []byte(`{"name":"@compozy/cli"}`), 0o644))
+		gitRepo.On("CheckoutBranch", mock.Anything, "v1.2.0").Return(nil).Once()
+		githubRepo.On("PublishRelease", mock.Anything, "v1.2.0").Return(nil).Once()
+		npmSvc.On("AddDistTag", mock.Anything, ".", "v1.2.0", "latest").Return(nil).Once()
+		orch := NewPublishOrchestrator(gitRepo, nil, githubRepo, fsRepo, nil)
+		orch.npmSvc = npmSvc
+		require.NoError(t, orch.Finalize(ctx, PublishFinalizeConfig{Version: "1.2.0"}))
+		gitRepo.AssertExpectations(t)
+		githubRepo.AssertExpectations(t)
+		npmSvc.AssertExpectations(t)
+	})
+	t.Run("Should leave npm alone when the draft cannot be published", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		npmSvc := new(mockNpmService)
+		gitRepo.On("CheckoutBranch", mock.Anything, "v1.2.0").Return(nil).Once()
+		githubRepo.On("PublishRelease", mock.Anything, "v1.2.0").Return(errors.New("not found")).Once()
+		orch := NewPublishOrchestrator(gitRepo, nil, githubRepo, afero.NewMemMapFs(), nil)
+		orch.npmSvc = npmSvc
+		err := orch.Finalize(ctx, PublishFinalizeConfig{Version: "v1.2.0"})
+		assert.ErrorContains(t, err, "failed to publish staged release v1.2.0")
+		npmSvc.AssertNotCalled(t, "AddDistTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPublishOrchestrator_tagBefore(t *testing.T) {
+	t.Run("Should return the release tag preceding the finalized one", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseCommentPRs = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch := NewPublishOrchestrator(new(mockGitExtendedRepository), nil, nil, afero.NewMemMapFs(), nil)
+		orch.gitRepo.(*mockGitExtendedRepository).On("ReleaseTags", mock.Anything).
+			Return([]domain.ReleaseTag{{Name: "v1.0.0"}, {Name: "v1.1.0"}, {Name: "v1.2.0"}}, nil).Once()
+		previous, err := orch.tagBefore(ctx, "v1.2.0")
+		require.NoError(t, err)
+		assert.Equal(t, "v1.1.0", previous)
+	})
+}
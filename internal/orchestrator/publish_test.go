@@ -0,0 +1,248 @@
+package orchestrator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPublishOrchestrator(
+	gitRepo *mockGitExtendedRepository,
+	goreleaserSvc *mockGoReleaserService,
+) *PublishOrchestrator {
+	return NewPublishOrchestrator(
+		gitRepo,
+		goreleaserSvc,
+		new(mockGithubExtendedRepository),
+		afero.NewMemMapFs(),
+		new(mockCosignService),
+	)
+}
+
+func TestPublishOrchestrator_Execute(t *testing.T) {
+	t.Run("Should tag the merge commit and publish the committed release body", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(false, nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "abc123").Return(nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v1.2.0", "Release v1.2.0").Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v1.2.0").Return(nil).Once()
+		goreleaserSvc.On(
+			"Run",
+			mock.Anything,
+			"release",
+			"--clean",
+			"--release-notes=RELEASE_BODY.md",
+			"--release-header-tmpl=.goreleaser.release-header.md.tmpl",
+			"--release-footer-tmpl=.goreleaser.release-footer.md.tmpl",
+		).Return(nil).Once()
+		orch := newTestPublishOrchestrator(gitRepo, goreleaserSvc)
+		err := orch.Execute(ctx, PublishConfig{Version: "1.2.0", Ref: "abc123"})
+		require.NoError(t, err)
+		gitRepo.AssertExpectations(t)
+		goreleaserSvc.AssertExpectations(t)
+	})
+	t.Run("Should refuse to publish an existing tag", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(true, nil).Once()
+		orch := newTestPublishOrchestrator(gitRepo, new(mockGoReleaserService))
+		err := orch.Execute(ctx, PublishConfig{Version: "v1.2.0", Ref: "abc123"})
+		assert.ErrorContains(t, err, "tag v1.2.0 already exists, pass --force-tag to move it")
+		gitRepo.AssertNotCalled(t, "CreateTag", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should restore a force-moved tag when publishing fails", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(true, nil).Once()
+		gitRepo.On("TagCommit", mock.Anything, "v1.2.0").Return("0ld5ha", nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "abc123").Return(nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("TagAnnotation", mock.Anything, "v1.2.0").Return("Release v1.2.0\n\nOriginal notes", true, nil).Once()
+		gitRepo.On("CreateTagForce", mock.Anything, "v1.2.0", "", "Release v1.2.0").Return(nil).Once()
+		gitRepo.On("CreateTagForce", mock.Anything, "v1.2.0", "0ld5ha", "Release v1.2.0\n\nOriginal notes").
+			Return(nil).Once()
+		gitRepo.On("PushTagForce", mock.Anything, "v1.2.0").Return(nil).Twice()
+		goreleaserSvc.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(errors.New("goreleaser failed")).Once()
+		orch := newTestPublishOrchestrator(gitRepo, goreleaserSvc)
+		err := orch.Execute(ctx, PublishConfig{Version: "v1.2.0", Ref: "abc123", ForceTag: true})
+		require.ErrorContains(t, err, "failed to publish release v1.2.0")
+		gitRepo.AssertExpectations(t)
+	})
+	t.Run("Should restore a force-moved lightweight tag locally when its push fails", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(true, nil).Once()
+		gitRepo.On("TagCommit", mock.Anything, "v1.2.0").Return("0ld5ha", nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("TagAnnotation", mock.Anything, "v1.2.0").Return("", false, nil).Once()
+		gitRepo.On("CreateTagForce", mock.Anything, "v1.2.0", "", "Release v1.2.0").Return(nil).Once()
+		gitRepo.On("PushTagForce", mock.Anything, "v1.2.0").Return(errors.New("rejected")).Once()
+		gitRepo.On("CreateTagForce", mock.Anything, "v1.2.0", "0ld5ha", "").Return(nil).Once()
+		orch := newTestPublishOrchestrator(gitRepo, new(mockGoReleaserService))
+		err := orch.Execute(ctx, PublishConfig{Version: "v1.2.0", ForceTag: true})
+		require.ErrorContains(t, err, "failed to push tag v1.2.0")
+		gitRepo.AssertExpectations(t)
+	})
+	t.Run("Should write and attach the release manifest after publishing", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseManifestPath = "release-manifest.json"
+		cfg.AttachReleaseManifest = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, "dist/app_linux_amd64.tar.gz", []byte("binary"), 0o644))
+		require.NoError(t, afero.WriteFile(fsRepo, cfg.ArtifactMetadataPath, []byte(`{"tag":"v1.2.0","artifacts":[
+			{"name":"app_linux_amd64.tar.gz","path":"dist/app_linux_amd64.tar.gz","type":"Archive",
+			 "goos":"linux","goarch":"amd64"},
+			{"name":"app_darwin_arm64.tar.gz","path":"dist/app_darwin_arm64.tar.gz","type":"Archive",
+			 "goos":"darwin","goarch":"arm64","extra":{"Checksum":"sha256:feed"}}
+		]}`), 0o644))
+		gitRepo := new(mockGitExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		githubRepo := new(mockGithubExtendedRepository)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(false, nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "abc123").Return(nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v1.2.0", "Release v1.2.0").Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v1.2.0").Return(nil).Once()
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123def", nil).Once()
+		gitRepo.On("ReleaseTags", mock.Anything).
+			Return([]domain.ReleaseTag{{Name: "v1.1.0"}, {Name: "v1.2.0"}}, nil).Once()
+		goreleaserSvc.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).Once()
+		githubRepo.On("UploadReleaseAsset", mock.Anything, "v1.2.0", "release-manifest.json").Return(nil).Once()
+		orch := NewPublishOrchestrator(gitRepo, goreleaserSvc, githubRepo, fsRepo, new(mockCosignService))
+		require.NoError(t, orch.Execute(ctx, PublishConfig{Version: "1.2.0", Ref: "abc123"}))
+		data, err := afero.ReadFile(fsRepo, "release-manifest.json")
+		require.NoError(t, err)
+		var manifest domain.ReleaseManifest
+		require.NoError(t, json.Unmarshal(data, &manifest))
+		assert.Equal(t, domain.ReleaseManifestSchemaVersion, manifest.SchemaVersion)
+		assert.Equal(t, "1.2.0", manifest.Version)
+		assert.Equal(t, "v1.2.0", manifest.Tag)
+		assert.Equal(t, "abc123def", manifest.Commit)
+		assert.NotNil(t, manifest.PublishedAt)
+		require.Len(t, manifest.Artifacts, 2)
+		sum := sha256.Sum256([]byte("binary"))
+		assert.Equal(t, hex.EncodeToString(sum[:]), manifest.Artifacts[0].SHA256)
+		assert.Equal(t, "feed", manifest.Artifacts[1].SHA256)
+		assert.Equal(t, &domain.ManifestCompatibility{
+			Level:           domain.CompatibilitySafe,
+			PreviousVersion: "v1.1.0",
+			Range:           "^1.1.0",
+		}, manifest.Compatibility)
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should attach the release notes and record their checksum in the manifest", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseManifestPath = "release-manifest.json"
+		cfg.AttachReleaseNotes = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, ReleaseNotesOutputFile, []byte("## Highlights\n"), 0o644))
+		gitRepo := new(mockGitExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		githubRepo := new(mockGithubExtendedRepository)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(false, nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v1.2.0", "Release v1.2.0").Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v1.2.0").Return(nil).Once()
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123def", nil).Once()
+		gitRepo.On("ReleaseTags", mock.Anything).Return([]domain.ReleaseTag{{Name: "v1.2.0"}}, nil).Once()
+		goreleaserSvc.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).Once()
+		githubRepo.On("UploadReleaseAsset", mock.Anything, "v1.2.0", ReleaseNotesOutputFile).Return(nil).Once()
+		orch := NewPublishOrchestrator(gitRepo, goreleaserSvc, githubRepo, fsRepo, new(mockCosignService))
+		require.NoError(t, orch.Execute(ctx, PublishConfig{Version: "1.2.0"}))
+		data, err := afero.ReadFile(fsRepo, "release-manifest.json")
+		require.NoError(t, err)
+		var manifest domain.ReleaseManifest
+		require.NoError(t, json.Unmarshal(data, &manifest))
+		sum := sha256.Sum256([]byte("## Highlights\n"))
+		assert.Equal(t, &domain.ManifestReleaseNotes{
+			Name:   ReleaseNotesOutputFile,
+			SHA256: hex.EncodeToString(sum[:]),
+		}, manifest.ReleaseNotes)
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should write the manifest without attaching it when publishing is skipped", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseManifestPath = "release-manifest.json"
+		cfg.AttachReleaseManifest = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		body := "## 1.2.0\n\n### Features\n\n- **BREAKING:** rename the config keys\n"
+		require.NoError(t, afero.WriteFile(fsRepo, ReleaseBodyOutputFile, []byte(body), 0o644))
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(false, nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v1.2.0", mock.Anything).Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v1.2.0").Return(nil).Once()
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123def", nil).Once()
+		gitRepo.On("ReleaseTags", mock.Anything).
+			Return([]domain.ReleaseTag{{Name: "v1.1.0"}, {Name: "v1.2.0"}}, nil).Once()
+		orch := NewPublishOrchestrator(gitRepo, new(mockGoReleaserService), githubRepo, fsRepo, new(mockCosignService))
+		require.NoError(t, orch.Execute(ctx, PublishConfig{Version: "1.2.0", SkipPublish: true}))
+		data, err := afero.ReadFile(fsRepo, "release-manifest.json")
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "published_at")
+		assert.Contains(t, string(data), `"level": "breaking"`)
+		githubRepo.AssertNotCalled(t, "UploadReleaseAsset", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestWebhookOrchestrator(t *testing.T) {
+	t.Run("Should sync the base branch before publishing", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		gitRepo.On("SyncBranch", mock.Anything, "main").Return(nil).Once()
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(false, nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "abc123").Return(nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v1.2.0", "Release v1.2.0").Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v1.2.0").Return(nil).Once()
+		goreleaserSvc.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).Once()
+		prOrch := NewPRReleaseOrchestrator(
+			gitRepo,
+			new(mockGithubExtendedRepository),
+			afero.NewMemMapFs(),
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		orch := NewWebhookOrchestrator(gitRepo, prOrch, newTestPublishOrchestrator(gitRepo, goreleaserSvc))
+		require.NoError(t, orch.Publish(ctx, "main", "v1.2.0", "abc123"))
+		gitRepo.AssertExpectations(t)
+		goreleaserSvc.AssertExpectations(t)
+	})
+	t.Run("Should not run the release PR workflow when sync fails", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("SyncBranch", mock.Anything, "main").Return(assert.AnError).Once()
+		prOrch := NewPRReleaseOrchestrator(
+			gitRepo,
+			new(mockGithubExtendedRepository),
+			afero.NewMemMapFs(),
+			new(mockCliffService),
+			new(mockNpmService),
+		)
+		orch := NewWebhookOrchestrator(gitRepo, prOrch, newTestPublishOrchestrator(gitRepo, new(mockGoReleaserService)))
+		err := orch.ReleasePR(ctx, "main")
+		assert.ErrorContains(t, err, "failed to sync main")
+		gitRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,76 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+// publishPythonPackages builds the Python packages of pypi_packages, in order, into a temporary
+// directory and uploads them with twine once the release is published. Publishing is skipped without a PyPI token, and files the
+// package index already has are skipped by twine, so a re-run only uploads what is missing.
+func (o *PublishOrchestrator) publishPythonPackages(ctx context.Context) error {
+	cfg := config.FromContext(ctx)
+	if len(cfg.PyPIPackages) == 0 {
+		return nil
+	}
+	log := o.logger(ctx)
+	if cfg.PyPIToken == "" {
+		log.Warn("Skipping Python package publishing", zap.String("reason", "no PyPI token (PYPI_TOKEN)"))
+		return nil
+	}
+	outDir, err := afero.TempDir(o.fsRepo, "", "pr-release-pypi-")
+	if err != nil {
+		return fmt.Errorf("failed to create the Python build directory: %w", err)
+	}
+	defer o.fsRepo.RemoveAll(outDir)
+	for i, path := range cfg.PyPIPackages {
+		dists, err := o.pypiSvc.Build(ctx, path, filepath.Join(outDir, strconv.Itoa(i)), cfg.PyPIBuildCommand)
+		if err != nil {
+			return err
+		}
+		opts := service.PyPIUploadOptions{RepositoryURL: cfg.PyPIRepositoryURL, Token: cfg.PyPIToken}
+		if err := o.pypiSvc.Upload(ctx, dists, opts); err != nil {
+			return fmt.Errorf("python package %s: %w", path, err)
+		}
+		log.Info("Published Python package", zap.String("path", path), zap.Strings("files", dists))
+	}
+	return nil
+}
+
+// stepValidatePythonPackages builds every package of pypi_packages and checks its distributions with
+// twine check, so a package that does not build or has invalid metadata fails the release PR instead
+// of the publish.
+func (o *DryRunOrchestrator) stepValidatePythonPackages(ctx context.Context, cfg DryRunConfig) error {
+	o.logStatus(ctx, cfg.CIOutput, "### 🐍 Validating Python packages")
+	log := o.logger(ctx)
+	outDir, err := afero.TempDir(o.fsRepo, "", "pr-release-pypi-")
+	if err != nil {
+		return fmt.Errorf("failed to create the Python build directory: %w", err)
+	}
+	defer o.fsRepo.RemoveAll(outDir)
+	var errs []error
+	for i, path := range cfg.PyPIPackages {
+		log.Info("Validating Python package", zap.String("path", path))
+		dists, err := o.pypiSvc.Build(ctx, path, filepath.Join(outDir, strconv.Itoa(i)), cfg.PyPIBuildCommand)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := o.pypiSvc.Check(ctx, dists); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("python package validation failed: %w", err)
+	}
+	log.Info("Python packages validated", zap.Int("count", len(cfg.PyPIPackages)))
+	return nil
+}
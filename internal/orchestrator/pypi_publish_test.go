@@ -0,0 +1,66 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishOrchestrator_publishPythonPackages(t *testing.T) {
+	t.Run("Should build and upload every package with the token", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.PyPIPackages = []string{"sdks/python"}
+		cfg.PyPIBuildCommand = []string{"uv", "build"}
+		cfg.PyPIRepositoryURL = "https://test.pypi.org/legacy/"
+		cfg.PyPIToken = "pypi-token"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		dists := []string{"/tmp/pr-release-pypi/0/acme-1.2.0-py3-none-any.whl", "/tmp/pr-release-pypi/0/acme-1.2.0.tar.gz"}
+		pypiSvc := new(mockPyPIService)
+		pypiSvc.On("Build", mock.Anything, "sdks/python", mock.Anything, []string{"uv", "build"}).Return(dists, nil).Once()
+		opts := service.PyPIUploadOptions{RepositoryURL: "https://test.pypi.org/legacy/", Token: "pypi-token"}
+		pypiSvc.On("Upload", mock.Anything, dists, opts).Return(nil).Once()
+		orch := &PublishOrchestrator{fsRepo: afero.NewMemMapFs(), pypiSvc: pypiSvc}
+		require.NoError(t, orch.publishPythonPackages(ctx))
+		pypiSvc.AssertExpectations(t)
+	})
+	t.Run("Should skip publishing without a PyPI token", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.PyPIPackages = []string{"sdks/python"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		pypiSvc := new(mockPyPIService)
+		orch := &PublishOrchestrator{fsRepo: afero.NewMemMapFs(), pypiSvc: pypiSvc}
+		require.NoError(t, orch.publishPythonPackages(ctx))
+		pypiSvc.AssertNotCalled(t, "Build", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should not upload a package that fails to build", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.PyPIPackages = []string{"sdks/python"}
+		cfg.PyPIToken = "pypi-token"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		pypiSvc := new(mockPyPIService)
+		pypiSvc.On("Build", mock.Anything, "sdks/python", mock.Anything, []string(nil)).
+			Return(nil, errors.New("failed to build python package at sdks/python: command failed")).Once()
+		orch := &PublishOrchestrator{fsRepo: afero.NewMemMapFs(), pypiSvc: pypiSvc}
+		require.ErrorContains(t, orch.publishPythonPackages(ctx), "failed to build python package")
+		pypiSvc.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDryRunOrchestrator_stepValidatePythonPackages(t *testing.T) {
+	t.Run("Should check the built distributions with twine", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		dists := []string{"/tmp/pr-release-pypi/0/acme-1.2.0.tar.gz"}
+		pypiSvc := new(mockPyPIService)
+		pypiSvc.On("Build", mock.Anything, "sdks/python", mock.Anything, []string(nil)).Return(dists, nil).Once()
+		pypiSvc.On("Check", mock.Anything, dists).
+			Return(errors.New("twine check failed: command failed")).Once()
+		orch := &DryRunOrchestrator{fsRepo: afero.NewMemMapFs(), pypiSvc: pypiSvc}
+		err := orch.stepValidatePythonPackages(ctx, DryRunConfig{PyPIPackages: []string{"sdks/python"}})
+		require.ErrorContains(t, err, "python package validation failed: sdks/python: twine check failed")
+		pypiSvc.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,34 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+)
+
+const readmeFile = "README.md"
+
+// updateReadmeVersion rewrites the versions pinned in the root README.md, such as badges and install
+// snippets, with readme_version_patterns and returns the file when it changed.
+func (o *PRReleaseOrchestrator) updateReadmeVersion(ctx context.Context, version string) ([]string, error) {
+	cfg := config.FromContext(ctx)
+	patterns, err := domain.ParseReadmeVersionPatterns(cfg.ReadmeVersionPatterns, cfg.GithubOwner, cfg.GithubRepo)
+	if err != nil {
+		return nil, err
+	}
+	content, err := readOptionalFile(o.fsRepo, readmeFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", readmeFile, err)
+	}
+	updated := patterns.Rewrite(content, version)
+	if updated == content {
+		return nil, nil
+	}
+	if err := afero.WriteFile(o.fsRepo, readmeFile, []byte(updated), FilePermissionsReadWrite); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", readmeFile, err)
+	}
+	return []string{readmeFile}, nil
+}
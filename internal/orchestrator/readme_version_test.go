@@ -0,0 +1,52 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRReleaseOrchestrator_updateReadmeVersion(t *testing.T) {
+	t.Run("Should rewrite pinned versions as part of the package versions", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.GithubOwner = "acme"
+		cfg.GithubRepo = "tool"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		readme := "# Tool\n\n    go install github.com/acme/tool@v1.1.0\n"
+		require.NoError(t, afero.WriteFile(fsRepo, "README.md", []byte(readme), 0644))
+		files, err := orch.updatePackageVersions(ctx, "v1.2.0", "v1.1.0")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"README.md"}, files)
+		data, err := afero.ReadFile(fsRepo, "README.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Tool\n\n    go install github.com/acme/tool@v1.2.0\n", string(data))
+	})
+	t.Run("Should use the configured patterns", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReadmeVersionPatterns = []string{`image: acme/tool:(\S+)`}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		readme := "image: acme/tool:1.1.0\ngo install github.com/acme/tool@v1.1.0\n"
+		require.NoError(t, afero.WriteFile(fsRepo, "README.md", []byte(readme), 0644))
+		files, err := orch.updateReadmeVersion(ctx, "v1.2.0")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"README.md"}, files)
+		data, err := afero.ReadFile(fsRepo, "README.md")
+		require.NoError(t, err)
+		assert.Equal(t, "image: acme/tool:1.2.0\ngo install github.com/acme/tool@v1.1.0\n", string(data))
+	})
+	t.Run("Should report nothing without a README or pinned versions", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		files, err := orch.updateReadmeVersion(ctx, "v1.2.0")
+		require.NoError(t, err)
+		assert.Empty(t, files)
+		require.NoError(t, afero.WriteFile(fsRepo, "README.md", []byte("# Tool\n"), 0644))
+		files, err = orch.updateReadmeVersion(ctx, "v1.2.0")
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+}
@@ -4,14 +4,17 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
 	"github.com/spf13/afero"
 	"go.uber.org/zap"
 )
@@ -25,11 +28,15 @@ type releaseArtifactCommandRunner func(
 ) error
 
 type releaseArtifactResult struct {
-	addPatterns   []string
 	modifiedFiles []string
 	createdFiles  []string
 }
 
+// files returns every file the release artifact commands left matching their add patterns.
+func (r *releaseArtifactResult) files() []string {
+	return slices.Concat(r.modifiedFiles, r.createdFiles)
+}
+
 func defaultReleaseArtifactCommandRunner(
 	ctx context.Context,
 	command *config.ReleaseArtifactCommand,
@@ -94,7 +101,8 @@ func releaseArtifactWorkingDirectory() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to get working directory: %w", err)
 	}
-	repoRoot := findRepoRoot(wd)
+	// The commands run as real processes, so the root is looked up on the OS filesystem.
+	repoRoot := findRepoRoot(afero.NewOsFs(), wd)
 	if repoRoot == "" {
 		return "", fmt.Errorf("repository root not found from %s", wd)
 	}
@@ -114,6 +122,19 @@ func releaseArtifactProcessEnv(overrides map[string]string) []string {
 	return env
 }
 
+// releaseArtifactCommands runs the configured release artifact commands unless the step is skipped.
+func (o *PRReleaseOrchestrator) releaseArtifactCommands(
+	ctx context.Context,
+	version, branchName, latestTag string,
+	skipped domain.SkippedSteps,
+) (*releaseArtifactResult, error) {
+	if skipped.Has(domain.StepReleaseArtifacts) {
+		o.logSkippedStep(ctx, domain.StepReleaseArtifacts)
+		return &releaseArtifactResult{}, nil
+	}
+	return o.runReleaseArtifactCommands(ctx, version, branchName, latestTag)
+}
+
 func (o *PRReleaseOrchestrator) runReleaseArtifactCommands(
 	ctx context.Context,
 	version string,
@@ -121,9 +142,7 @@ func (o *PRReleaseOrchestrator) runReleaseArtifactCommands(
 	previousTag string,
 ) (*releaseArtifactResult, error) {
 	cfg := config.FromContext(ctx)
-	result := &releaseArtifactResult{
-		addPatterns: releaseArtifactAddPatterns(cfg.ReleaseArtifacts),
-	}
+	result := &releaseArtifactResult{}
 	if len(cfg.ReleaseArtifacts) == 0 {
 		return result, nil
 	}
@@ -139,8 +158,12 @@ func (o *PRReleaseOrchestrator) runReleaseArtifactCommands(
 	for index := range cfg.ReleaseArtifacts {
 		command := &cfg.ReleaseArtifacts[index]
 		name := strings.TrimSpace(command.Name)
+		commandEnv, err := releaseArtifactCommandEnv(index, command, env)
+		if err != nil {
+			return nil, fmt.Errorf("release artifact %q: %w", name, err)
+		}
 		o.logger(ctx).Info("Running release artifact command", zap.String("name", name))
-		if err := o.artifactRunner(ctx, command, env); err != nil {
+		if err := o.artifactRunner(ctx, command, commandEnv); err != nil {
 			return nil, fmt.Errorf("release artifact %q failed: %w", name, err)
 		}
 	}
@@ -178,6 +201,28 @@ func releaseArtifactEnvironment(
 	}
 }
 
+// releaseArtifactCommandEnv adds the env configured for the command to the release environment,
+// resolving its references now that the command is about to run.
+func releaseArtifactCommandEnv(
+	index int,
+	command *config.ReleaseArtifactCommand,
+	env map[string]string,
+) (map[string]string, error) {
+	if len(command.Env) == 0 {
+		return env, nil
+	}
+	resolved, err := config.ResolveEnv(fmt.Sprintf("release_artifacts[%d].env", index), command.Env)
+	if err != nil {
+		return nil, err
+	}
+	result := maps.Clone(env)
+	for _, entry := range resolved {
+		key, value, _ := strings.Cut(entry, "=")
+		result[key] = value
+	}
+	return result, nil
+}
+
 func (o *PRReleaseOrchestrator) releaseArtifactFiles(
 	commands []config.ReleaseArtifactCommand,
 	requireMatches bool,
@@ -204,18 +249,3 @@ func (o *PRReleaseOrchestrator) releaseArtifactFiles(
 	sort.Strings(result)
 	return result, nil
 }
-
-func releaseArtifactAddPatterns(commands []config.ReleaseArtifactCommand) []string {
-	seen := map[string]struct{}{}
-	patterns := make([]string, 0)
-	for _, command := range commands {
-		for _, pattern := range command.Add {
-			if _, ok := seen[pattern]; ok {
-				continue
-			}
-			seen[pattern] = struct{}{}
-			patterns = append(patterns, pattern)
-		}
-	}
-	return patterns
-}
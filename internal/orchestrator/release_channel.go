@@ -0,0 +1,127 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+	"go.uber.org/zap"
+)
+
+// Environment variables that expose the release channel to GoReleaser templates, e.g.
+// make_latest: "{{ .Env.PR_RELEASE_MAKE_LATEST }}".
+const (
+	envReleaseChannel    = "PR_RELEASE_CHANNEL"
+	envReleaseMakeLatest = "PR_RELEASE_MAKE_LATEST"
+	envGithubBaseRef     = "GITHUB_BASE_REF"
+)
+
+type releaseChannelKey struct{}
+
+// releaseTarget is the channel of a release and the branch its release PR targets.
+type releaseTarget struct {
+	channel domain.ReleaseChannel
+	base    string
+}
+
+func withReleaseTarget(ctx context.Context, target releaseTarget) context.Context {
+	return context.WithValue(ctx, releaseChannelKey{}, target)
+}
+
+func releaseTargetFromContext(ctx context.Context) releaseTarget {
+	if target, ok := ctx.Value(releaseChannelKey{}).(releaseTarget); ok {
+		return target
+	}
+	channel := domain.DefaultReleaseChannel()
+	return releaseTarget{channel: channel, base: channel.Branch}
+}
+
+// releaseChannel returns the channel of the release, latest unless release_channels maps the branch.
+func releaseChannel(ctx context.Context) domain.ReleaseChannel {
+	return releaseTargetFromContext(ctx).channel
+}
+
+// releaseBase returns the branch the release PR targets: the channel branch it was cut from, or main.
+func releaseBase(ctx context.Context) string {
+	return releaseTargetFromContext(ctx).base
+}
+
+// resolveReleaseChannel maps the branch pr-release runs on to its release channel. Branches no
+// channel matches keep releasing to latest against main.
+func (o *PRReleaseOrchestrator) resolveReleaseChannel(ctx context.Context) (context.Context, error) {
+	channels := config.FromContext(ctx).Channels()
+	if len(channels) == 0 {
+		return ctx, nil
+	}
+	branch, err := o.gitRepo.GetCurrentBranch(ctx)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to resolve the release channel: %w", err)
+	}
+	channel, ok := domain.ResolveReleaseChannel(channels, branch)
+	if !ok {
+		o.logger(ctx).Info("Branch is not mapped to a release channel, releasing to latest",
+			zap.String("branch", branch))
+		return ctx, nil
+	}
+	o.logger(ctx).Info("Resolved release channel",
+		zap.String("branch", branch),
+		zap.String("channel", channel.Name),
+		zap.Bool("prerelease", channel.Prerelease),
+	)
+	return withReleaseTarget(ctx, releaseTarget{channel: channel, base: branch}), nil
+}
+
+// resumeReleaseChannel restores the release channel of a resumed session from the base branch it
+// recorded, so the resumed run targets the branch its release PR was opened against whichever branch
+// is checked out. Sessions without a recorded base resolve the channel from the current branch.
+func (o *PRReleaseOrchestrator) resumeReleaseChannel(
+	ctx context.Context,
+	state *domain.RollbackState,
+) (context.Context, error) {
+	if state.BaseBranch == "" {
+		return o.resolveReleaseChannel(ctx)
+	}
+	channel, ok := domain.ResolveReleaseChannel(config.FromContext(ctx).Channels(), state.BaseBranch)
+	if !ok {
+		channel = domain.DefaultReleaseChannel()
+	}
+	return withReleaseTarget(ctx, releaseTarget{channel: channel, base: state.BaseBranch}), nil
+}
+
+// publishReleaseChannel returns the channel of the release PR being published, resolved from its
+// base branch, and false when no release channels are configured.
+func publishReleaseChannel(
+	ctx context.Context,
+	gitRepo repository.GitExtendedRepository,
+) (domain.ReleaseChannel, bool) {
+	channels := config.FromContext(ctx).Channels()
+	if len(channels) == 0 {
+		return domain.DefaultReleaseChannel(), false
+	}
+	base := os.Getenv(envGithubBaseRef)
+	if base == "" {
+		base, _ = gitRepo.GetCurrentBranch(ctx)
+	}
+	channel, ok := domain.ResolveReleaseChannel(channels, base)
+	if !ok {
+		channel = domain.DefaultReleaseChannel()
+	}
+	return channel, true
+}
+
+// publishChannelEnv returns the GoReleaser environment describing the channel of the release PR
+// being published, resolved from its base branch. It is empty when no release channels are configured.
+func publishChannelEnv(ctx context.Context, gitRepo repository.GitExtendedRepository) []string {
+	channel, ok := publishReleaseChannel(ctx, gitRepo)
+	if !ok {
+		return nil
+	}
+	return []string{
+		envReleaseChannel + "=" + channel.Name,
+		envReleaseMakeLatest + "=" + strconv.FormatBool(channel.IsLatest()),
+	}
+}
@@ -0,0 +1,138 @@
+package orchestrator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testChannelsConfig() *config.Config {
+	cfg := testReleaseConfig()
+	cfg.ReleaseChannels = []config.ReleaseChannelConfig{
+		{Branch: "main", Channel: "latest"},
+		{Branch: "next", Channel: "next", Prerelease: true},
+		{Branch: "lts/*", Channel: "lts"},
+	}
+	return cfg
+}
+
+func TestPRReleaseOrchestrator_resolveReleaseChannel(t *testing.T) {
+	t.Run("Should release to latest against main without configured channels", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		ctx, err := orch.resolveReleaseChannel(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, domain.LatestReleaseChannel, releaseChannel(ctx).Name)
+		assert.Equal(t, "main", releaseBase(ctx))
+		orch.gitRepo.(*mockGitExtendedRepository).AssertNotCalled(t, "GetCurrentBranch", mock.Anything)
+	})
+	t.Run("Should target the channel branch the release is cut from", func(t *testing.T) {
+		ctx := testReleaseContextWithConfig(t, testChannelsConfig())
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		orch.gitRepo.(*mockGitExtendedRepository).On("GetCurrentBranch", mock.Anything).Return("lts/1.x", nil)
+		ctx, err := orch.resolveReleaseChannel(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "lts", releaseChannel(ctx).Name)
+		assert.Equal(t, "lts/1.x", releaseBase(ctx))
+	})
+	t.Run("Should release unmapped branches to latest against main", func(t *testing.T) {
+		ctx := testReleaseContextWithConfig(t, testChannelsConfig())
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		orch.gitRepo.(*mockGitExtendedRepository).On("GetCurrentBranch", mock.Anything).Return("feature/x", nil)
+		ctx, err := orch.resolveReleaseChannel(ctx)
+		require.NoError(t, err)
+		assert.True(t, releaseChannel(ctx).IsLatest())
+		assert.Equal(t, "main", releaseBase(ctx))
+	})
+}
+
+func TestPRReleaseOrchestrator_resumeReleaseChannel(t *testing.T) {
+	t.Run("Should target the base branch the session recorded", func(t *testing.T) {
+		ctx := testReleaseContextWithConfig(t, testChannelsConfig())
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		ctx, err := orch.resumeReleaseChannel(ctx, &domain.RollbackState{BaseBranch: "next"})
+		require.NoError(t, err)
+		assert.Equal(t, "next", releaseChannel(ctx).Name)
+		assert.Equal(t, "next", releaseBase(ctx))
+		orch.gitRepo.(*mockGitExtendedRepository).AssertNotCalled(t, "GetCurrentBranch", mock.Anything)
+	})
+	t.Run("Should resolve sessions without a recorded base from the current branch", func(t *testing.T) {
+		ctx := testReleaseContextWithConfig(t, testChannelsConfig())
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		orch.gitRepo.(*mockGitExtendedRepository).On("GetCurrentBranch", mock.Anything).Return("lts/1.x", nil)
+		ctx, err := orch.resumeReleaseChannel(ctx, &domain.RollbackState{})
+		require.NoError(t, err)
+		assert.Equal(t, "lts/1.x", releaseBase(ctx))
+	})
+}
+
+func TestPRReleaseOrchestrator_calculateVersionOnChannel(t *testing.T) {
+	t.Run("Should continue the prerelease numbering of the channel", func(t *testing.T) {
+		ctx := testReleaseContextWithConfig(t, testChannelsConfig())
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		gitRepo := orch.gitRepo.(*mockGitExtendedRepository)
+		gitRepo.On("GetCurrentBranch", mock.Anything).Return("next", nil)
+		gitRepo.On("LatestTag", mock.Anything).Return("v1.3.0-next.1", nil)
+		next, err := domain.NewVersion("1.3.0")
+		require.NoError(t, err)
+		orch.cliffSvc.(*mockCliffService).On("CalculateNextVersion", mock.Anything, "v1.3.0-next.1").Return(next, nil)
+		ctx, err = orch.resolveReleaseChannel(ctx)
+		require.NoError(t, err)
+		version, err := orch.calculateVersion(ctx, "v1.3.0-next.1")
+		require.NoError(t, err)
+		assert.Equal(t, "v1.3.0-next.2", version)
+	})
+}
+
+func TestPRReleaseOrchestrator_updatePackageJSONDistTag(t *testing.T) {
+	readPublishConfig := func(t *testing.T, fsRepo afero.Fs) map[string]any {
+		t.Helper()
+		data, err := afero.ReadFile(fsRepo, "package.json")
+		require.NoError(t, err)
+		var pkg map[string]any
+		require.NoError(t, json.Unmarshal(data, &pkg))
+		publishConfig, _ := pkg["publishConfig"].(map[string]any)
+		return publishConfig
+	}
+	t.Run("Should publish channel releases under the channel dist-tag", func(t *testing.T) {
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, afero.WriteFile(fsRepo, "package.json", []byte(`{"name":"pkg","version":"1.2.0"}`), 0644))
+		_, err := orch.updatePackageJSON("v1.3.0-next.1", domain.ReleaseChannel{Name: "next", Prerelease: true})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"tag": "next"}, readPublishConfig(t, fsRepo))
+	})
+	t.Run("Should reset the dist-tag of latest releases", func(t *testing.T) {
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		pkg := `{"name":"pkg","version":"1.3.0-next.1","publishConfig":{"access":"public","tag":"next"}}`
+		require.NoError(t, afero.WriteFile(fsRepo, "package.json", []byte(pkg), 0644))
+		_, err := orch.updatePackageJSON("v1.3.0", domain.DefaultReleaseChannel())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"access": "public", "tag": "latest"}, readPublishConfig(t, fsRepo))
+	})
+	t.Run("Should not add a dist-tag to latest releases", func(t *testing.T) {
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, afero.WriteFile(fsRepo, "package.json", []byte(`{"name":"pkg","version":"1.2.0"}`), 0644))
+		_, err := orch.updatePackageJSON("v1.3.0", domain.DefaultReleaseChannel())
+		require.NoError(t, err)
+		assert.Nil(t, readPublishConfig(t, fsRepo))
+	})
+}
+
+func TestPublishChannelEnv(t *testing.T) {
+	t.Run("Should describe the channel of the release PR base to GoReleaser", func(t *testing.T) {
+		t.Setenv(envGithubBaseRef, "lts/1.x")
+		ctx := testReleaseContextWithConfig(t, testChannelsConfig())
+		env := publishChannelEnv(ctx, new(mockGitExtendedRepository))
+		assert.Equal(t, []string{"PR_RELEASE_CHANNEL=lts", "PR_RELEASE_MAKE_LATEST=false"}, env)
+	})
+	t.Run("Should leave the environment alone without configured channels", func(t *testing.T) {
+		t.Setenv(envGithubBaseRef, "next")
+		assert.Empty(t, publishChannelEnv(testReleaseContext(t), new(mockGitExtendedRepository)))
+	})
+}
@@ -0,0 +1,110 @@
+package orchestrator
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/sethvargo/go-retry"
+	"go.uber.org/zap"
+)
+
+// releaseCommentBatchSize is how many release comments are posted before pausing. GitHub throttles
+// bursts of content creation with secondary rate limits, which a large release would hit.
+const releaseCommentBatchSize = 20
+
+// releaseCommentBatchPause is how long to wait between two batches of release comments.
+var releaseCommentBatchPause = 10 * time.Second
+
+// commentReleasedItems announces the release of tag on every pull request merged since previousTag
+// and, with release_comment_issues, on the issues they close. Failures are only logged: the release
+// is already published.
+func (o *PublishOrchestrator) commentReleasedItems(ctx context.Context, previousTag, tag string) {
+	cfg := config.FromContext(ctx)
+	if !cfg.ReleaseCommentPRs {
+		return
+	}
+	log := o.logger(ctx).With(zap.String("version", tag), zap.String("previous_tag", previousTag))
+	if previousTag == "" || previousTag == tag {
+		log.Info("Skipping release comments", zap.String("reason", "no previous release"))
+		return
+	}
+	prs, err := o.githubRepo.MergedPullRequests(ctx, previousTag, tag)
+	if err != nil {
+		log.Warn("Failed to list released pull requests", zap.Error(err))
+		return
+	}
+	body, err := usecase.RenderTemplate(o.fsRepo, usecase.TemplateReleasedComment, map[string]string{
+		"Tag": tag,
+		"URL": domain.ReleaseURL(cfg.GithubOwner, cfg.GithubRepo, tag),
+	})
+	if err != nil {
+		log.Warn("Failed to render the release comment", zap.Error(err))
+		return
+	}
+	body = strings.TrimSpace(body)
+	targets := releaseCommentTargets(prs, cfg.ReleaseCommentIssues)
+	commented := 0
+	for i, number := range targets {
+		if i > 0 && i%releaseCommentBatchSize == 0 {
+			if err := pause(ctx, releaseCommentBatchPause); err != nil {
+				log.Warn("Stopped release comments", zap.Int("remaining", len(targets)-i), zap.Error(err))
+				break
+			}
+		}
+		err := retry.Do(
+			ctx,
+			retry.WithMaxRetries(DefaultRetryCount, retry.NewExponential(DefaultRetryDelay)),
+			func(ctx context.Context) error {
+				return retryableGitHubError(o.githubRepo.AddComment(ctx, number, body))
+			},
+		)
+		if err != nil {
+			log.Warn("Failed to comment on released item", zap.Int("number", number), zap.Error(err))
+			continue
+		}
+		commented++
+	}
+	log.Info("Commented on released pull requests and issues",
+		zap.Int("comments", commented),
+		zap.Int("targets", len(targets)),
+	)
+}
+
+// releaseCommentTargets returns the numbers to comment on: the pull requests, then, with issues, the
+// issues they close that are not themselves among the pull requests.
+func releaseCommentTargets(prs []domain.PullRequest, issues bool) []int {
+	targets := make([]int, 0, len(prs))
+	for _, pr := range prs {
+		targets = append(targets, pr.Number)
+	}
+	if !issues {
+		return targets
+	}
+	var closed []int
+	for _, pr := range prs {
+		for _, issue := range pr.Closes {
+			if !slices.Contains(targets, issue) {
+				closed = append(closed, issue)
+			}
+		}
+	}
+	slices.Sort(closed)
+	return append(targets, slices.Compact(closed)...)
+}
+
+// pause waits for d unless ctx is done first.
+func pause(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishOrchestrator_commentReleasedItems(t *testing.T) {
+	prs := []domain.PullRequest{{Number: 12, Closes: []int{7, 15}}, {Number: 15, Closes: []int{3, 7}}}
+	released := "🚀 Released in [v1.2.0](https://github.com/compozy/releasepr/releases/tag/v1.2.0)"
+	t.Run("Should comment on released pull requests and the issues they close", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseCommentPRs = true
+		cfg.ReleaseCommentIssues = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("MergedPullRequests", mock.Anything, "v1.1.0", "v1.2.0").Return(prs, nil).Once()
+		for _, number := range []int{12, 15, 3, 7} {
+			githubRepo.On("AddComment", mock.Anything, number, released).Return(nil).Once()
+		}
+		orch := NewPublishOrchestrator(nil, nil, githubRepo, nil, nil)
+		orch.commentReleasedItems(ctx, "v1.1.0", "v1.2.0")
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should keep commenting past failures and across batches", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseCommentPRs = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		previousPause := releaseCommentBatchPause
+		releaseCommentBatchPause = 0
+		t.Cleanup(func() { releaseCommentBatchPause = previousPause })
+		many := make([]domain.PullRequest, releaseCommentBatchSize+2)
+		for i := range many {
+			many[i] = domain.PullRequest{Number: i + 1, Closes: []int{100}}
+		}
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("MergedPullRequests", mock.Anything, "v1.1.0", "v1.2.0").Return(many, nil).Once()
+		githubRepo.On("AddComment", mock.Anything, 1, released).Return(errors.New("locked")).Once()
+		githubRepo.On("AddComment", mock.Anything, mock.Anything, released).Return(nil)
+		orch := NewPublishOrchestrator(nil, nil, githubRepo, nil, nil)
+		orch.commentReleasedItems(ctx, "v1.1.0", "v1.2.0")
+		githubRepo.AssertNumberOfCalls(t, "AddComment", len(many))
+		githubRepo.AssertNotCalled(t, "AddComment", mock.Anything, 100, mock.Anything)
+	})
+	t.Run("Should not comment when disabled or without a previous release", func(t *testing.T) {
+		githubRepo := new(mockGithubExtendedRepository)
+		orch := NewPublishOrchestrator(nil, nil, githubRepo, nil, nil)
+		orch.commentReleasedItems(testReleaseContext(t), "v1.1.0", "v1.2.0")
+		cfg := testReleaseConfig()
+		cfg.ReleaseCommentPRs = true
+		orch.commentReleasedItems(testReleaseContextWithConfig(t, cfg), "", "v1.2.0")
+		githubRepo.AssertNotCalled(t, "MergedPullRequests", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestReleaseCommentTargets(t *testing.T) {
+	t.Run("Should list pull requests before the issues they close", func(t *testing.T) {
+		prs := []domain.PullRequest{{Number: 12, Closes: []int{9, 15}}, {Number: 15, Closes: []int{4, 9}}}
+		assert.Equal(t, []int{12, 15}, releaseCommentTargets(prs, false))
+		require.Equal(t, []int{12, 15, 4, 9}, releaseCommentTargets(prs, true))
+	})
+}
@@ -0,0 +1,54 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/compozy/releasepr/internal/config"
+)
+
+// defaultReleaseDateFormat matches the date git-cliff renders into release headings.
+const defaultReleaseDateFormat = "2006-01-02"
+
+// changelogHeadingDatePattern matches the ISO date git-cliff renders after the version in a release heading.
+var changelogHeadingDatePattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}`)
+
+// formatReleaseDate renders now in the configured release timezone and date format.
+func formatReleaseDate(ctx context.Context, now time.Time) (string, error) {
+	cfg := config.FromContext(ctx)
+	location, err := time.LoadLocation(cfg.ReleaseTimezone)
+	if err != nil {
+		return "", fmt.Errorf("invalid release_timezone: %w", err)
+	}
+	format := cfg.ReleaseDateFormat
+	if format == "" {
+		format = defaultReleaseDateFormat
+	}
+	return now.In(location).Format(format), nil
+}
+
+// stampReleaseDate replaces the date git-cliff rendered in the heading of the given version.
+// Headings of other versions and headings without a date are left untouched.
+func stampReleaseDate(document, version, date string) string {
+	targetVersion := normalizeReleaseVersion(version)
+	if targetVersion == "" || date == "" {
+		return document
+	}
+	lines := strings.Split(document, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "## ") || normalizeReleaseVersion(headingVersion(trimmed)) != targetVersion {
+			continue
+		}
+		location := changelogHeadingDatePattern.FindStringIndex(line)
+		if location == nil {
+			return document
+		}
+		lines[i] = line[:location[0]] + date + line[location[1]:]
+		return strings.Join(lines, "\n")
+	}
+	return document
+}
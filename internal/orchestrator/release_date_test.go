@@ -0,0 +1,81 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatReleaseDate(t *testing.T) {
+	t.Run("Should render the date in the configured timezone and format", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseTimezone = "Asia/Tokyo"
+		cfg.ReleaseDateFormat = "January 2, 2006"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		date, err := formatReleaseDate(ctx, time.Date(2026, time.October, 16, 22, 30, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, "October 17, 2026", date)
+	})
+	t.Run("Should default to UTC and ISO dates", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseTimezone = ""
+		cfg.ReleaseDateFormat = ""
+		ctx := testReleaseContextWithConfig(t, cfg)
+		now := time.Date(2026, time.October, 16, 22, 30, 0, 0, time.FixedZone("UTC-5", -5*60*60))
+		date, err := formatReleaseDate(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-10-17", date)
+	})
+}
+
+func TestStampReleaseDate(t *testing.T) {
+	t.Run("Should replace the date of the released version only", func(t *testing.T) {
+		document := "# Changelog\n\n## 1.2.0 - 2026-10-16\n\n- New\n\n## 1.1.0 - 2026-09-01\n\n- Old"
+		stamped := stampReleaseDate(document, "v1.2.0", "17.10.2026")
+		assert.Equal(t, "# Changelog\n\n## 1.2.0 - 17.10.2026\n\n- New\n\n## 1.1.0 - 2026-09-01\n\n- Old", stamped)
+	})
+	t.Run("Should leave headings without a date untouched", func(t *testing.T) {
+		document := "## v1.2.0\n\n- New"
+		assert.Equal(t, document, stampReleaseDate(document, "v1.2.0", "2026-10-17"))
+	})
+}
+
+func TestPRReleaseOrchestrator_generateChangelogReleaseDate(t *testing.T) {
+	t.Run("Should stamp the same release date into the changelog, CHANGELOG.md and release body", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseTimezone = "Asia/Tokyo"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		cliffSvc := new(mockCliffService)
+		scopedChangelog := "## 1.2.0 - 2026-10-16\n\n### Features\n- Current release"
+		fullChangelog := "# Changelog\n\n" + scopedChangelog + "\n\n## 1.1.0 - 2026-09-01\n\n- Previous release"
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.2.0", "release").Return(scopedChangelog, nil).Once()
+		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.2.0").Return(fullChangelog, nil).Once()
+		orch := NewPRReleaseOrchestrator(
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			fsRepo,
+			cliffSvc,
+			new(mockNpmService),
+		)
+		orch.now = func() time.Time {
+			return time.Date(2026, time.October, 16, 22, 30, 0, 0, time.UTC)
+		}
+		artifacts, err := orch.generateChangelog(ctx, "v1.2.0", "", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-10-17", artifacts.date)
+		assert.Contains(t, artifacts.changelog, "## 1.2.0 - 2026-10-17")
+		changelogData, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Contains(t, string(changelogData), "## 1.2.0 - 2026-10-17")
+		assert.Contains(t, string(changelogData), "## 1.1.0 - 2026-09-01")
+		releaseBodyData, err := afero.ReadFile(fsRepo, ReleaseBodyOutputFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(releaseBodyData), "## 1.2.0 - 2026-10-17")
+		cliffSvc.AssertExpectations(t)
+	})
+}
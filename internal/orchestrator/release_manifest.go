@@ -0,0 +1,186 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+const (
+	releaseManifestFileMode = 0o644
+	sha256ChecksumPrefix    = "sha256:"
+)
+
+// releaseManifestInput describes the release recorded in the manifest.
+type releaseManifestInput struct {
+	version *domain.Version
+	// previousTag is the release before version, empty for the first release or when unknown
+	previousTag string
+	prNumber    int
+	signOff     *domain.ManifestSignOff
+	published   bool
+}
+
+// writeReleaseManifest records the release at HEAD in the configured manifest file and returns its path.
+// An empty path means the manifest is disabled.
+func writeReleaseManifest(
+	ctx context.Context,
+	gitRepo repository.GitExtendedRepository,
+	fsRepo afero.Fs,
+	input releaseManifestInput,
+) (string, error) {
+	cfg := config.FromContext(ctx)
+	path := strings.TrimSpace(cfg.ReleaseManifestPath)
+	if path == "" {
+		return "", nil
+	}
+	commit, err := gitRepo.GetHeadCommit(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve release commit: %w", err)
+	}
+	metadata, err := readReleaseArtifactMetadata(fsRepo, cfg.ArtifactMetadataPath, input.version.String())
+	if err != nil {
+		return "", err
+	}
+	artifacts, err := metadata.ManifestArtifacts(fsRepo)
+	if err != nil {
+		return "", err
+	}
+	notes, err := manifestReleaseNotes(fsRepo)
+	if err != nil {
+		return "", err
+	}
+	compatibility, err := manifestCompatibility(fsRepo, input)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	manifest := &domain.ReleaseManifest{
+		SchemaVersion: domain.ReleaseManifestSchemaVersion,
+		Version:       input.version.Version.String(),
+		Tag:           input.version.String(),
+		Commit:        commit,
+		PRNumber:      input.prNumber,
+		Artifacts:     artifacts,
+		ReleaseNotes:  notes,
+		SignOff:       input.signOff,
+		Compatibility: compatibility,
+		CreatedAt:     now,
+	}
+	if input.published {
+		manifest.PublishedAt = &now
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode release manifest: %w", err)
+	}
+	if err := afero.WriteFile(fsRepo, path, append(data, '\n'), releaseManifestFileMode); err != nil {
+		return "", fmt.Errorf("failed to write release manifest %s: %w", path, err)
+	}
+	logger.FromContext(ctx).Named("orchestrator.manifest").Info("Wrote release manifest",
+		zap.String("path", path),
+		zap.Int("artifacts", len(artifacts)),
+		zap.String("compatibility", string(compatibility.Level)),
+	)
+	return path, nil
+}
+
+// ManifestArtifacts returns every artifact with a SHA-256 checksum, taken from the metadata when GoReleaser
+// recorded one and computed from the file on disk otherwise.
+func (m *artifactMetadata) ManifestArtifacts(fsRepo afero.Fs) ([]domain.ManifestArtifact, error) {
+	artifacts := make([]domain.ManifestArtifact, 0)
+	if m == nil {
+		return artifacts, nil
+	}
+	for _, item := range m.Artifacts {
+		checksum, err := artifactChecksum(fsRepo, item)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, domain.ManifestArtifact{
+			Name:   item.Name,
+			Path:   item.Path,
+			Type:   item.Type,
+			OS:     item.Goos,
+			Arch:   item.Goarch,
+			SHA256: checksum,
+		})
+	}
+	return artifacts, nil
+}
+
+func artifactChecksum(fsRepo afero.Fs, item artifactMetadataItem) (string, error) {
+	if checksum, ok := strings.CutPrefix(item.Extra.Checksum, sha256ChecksumPrefix); ok {
+		return checksum, nil
+	}
+	if item.Path == "" {
+		return "", nil
+	}
+	return fileChecksum(fsRepo, item.Path)
+}
+
+// manifestReleaseNotes identifies the generated release notes by their checksum, or returns nil when
+// the release has no release notes file.
+func manifestReleaseNotes(fsRepo afero.Fs) (*domain.ManifestReleaseNotes, error) {
+	checksum, err := fileChecksum(fsRepo, ReleaseNotesOutputFile)
+	if err != nil || checksum == "" {
+		return nil, err
+	}
+	return &domain.ManifestReleaseNotes{Name: ReleaseNotesOutputFile, SHA256: checksum}, nil
+}
+
+// manifestCompatibility assesses the compatibility of the release with the previous tag, weighing the
+// breaking changes RELEASE_BODY.md marks. A previous tag that is not an earlier semver release, such as
+// the release tag itself when publish moves it, is ignored.
+func manifestCompatibility(fsRepo afero.Fs, input releaseManifestInput) (*domain.ManifestCompatibility, error) {
+	notes, err := readOptionalFile(fsRepo, ReleaseBodyOutputFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", ReleaseBodyOutputFile, err)
+	}
+	var previous *domain.Version
+	if input.previousTag != "" {
+		if parsed, err := domain.NewVersion(input.previousTag); err == nil && parsed.LessThan(input.version.Version) {
+			previous = parsed
+		}
+	}
+	compat := domain.AssessCompatibility(previous, input.version, notes)
+	return &domain.ManifestCompatibility{
+		Level:           compat.Level,
+		PreviousVersion: compat.Previous,
+		Range:           compat.Range,
+		Reasons:         compat.Reasons,
+	}, nil
+}
+
+// fileChecksum returns the hex SHA-256 of the file at path, or an empty string when it does not exist.
+func fileChecksum(fsRepo afero.Fs, path string) (string, error) {
+	exists, err := afero.Exists(fsRepo, path)
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect artifact %s: %w", path, err)
+	}
+	if !exists {
+		return "", nil
+	}
+	file, err := fsRepo.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open artifact %s: %w", path, err)
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to checksum artifact %s: %w", path, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,259 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/telemetry"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// planDiffContext is the number of unchanged lines around each change of a planned file diff.
+const planDiffContext = 3
+
+// Plan describes the mutations a pr-release run with cfg would make without performing them: the
+// release branch, the files of the release commit with their diffs, the external commands it runs
+// and the pull request it opens. Files are written to an in-memory layer over the worktree, and
+// custom version updaters and release artifact commands are listed instead of run.
+func (o *PRReleaseOrchestrator) Plan(ctx context.Context, cfg PRReleaseConfig) (_ *domain.ReleasePlan, err error) {
+	ctx, span := telemetry.Start(ctx, "plan", attribute.Bool("release.force", cfg.ForceRelease))
+	defer func() { telemetry.End(span, err) }()
+	ctx, cancel := context.WithTimeout(ctx, DefaultWorkflowTimeout)
+	defer cancel()
+	if ctx, err = o.resolveReleaseChannel(ctx); err != nil {
+		return nil, err
+	}
+	skipped, err := skippedSteps(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	plan := &domain.ReleasePlan{
+		SchemaVersion: domain.ReleasePlanSchemaVersion,
+		Options:       domain.PlanOptions{Force: cfg.ForceRelease, SkipPR: cfg.SkipPR, SkipSteps: cfg.SkipSteps},
+	}
+	check, err := o.checkChanges(ctx)
+	if errors.Is(err, repository.ErrEmptyRepository) {
+		plan.Reason = emptyRepositoryStatus
+		return plan, nil
+	}
+	if err != nil {
+		return nil, stepFailed(stepNameCheckChanges, fmt.Errorf("failed to check changes: %w", err))
+	}
+	plan.HasChanges = check.HasChanges
+	plan.LatestTag = check.LatestTag
+	if !check.HasChanges && !cfg.ForceRelease {
+		plan.Reason = noChangesStatus(check.Reason)
+		return plan, nil
+	}
+	version, branchName, err := o.releaseVersion(ctx, check.LatestTag)
+	if err != nil {
+		return nil, err
+	}
+	baseCommit, err := o.gitRepo.GetHeadCommit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the base commit: %w", err)
+	}
+	plan.Version = version
+	plan.BaseCommit = baseCommit
+	plan.Branch = &domain.PlannedBranch{Name: branchName, Base: releaseBase(ctx)}
+	files, commands, artifacts, err := o.planFiles(ctx, version, branchName, check.LatestTag, skipped)
+	if err != nil {
+		return nil, err
+	}
+	plan.Files, plan.Commands = files, commands
+	if !cfg.SkipPR && !skipped.Has(domain.StepPullRequest) {
+		plan.PullRequest = &domain.PlannedPullRequest{
+			Title:  releasePRTitle(version),
+			Head:   branchName,
+			Base:   releaseBase(ctx),
+			Labels: releasePRLabels(version, check.LatestTag, artifacts.changelog+"\n"+artifacts.releaseNotes),
+		}
+	}
+	return plan, nil
+}
+
+// Apply runs pr-release with the options of an approved plan once the run is checked to make exactly
+// the approved mutations. A plan that no longer matches, because the base branch moved or the
+// configuration changed, is refused so the approval cannot be used for another release.
+func (o *PRReleaseOrchestrator) Apply(ctx context.Context, approved *domain.ReleasePlan, cfg PRReleaseConfig) error {
+	cfg.ForceRelease = approved.Options.Force
+	cfg.SkipPR = approved.Options.SkipPR
+	cfg.SkipSteps = approved.Options.SkipSteps
+	current, err := o.Plan(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to recompute the release plan: %w", err)
+	}
+	if differences := approved.Differences(current); len(differences) > 0 {
+		return fmt.Errorf("the approved release plan is outdated (%s changed); plan and approve the release again",
+			strings.Join(differences, ", "))
+	}
+	return o.Execute(ctx, cfg)
+}
+
+// planFiles runs the file steps of the release on a copy-on-write layer over the worktree and
+// returns the file changes of the release commit, the external commands the steps run and the
+// rendered changelog and release notes.
+func (o *PRReleaseOrchestrator) planFiles(
+	ctx context.Context,
+	version, branchName, latestTag string,
+	skipped domain.SkippedSteps,
+) ([]domain.PlannedFileChange, []domain.PlannedCommand, *releaseArtifacts, error) {
+	var commands []domain.PlannedCommand
+	planner, overlay := o.planner(func(command domain.PlannedCommand) {
+		commands = append(commands, command)
+	})
+	changes := NewChangeSet()
+	if !skipped.Has(domain.StepPackageVersions) {
+		packageFiles, err := planner.updatePackageVersions(ctx, version, latestTag)
+		if err != nil {
+			err = fmt.Errorf("failed to update package versions: %w", err)
+			return nil, nil, nil, stepFailed(stepNamePackageVersions, err)
+		}
+		changes.Track(packageFiles...)
+	}
+	artifacts, err := planner.generateChangelog(ctx, version, latestTag, nil, skipped)
+	if err != nil {
+		return nil, nil, nil, stepFailed(stepNameChangelog, fmt.Errorf("failed to generate changelog: %w", err))
+	}
+	changes.Track(artifacts.files...)
+	artifactResult, err := planner.releaseArtifactCommands(ctx, version, branchName, latestTag, skipped)
+	if err != nil {
+		return nil, nil, nil, stepFailed(stepNameReleaseArtifacts, err)
+	}
+	changes.Track(artifactResult.files()...)
+	files, err := diffPlannedFiles(o.fsRepo, overlay, changes.Paths())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	moved, err := o.planMovedFiles(ctx, version, skipped)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return append(files, moved...), commands, artifacts, nil
+}
+
+// planner returns a copy of the orchestrator that writes to a copy-on-write layer over the worktree
+// and passes the version updaters and release artifact commands it would run to record instead.
+func (o *PRReleaseOrchestrator) planner(
+	record func(domain.PlannedCommand),
+) (*PRReleaseOrchestrator, afero.Fs) {
+	overlay := afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(o.fsRepo), afero.NewMemMapFs())
+	planner := *o
+	planner.fsRepo = overlay
+	planner.updaterRunner = func(_ context.Context, updater, _, _ string) ([]byte, error) {
+		record(domain.PlannedCommand{
+			Step:    domain.StepPackageVersions,
+			Name:    updater,
+			Command: updater,
+		})
+		return nil, nil
+	}
+	planner.artifactRunner = func(
+		_ context.Context,
+		command *config.ReleaseArtifactCommand,
+		_ map[string]string,
+	) error {
+		record(domain.PlannedCommand{
+			Step:    domain.StepReleaseArtifacts,
+			Name:    strings.TrimSpace(command.Name),
+			Command: strings.Join(append([]string{command.Command}, command.Args...), " "),
+		})
+		return nil
+	}
+	return &planner, overlay
+}
+
+// planMovedFiles lists the release notes the run archives and the change files it consumes.
+func (o *PRReleaseOrchestrator) planMovedFiles(
+	ctx context.Context,
+	version string,
+	skipped domain.SkippedSteps,
+) ([]domain.PlannedFileChange, error) {
+	var files []domain.PlannedFileChange
+	if !skipped.Has(domain.StepArchiveNotes) {
+		moves, err := (&usecase.ArchiveReleaseNotesUseCase{FSRepo: o.fsRepo}).Plan(version)
+		if err != nil {
+			return nil, stepFailed(stepNameArchiveNotes, fmt.Errorf("failed to plan archived release notes: %w", err))
+		}
+		for _, move := range moves {
+			files = append(files, domain.PlannedFileChange{
+				Path:   move.To,
+				Action: domain.FileActionRename,
+				From:   move.From,
+			})
+		}
+	}
+	consumes, err := o.consumesChangeFiles(ctx)
+	if err != nil {
+		return nil, stepFailed(stepNameConsumeChangeFiles, err)
+	}
+	if !consumes {
+		return files, nil
+	}
+	consumed, err := o.consumableChangeFiles(ctx)
+	if err != nil {
+		return nil, stepFailed(stepNameConsumeChangeFiles, err)
+	}
+	for _, file := range consumed {
+		files = append(files, domain.PlannedFileChange{Path: file.SourcePath, Action: domain.FileActionDelete})
+	}
+	return files, nil
+}
+
+// diffPlannedFiles compares paths between the worktree and the planned layer, returning a unified
+// diff of every file that was created, modified or deleted.
+func diffPlannedFiles(worktree, planned afero.Fs, paths []string) ([]domain.PlannedFileChange, error) {
+	var files []domain.PlannedFileChange
+	for _, path := range paths {
+		original, existed, err := readPlannedFile(worktree, path)
+		if err != nil {
+			return nil, err
+		}
+		updated, exists, err := readPlannedFile(planned, path)
+		if err != nil {
+			return nil, err
+		}
+		if existed == exists && original == updated {
+			continue
+		}
+		change := domain.PlannedFileChange{Path: path, Action: domain.FileActionModify}
+		switch {
+		case !existed:
+			change.Action = domain.FileActionCreate
+		case !exists:
+			change.Action = domain.FileActionDelete
+		}
+		change.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(original),
+			B:        difflib.SplitLines(updated),
+			FromFile: "a/" + path,
+			ToFile:   "b/" + path,
+			Context:  planDiffContext,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff %s: %w", path, err)
+		}
+		files = append(files, change)
+	}
+	return files, nil
+}
+
+func readPlannedFile(fsRepo afero.Fs, path string) (string, bool, error) {
+	data, err := afero.ReadFile(fsRepo, path)
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return string(data), true, nil
+}
@@ -0,0 +1,123 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const planPackageJSON = "{\n  \"name\": \"app\",\n  \"version\": \"1.2.2\"\n}\n"
+
+// newPlanTest returns an orchestrator over a worktree with a changelog and a package.json, whose
+// mocks expect the checks of a v1.2.3 release from v1.2.2.
+func newPlanTest(t *testing.T) (afero.Fs, *mockGitExtendedRepository, *PRReleaseOrchestrator) {
+	t.Helper()
+	fsRepo := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fsRepo, "CHANGELOG.md", []byte("# Changelog\n"), 0644))
+	require.NoError(t, afero.WriteFile(fsRepo, "package.json", []byte(planPackageJSON), 0644))
+	gitRepo := new(mockGitExtendedRepository)
+	githubRepo := new(mockGithubExtendedRepository)
+	cliffSvc := new(mockCliffService)
+	gitRepo.On("LatestTag", mock.Anything).Return("v1.2.2", nil)
+	gitRepo.On("CommitsSinceTag", mock.Anything, "v1.2.2").Return(1, nil)
+	gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil)
+	nextVersion, err := domain.NewVersion("v1.2.3")
+	require.NoError(t, err)
+	cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.2.2").Return(nextVersion, nil)
+	changelog := "## v1.2.3\n\n### Features\n- Plan releases"
+	cliffSvc.On("GenerateChangelog", mock.Anything, "v1.2.3", "release").Return(changelog, nil)
+	cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.2.3").Return("# Changelog\n\n"+changelog, nil)
+	orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, fsRepo, cliffSvc, new(mockNpmService))
+	return fsRepo, gitRepo, orch
+}
+
+func TestPRReleaseOrchestrator_Plan(t *testing.T) {
+	t.Run("Should describe the release without changing the worktree", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseArtifacts = []config.ReleaseArtifactCommand{
+			{Name: "site", Command: "bun", Args: []string{"run", "site"}},
+		}
+		fsRepo, gitRepo, orch := newPlanTest(t)
+		orch.artifactRunner = func(context.Context, *config.ReleaseArtifactCommand, map[string]string) error {
+			t.Fatal("release artifact commands must not run while planning")
+			return nil
+		}
+		plan, err := orch.Plan(testReleaseContextWithConfig(t, cfg), PRReleaseConfig{})
+		require.NoError(t, err)
+		assert.True(t, plan.HasChanges)
+		assert.Equal(t, "v1.2.3", plan.Version)
+		assert.Equal(t, "abc123", plan.BaseCommit)
+		assert.Equal(t, &domain.PlannedBranch{Name: "release/v1.2.3", Base: "main"}, plan.Branch)
+		actions := map[string]string{}
+		for _, file := range plan.Files {
+			actions[file.Path] = file.Action
+		}
+		assert.Equal(t, map[string]string{
+			"package.json":         domain.FileActionModify,
+			"CHANGELOG.md":         domain.FileActionModify,
+			ReleaseBodyOutputFile:  domain.FileActionCreate,
+			ReleaseNotesOutputFile: domain.FileActionCreate,
+		}, actions)
+		assert.Contains(t, plan.Files[0].Diff, "-  \"version\": \"1.2.2\"\n+  \"version\": \"1.2.3\"")
+		assert.Equal(t, []domain.PlannedCommand{
+			{Step: domain.StepReleaseArtifacts, Name: "site", Command: "bun run site"},
+		}, plan.Commands)
+		require.NotNil(t, plan.PullRequest)
+		assert.Equal(t, "release: Release v1.2.3", plan.PullRequest.Title)
+		assert.Equal(t, []string{"release-pending", "automated", "release:patch", "compat:safe"}, plan.PullRequest.Labels)
+		data, err := afero.ReadFile(fsRepo, "package.json")
+		require.NoError(t, err)
+		assert.Equal(t, planPackageJSON, string(data))
+		exists, err := afero.Exists(fsRepo, ReleaseBodyOutputFile)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		gitRepo.AssertNotCalled(t, "CreateBranch", mock.Anything, mock.Anything)
+	})
+	t.Run("Should label the planned PR breaking when the rendered notes mark breaking changes", func(t *testing.T) {
+		_, _, orch := newPlanTest(t)
+		cliffSvc := new(mockCliffService)
+		nextVersion, err := domain.NewVersion("v1.2.3")
+		require.NoError(t, err)
+		cliffSvc.On("CalculateNextVersion", mock.Anything, "v1.2.2").Return(nextVersion, nil)
+		changelog := "## v1.2.3\n\n### Features\n- **BREAKING:** Drop the legacy flag"
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.2.3", "release").Return(changelog, nil)
+		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.2.3").Return("# Changelog\n\n"+changelog, nil)
+		orch.cliffSvc = cliffSvc
+		plan, err := orch.Plan(testReleaseContext(t), PRReleaseConfig{})
+		require.NoError(t, err)
+		require.NotNil(t, plan.PullRequest)
+		assert.Equal(t, []string{"release-pending", "automated", "release:patch", "compat:breaking"},
+			plan.PullRequest.Labels)
+	})
+	t.Run("Should report a run without changes", func(t *testing.T) {
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("LatestTag", mock.Anything).Return("v1.2.2", nil)
+		gitRepo.On("CommitsSinceTag", mock.Anything, "v1.2.2").Return(0, nil)
+		orch := NewPRReleaseOrchestrator(gitRepo, new(mockGithubExtendedRepository), afero.NewMemMapFs(),
+			new(mockCliffService), new(mockNpmService))
+		plan, err := orch.Plan(testReleaseContext(t), PRReleaseConfig{})
+		require.NoError(t, err)
+		assert.False(t, plan.HasChanges)
+		assert.Equal(t, "No changes detected since last release", plan.Reason)
+		assert.Nil(t, plan.Branch)
+	})
+}
+
+func TestPRReleaseOrchestrator_Apply(t *testing.T) {
+	t.Run("Should refuse a plan approved for another base commit", func(t *testing.T) {
+		_, gitRepo, orch := newPlanTest(t)
+		ctx := testReleaseContext(t)
+		approved, err := orch.Plan(ctx, PRReleaseConfig{})
+		require.NoError(t, err)
+		approved.BaseCommit = "def456"
+		err = orch.Apply(ctx, approved, PRReleaseConfig{})
+		require.ErrorContains(t, err, "approved release plan is outdated (base_commit changed)")
+		gitRepo.AssertNotCalled(t, "CreateBranch", mock.Anything, mock.Anything)
+	})
+}
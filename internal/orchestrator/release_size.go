@@ -0,0 +1,70 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"go.uber.org/zap"
+)
+
+// Values for the release_pr_size_action setting.
+const (
+	ReleaseSizeActionWarn = "warn"
+	ReleaseSizeActionFail = "fail"
+)
+
+// releaseSizeLargestFiles is how many of the largest files an oversized release lists.
+const releaseSizeLargestFiles = 5
+
+// excludeReleaseFiles keeps files matching release_pr_exclude out of the release commit. They stay
+// modified in the worktree.
+func (o *PRReleaseOrchestrator) excludeReleaseFiles(ctx context.Context, changes *ChangeSet) error {
+	patterns, err := domain.ParsePathPatterns(config.FromContext(ctx).ReleasePRExclude)
+	if err != nil {
+		return fmt.Errorf("invalid release_pr_exclude: %w", err)
+	}
+	if excluded := changes.Untrack(patterns.Match); len(excluded) > 0 {
+		o.logger(ctx).Info("Excluded files from the release commit", zap.Strings("files", excluded))
+	}
+	return nil
+}
+
+// checkReleaseSize compares the release commit with release_pr_max_files and release_pr_max_lines,
+// so generated files do not turn the release PR into an unreviewable diff. Depending on
+// release_pr_size_action, an oversized commit fails the run or only logs a warning.
+func (o *PRReleaseOrchestrator) checkReleaseSize(ctx context.Context) error {
+	cfg := config.FromContext(ctx)
+	limits := domain.ReleaseSizeLimits{MaxFiles: cfg.ReleasePRMaxFiles, MaxLines: cfg.ReleasePRMaxLines}
+	if limits.MaxFiles == 0 && limits.MaxLines == 0 {
+		return nil
+	}
+	stats, err := o.gitRepo.HeadCommitDiff(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to measure the release commit: %w", err)
+	}
+	exceeded := limits.Exceeded(stats)
+	if exceeded == "" {
+		return nil
+	}
+	largest := domain.LargestFiles(stats, releaseSizeLargestFiles)
+	paths := make([]string, 0, len(largest))
+	for _, stat := range largest {
+		paths = append(paths, fmt.Sprintf("%s (%d lines)", stat.Path, stat.Lines()))
+	}
+	message := fmt.Sprintf("release PR is too large: %s; largest files: %s. "+
+		"Add generated paths to release_pr_exclude to keep them out of the release commit",
+		exceeded, strings.Join(paths, ", "))
+	if strings.EqualFold(strings.TrimSpace(cfg.ReleasePRSizeAction), ReleaseSizeActionFail) {
+		return fmt.Errorf("%s", message)
+	}
+	o.logger(ctx).Warn(message)
+	if os.Getenv(envGithubActions) == githubActionsTrue {
+		annotation := domain.Annotation{Level: domain.AnnotationWarning, Title: "Large release PR", Message: message}
+		_, _ = fmt.Fprintln(o.annotations, annotation.String())
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package orchestrator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRReleaseOrchestrator_checkReleaseSize(t *testing.T) {
+	stats := []domain.FileDiffStat{
+		{Path: "CHANGELOG.md", Insertions: 12},
+		{Path: "dist/bundle.js", Insertions: 20000},
+	}
+	t.Run("Should warn about oversized release commits by default", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleasePRMaxLines = 5000
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		var out bytes.Buffer
+		orch.annotations = &out
+		t.Setenv("GITHUB_ACTIONS", "true")
+		orch.gitRepo.(*mockGitExtendedRepository).On("HeadCommitDiff", mock.Anything).Return(stats, nil)
+		require.NoError(t, orch.checkReleaseSize(ctx))
+		assert.Contains(t, out.String(), "::warning title=Large release PR::release PR is too large")
+		assert.Contains(t, out.String(), "dist/bundle.js (20000 lines)")
+	})
+	t.Run("Should fail oversized release commits when configured", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleasePRMaxFiles = 1
+		cfg.ReleasePRSizeAction = ReleaseSizeActionFail
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		orch.gitRepo.(*mockGitExtendedRepository).On("HeadCommitDiff", mock.Anything).Return(stats, nil)
+		err := orch.checkReleaseSize(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "2 files changed (limit 1)")
+		assert.Contains(t, err.Error(), "release_pr_exclude")
+	})
+	t.Run("Should skip the diff without limits", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, orch.checkReleaseSize(ctx))
+		orch.gitRepo.(*mockGitExtendedRepository).AssertNotCalled(t, "HeadCommitDiff", mock.Anything)
+	})
+}
+
+func TestPRReleaseOrchestrator_excludeReleaseFiles(t *testing.T) {
+	t.Run("Should untrack files matching release_pr_exclude", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleasePRExclude = []string{"dist/"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		changes := NewChangeSet()
+		changes.Track("CHANGELOG.md", "dist/bundle.js", "package.json")
+		require.NoError(t, orch.excludeReleaseFiles(ctx, changes))
+		assert.Equal(t, []string{"CHANGELOG.md", "package.json"}, changes.Paths())
+	})
+}
@@ -0,0 +1,36 @@
+package orchestrator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"go.uber.org/zap"
+)
+
+// releaseStatsFooter renders the commit, contributor and diff statistics since latestTag in the
+// release locale when release_stats is enabled. Statistics are informational: failures are logged
+// and yield no footer.
+func (o *PRReleaseOrchestrator) releaseStatsFooter(ctx context.Context, latestTag string, locale domain.Locale) string {
+	if !config.FromContext(ctx).ReleaseStats {
+		return ""
+	}
+	stats, err := o.gitRepo.ReleaseStats(ctx, latestTag)
+	if err != nil {
+		o.logger(ctx).Warn("Skipping release statistics", zap.String("since", latestTag), zap.Error(err))
+		return ""
+	}
+	return stats.LocalizedMarkdown(locale)
+}
+
+// appendReleaseStats adds the statistics footer after the collected release notes.
+func appendReleaseStats(releaseNotes, footer string) string {
+	if footer == "" {
+		return releaseNotes
+	}
+	if strings.TrimSpace(releaseNotes) == "" {
+		return footer
+	}
+	return strings.TrimSpace(releaseNotes) + "\n\n" + footer
+}
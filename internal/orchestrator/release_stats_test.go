@@ -0,0 +1,70 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRReleaseOrchestrator_releaseStats(t *testing.T) {
+	changelog := "## v1.1.0\n\n### Features\n- Current release"
+	setup := func(t *testing.T, gitRepo *mockGitExtendedRepository) (afero.Fs, *PRReleaseOrchestrator) {
+		t.Helper()
+		fsRepo := afero.NewMemMapFs()
+		cliffSvc := new(mockCliffService)
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.1.0", "release").Return(changelog, nil).Once()
+		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.1.0").Return("# Changelog\n\n"+changelog, nil).Once()
+		return fsRepo, NewPRReleaseOrchestrator(gitRepo, new(mockGithubExtendedRepository), fsRepo, cliffSvc,
+			new(mockNpmService))
+	}
+	t.Run("Should append the statistics footer to the release notes", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseStats = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		stats := domain.ReleaseStats{Commits: 3, Contributors: 2, FilesChanged: 5, Insertions: 40, Deletions: 7}
+		gitRepo.On("ReleaseStats", mock.Anything, "v1.0.0").Return(stats, nil).Once()
+		fsRepo, orch := setup(t, gitRepo)
+		artifacts, err := orch.generateChangelog(ctx, "v1.1.0", "v1.0.0", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, stats.RenderMarkdown(), artifacts.releaseNotes)
+		releaseBody, err := afero.ReadFile(fsRepo, ReleaseBodyOutputFile)
+		require.NoError(t, err)
+		assert.Equal(t, changelog+"\n\n"+stats.RenderMarkdown(), string(releaseBody))
+		gitRepo.AssertExpectations(t)
+	})
+	t.Run("Should not compute statistics unless enabled", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		_, orch := setup(t, gitRepo)
+		artifacts, err := orch.generateChangelog(ctx, "v1.1.0", "v1.0.0", nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, artifacts.releaseNotes)
+		gitRepo.AssertNotCalled(t, "ReleaseStats", mock.Anything, mock.Anything)
+	})
+	t.Run("Should release without statistics when they cannot be computed", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseStats = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("ReleaseStats", mock.Anything, "").Return(domain.ReleaseStats{}, assert.AnError).Once()
+		_, orch := setup(t, gitRepo)
+		artifacts, err := orch.generateChangelog(ctx, "v1.1.0", "", nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, artifacts.releaseNotes)
+		gitRepo.AssertExpectations(t)
+	})
+}
+
+func TestAppendReleaseStats(t *testing.T) {
+	t.Run("Should place the footer after the release notes", func(t *testing.T) {
+		assert.Equal(t, "### Release Notes\n\nBody\n\n### Release Statistics",
+			appendReleaseStats("### Release Notes\n\nBody\n", "### Release Statistics"))
+		assert.Equal(t, "notes", appendReleaseStats("notes", ""))
+		assert.Equal(t, "footer", appendReleaseStats("", "footer"))
+	})
+}
@@ -0,0 +1,127 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+// Config keys of the custom release templates, used in error messages.
+const (
+	templateKeyPRBody       = "pr_body_template"
+	templateKeyReleaseNotes = "release_notes_template"
+)
+
+// releaseLinks computes the template variables of a release. The GitHub lookups behind the PR URL,
+// contributors and milestone only run when a custom template can use them, and their failures are
+// logged rather than blocking the release.
+func (o *PRReleaseOrchestrator) releaseLinks(ctx context.Context, version, latestTag string) domain.ReleaseLinks {
+	cfg := config.FromContext(ctx)
+	links := domain.NewReleaseLinks(cfg.GithubOwner, cfg.GithubRepo, latestTag, version)
+	if !cfg.CustomTemplates() {
+		return links
+	}
+	log := o.logger(ctx)
+	prNumber, err := o.githubRepo.FindOpenPR(ctx, fmt.Sprintf("release/%s", version), releaseBase(ctx))
+	if err != nil {
+		log.Warn("Skipping PR URL template variable", zap.Error(err))
+	} else if prNumber > 0 {
+		links.PRURL = domain.PullRequestURL(cfg.GithubOwner, cfg.GithubRepo, prNumber)
+	}
+	if latestTag != "" {
+		logins, err := o.githubRepo.ReleaseContributors(ctx, latestTag, releaseBase(ctx))
+		if err != nil {
+			log.Warn("Skipping contributors template variable", zap.Error(err))
+		}
+		links.Contributors = domain.ContributorHandles(logins)
+	}
+	links.MilestoneURL = o.milestoneURL(ctx, version)
+	return links
+}
+
+// mergedPullRequests lists the pull requests merged since previousTag for the release PR body when
+// pr_body_merged_prs is enabled. A failed lookup is logged and leaves the table out.
+func (o *PRReleaseOrchestrator) mergedPullRequests(ctx context.Context, previousTag string) []domain.PullRequest {
+	if !config.FromContext(ctx).PRBodyMergedPRs || previousTag == "" {
+		return nil
+	}
+	prs, err := o.githubRepo.MergedPullRequests(ctx, previousTag, releaseBase(ctx))
+	if err != nil {
+		o.logger(ctx).Warn("Skipping merged pull requests table", zap.Error(err))
+		return nil
+	}
+	return prs
+}
+
+// milestoneURL links the milestone titled after the version, with or without the v prefix.
+func (o *PRReleaseOrchestrator) milestoneURL(ctx context.Context, version string) string {
+	cfg := config.FromContext(ctx)
+	titles := []string{version}
+	if trimmed := strings.TrimPrefix(version, "v"); trimmed != version {
+		titles = append(titles, trimmed)
+	}
+	for _, title := range titles {
+		number, err := o.githubRepo.FindMilestone(ctx, title)
+		if err != nil {
+			o.logger(ctx).Warn("Skipping milestone template variable", zap.Error(err))
+			return ""
+		}
+		if number > 0 {
+			return domain.MilestoneURL(cfg.GithubOwner, cfg.GithubRepo, number)
+		}
+	}
+	return ""
+}
+
+// readTemplate reads a configured template file; an empty path selects the built-in template.
+func (o *PRReleaseOrchestrator) readTemplate(key, path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return "", nil
+	}
+	data, err := afero.ReadFile(o.fsRepo, path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s %s: %w", key, path, err)
+	}
+	return string(data), nil
+}
+
+// releaseBodyDocument renders RELEASE_BODY.md from release_notes_template when one is configured,
+// otherwise from the release notes template of the override directory or the built-in one, which
+// joins the changelog and the release notes.
+func (o *PRReleaseOrchestrator) releaseBodyDocument(
+	ctx context.Context,
+	version string,
+	artifacts *releaseArtifacts,
+) (string, error) {
+	text, err := o.readTemplate(templateKeyReleaseNotes, config.FromContext(ctx).ReleaseNotesTemplate)
+	if err != nil {
+		return "", err
+	}
+	if text == "" {
+		if text, err = usecase.LoadTemplate(o.fsRepo, usecase.TemplateReleaseNotes); err != nil {
+			return "", err
+		}
+	}
+	ver, err := domain.NewVersion(version)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse version: %w", err)
+	}
+	builds := o.previewArtifacts(ctx, ver.String())
+	uc := &usecase.RenderReleaseBodyUseCase{Template: text}
+	return uc.Execute(ctx, &domain.Release{
+		Version:      ver,
+		Changelog:    artifacts.changelog,
+		ReleaseNotes: artifacts.releaseNotes,
+		Date:         artifacts.date,
+		Artifacts:    builds,
+		ClosedIssues: artifacts.closedIssues,
+		Links:        artifacts.links,
+	})
+}
@@ -0,0 +1,144 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRReleaseOrchestrator_mergedPullRequests(t *testing.T) {
+	t.Run("Should skip the lookup unless pr_body_merged_prs is enabled", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch.githubRepo = githubRepo
+		assert.Empty(t, orch.mergedPullRequests(ctx, "v1.1.0"))
+		githubRepo.AssertNotCalled(t, "MergedPullRequests", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should list the pull requests merged since the previous tag", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.PRBodyMergedPRs = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch.githubRepo = githubRepo
+		prs := []domain.PullRequest{{Number: 12, Title: "feat: add doctor", Author: "alice"}}
+		githubRepo.On("MergedPullRequests", mock.Anything, "v1.1.0", "main").Return(prs, nil).Once()
+		assert.Equal(t, prs, orch.mergedPullRequests(ctx, "v1.1.0"))
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should leave the table out when the lookup fails", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.PRBodyMergedPRs = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch.githubRepo = githubRepo
+		githubRepo.On("MergedPullRequests", mock.Anything, "v1.1.0", "main").Return(nil, errors.New("rate limited"))
+		assert.Empty(t, orch.mergedPullRequests(ctx, "v1.1.0"))
+	})
+}
+
+func TestPRReleaseOrchestrator_releaseLinks(t *testing.T) {
+	t.Run("Should only derive tag links without custom templates", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+
+		links := orch.releaseLinks(ctx, "v1.2.0", "v1.1.0")
+
+		assert.Equal(t, "https://github.com/compozy/releasepr/compare/v1.1.0...v1.2.0", links.CompareURL)
+		assert.Empty(t, links.PRURL)
+		assert.Empty(t, links.Contributors)
+	})
+
+	t.Run("Should look up the PR, contributors and milestone for custom templates", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.PRBodyTemplate = ".github/release-pr.md.tmpl"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch.githubRepo = githubRepo
+		githubRepo.On("FindOpenPR", mock.Anything, "release/v1.2.0", "main").Return(42, nil)
+		githubRepo.On("ReleaseContributors", mock.Anything, "v1.1.0", "main").
+			Return([]string{"alice", "renovate[bot]"}, nil)
+		githubRepo.On("FindMilestone", mock.Anything, "v1.2.0").Return(0, nil)
+		githubRepo.On("FindMilestone", mock.Anything, "1.2.0").Return(4, nil)
+
+		links := orch.releaseLinks(ctx, "v1.2.0", "v1.1.0")
+
+		assert.Equal(t, "v1.1.0", links.PreviousTag)
+		assert.Equal(t, "https://github.com/compozy/releasepr/pull/42", links.PRURL)
+		assert.Equal(t, []string{"@alice"}, links.Contributors)
+		assert.Equal(t, "https://github.com/compozy/releasepr/milestone/4", links.MilestoneURL)
+		githubRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should leave variables empty when GitHub lookups fail", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseNotesTemplate = ".github/release-notes.md.tmpl"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch.githubRepo = githubRepo
+		lookupErr := errors.New("rate limited")
+		githubRepo.On("FindOpenPR", mock.Anything, mock.Anything, mock.Anything).Return(0, lookupErr)
+		githubRepo.On("FindMilestone", mock.Anything, mock.Anything).Return(0, lookupErr)
+
+		links := orch.releaseLinks(ctx, "v0.1.0", "")
+
+		assert.Equal(t, "https://github.com/compozy/releasepr/commits/v0.1.0", links.CompareURL)
+		assert.Empty(t, links.PRURL)
+		assert.Empty(t, links.MilestoneURL)
+		githubRepo.AssertNotCalled(t, "ReleaseContributors", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPRReleaseOrchestrator_releaseBodyDocument(t *testing.T) {
+	t.Run("Should render RELEASE_BODY.md from the release notes template", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseNotesTemplate = ".github/release-notes.md.tmpl"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		template := "{{.Changelog}}\n\n**Full Changelog**: {{.CompareURL}}\n"
+		require.NoError(t, afero.WriteFile(fsRepo, ".github/release-notes.md.tmpl", []byte(template), 0644))
+		artifacts := &releaseArtifacts{changelog: "### Features\n- New feature"}
+		artifacts.links.CompareURL = "https://github.com/compozy/releasepr/compare/v1.1.0...v1.2.0"
+
+		body, err := orch.releaseBodyDocument(ctx, "v1.2.0", artifacts)
+
+		require.NoError(t, err)
+		assert.Equal(t, "### Features\n- New feature\n\n"+
+			"**Full Changelog**: https://github.com/compozy/releasepr/compare/v1.1.0...v1.2.0", body)
+	})
+
+	t.Run("Should render RELEASE_BODY.md from the override directory", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		template := "{{.Changelog}}\n\nReleased on {{.Date}}\n"
+		overridePath := usecase.TemplateOverrideDir + "/" + usecase.TemplateReleaseNotes
+		require.NoError(t, afero.WriteFile(fsRepo, overridePath, []byte(template), 0644))
+		artifacts := &releaseArtifacts{changelog: "### Features\n- New feature", date: "2026-10-16"}
+
+		body, err := orch.releaseBodyDocument(ctx, "v1.2.0", artifacts)
+
+		require.NoError(t, err)
+		assert.Equal(t, "### Features\n- New feature\n\nReleased on 2026-10-16", body)
+	})
+
+	t.Run("Should fail when the template file is missing", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.ReleaseNotesTemplate = ".github/missing.tmpl"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+
+		_, err := orch.releaseBodyDocument(ctx, "v1.2.0", &releaseArtifacts{})
+
+		assert.ErrorContains(t, err, "failed to read release_notes_template .github/missing.tmpl")
+	})
+}
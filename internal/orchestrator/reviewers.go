@@ -0,0 +1,67 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+	"go.uber.org/zap"
+)
+
+// requestReviews asks the owners of the changed files, or the fallback reviewers, to review the release PR.
+// Review requests are a convenience: failures are logged and never fail the release.
+func (o *PRReleaseOrchestrator) requestReviews(ctx context.Context, branchName string, paths []string) {
+	log := o.logger(ctx).With(zap.String("branch", branchName))
+	reviewers, err := o.releaseReviewers(ctx, paths)
+	if err != nil {
+		log.Warn("Skipping review requests", zap.Error(err))
+		return
+	}
+	if reviewers.IsEmpty() {
+		log.Debug("No reviewers to request")
+		return
+	}
+	if err := o.githubRepo.RequestReviewers(ctx, branchName, releaseBase(ctx), reviewers); err != nil {
+		log.Warn("Failed to request reviewers",
+			zap.Strings("users", reviewers.Users),
+			zap.Strings("teams", reviewers.Teams),
+			zap.Error(err),
+		)
+	}
+}
+
+// releaseReviewers resolves CODEOWNERS for paths when enabled and falls back to fallback_reviewers
+// when no owner is found.
+func (o *PRReleaseOrchestrator) releaseReviewers(ctx context.Context, paths []string) (domain.Reviewers, error) {
+	cfg := config.FromContext(ctx)
+	var reviewers domain.Reviewers
+	if cfg.RequestCodeOwnerReviews {
+		codeOwners, err := readCodeOwners(o.fsRepo)
+		if err != nil {
+			return domain.Reviewers{}, err
+		}
+		reviewers = codeOwners.ReviewersFor(paths)
+	}
+	if reviewers.IsEmpty() {
+		for _, reviewer := range cfg.FallbackReviewers {
+			reviewers.Add(reviewer)
+		}
+	}
+	return reviewers, nil
+}
+
+// readCodeOwners parses the first CODEOWNERS file found in the locations GitHub supports.
+func readCodeOwners(fsRepo repository.FileSystemRepository) (domain.CodeOwners, error) {
+	for _, path := range domain.CodeOwnersLocations {
+		content, err := readOptionalFile(fsRepo, path)
+		if err != nil {
+			return domain.CodeOwners{}, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if content != "" {
+			return domain.ParseCodeOwners(content), nil
+		}
+	}
+	return domain.CodeOwners{}, nil
+}
@@ -0,0 +1,75 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReviewersOrchestrator(fsRepo afero.Fs, githubRepo *mockGithubExtendedRepository) *PRReleaseOrchestrator {
+	return NewPRReleaseOrchestrator(
+		new(mockGitExtendedRepository),
+		githubRepo,
+		fsRepo,
+		new(mockCliffService),
+		new(mockNpmService),
+	)
+}
+
+func TestPRReleaseOrchestrator_requestReviews(t *testing.T) {
+	t.Run("Should request reviews from the owners of the changed files", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.RequestCodeOwnerReviews = true
+		cfg.FallbackReviewers = []string{"release-captain"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, ".github/CODEOWNERS", []byte(`* @compozy/maintainers
+/CHANGELOG.md @compozy/docs
+/packages/ @alice
+`), 0o644))
+		githubRepo := new(mockGithubExtendedRepository)
+		expected := domain.Reviewers{Users: []string{"alice"}, Teams: []string{"docs", "maintainers"}}
+		githubRepo.On("RequestReviewers", mock.Anything, "release/v1.2.0", "main", expected).Return(nil).Once()
+		orch := newTestReviewersOrchestrator(fsRepo, githubRepo)
+		orch.requestReviews(ctx, "release/v1.2.0", []string{"CHANGELOG.md", "packages/ui/package.json", "go.mod"})
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should fall back to the configured reviewers when no owner matches", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.RequestCodeOwnerReviews = true
+		cfg.FallbackReviewers = []string{"@release-captain", "compozy/release"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		expected := domain.Reviewers{Users: []string{"release-captain"}, Teams: []string{"release"}}
+		githubRepo.On("RequestReviewers", mock.Anything, "release/v1.2.0", "main", expected).Return(nil).Once()
+		orch := newTestReviewersOrchestrator(afero.NewMemMapFs(), githubRepo)
+		orch.requestReviews(ctx, "release/v1.2.0", []string{"CHANGELOG.md"})
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should not request reviews when nothing is configured", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, "CODEOWNERS", []byte("* @compozy/maintainers\n"), 0o644))
+		githubRepo := new(mockGithubExtendedRepository)
+		orch := newTestReviewersOrchestrator(fsRepo, githubRepo)
+		orch.requestReviews(ctx, "release/v1.2.0", []string{"CHANGELOG.md"})
+		githubRepo.AssertNotCalled(t, "RequestReviewers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should not fail the release when the review request fails", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.FallbackReviewers = []string{"release-captain"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("RequestReviewers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(assert.AnError).Once()
+		orch := newTestReviewersOrchestrator(afero.NewMemMapFs(), githubRepo)
+		assert.NotPanics(t, func() {
+			orch.requestReviews(ctx, "release/v1.2.0", []string{"CHANGELOG.md"})
+		})
+		githubRepo.AssertExpectations(t)
+	})
+}
@@ -2,13 +2,16 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/compozy/releasepr/internal/domain"
 	"github.com/compozy/releasepr/internal/logger"
 	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/telemetry"
 	"github.com/google/uuid"
 	"github.com/sethvargo/go-retry"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -20,6 +23,11 @@ type SagaStep struct {
 	Compensate func(ctx context.Context, rollbackData map[string]any) error
 }
 
+// permanentStepError marks a step failure that retrying cannot fix, so the saga fails the step at once.
+type permanentStepError struct{ error }
+
+func (e permanentStepError) Unwrap() error { return e.error }
+
 // SagaExecutor manages the execution of saga workflows with rollback support
 type SagaExecutor struct {
 	sessionID      string
@@ -27,6 +35,8 @@ type SagaExecutor struct {
 	state          *domain.RollbackState
 	steps          []SagaStep
 	enableRollback bool
+	bestEffort     bool
+	events         *EventBus
 }
 
 func (s *SagaExecutor) logger(ctx context.Context) *zap.Logger {
@@ -79,8 +89,12 @@ func (s *SagaExecutor) Execute(ctx context.Context) error {
 	}
 	s.state.Status = domain.WorkflowStatusRunning
 	for _, step := range s.steps {
-		if err := s.executeStep(ctx, step); err != nil {
+		s.publish(ctx, Event{Type: EventStepStarted, Step: step.Name})
+		skipped, err := s.executeStep(ctx, step)
+		s.publishFinished(ctx, step.Name, skipped, err)
+		if err != nil {
 			s.state.MarkOperationFailed(step.Type, err)
+			failure := stepFailed(step.Name, fmt.Errorf("step '%s' failed: %w", step.Name, err))
 			if s.enableRollback {
 				if saveErr := s.saveState(ctx); saveErr != nil {
 					s.logger(ctx).Warn("Failed to save state before rollback", zap.Error(saveErr))
@@ -90,11 +104,12 @@ func (s *SagaExecutor) Execute(ctx context.Context) error {
 				rollbackErr := s.rollback(rollbackCtx)
 				cancel() // Call cancel immediately after rollback
 				if rollbackErr != nil {
-					return fmt.Errorf("step '%s' failed: %w, rollback also failed: %v",
-						step.Name, err, rollbackErr)
+					failure = stepFailed(step.Name, fmt.Errorf("step '%s' failed: %w, rollback also failed: %v",
+						step.Name, err, rollbackErr))
 				}
 			}
-			return fmt.Errorf("step '%s' failed: %w", step.Name, err)
+			s.publish(ctx, Event{Type: EventSessionFailed, Step: step.Name, Err: failure})
+			return failure
 		}
 	}
 	s.state.Status = domain.WorkflowStatusCompleted
@@ -106,8 +121,38 @@ func (s *SagaExecutor) Execute(ctx context.Context) error {
 	return nil
 }
 
-// executeStep executes a single saga step with retry logic
-func (s *SagaExecutor) executeStep(ctx context.Context, step SagaStep) error {
+// SetBestEffort makes rollback attempt every compensation even after one fails, instead of stopping
+// at the first failure. Failed compensations are recorded in the state as needing manual cleanup.
+func (s *SagaExecutor) SetBestEffort(bestEffort bool) {
+	s.bestEffort = bestEffort
+}
+
+// SetEventBus publishes the progress of every step, and the failure of the session once its rollback
+// settled, on events.
+func (s *SagaExecutor) SetEventBus(events *EventBus) {
+	s.events = events
+}
+
+// publish sends event on the event bus of the saga with its session and version.
+func (s *SagaExecutor) publish(ctx context.Context, event Event) {
+	event.Session = s.state
+	event.Version = s.state.Version
+	s.events.Publish(ctx, event)
+}
+
+func (s *SagaExecutor) publishFinished(ctx context.Context, name string, skipped bool, err error) {
+	switch {
+	case err != nil:
+		s.publish(ctx, Event{Type: EventStepFailed, Step: name, Err: err})
+	case skipped:
+		s.publish(ctx, Event{Type: EventStepSkipped, Step: name})
+	default:
+		s.publish(ctx, Event{Type: EventStepSucceeded, Step: name})
+	}
+}
+
+// executeStep executes a single saga step with retry logic and reports whether the step skipped itself
+func (s *SagaExecutor) executeStep(ctx context.Context, step SagaStep) (bool, error) {
 	s.state.MarkOperationStarted(step.Type)
 	if s.enableRollback {
 		if saveErr := s.saveState(ctx); saveErr != nil {
@@ -124,6 +169,9 @@ func (s *SagaExecutor) executeStep(ctx context.Context, step SagaStep) error {
 		default:
 		}
 		data, execErr := step.Execute(retryCtx)
+		if repository.IsPermanentGitHubError(execErr) || errors.As(execErr, new(permanentStepError)) {
+			return execErr
+		}
 		if execErr != nil {
 			return retry.RetryableError(execErr)
 		}
@@ -131,7 +179,7 @@ func (s *SagaExecutor) executeStep(ctx context.Context, step SagaStep) error {
 		return nil
 	})
 	if err != nil {
-		return err
+		return false, err
 	}
 	s.state.MarkOperationCompleted(step.Type, rollbackData)
 	if s.enableRollback {
@@ -139,7 +187,8 @@ func (s *SagaExecutor) executeStep(ctx context.Context, step SagaStep) error {
 			s.logger(ctx).Warn("Failed to save state after marking operation completed", zap.Error(saveErr))
 		}
 	}
-	return nil
+	skipped, _ := rollbackData["skip"].(bool)
+	return skipped, nil
 }
 
 // Rollback executes compensating actions for completed operations
@@ -156,6 +205,8 @@ func (s *SagaExecutor) rollback(ctx context.Context) error {
 		log.Info("No operations to rollback")
 		return nil
 	}
+	s.state.ManualCleanup = nil
+	var failures []error
 	for _, op := range completedOps {
 		// Check context cancellation
 		select {
@@ -170,7 +221,11 @@ func (s *SagaExecutor) rollback(ctx context.Context) error {
 		log.Info("Rolling back step", zap.String("step", step.Name))
 		if err := s.executeCompensation(ctx, step, op.RollbackData); err != nil {
 			log.Error("Failed to rollback step", zap.String("step", step.Name), zap.Error(err))
-			return fmt.Errorf("rollback failed for %s: %w", step.Name, err)
+			if !s.bestEffort {
+				return fmt.Errorf("rollback failed for %s: %w", step.Name, err)
+			}
+			s.state.AddManualCleanup(op.Type, step.Name, err)
+			failures = append(failures, fmt.Errorf("%s: %w", step.Name, err))
 		}
 		if s.enableRollback {
 			if saveErr := s.saveState(ctx); saveErr != nil {
@@ -178,6 +233,17 @@ func (s *SagaExecutor) rollback(ctx context.Context) error {
 			}
 		}
 	}
+	if len(failures) > 0 {
+		s.state.Status = domain.WorkflowStatusFailed
+		if s.enableRollback {
+			if saveErr := s.saveState(ctx); saveErr != nil {
+				log.Warn("Failed to save state after rollback", zap.Error(saveErr))
+			}
+		}
+		log.Error("Rollback incomplete, some steps need manual cleanup", zap.Int("failed", len(failures)))
+		return fmt.Errorf("rollback incomplete, %d step(s) need manual cleanup: %w",
+			len(failures), errors.Join(failures...))
+	}
 	s.state.Status = domain.WorkflowStatusRolledBack
 	if s.enableRollback {
 		if saveErr := s.saveState(ctx); saveErr != nil {
@@ -188,8 +254,25 @@ func (s *SagaExecutor) rollback(ctx context.Context) error {
 	return nil
 }
 
+// retryableGitHubError marks transient GitHub API failures for retry; any other error stops the retry loop.
+func retryableGitHubError(err error) error {
+	if repository.IsRetryableGitHubError(err) {
+		return retry.RetryableError(err)
+	}
+	return err
+}
+
 // executeCompensation executes a compensating action with retry
-func (s *SagaExecutor) executeCompensation(ctx context.Context, step *SagaStep, rollbackData map[string]any) error {
+func (s *SagaExecutor) executeCompensation(
+	ctx context.Context,
+	step *SagaStep,
+	rollbackData map[string]any,
+) (err error) {
+	ctx, span := telemetry.Start(ctx, "compensate "+step.Name,
+		attribute.String("saga.session_id", s.sessionID),
+		attribute.String("saga.operation", string(step.Type)),
+	)
+	defer func() { telemetry.End(span, err) }()
 	retryStrategy := retry.WithMaxRetries(DefaultRetryCount, retry.NewExponential(DefaultRetryDelay))
 	return retry.Do(ctx, retryStrategy, func(retryCtx context.Context) error {
 		// Check if context is canceled
@@ -241,3 +324,39 @@ func (s *SagaExecutor) SetBranchName(branchName string) {
 func (s *SagaExecutor) SetOriginalBranch(branchName string) {
 	s.state.OriginalBranch = branchName
 }
+
+// SetBaseBranch sets the branch the release PR targets in the state
+func (s *SagaExecutor) SetBaseBranch(branchName string) {
+	s.state.BaseBranch = branchName
+}
+
+// SetOrigin records the binary version, start commit and config hash the session started with
+func (s *SagaExecutor) SetOrigin(toolVersion, startCommit, configHash string) {
+	s.state.ToolVersion = toolVersion
+	s.state.StartCommit = startCommit
+	s.state.ConfigHash = configHash
+}
+
+// SetPullRequest records the release PR of the session in the state
+func (s *SagaExecutor) SetPullRequest(pr domain.PullRequestRef) {
+	s.state.PRNumber = pr.Number
+	s.state.PRURL = pr.URL
+}
+
+// SetDryRun marks the session as a dry run in the state
+func (s *SagaExecutor) SetDryRun(dryRun bool) {
+	s.state.DryRun = dryRun
+}
+
+// SetRunFlags records the pr-release flags of the session in the state
+func (s *SagaExecutor) SetRunFlags(forceRelease, skipPR bool, skipSteps []string) {
+	s.state.ForceRelease = forceRelease
+	s.state.SkipPR = skipPR
+	s.state.SkipSteps = skipSteps
+}
+
+// PlanAction records a mutating operation a dry run skipped and returns the step's skip data
+func (s *SagaExecutor) PlanAction(action string) map[string]any {
+	s.state.AddPlannedAction(action)
+	return map[string]any{"skip": true, "planned": action}
+}
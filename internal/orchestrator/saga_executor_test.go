@@ -4,12 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 // Define error for testing
@@ -51,6 +59,30 @@ func (m *MockStateRepository) Exists(ctx context.Context, sessionID string) (boo
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockStateRepository) List(ctx context.Context) ([]*domain.RollbackState, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RollbackState), args.Error(1)
+}
+
+func (m *MockStateRepository) ListByStatus(
+	ctx context.Context,
+	status domain.WorkflowStatus,
+) ([]*domain.RollbackState, error) {
+	args := m.Called(ctx, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RollbackState), args.Error(1)
+}
+
+func (m *MockStateRepository) Prune(ctx context.Context, cutoff time.Time) (int, error) {
+	args := m.Called(ctx, cutoff)
+	return args.Int(0), args.Error(1)
+}
+
 func TestSagaExecutor_Execute(t *testing.T) {
 	t.Run("Should execute all steps successfully", func(t *testing.T) {
 		// Arrange
@@ -137,12 +169,64 @@ func TestSagaExecutor_Execute(t *testing.T) {
 
 		// Assert
 		assert.Error(t, err)
+		var stepErr *StepError
+		require.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, "Step 2", stepErr.Step)
 		assert.True(t, step1Compensated)
 		assert.False(t, step2Compensated) // Step 2 never succeeded
 		assert.Equal(t, domain.WorkflowStatusRolledBack, saga.GetState().Status)
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("Should not retry permanent GitHub API errors", func(t *testing.T) {
+		// Arrange
+		saga := NewSagaExecutor(new(MockStateRepository), false)
+		attempts := 0
+		saga.AddStep(SagaStep{
+			Name: "Create PR",
+			Type: domain.OperationTypeCreatePR,
+			Execute: func(_ context.Context) (map[string]any, error) {
+				attempts++
+				return nil, &repository.GitHubAPIError{
+					Operation:  "create pull request",
+					StatusCode: http.StatusUnprocessableEntity,
+					Message:    "Validation Failed",
+				}
+			},
+		})
+
+		// Act
+		err := saga.Execute(context.Background())
+
+		// Assert
+		require.ErrorContains(t, err, "GitHub API returned 422: Validation Failed")
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("Should trace every step with its outcome", func(t *testing.T) {
+		recorder := tracetest.NewSpanRecorder()
+		previous := otel.GetTracerProvider()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		t.Cleanup(func() { otel.SetTracerProvider(previous) })
+		saga := NewSagaExecutor(new(MockStateRepository), false)
+		events := NewEventBus()
+		events.Subscribe(newStepTracer())
+		saga.SetEventBus(events)
+		saga.AddStep(SagaStep{
+			Name: "Push Branch",
+			Type: domain.OperationTypePushBranch,
+			Execute: func(_ context.Context) (map[string]any, error) {
+				return map[string]any{"skip": true}, nil
+			},
+		})
+		require.NoError(t, saga.Execute(context.Background()))
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "step Push Branch", spans[0].Name())
+		assert.Contains(t, spans[0].Attributes(), attribute.Bool("saga.skipped", true))
+		assert.Contains(t, spans[0].Attributes(), attribute.String("saga.session_id", saga.sessionID))
+	})
+
 	t.Run("Should handle compensate errors", func(t *testing.T) {
 		// Arrange
 		mockRepo := new(MockStateRepository)
@@ -323,6 +407,65 @@ func TestSagaExecutor_Rollback(t *testing.T) {
 	})
 }
 
+func TestSagaExecutor_RollbackBestEffort(t *testing.T) {
+	failingSaga := func(attempted *[]string) *SagaExecutor {
+		saga := NewSagaExecutor(new(MockStateRepository), false)
+		saga.state.Operations = []domain.OperationRecord{
+			{Type: domain.OperationTypeCreateBranch, Status: domain.OperationStatusCompleted},
+			{Type: domain.OperationTypeCommitChanges, Status: domain.OperationStatusCompleted},
+			{Type: domain.OperationTypePushBranch, Status: domain.OperationStatusCompleted},
+		}
+		compensate := func(name string, err error) func(context.Context, map[string]any) error {
+			return func(_ context.Context, _ map[string]any) error {
+				*attempted = append(*attempted, name)
+				return err
+			}
+		}
+		saga.AddStep(SagaStep{
+			Name:       "Create Branch",
+			Type:       domain.OperationTypeCreateBranch,
+			Compensate: compensate("Create Branch", nil),
+		})
+		saga.AddStep(SagaStep{
+			Name:       "Commit Changes",
+			Type:       domain.OperationTypeCommitChanges,
+			Compensate: compensate("Commit Changes", errors.New("worktree is dirty")),
+		})
+		saga.AddStep(SagaStep{
+			Name:       "Push Branch",
+			Type:       domain.OperationTypePushBranch,
+			Compensate: compensate("Push Branch", errors.New("permission denied")),
+		})
+		return saga
+	}
+	t.Run("Should attempt every compensation and record the failed ones", func(t *testing.T) {
+		var attempted []string
+		saga := failingSaga(&attempted)
+		saga.SetBestEffort(true)
+		err := saga.Rollback(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "2 step(s) need manual cleanup")
+		assert.Contains(t, err.Error(), "Push Branch: permission denied")
+		assert.Contains(t, err.Error(), "Commit Changes: worktree is dirty")
+		assert.Equal(t, []string{"Push Branch", "Commit Changes", "Create Branch"}, slices.Compact(attempted))
+		state := saga.GetState()
+		assert.Equal(t, domain.WorkflowStatusFailed, state.Status)
+		assert.Equal(t, []domain.ManualCleanup{
+			{Operation: domain.OperationTypePushBranch, Step: "Push Branch", Error: "permission denied"},
+			{Operation: domain.OperationTypeCommitChanges, Step: "Commit Changes", Error: "worktree is dirty"},
+		}, state.ManualCleanup)
+	})
+	t.Run("Should stop at the first failing compensation by default", func(t *testing.T) {
+		var attempted []string
+		saga := failingSaga(&attempted)
+		err := saga.Rollback(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "rollback failed for Push Branch")
+		assert.Equal(t, []string{"Push Branch"}, slices.Compact(attempted))
+		assert.Empty(t, saga.GetState().ManualCleanup)
+	})
+}
+
 func TestLoadExistingSaga(t *testing.T) {
 	t.Run("Should load existing saga from repository", func(t *testing.T) {
 		// Arrange
@@ -412,4 +555,55 @@ func TestSagaExecutor_SettersAndGetters(t *testing.T) {
 		// Assert
 		assert.Equal(t, "main", saga.GetState().OriginalBranch)
 	})
+
+	t.Run("Should set and get the release pull request", func(t *testing.T) {
+		saga := NewSagaExecutor(new(MockStateRepository), false)
+		saga.SetPullRequest(domain.PullRequestRef{Number: 42, URL: "https://example.com/pull/42"})
+		assert.Equal(t, 42, saga.GetState().PRNumber)
+		assert.Equal(t, "https://example.com/pull/42", saga.GetState().PRURL)
+	})
+}
+
+func TestSagaExecutor_Events(t *testing.T) {
+	t.Run("Should publish succeeded, skipped and failed steps and the failed session", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		saga := NewSagaExecutor(new(MockStateRepository), false)
+		saga.state.Version = "v1.2.0"
+		events := NewEventBus()
+		var published []EventType
+		var started []string
+		var failure Event
+		events.Subscribe(EventSubscriberFunc(func(_ context.Context, event Event) {
+			published = append(published, event.Type)
+			switch event.Type {
+			case EventStepStarted:
+				started = append(started, event.Step)
+			case EventSessionFailed:
+				failure = event
+			}
+		}))
+		saga.SetEventBus(events)
+		saga.AddStep(SagaStep{Name: "ok", Execute: func(context.Context) (map[string]any, error) {
+			return nil, nil
+		}})
+		saga.AddStep(SagaStep{Name: "skip", Execute: func(context.Context) (map[string]any, error) {
+			return map[string]any{"skip": true}, nil
+		}})
+		saga.AddStep(SagaStep{Name: "fail", Execute: func(context.Context) (map[string]any, error) {
+			return nil, errors.New("boom")
+		}})
+		err := saga.Execute(ctx)
+		require.Error(t, err)
+		assert.Equal(t, []string{"ok", "skip", "fail"}, started)
+		assert.Equal(t, []EventType{
+			EventStepStarted, EventStepSucceeded,
+			EventStepStarted, EventStepSkipped,
+			EventStepStarted, EventStepFailed,
+			EventSessionFailed,
+		}, published)
+		assert.Equal(t, "fail", failure.Step)
+		assert.Equal(t, "v1.2.0", failure.Version)
+		assert.Same(t, saga.GetState(), failure.Session)
+		assert.Equal(t, err, failure.Err)
+	})
 }
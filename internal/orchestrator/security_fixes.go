@@ -0,0 +1,78 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+// securityFixes lists the security fixes released since latestTag when security_section is enabled:
+// the commits of type security or with a security footer, and the merged pull requests carrying one
+// of security_labels. A failed pull request lookup is logged and leaves the commits only.
+func (o *PRReleaseOrchestrator) securityFixes(ctx context.Context, latestTag string) (domain.SecurityFixes, error) {
+	cfg := config.FromContext(ctx)
+	if !cfg.SecuritySection {
+		return nil, nil
+	}
+	messages, err := o.gitRepo.CommitMessagesSinceTag(ctx, latestTag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the commits since %q: %w", latestTag, err)
+	}
+	var fixes domain.SecurityFixes
+	for _, message := range slices.Backward(messages) {
+		if fix, ok := domain.ParseSecurityCommit(message); ok {
+			fixes = fixes.Add(fix)
+		}
+	}
+	if latestTag == "" {
+		return fixes, nil
+	}
+	prs, err := o.githubRepo.MergedPullRequests(ctx, latestTag, releaseBase(ctx))
+	if err != nil {
+		o.logger(ctx).Warn("Skipping security labels of merged pull requests", zap.Error(err))
+		return fixes, nil
+	}
+	for _, pr := range prs {
+		if hasSecurityLabel(pr.Labels, cfg.SecurityLabels) {
+			fixes = fixes.Add(domain.NewSecurityFix(fmt.Sprintf("%s (#%d)", pr.Title, pr.Number), pr.Title))
+		}
+	}
+	return fixes, nil
+}
+
+// markSecurityRelease marks the GitHub release for the tag as containing security fixes when its
+// release body has the security section. The release is already public, so failures are only logged.
+func (o *PublishOrchestrator) markSecurityRelease(ctx context.Context, tag string) {
+	body, err := afero.ReadFile(o.fsRepo, ReleaseBodyOutputFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			o.logger(ctx).Warn("Failed to read the release body", zap.Error(err))
+		}
+		return
+	}
+	if !strings.Contains(string(body), domain.SecurityFixesMarker) {
+		return
+	}
+	if err := o.githubRepo.MarkSecurityRelease(ctx, tag); err != nil {
+		o.logger(ctx).Warn("Failed to mark the release as containing security fixes",
+			zap.String("version", tag), zap.Error(err))
+	}
+}
+
+func hasSecurityLabel(labels, securityLabels []string) bool {
+	for _, label := range labels {
+		if slices.ContainsFunc(securityLabels, func(security string) bool {
+			return strings.EqualFold(strings.TrimSpace(security), label)
+		}) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRReleaseOrchestrator_securityFixes(t *testing.T) {
+	t.Run("Should collect security commits and labeled pull requests", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.SecuritySection = true
+		cfg.SecurityLabels = []string{"security"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("CommitMessagesSinceTag", mock.Anything, "v1.1.0").Return([]string{
+			"fix: escape titles (#43)\n\nCVE: CVE-2024-5678",
+			"feat: add impact",
+			"security: reject paths escaping the target (#42)",
+		}, nil).Once()
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("MergedPullRequests", mock.Anything, "v1.1.0", mock.Anything).Return([]domain.PullRequest{
+			{Number: 42, Title: "security: reject paths escaping the target", Labels: []string{"Security"}},
+			{Number: 44, Title: "Bump yaml for GHSA-jfh8-c2jp-5v3q", Labels: []string{"security", "deps"}},
+			{Number: 45, Title: "feat: add impact", Labels: []string{"enhancement"}},
+		}, nil).Once()
+		orch := &PRReleaseOrchestrator{gitRepo: gitRepo, githubRepo: githubRepo}
+		fixes, err := orch.securityFixes(ctx, "v1.1.0")
+		require.NoError(t, err)
+		assert.Equal(t, domain.SecurityFixes{
+			{Description: "Reject paths escaping the target (#42)"},
+			{Description: "Escape titles (#43)", Advisories: []string{"CVE-2024-5678"}},
+			{Description: "Bump yaml for GHSA-jfh8-c2jp-5v3q (#44)", Advisories: []string{"GHSA-jfh8-c2jp-5v3q"}},
+		}, fixes)
+	})
+	t.Run("Should keep the commits when the pull requests cannot be listed", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.SecuritySection = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("CommitMessagesSinceTag", mock.Anything, "v1.1.0").
+			Return([]string{"security: reject paths"}, nil).Once()
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("MergedPullRequests", mock.Anything, "v1.1.0", mock.Anything).
+			Return(nil, errors.New("rate limited")).Once()
+		orch := &PRReleaseOrchestrator{gitRepo: gitRepo, githubRepo: githubRepo}
+		fixes, err := orch.securityFixes(ctx, "v1.1.0")
+		require.NoError(t, err)
+		assert.Equal(t, domain.SecurityFixes{{Description: "Reject paths"}}, fixes)
+	})
+	t.Run("Should not look for fixes unless security_section is enabled", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		orch := &PRReleaseOrchestrator{gitRepo: gitRepo}
+		fixes, err := orch.securityFixes(ctx, "v1.1.0")
+		require.NoError(t, err)
+		assert.Empty(t, fixes)
+		gitRepo.AssertNotCalled(t, "CommitMessagesSinceTag", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPublishOrchestrator_markSecurityRelease(t *testing.T) {
+	t.Run("Should mark the release when its body has the security section", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		body := "## v1.2.0\n\n### 🔒 Security\n\n" + domain.SecurityFixesMarker + "\n\n- Reject paths\n"
+		require.NoError(t, afero.WriteFile(fsRepo, ReleaseBodyOutputFile, []byte(body), 0o644))
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("MarkSecurityRelease", mock.Anything, "v1.2.0").Return(errors.New("forbidden")).Once()
+		orch := &PublishOrchestrator{githubRepo: githubRepo, fsRepo: fsRepo}
+		require.NotPanics(t, func() { orch.markSecurityRelease(ctx, "v1.2.0") })
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should leave releases without security fixes unmarked", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, ReleaseBodyOutputFile, []byte("## v1.2.0\n"), 0o644))
+		githubRepo := new(mockGithubExtendedRepository)
+		orch := &PublishOrchestrator{githubRepo: githubRepo, fsRepo: fsRepo}
+		orch.markSecurityRelease(ctx, "v1.2.0")
+		orch.fsRepo = afero.NewMemMapFs()
+		orch.markSecurityRelease(ctx, "v1.2.0")
+		githubRepo.AssertNotCalled(t, "MarkSecurityRelease", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,223 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/compozy/releasepr/internal/service"
+	"github.com/compozy/releasepr/pkg/version"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+// toolBinaryName is the binary in every pr-release release archive.
+const toolBinaryName = "pr-release"
+
+// SelfUpdateConfig contains configuration for self-update.
+type SelfUpdateConfig struct {
+	CheckOnly bool // Report whether a newer release exists without installing it
+}
+
+// SelfUpdateResult reports the latest release self-update found and whether it installed it.
+type SelfUpdateResult struct {
+	Current string
+	Latest  string
+	Updated bool
+}
+
+// UpdateAvailable reports whether the latest release is newer than the running binary. Builds
+// without a release version, such as dev, are always behind.
+func (r SelfUpdateResult) UpdateAvailable() bool {
+	latest, err := domain.NewVersion(r.Latest)
+	if err != nil {
+		return false
+	}
+	current, err := domain.NewVersion(r.Current)
+	if err != nil {
+		return true
+	}
+	return latest.Compare(current) > 0
+}
+
+// SelfUpdateOrchestrator replaces the running pr-release binary with the latest GitHub release
+// for its platform, after verifying the cosign signature of the checksums of the release and the
+// archive against them.
+type SelfUpdateOrchestrator struct {
+	releases  repository.ToolReleaseRepository
+	fsRepo    afero.Fs
+	cosignSvc service.CosignService
+	// current is the version of the running binary; executable is its path, resolved with
+	// os.Executable when empty
+	current    string
+	executable string
+	goos       string
+	goarch     string
+}
+
+// NewSelfUpdateOrchestrator creates a new SelfUpdateOrchestrator for the running binary.
+func NewSelfUpdateOrchestrator(
+	releases repository.ToolReleaseRepository,
+	fsRepo repository.FileSystemRepository,
+) *SelfUpdateOrchestrator {
+	return &SelfUpdateOrchestrator{
+		releases:  releases,
+		fsRepo:    fsRepo,
+		cosignSvc: service.NewCosignService(),
+		current:   version.Version,
+		goos:      runtime.GOOS,
+		goarch:    runtime.GOARCH,
+	}
+}
+
+func (o *SelfUpdateOrchestrator) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx).Named("orchestrator.self_update")
+}
+
+// Execute looks up the latest release and, unless cfg.CheckOnly is set, installs it over the running
+// binary when it is newer.
+func (o *SelfUpdateOrchestrator) Execute(ctx context.Context, cfg SelfUpdateConfig) (SelfUpdateResult, error) {
+	release, err := o.releases.LatestRelease(ctx)
+	if err != nil {
+		return SelfUpdateResult{}, fmt.Errorf("failed to look up the latest release: %w", err)
+	}
+	result := SelfUpdateResult{Current: o.current, Latest: release.Tag}
+	if cfg.CheckOnly || !result.UpdateAvailable() {
+		return result, nil
+	}
+	executable, err := o.executablePath()
+	if err != nil {
+		return result, err
+	}
+	dir, err := afero.TempDir(o.fsRepo, "", "pr-release-update-")
+	if err != nil {
+		return result, fmt.Errorf("failed to create update directory: %w", err)
+	}
+	defer o.fsRepo.RemoveAll(dir)
+	checksums, err := o.verifiedChecksums(ctx, release, dir)
+	if err != nil {
+		return result, err
+	}
+	archiveName := domain.ToolArchiveName(release.Tag, o.goos, o.goarch)
+	archive, err := o.download(ctx, release, archiveName)
+	if err != nil {
+		return result, err
+	}
+	if err := verifyToolChecksum(archiveName, archive, checksums); err != nil {
+		return result, err
+	}
+	if err := o.install(dir, archiveName, archive, executable); err != nil {
+		return result, err
+	}
+	o.logger(ctx).Info("Updated pr-release",
+		zap.String("from", o.current), zap.String("to", release.Tag), zap.String("path", executable))
+	result.Updated = true
+	return result, nil
+}
+
+func (o *SelfUpdateOrchestrator) executablePath() (string, error) {
+	if o.executable != "" {
+		return o.executable, nil
+	}
+	executable, err := os.Executable()
+	if err == nil {
+		executable, err = filepath.EvalSymlinks(executable)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the running binary: %w", err)
+	}
+	return executable, nil
+}
+
+func (o *SelfUpdateOrchestrator) download(
+	ctx context.Context,
+	release domain.ToolRelease,
+	name string,
+) ([]byte, error) {
+	asset, ok := release.Asset(name)
+	if !ok {
+		return nil, fmt.Errorf("release %s has no asset %s", release.Tag, name)
+	}
+	return o.releases.DownloadAsset(ctx, asset.URL)
+}
+
+// verifiedChecksums downloads the checksums of release with their signature and certificate into
+// dir and returns them once cosign verifies they were signed by a release workflow of pr-release.
+func (o *SelfUpdateOrchestrator) verifiedChecksums(
+	ctx context.Context,
+	release domain.ToolRelease,
+	dir string,
+) (map[string]string, error) {
+	var checksums []byte
+	for _, name := range []string{
+		domain.ToolChecksumsAsset,
+		domain.ToolChecksumsSignatureAsset,
+		domain.ToolChecksumsCertificateAsset,
+	} {
+		data, err := o.download(ctx, release, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := afero.WriteFile(o.fsRepo, filepath.Join(dir, name), data, 0o600); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		if name == domain.ToolChecksumsAsset {
+			checksums = data
+		}
+	}
+	signed := domain.SignedFile{
+		Path:        filepath.Join(dir, domain.ToolChecksumsAsset),
+		Signature:   filepath.Join(dir, domain.ToolChecksumsSignatureAsset),
+		Certificate: filepath.Join(dir, domain.ToolChecksumsCertificateAsset),
+	}
+	err := o.cosignSvc.VerifyBlob(ctx, signed, repository.ToolReleaseOwner, repository.ToolReleaseRepo)
+	if err != nil {
+		return nil, fmt.Errorf("%w (cosign must be on PATH to verify releases)", err)
+	}
+	return domain.ParseChecksums(string(checksums)), nil
+}
+
+// install extracts the binary of archive, written to dir, next to executable and renames it over it,
+// so the binary is never left half written. Windows cannot overwrite a running executable, so it is
+// moved aside to <executable>.old first.
+func (o *SelfUpdateOrchestrator) install(dir, archiveName string, archive []byte, executable string) error {
+	archivePath := filepath.Join(dir, archiveName)
+	if err := afero.WriteFile(o.fsRepo, archivePath, archive, 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", archiveName, err)
+	}
+	staged := executable + ".new"
+	if err := extractArchiveBinary(o.fsRepo, archivePath, toolBinaryName, staged); err != nil {
+		return err
+	}
+	if o.goos == "windows" {
+		if err := o.fsRepo.Rename(executable, executable+".old"); err != nil {
+			o.fsRepo.Remove(staged)
+			return fmt.Errorf("failed to move %s aside: %w", executable, err)
+		}
+	}
+	if err := o.fsRepo.Rename(staged, executable); err != nil {
+		o.fsRepo.Remove(staged)
+		return fmt.Errorf("failed to replace %s: %w", executable, err)
+	}
+	return nil
+}
+
+// verifyToolChecksum checks the SHA-256 digest of the archive called name against checksums.
+func verifyToolChecksum(name string, data []byte, checksums map[string]string) error {
+	want, ok := checksums[name]
+	if !ok {
+		return fmt.Errorf("%s is not listed in %s", name, domain.ToolChecksumsAsset)
+	}
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != want {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", name, want, got)
+	}
+	return nil
+}
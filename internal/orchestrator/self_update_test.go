@@ -0,0 +1,130 @@
+package orchestrator
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// toolArchive packages content as the pr-release binary of a tar.gz release archive.
+func toolArchive(t *testing.T, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	header := &tar.Header{Name: "pr-release_1.5.0_linux_x86_64/pr-release", Mode: 0o755, Size: int64(len(content))}
+	require.NoError(t, tw.WriteHeader(header))
+	_, err := tw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func newTestSelfUpdate(t *testing.T, archive []byte, checksum string) (*SelfUpdateOrchestrator, string) {
+	t.Helper()
+	executable := filepath.Join(t.TempDir(), "pr-release")
+	require.NoError(t, os.WriteFile(executable, []byte("old"), 0o755))
+	archiveName := "pr-release_1.5.0_linux_x86_64.tar.gz"
+	releases := new(mockToolReleaseRepository)
+	releases.On("LatestRelease", mock.Anything).Return(domain.ToolRelease{
+		Tag: "v1.5.0",
+		Assets: []domain.ReleaseAsset{
+			{Name: archiveName, URL: "https://example.com/archive"},
+			{Name: domain.ToolChecksumsAsset, URL: "https://example.com/checksums"},
+			{Name: domain.ToolChecksumsSignatureAsset, URL: "https://example.com/checksums.sig"},
+			{Name: domain.ToolChecksumsCertificateAsset, URL: "https://example.com/checksums.pem"},
+		},
+	}, nil)
+	releases.On("DownloadAsset", mock.Anything, "https://example.com/archive").Return(archive, nil).Maybe()
+	releases.On("DownloadAsset", mock.Anything, "https://example.com/checksums").
+		Return([]byte(checksum+"  "+archiveName+"\n"), nil).Maybe()
+	releases.On("DownloadAsset", mock.Anything, "https://example.com/checksums.sig").Return([]byte("sig"), nil).Maybe()
+	releases.On("DownloadAsset", mock.Anything, "https://example.com/checksums.pem").Return([]byte("pem"), nil).Maybe()
+	cosignSvc := new(mockCosignService)
+	cosignSvc.On("VerifyBlob", mock.Anything, mock.MatchedBy(func(signed domain.SignedFile) bool {
+		return filepath.Base(signed.Path) == "checksums.txt" &&
+			filepath.Base(signed.Signature) == "checksums.txt.sig" &&
+			filepath.Base(signed.Certificate) == "checksums.txt.pem"
+	}), "compozy", "releasepr").Return(nil).Maybe()
+	orch := NewSelfUpdateOrchestrator(releases, afero.NewOsFs())
+	orch.cosignSvc = cosignSvc
+	orch.current = "v1.4.0"
+	orch.executable = executable
+	orch.goos, orch.goarch = "linux", "amd64"
+	return orch, executable
+}
+
+func TestSelfUpdateOrchestrator_Execute(t *testing.T) {
+	t.Run("Should replace the binary with the verified latest release", func(t *testing.T) {
+		archive := toolArchive(t, "new")
+		sum := sha256.Sum256(archive)
+		orch, executable := newTestSelfUpdate(t, archive, hex.EncodeToString(sum[:]))
+		result, err := orch.Execute(t.Context(), SelfUpdateConfig{})
+		require.NoError(t, err)
+		assert.Equal(t, SelfUpdateResult{Current: "v1.4.0", Latest: "v1.5.0", Updated: true}, result)
+		data, err := os.ReadFile(executable)
+		require.NoError(t, err)
+		assert.Equal(t, "new", string(data))
+		assert.NoFileExists(t, executable+".new")
+	})
+	t.Run("Should keep the binary when the checksum does not match", func(t *testing.T) {
+		orch, executable := newTestSelfUpdate(t, toolArchive(t, "tampered"), "deadbeef")
+		_, err := orch.Execute(t.Context(), SelfUpdateConfig{})
+		assert.ErrorContains(t, err, "checksum mismatch")
+		data, err := os.ReadFile(executable)
+		require.NoError(t, err)
+		assert.Equal(t, "old", string(data))
+	})
+	t.Run("Should keep the binary when the checksums signature does not verify", func(t *testing.T) {
+		archive := toolArchive(t, "new")
+		sum := sha256.Sum256(archive)
+		orch, executable := newTestSelfUpdate(t, archive, hex.EncodeToString(sum[:]))
+		cosignSvc := new(mockCosignService)
+		cosignSvc.On("VerifyBlob", mock.Anything, mock.Anything, "compozy", "releasepr").
+			Return(errors.New("failed to verify the signature of checksums.txt: none of the certificates matched"))
+		orch.cosignSvc = cosignSvc
+		_, err := orch.Execute(t.Context(), SelfUpdateConfig{})
+		assert.ErrorContains(t, err, "failed to verify the signature of checksums.txt")
+		data, err := os.ReadFile(executable)
+		require.NoError(t, err)
+		assert.Equal(t, "old", string(data))
+		orch.releases.(*mockToolReleaseRepository).AssertNotCalled(t, "DownloadAsset", mock.Anything,
+			"https://example.com/archive")
+	})
+	t.Run("Should only report the latest release with CheckOnly", func(t *testing.T) {
+		orch, _ := newTestSelfUpdate(t, nil, "")
+		result, err := orch.Execute(t.Context(), SelfUpdateConfig{CheckOnly: true})
+		require.NoError(t, err)
+		assert.True(t, result.UpdateAvailable())
+		assert.False(t, result.Updated)
+		orch.releases.(*mockToolReleaseRepository).AssertNotCalled(t, "DownloadAsset", mock.Anything, mock.Anything)
+	})
+	t.Run("Should not update when the binary is already current", func(t *testing.T) {
+		orch, _ := newTestSelfUpdate(t, nil, "")
+		orch.current = "v1.5.0"
+		result, err := orch.Execute(t.Context(), SelfUpdateConfig{})
+		require.NoError(t, err)
+		assert.False(t, result.UpdateAvailable())
+		assert.False(t, result.Updated)
+	})
+}
+
+func TestSelfUpdateResult_UpdateAvailable(t *testing.T) {
+	t.Run("Should treat builds without a release version as behind", func(t *testing.T) {
+		assert.True(t, SelfUpdateResult{Current: "dev", Latest: "v1.5.0"}.UpdateAvailable())
+		assert.False(t, SelfUpdateResult{Current: "v1.6.0", Latest: "v1.5.0"}.UpdateAvailable())
+	})
+}
@@ -0,0 +1,47 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"go.uber.org/zap"
+)
+
+// verifySignOff checks the release pull request of the commit being published against the
+// signoff_* policy before it is tagged. An unmet policy fails the publish unless signoff_override
+// gives a reason, in which case the requirements published without are returned for the manifest.
+// It returns nil when no sign-off is required.
+func (o *PublishOrchestrator) verifySignOff(ctx context.Context) (*domain.ManifestSignOff, error) {
+	cfg := config.FromContext(ctx)
+	policy := cfg.SignOffPolicy()
+	if policy.IsZero() {
+		return nil, nil
+	}
+	commit, err := o.gitRepo.GetHeadCommit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the release commit: %w", err)
+	}
+	status, err := o.githubRepo.ReviewStatus(ctx, commit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the sign-off of the release pull request: %w", err)
+	}
+	signOff := &domain.ManifestSignOff{PullRequest: status.PullRequest, Approvers: status.Approvers}
+	log := o.logger(ctx).With(zap.Int("pr_number", status.PullRequest), zap.Strings("approvers", status.Approvers))
+	unmet := policy.Evaluate(status)
+	if len(unmet) == 0 {
+		log.Info("Release pull request is signed off")
+		return signOff, nil
+	}
+	reason := strings.TrimSpace(cfg.SignOffOverride)
+	if reason == "" {
+		return nil, fmt.Errorf("release pull request #%d is not signed off: %s "+
+			"(set signoff_override to publish anyway)", status.PullRequest, strings.Join(unmet, "; "))
+	}
+	log.Warn("Publishing without the required sign-off", zap.Strings("unmet", unmet), zap.String("reason", reason))
+	signOff.Overrides = unmet
+	signOff.OverrideReason = reason
+	return signOff, nil
+}
@@ -0,0 +1,106 @@
+package orchestrator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishOrchestrator_verifySignOff(t *testing.T) {
+	status := domain.ReviewStatus{
+		PullRequest: 42,
+		Approvers:   []string{"alice"},
+		Checks:      map[string]string{"test": "success", "e2e": "failure"},
+	}
+	t.Run("Should record the approvers of a signed off release pull request", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.SignOffMinApprovals = 1
+		cfg.SignOffRequiredChecks = []string{"test"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Once()
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("ReviewStatus", mock.Anything, "abc123").Return(status, nil).Once()
+		orch := &PublishOrchestrator{gitRepo: gitRepo, githubRepo: githubRepo}
+		signOff, err := orch.verifySignOff(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, &domain.ManifestSignOff{PullRequest: 42, Approvers: []string{"alice"}}, signOff)
+	})
+	t.Run("Should record the overridden requirements with the reason", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.SignOffMinApprovals = 2
+		cfg.SignOffRequiredChecks = []string{"e2e"}
+		cfg.SignOffOverride = "hotfix for the outage, approved by the release captain"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Once()
+		githubRepo := new(mockGithubExtendedRepository)
+		githubRepo.On("ReviewStatus", mock.Anything, "abc123").Return(status, nil).Once()
+		orch := &PublishOrchestrator{gitRepo: gitRepo, githubRepo: githubRepo}
+		signOff, err := orch.verifySignOff(ctx)
+		require.NoError(t, err)
+		unmet := []string{"1 approval(s), signoff_min_approvals is 2", `check "e2e" is failure`}
+		assert.Equal(t, unmet, signOff.Overrides)
+		assert.Equal(t, cfg.SignOffOverride, signOff.OverrideReason)
+	})
+	t.Run("Should not look up the sign-off when none is required", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch := &PublishOrchestrator{githubRepo: githubRepo}
+		signOff, err := orch.verifySignOff(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, signOff)
+		githubRepo.AssertNotCalled(t, "ReviewStatus", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPublishOrchestrator_SignOff(t *testing.T) {
+	t.Run("Should not tag a release pull request that is not signed off", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.SignOffMinApprovals = 2
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(false, nil).Once()
+		gitRepo.On("CheckoutBranch", mock.Anything, "abc123").Return(nil).Once()
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Once()
+		githubRepo.On("ReviewStatus", mock.Anything, "abc123").
+			Return(domain.ReviewStatus{PullRequest: 42, Approvers: []string{"alice"}}, nil).Once()
+		orch := NewPublishOrchestrator(gitRepo, new(mockGoReleaserService), githubRepo, afero.NewMemMapFs(),
+			new(mockCosignService))
+		err := orch.Execute(ctx, PublishConfig{Version: "1.2.0", Ref: "abc123"})
+		require.ErrorContains(t, err, "release pull request #42 is not signed off: 1 approval(s)")
+		gitRepo.AssertNotCalled(t, "CreateTag", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should record the sign-off in the release manifest", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.SignOffMinApprovals = 1
+		cfg.ReleaseManifestPath = "release-manifest.json"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(false, nil).Once()
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123", nil).Twice()
+		gitRepo.On("ReleaseTags", mock.Anything).Return([]domain.ReleaseTag{{Name: "v1.2.0"}}, nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v1.2.0", "Release v1.2.0").Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v1.2.0").Return(nil).Once()
+		githubRepo.On("ReviewStatus", mock.Anything, "abc123").
+			Return(domain.ReviewStatus{PullRequest: 42, Approvers: []string{"alice"}}, nil).Once()
+		orch := NewPublishOrchestrator(gitRepo, new(mockGoReleaserService), githubRepo, fsRepo, new(mockCosignService))
+		require.NoError(t, orch.Execute(ctx, PublishConfig{Version: "1.2.0", SkipPublish: true}))
+		data, err := afero.ReadFile(fsRepo, "release-manifest.json")
+		require.NoError(t, err)
+		var manifest domain.ReleaseManifest
+		require.NoError(t, json.Unmarshal(data, &manifest))
+		assert.Equal(t, 42, manifest.PRNumber)
+		assert.Equal(t, &domain.ManifestSignOff{PullRequest: 42, Approvers: []string{"alice"}}, manifest.SignOff)
+		gitRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,124 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+const (
+	artifactTypeChecksum = "Checksum"
+	tagStatementDir      = "dist"
+	tagStatementSuffix   = ".tag"
+)
+
+// signingMode returns the configured signing mode, defaulting to none.
+func signingMode(cfg *config.Config) string {
+	mode := strings.ToLower(strings.TrimSpace(cfg.Signing))
+	if mode == "" {
+		return domain.SigningNone
+	}
+	return mode
+}
+
+// signRelease signs the release tag and GoReleaser artifacts with cosign, uploads the signatures next
+// to the release assets and appends verification instructions to the release notes.
+func (o *PublishOrchestrator) signRelease(ctx context.Context, tag string, skipPublish bool) error {
+	cfg := config.FromContext(ctx)
+	mode := signingMode(cfg)
+	if skipPublish || mode == domain.SigningNone {
+		return nil
+	}
+	locale, err := releaseLocale(ctx, o.fsRepo)
+	if err != nil {
+		return err
+	}
+	statement, err := o.writeTagStatement(ctx, tag)
+	if err != nil {
+		return err
+	}
+	artifacts, err := o.signableArtifacts(cfg, tag)
+	if err != nil {
+		return err
+	}
+	key := ""
+	if mode == domain.SigningKey {
+		key = cfg.CosignKey
+	}
+	instructions := domain.SigningInstructions{Mode: mode, Owner: cfg.GithubOwner, Repo: cfg.GithubRepo}
+	for _, file := range append([]string{statement}, artifacts...) {
+		signed, err := o.cosignSvc.SignBlob(ctx, file, key)
+		if err != nil {
+			return err
+		}
+		// GoReleaser already uploaded the artifacts themselves; only the tag statement is new.
+		assets := []string{signed.Signature, signed.Certificate}
+		if file == statement {
+			assets = append(assets, statement)
+		}
+		for _, asset := range assets {
+			if asset == "" {
+				continue
+			}
+			if err := o.githubRepo.UploadReleaseAsset(ctx, tag, asset); err != nil {
+				return fmt.Errorf("failed to attach %s to %s: %w", asset, tag, err)
+			}
+		}
+		instructions.Files = append(instructions.Files, signed)
+	}
+	if err := o.githubRepo.AppendReleaseNotes(ctx, tag, instructions.LocalizedMarkdown(locale)); err != nil {
+		return fmt.Errorf("failed to add verification instructions to %s: %w", tag, err)
+	}
+	o.logger(ctx).Info("Signed release",
+		zap.String("tag", tag),
+		zap.String("mode", mode),
+		zap.Int("files", len(instructions.Files)),
+	)
+	return nil
+}
+
+// writeTagStatement records the tag and the commit it points to in a file that cosign can sign,
+// since cosign signs blobs rather than git objects.
+func (o *PublishOrchestrator) writeTagStatement(ctx context.Context, tag string) (string, error) {
+	commit, err := o.gitRepo.GetHeadCommit(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the commit of %s: %w", tag, err)
+	}
+	if err := o.fsRepo.MkdirAll(tagStatementDir, DirPermissionsDefault); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", tagStatementDir, err)
+	}
+	statementPath := path.Join(tagStatementDir, tag+tagStatementSuffix)
+	content := fmt.Sprintf("tag: %s\ncommit: %s\n", tag, commit)
+	if err := afero.WriteFile(o.fsRepo, statementPath, []byte(content), FilePermissionsReadWrite); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", statementPath, err)
+	}
+	return statementPath, nil
+}
+
+// signableArtifacts returns the GoReleaser checksum files, which cover every artifact they list,
+// or the archives themselves when the release has no checksum file.
+func (o *PublishOrchestrator) signableArtifacts(cfg *config.Config, tag string) ([]string, error) {
+	metadata, err := readReleaseArtifactMetadata(o.fsRepo, cfg.ArtifactMetadataPath, tag)
+	if err != nil || metadata == nil {
+		return nil, err
+	}
+	var checksums, archives []string
+	for _, artifact := range metadata.Artifacts {
+		switch artifact.Type {
+		case artifactTypeChecksum:
+			checksums = append(checksums, artifact.Path)
+		case artifactTypeArchive:
+			archives = append(archives, artifact.Path)
+		}
+	}
+	if len(checksums) > 0 {
+		return checksums, nil
+	}
+	return archives, nil
+}
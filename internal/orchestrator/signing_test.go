@@ -0,0 +1,138 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSigningOrchestrator(
+	fsRepo afero.Fs,
+	gitRepo *mockGitExtendedRepository,
+	githubRepo *mockGithubExtendedRepository,
+	cosignSvc *mockCosignService,
+) *PublishOrchestrator {
+	return NewPublishOrchestrator(gitRepo, new(mockGoReleaserService), githubRepo, fsRepo, cosignSvc)
+}
+
+func TestPublishOrchestrator_signRelease(t *testing.T) {
+	t.Run("Should sign the tag and checksums keylessly and record verification instructions", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.Signing = domain.SigningKeyless
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, cfg.ArtifactMetadataPath, []byte(`{"tag":"v1.2.0","artifacts":[
+			{"name":"app_linux_amd64.tar.gz","path":"dist/app_linux_amd64.tar.gz","type":"Archive"},
+			{"name":"checksums.txt","path":"dist/checksums.txt","type":"Checksum"}
+		]}`), 0o644))
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		cosignSvc := new(mockCosignService)
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123def", nil).Once()
+		cosignSvc.On("SignBlob", mock.Anything, "dist/v1.2.0.tag", "").Return(domain.SignedFile{
+			Path:        "dist/v1.2.0.tag",
+			Signature:   "dist/v1.2.0.tag.sig",
+			Certificate: "dist/v1.2.0.tag.pem",
+		}, nil).Once()
+		cosignSvc.On("SignBlob", mock.Anything, "dist/checksums.txt", "").Return(domain.SignedFile{
+			Path:        "dist/checksums.txt",
+			Signature:   "dist/checksums.txt.sig",
+			Certificate: "dist/checksums.txt.pem",
+		}, nil).Once()
+		for _, asset := range []string{
+			"dist/v1.2.0.tag", "dist/v1.2.0.tag.sig", "dist/v1.2.0.tag.pem",
+			"dist/checksums.txt.sig", "dist/checksums.txt.pem",
+		} {
+			githubRepo.On("UploadReleaseAsset", mock.Anything, "v1.2.0", asset).Return(nil).Once()
+		}
+		var notes string
+		githubRepo.On("AppendReleaseNotes", mock.Anything, "v1.2.0", mock.Anything).
+			Run(func(args mock.Arguments) { notes = args.String(2) }).
+			Return(nil).Once()
+		orch := newTestSigningOrchestrator(fsRepo, gitRepo, githubRepo, cosignSvc)
+		require.NoError(t, orch.signRelease(ctx, "v1.2.0", false))
+		statement, err := afero.ReadFile(fsRepo, "dist/v1.2.0.tag")
+		require.NoError(t, err)
+		assert.Equal(t, "tag: v1.2.0\ncommit: abc123def\n", string(statement))
+		assert.Contains(t, notes, "--certificate-identity-regexp '^https://github.com/compozy/releasepr/'")
+		assert.Contains(t, notes, "checksums.txt\n")
+		githubRepo.AssertNotCalled(t, "UploadReleaseAsset", mock.Anything, mock.Anything, "dist/checksums.txt")
+		cosignSvc.AssertExpectations(t)
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should sign archives with the configured key when there is no checksum file", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.Signing = domain.SigningKey
+		cfg.CosignKey = "env://COSIGN_PRIVATE_KEY"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, cfg.ArtifactMetadataPath, []byte(`{"tag":"v1.2.0","artifacts":[
+			{"name":"app_linux_amd64.tar.gz","path":"dist/app_linux_amd64.tar.gz","type":"Archive"},
+			{"name":"app","path":"dist/app_linux_amd64/app","type":"Binary"}
+		]}`), 0o644))
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		cosignSvc := new(mockCosignService)
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123def", nil).Once()
+		cosignSvc.On("SignBlob", mock.Anything, "dist/v1.2.0.tag", cfg.CosignKey).
+			Return(domain.SignedFile{Path: "dist/v1.2.0.tag", Signature: "dist/v1.2.0.tag.sig"}, nil).Once()
+		cosignSvc.On("SignBlob", mock.Anything, "dist/app_linux_amd64.tar.gz", cfg.CosignKey).
+			Return(domain.SignedFile{
+				Path:      "dist/app_linux_amd64.tar.gz",
+				Signature: "dist/app_linux_amd64.tar.gz.sig",
+			}, nil).Once()
+		githubRepo.On("UploadReleaseAsset", mock.Anything, "v1.2.0", mock.Anything).Return(nil).Times(3)
+		githubRepo.On("AppendReleaseNotes", mock.Anything, "v1.2.0", mock.MatchedBy(func(notes string) bool {
+			return assert.Contains(t, notes, "--key cosign.pub --signature app_linux_amd64.tar.gz.sig")
+		})).Return(nil).Once()
+		orch := newTestSigningOrchestrator(fsRepo, gitRepo, githubRepo, cosignSvc)
+		require.NoError(t, orch.signRelease(ctx, "v1.2.0", false))
+		cosignSvc.AssertExpectations(t)
+		githubRepo.AssertExpectations(t)
+	})
+	t.Run("Should not sign when signing is disabled or publishing is skipped", func(t *testing.T) {
+		cosignSvc := new(mockCosignService)
+		orch := newTestSigningOrchestrator(
+			afero.NewMemMapFs(),
+			new(mockGitExtendedRepository),
+			new(mockGithubExtendedRepository),
+			cosignSvc,
+		)
+		require.NoError(t, orch.signRelease(testReleaseContext(t), "v1.2.0", false))
+		cfg := testReleaseConfig()
+		cfg.Signing = domain.SigningKeyless
+		require.NoError(t, orch.signRelease(testReleaseContextWithConfig(t, cfg), "v1.2.0", true))
+		cosignSvc.AssertNotCalled(t, "SignBlob", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("Should fail the publish when cosign fails", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.Signing = domain.SigningKeyless
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		goreleaserSvc := new(mockGoReleaserService)
+		cosignSvc := new(mockCosignService)
+		gitRepo.On("TagExists", mock.Anything, "v1.2.0").Return(false, nil).Once()
+		gitRepo.On("ConfigureUser", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		gitRepo.On("CreateTag", mock.Anything, "v1.2.0", "Release v1.2.0").Return(nil).Once()
+		gitRepo.On("PushTag", mock.Anything, "v1.2.0").Return(nil).Once()
+		gitRepo.On("GetHeadCommit", mock.Anything).Return("abc123def", nil)
+		goreleaserSvc.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).Once()
+		cosignSvc.On("SignBlob", mock.Anything, "dist/v1.2.0.tag", "").
+			Return(domain.SignedFile{}, assert.AnError).Once()
+		orch := NewPublishOrchestrator(
+			gitRepo,
+			goreleaserSvc,
+			new(mockGithubExtendedRepository),
+			afero.NewMemMapFs(),
+			cosignSvc,
+		)
+		err := orch.Execute(ctx, PublishConfig{Version: "1.2.0"})
+		require.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "release v1.2.0 was published but signing failed")
+	})
+}
@@ -0,0 +1,100 @@
+package orchestrator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/compozy/releasepr/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// stepProgress publishes the step events of a run without rollback support, which has no saga to
+// publish them, so subscribers see the same steps whichever flow the run took. A nil stepProgress
+// publishes nothing.
+type stepProgress struct {
+	events  *EventBus
+	version string
+	current string
+}
+
+func newStepProgress(events *EventBus) *stepProgress {
+	return &stepProgress{events: events}
+}
+
+// setVersion attaches version to the events published from now on.
+func (p *stepProgress) setVersion(version string) {
+	if p != nil {
+		p.version = version
+	}
+}
+
+// start finishes the running step as succeeded and publishes the start of name.
+func (p *stepProgress) start(ctx context.Context, name string) {
+	if p == nil {
+		return
+	}
+	p.finish(ctx, nil)
+	p.current = name
+	p.publish(ctx, Event{Type: EventStepStarted, Step: name})
+}
+
+// skip finishes the running step as succeeded and publishes name as skipped by configuration.
+func (p *stepProgress) skip(ctx context.Context, name string) {
+	if p == nil {
+		return
+	}
+	p.start(ctx, name)
+	p.current = ""
+	p.publish(ctx, Event{Type: EventStepSkipped, Step: name})
+}
+
+// finish publishes the end of the running step, failed with err when it is not nil.
+func (p *stepProgress) finish(ctx context.Context, err error) {
+	if p == nil || p.current == "" {
+		return
+	}
+	event := Event{Type: EventStepSucceeded, Step: p.current}
+	if err != nil {
+		event = Event{Type: EventStepFailed, Step: p.current, Err: err}
+	}
+	p.current = ""
+	p.publish(ctx, event)
+}
+
+func (p *stepProgress) publish(ctx context.Context, event Event) {
+	event.Version = p.version
+	p.events.Publish(ctx, event)
+}
+
+// stepTracer traces every workflow step in a span named after it, from its step.started event to the
+// event that finishes it.
+type stepTracer struct {
+	mu    sync.Mutex
+	spans map[string]trace.Span
+}
+
+func newStepTracer() *stepTracer {
+	return &stepTracer{spans: make(map[string]trace.Span)}
+}
+
+// HandleEvent implements EventSubscriber.
+func (t *stepTracer) HandleEvent(ctx context.Context, event Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if event.Type == EventStepStarted {
+		var attrs []attribute.KeyValue
+		if event.Session != nil {
+			attrs = append(attrs, attribute.String("saga.session_id", event.Session.SessionID))
+		}
+		_, t.spans[event.Step] = telemetry.Start(ctx, "step "+event.Step, attrs...)
+		return
+	}
+	span, ok := t.spans[event.Step]
+	if !ok {
+		return
+	}
+	delete(t.spans, event.Step)
+	span.SetAttributes(attribute.Bool("saga.skipped", event.Type == EventStepSkipped))
+	telemetry.End(span, event.Err)
+}
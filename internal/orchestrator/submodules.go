@@ -0,0 +1,97 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+const gitmodulesFile = ".gitmodules"
+
+// hasSubmodules reports whether the repository has a .gitmodules file.
+func (o *PRReleaseOrchestrator) hasSubmodules() (bool, error) {
+	exists, err := afero.Exists(o.fsRepo, gitmodulesFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to check %s: %w", gitmodulesFile, err)
+	}
+	return exists, nil
+}
+
+// bumpSubmodules moves every submodule to its latest tag when submodule_bump is enabled and stages
+// the new pointers, so they join the release commit. It runs in the apply step of a run that is not a
+// dry run, before the changelog lists the moved pointers; the pointers bumped before a failure are
+// returned with it so they can be restored.
+func (o *PRReleaseOrchestrator) bumpSubmodules(ctx context.Context) (domain.SubmoduleUpdates, error) {
+	if !config.FromContext(ctx).SubmoduleBump {
+		return nil, nil
+	}
+	exists, err := o.hasSubmodules()
+	if err != nil || !exists {
+		return nil, err
+	}
+	bumped, err := o.gitRepo.BumpSubmodules(ctx)
+	for _, update := range bumped {
+		o.logger(ctx).Info("Bumped submodule",
+			zap.String("path", update.Path), zap.String("tag", update.Tag), zap.String("commit", update.To))
+	}
+	if err != nil {
+		return bumped, fmt.Errorf("failed to bump submodules: %w", err)
+	}
+	return bumped, nil
+}
+
+// componentUpdates lists the submodule pointers moved since latestTag, with the pointers bumped by
+// the run. Repositories without a .gitmodules file have no components.
+func (o *PRReleaseOrchestrator) componentUpdates(
+	ctx context.Context,
+	latestTag string,
+	bumped domain.SubmoduleUpdates,
+) (domain.SubmoduleUpdates, error) {
+	exists, err := o.hasSubmodules()
+	if err != nil || !exists {
+		return nil, err
+	}
+	updates, err := o.gitRepo.SubmoduleUpdates(ctx, latestTag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect submodule updates: %w", err)
+	}
+	return updates.Merge(bumped), nil
+}
+
+// insertComponentUpdates appends section to the end of the version's section of a changelog, or to
+// the end of the document when it has no heading for version.
+func insertComponentUpdates(document, version, section string) string {
+	if section == "" {
+		return document
+	}
+	lines := strings.Split(strings.TrimRight(document, "\n"), "\n")
+	start := -1
+	end := len(lines)
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "## ") {
+			continue
+		}
+		if start >= 0 {
+			end = i
+			break
+		}
+		if normalizeReleaseVersion(headingVersion(trimmed)) == normalizeReleaseVersion(version) {
+			start = i
+		}
+	}
+	if start < 0 {
+		end = len(lines)
+	}
+	head := strings.TrimRight(strings.Join(lines[:end], "\n"), "\n")
+	updated := head + "\n\n" + section + "\n"
+	if end < len(lines) {
+		updated += "\n" + strings.Join(lines[end:], "\n") + "\n"
+	}
+	return updated
+}
@@ -0,0 +1,111 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRReleaseOrchestrator_componentUpdates(t *testing.T) {
+	previous := "## v1.0.0\n\n### Features\n- Earlier release"
+	changelog := "## v1.1.0\n\n### Features\n- Current release"
+	committed := domain.SubmoduleUpdates{{Path: "libs/parser", From: "1a2b3c4d5e", To: "5d6e7f8a9b"}}
+	setup := func(t *testing.T, gitRepo *mockGitExtendedRepository) (afero.Fs, *PRReleaseOrchestrator) {
+		t.Helper()
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, gitmodulesFile, []byte("[submodule \"libs/parser\"]\n"), 0o644))
+		cliffSvc := new(mockCliffService)
+		cliffSvc.On("GenerateChangelog", mock.Anything, "v1.1.0", "release").Return(changelog, nil).Once()
+		cliffSvc.On("GenerateFullChangelog", mock.Anything, "v1.1.0").
+			Return("# Changelog\n\n"+changelog+"\n\n"+previous, nil).Once()
+		return fsRepo, NewPRReleaseOrchestrator(gitRepo, new(mockGithubExtendedRepository), fsRepo, cliffSvc,
+			new(mockNpmService))
+	}
+	t.Run("Should add submodule pointer changes to both changelogs", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("SubmoduleUpdates", mock.Anything, "v1.0.0").Return(committed, nil).Once()
+		fsRepo, orch := setup(t, gitRepo)
+		artifacts, err := orch.generateChangelog(ctx, "v1.1.0", "v1.0.0", nil, nil)
+		require.NoError(t, err)
+		section := committed.RenderMarkdown()
+		assert.Equal(t, changelog+"\n\n"+section+"\n", artifacts.changelog)
+		full, err := afero.ReadFile(fsRepo, "CHANGELOG.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Changelog\n\n"+changelog+"\n\n"+section+"\n\n"+previous+"\n", string(full))
+		gitRepo.AssertNotCalled(t, "BumpSubmodules", mock.Anything)
+	})
+	t.Run("Should list the submodules bumped to their latest tags when enabled", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.SubmoduleBump = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("SubmoduleUpdates", mock.Anything, "v1.0.0").Return(committed, nil).Once()
+		bumped := domain.SubmoduleUpdates{{Path: "libs/parser", From: "5d6e7f8a9b", To: "9f8e7d6c5b", Tag: "v2.3.0"}}
+		gitRepo.On("BumpSubmodules", mock.Anything).Return(bumped, nil).Once()
+		_, orch := setup(t, gitRepo)
+		moved, err := orch.bumpSubmodules(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"libs/parser"}, moved.Paths())
+		artifacts, err := orch.generateChangelog(ctx, "v1.1.0", "v1.0.0", moved, nil)
+		require.NoError(t, err)
+		assert.Contains(t, artifacts.changelog, "- `libs/parser`: 1a2b3c4 → v2.3.0 (9f8e7d6)")
+		gitRepo.AssertExpectations(t)
+	})
+	t.Run("Should not bump submodules in a dry run", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.SubmoduleBump = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		gitRepo.On("SubmoduleUpdates", mock.Anything, "v1.0.0").Return(committed, nil).Once()
+		_, orch := setup(t, gitRepo)
+		err := orch.updateAndCreatePR(ctx, "v1.1.0", "release/v1.1.0", "v1.0.0", false,
+			PRReleaseConfig{DryRun: true}, nil, nil)
+		require.NoError(t, err)
+		gitRepo.AssertNotCalled(t, "BumpSubmodules", mock.Anything)
+	})
+	t.Run("Should restore the bumped pointers when the run fails before committing", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.SubmoduleBump = true
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		bumped := domain.SubmoduleUpdates{{Path: "libs/parser", From: "5d6e7f8a9b", To: "9f8e7d6c5b", Tag: "v2.3.0"}}
+		gitRepo.On("BumpSubmodules", mock.Anything).Return(bumped, nil).Once()
+		gitRepo.On("SubmoduleUpdates", mock.Anything, "v1.0.0").Return(nil, errors.New("bad object")).Once()
+		gitRepo.On("GetFileStatus", mock.Anything, "libs/parser").Return("modified", nil).Once()
+		gitRepo.On("RestoreFile", mock.Anything, "libs/parser").Return(nil).Once()
+		_, orch := setup(t, gitRepo)
+		err := orch.updateAndCreatePR(ctx, "v1.1.0", "release/v1.1.0", "v1.0.0", false,
+			PRReleaseConfig{}, nil, nil)
+		require.ErrorContains(t, err, "bad object")
+		gitRepo.AssertExpectations(t)
+	})
+	t.Run("Should skip detection without a .gitmodules file", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		gitRepo := new(mockGitExtendedRepository)
+		fsRepo, orch := setup(t, gitRepo)
+		require.NoError(t, fsRepo.Remove(gitmodulesFile))
+		artifacts, err := orch.generateChangelog(ctx, "v1.1.0", "v1.0.0", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, changelog, artifacts.changelog)
+		gitRepo.AssertNotCalled(t, "SubmoduleUpdates", mock.Anything, mock.Anything)
+	})
+}
+
+func TestInsertComponentUpdates(t *testing.T) {
+	t.Run("Should append the section to the end of the version section", func(t *testing.T) {
+		document := "# Changelog\n\n## 1.1.0 - 2026-10-16\n\n- new\n\n## 1.0.0 - 2026-09-01\n\n- old\n"
+		want := "# Changelog\n\n## 1.1.0 - 2026-10-16\n\n- new\n\n### Component Updates\n\n" +
+			"## 1.0.0 - 2026-09-01\n\n- old\n"
+		assert.Equal(t, want, insertComponentUpdates(document, "v1.1.0", "### Component Updates"))
+	})
+	t.Run("Should append to the document without a matching heading", func(t *testing.T) {
+		assert.Equal(t, "- new\n\nsection\n", insertComponentUpdates("- new", "v1.1.0", "section"))
+		assert.Equal(t, "- new", insertComponentUpdates("- new", "v1.1.0", ""))
+	})
+}
@@ -0,0 +1,51 @@
+package orchestrator
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/compozy/releasepr/internal/repository"
+)
+
+// maxTagChangelogBytes caps the changelog embedded in a release tag message. Longer changelogs are
+// cut at a line break and point to the GitHub release for the rest.
+const maxTagChangelogBytes = 32 * 1024
+
+// tagChangelogTruncatedNote ends a changelog cut to fit the tag message.
+const tagChangelogTruncatedNote = "(truncated, the full notes are in the GitHub release)"
+
+// releaseTagMessage returns the annotation of the release tag: a "Release <tag>" subject followed by
+// the release-scoped changelog of RELEASE_BODY.md, so `git show <tag>` displays the notes offline.
+// Without a release body the subject stands alone.
+func releaseTagMessage(fsRepo repository.FileSystemRepository, tag string) (string, error) {
+	subject := fmt.Sprintf("Release %s", tag)
+	if fsRepo == nil {
+		return subject, nil
+	}
+	body, err := readOptionalFile(fsRepo, ReleaseBodyOutputFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", ReleaseBodyOutputFile, err)
+	}
+	changelog := strings.TrimSpace(strings.ReplaceAll(body, "\r\n", "\n"))
+	if changelog == "" {
+		return subject, nil
+	}
+	return subject + "\n\n" + capTagChangelog(changelog, maxTagChangelogBytes), nil
+}
+
+// capTagChangelog cuts changelog to at most limit bytes, at the last line break that fits, and notes
+// the cut.
+func capTagChangelog(changelog string, limit int) string {
+	if len(changelog) <= limit {
+		return changelog
+	}
+	cut := changelog[:limit]
+	if index := strings.LastIndexByte(cut, '\n'); index > 0 {
+		cut = cut[:index]
+	}
+	for !utf8.ValidString(cut) {
+		cut = cut[:len(cut)-1]
+	}
+	return strings.TrimSpace(cut) + "\n\n" + tagChangelogTruncatedNote
+}
@@ -0,0 +1,40 @@
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseTagMessage(t *testing.T) {
+	t.Run("Should embed the release body under the subject", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		body := "## v1.2.3 - 2026-10-16\r\n\r\n### Bug Fixes\r\n- handle empty input\r\n"
+		require.NoError(t, afero.WriteFile(fsRepo, ReleaseBodyOutputFile, []byte(body), 0o644))
+		msg, err := releaseTagMessage(fsRepo, "v1.2.3")
+		require.NoError(t, err)
+		assert.Equal(t, "Release v1.2.3\n\n## v1.2.3 - 2026-10-16\n\n### Bug Fixes\n- handle empty input", msg)
+	})
+	t.Run("Should use the subject alone without a release body", func(t *testing.T) {
+		msg, err := releaseTagMessage(afero.NewMemMapFs(), "v1.2.3")
+		require.NoError(t, err)
+		assert.Equal(t, "Release v1.2.3", msg)
+	})
+}
+
+func TestCapTagChangelog(t *testing.T) {
+	t.Run("Should keep changelogs within the limit", func(t *testing.T) {
+		assert.Equal(t, "- one\n- two", capTagChangelog("- one\n- two", 64))
+	})
+	t.Run("Should cut at a line break and note the cut", func(t *testing.T) {
+		changelog := "- one\n- two\n- three"
+		assert.Equal(t, "- one\n- two\n\n"+tagChangelogTruncatedNote, capTagChangelog(changelog, 14))
+	})
+	t.Run("Should not split a multi-byte character", func(t *testing.T) {
+		capped := capTagChangelog(strings.Repeat("é", 10), 5)
+		assert.Equal(t, "éé\n\n"+tagChangelogTruncatedNote, capped)
+	})
+}
@@ -0,0 +1,150 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/logger"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	timelineSpinnerInterval = 100 * time.Millisecond
+	timelineDurationStep    = 100 * time.Millisecond
+	ansiClearLine           = "\r\033[K"
+)
+
+var timelineSpinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// interactiveTerminal reports whether the timeline may draw on w; tests replace it.
+var interactiveTerminal = logger.Interactive
+
+// stepTimeline renders saga steps as a live list of spinners, durations and ✅/❌ markers.
+// It is an io.Writer so warnings logged meanwhile are printed above the spinner line.
+type stepTimeline struct {
+	mu      sync.Mutex
+	out     io.Writer
+	now     func() time.Time
+	began   time.Time
+	current string
+	started time.Time
+	frame   int
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// startTimeline returns a running timeline when the workflow is watched on an interactive terminal,
+// or nil when plain logs fit better: CI output, CI environments, pipes, or debug logging.
+func (o *PRReleaseOrchestrator) startTimeline(ctx context.Context, cfg PRReleaseConfig) *stepTimeline {
+	if o.terminal == nil || cfg.CIOutput || !interactiveTerminal(o.terminal) {
+		return nil
+	}
+	if config.FromContext(ctx).LogLevel == "debug" {
+		return nil
+	}
+	timeline := newStepTimeline(o.terminal, o.now)
+	go timeline.spin()
+	return timeline
+}
+
+func newStepTimeline(out io.Writer, now func() time.Time) *stepTimeline {
+	return &stepTimeline{
+		out:   out,
+		now:   now,
+		began: now(),
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+}
+
+// quietContext routes only warnings and errors through the timeline, replacing the per-step info logs.
+func (t *stepTimeline) quietContext(ctx context.Context) context.Context {
+	return logger.IntoContext(ctx, logger.NewConsoleWriter(t, zapcore.WarnLevel))
+}
+
+// HandleEvent implements EventSubscriber: a started step gets the spinner, a finished one its marker.
+func (t *stepTimeline) HandleEvent(_ context.Context, event Event) {
+	if event.Type == EventStepStarted {
+		t.stepStarted(event.Step)
+		return
+	}
+	t.stepFinished(event.Step, event.Type)
+}
+
+func (t *stepTimeline) stepStarted(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.current = name
+	t.started = t.now()
+	t.render()
+}
+
+func (t *stepTimeline) stepFinished(name string, outcome EventType) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	elapsed := t.now().Sub(t.started).Round(timelineDurationStep)
+	t.current = ""
+	switch outcome {
+	case EventStepSkipped:
+		fmt.Fprintf(t.out, "%s⏭️  %s (skipped)\n", ansiClearLine, name)
+	case EventStepFailed:
+		fmt.Fprintf(t.out, "%s❌ %s (%s)\n", ansiClearLine, name, elapsed)
+	default:
+		fmt.Fprintf(t.out, "%s✅ %s (%s)\n", ansiClearLine, name, elapsed)
+	}
+}
+
+// Write prints p above the spinner line and redraws the spinner.
+func (t *stepTimeline) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fmt.Fprint(t.out, ansiClearLine)
+	n, err := t.out.Write(p)
+	t.render()
+	return n, err
+}
+
+// Finish stops the spinner and prints the total duration of the workflow.
+func (t *stepTimeline) Finish(err error) {
+	close(t.stop)
+	<-t.done
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	total := t.now().Sub(t.began).Round(timelineDurationStep)
+	if err != nil {
+		fmt.Fprintf(t.out, "%sRelease PR workflow failed after %s\n", ansiClearLine, total)
+		return
+	}
+	fmt.Fprintf(t.out, "%sRelease PR workflow completed in %s\n", ansiClearLine, total)
+}
+
+func (t *stepTimeline) spin() {
+	defer close(t.done)
+	ticker := time.NewTicker(timelineSpinnerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.stop:
+			return
+		case <-ticker.C:
+			t.mu.Lock()
+			t.frame++
+			t.render()
+			t.mu.Unlock()
+		}
+	}
+}
+
+// render draws the spinner line of the running step; callers hold the lock.
+func (t *stepTimeline) render() {
+	if t.current == "" {
+		return
+	}
+	frame := timelineSpinnerFrames[t.frame%len(timelineSpinnerFrames)]
+	elapsed := t.now().Sub(t.started).Round(timelineDurationStep)
+	fmt.Fprintf(t.out, "%s%s %s (%s)", ansiClearLine, frame, t.current, elapsed)
+}
@@ -0,0 +1,77 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock advances by step on every reading.
+func fakeClock(step time.Duration) func() time.Time {
+	current := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return func() time.Time {
+		current = current.Add(step)
+		return current
+	}
+}
+
+func TestStepTimeline(t *testing.T) {
+	t.Run("Should print a marker and duration for every finished step", func(t *testing.T) {
+		var out bytes.Buffer
+		timeline := newStepTimeline(&out, fakeClock(time.Second))
+		timeline.HandleEvent(t.Context(), Event{Type: EventStepStarted, Step: stepNameCheckChanges})
+		timeline.HandleEvent(t.Context(), Event{Type: EventStepSucceeded, Step: stepNameCheckChanges})
+		timeline.HandleEvent(t.Context(), Event{Type: EventStepStarted, Step: stepNamePushBranch})
+		timeline.HandleEvent(t.Context(), Event{Type: EventStepSkipped, Step: stepNamePushBranch})
+		timeline.HandleEvent(t.Context(), Event{Type: EventStepStarted, Step: stepNameCreatePR})
+		timeline.HandleEvent(t.Context(), Event{Type: EventStepFailed, Step: stepNameCreatePR})
+		assert.Contains(t, out.String(), "⠋ Check Changes (1s)")
+		assert.Contains(t, out.String(), ansiClearLine+"✅ Check Changes (2s)\n")
+		assert.Contains(t, out.String(), ansiClearLine+"⏭️  Push Branch (skipped)\n")
+		assert.Contains(t, out.String(), ansiClearLine+"❌ Create Pull Request (2s)\n")
+	})
+	t.Run("Should print log lines above the spinner", func(t *testing.T) {
+		var out bytes.Buffer
+		timeline := newStepTimeline(&out, fakeClock(time.Second))
+		timeline.HandleEvent(t.Context(), Event{Type: EventStepStarted, Step: stepNameChangelog})
+		out.Reset()
+		log := logger.FromContext(timeline.quietContext(context.Background()))
+		log.Info("hidden")
+		log.Warn("careful")
+		assert.NotContains(t, out.String(), "hidden")
+		assert.Contains(t, out.String(), "careful")
+		assert.True(t, bytes.HasPrefix(out.Bytes(), []byte(ansiClearLine)))
+		assert.Contains(t, out.String(), "Generate Changelog")
+	})
+	t.Run("Should report the total duration when finished", func(t *testing.T) {
+		var out bytes.Buffer
+		timeline := newStepTimeline(&out, fakeClock(time.Second))
+		go timeline.spin()
+		timeline.Finish(errors.New("boom"))
+		assert.Contains(t, out.String(), "Release PR workflow failed after 1s\n")
+	})
+}
+
+func TestPRReleaseOrchestrator_startTimeline(t *testing.T) {
+	t.Run("Should stay off for CI output and non-interactive output", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		var out bytes.Buffer
+		orch.terminal = &out
+		assert.Nil(t, orch.startTimeline(ctx, PRReleaseConfig{}))
+		interactiveTerminal = func(io.Writer) bool { return true }
+		t.Cleanup(func() { interactiveTerminal = func(io.Writer) bool { return false } })
+		assert.Nil(t, orch.startTimeline(ctx, PRReleaseConfig{CIOutput: true}))
+		timeline := orch.startTimeline(ctx, PRReleaseConfig{})
+		require.NotNil(t, timeline)
+		timeline.Finish(nil)
+		assert.Contains(t, out.String(), "Release PR workflow completed in")
+	})
+}
@@ -0,0 +1,46 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/usecase"
+)
+
+// Values for the tools_lock_action setting.
+const (
+	ToolsLockActionWarn = "warn"
+	ToolsLockActionFail = "fail"
+)
+
+// stepVerifyTools compares the installed git-cliff and goreleaser with tools_lock before they run,
+// so a runner with other versions cannot silently change how the changelog is formatted. Depending
+// on tools_lock_action, a mismatch fails the dry-run or only logs a warning.
+func (o *DryRunOrchestrator) stepVerifyTools(ctx context.Context, cfg DryRunConfig) error {
+	if len(cfg.ToolsLock) == 0 {
+		return nil
+	}
+	o.logStatus(ctx, cfg.CIOutput, "### 🔒 Verifying Tool Versions")
+	uc := &usecase.CheckToolVersionsUseCase{ToolVersionSvc: o.toolVersionSvc, Lock: cfg.ToolsLock}
+	checks, err := uc.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("tool version check failed: %w", err)
+	}
+	var mismatches []string
+	for _, check := range checks {
+		if !check.OK() {
+			mismatches = append(mismatches, check.String())
+		}
+	}
+	if len(mismatches) == 0 {
+		o.logStatus(ctx, cfg.CIOutput, "✅ Tool versions match tools_lock")
+		return nil
+	}
+	message := "tool versions differ from tools_lock: " + strings.Join(mismatches, "; ")
+	if strings.EqualFold(strings.TrimSpace(cfg.ToolsLockAction), ToolsLockActionFail) {
+		return fmt.Errorf("%s", message)
+	}
+	o.logger(ctx).Warn(message)
+	return nil
+}
@@ -0,0 +1,44 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunOrchestrator_stepVerifyTools(t *testing.T) {
+	newOrchestrator := func(cliffVersion string) (*DryRunOrchestrator, *mockToolVersionService) {
+		svc := new(mockToolVersionService)
+		svc.On("InstalledVersion", mock.Anything, domain.ToolGitCliff).Return(cliffVersion, nil)
+		svc.On("InstalledVersion", mock.Anything, domain.ToolGoReleaser).Return("2.12.7", nil)
+		orch := NewDryRunOrchestrator(nil, nil, nil, nil, afero.NewMemMapFs(), nil)
+		orch.toolVersionSvc = svc
+		return orch, svc
+	}
+	lock := map[string]string{domain.ToolGitCliff: "2.8", domain.ToolGoReleaser: "2.12.7"}
+	t.Run("Should pass when the installed tools match tools_lock", func(t *testing.T) {
+		orch, svc := newOrchestrator("2.8.3")
+		cfg := DryRunConfig{ToolsLock: lock, ToolsLockAction: ToolsLockActionFail}
+		require.NoError(t, orch.stepVerifyTools(t.Context(), cfg))
+		svc.AssertExpectations(t)
+	})
+	t.Run("Should only warn about a mismatch by default", func(t *testing.T) {
+		orch, _ := newOrchestrator("2.9.0")
+		require.NoError(t, orch.stepVerifyTools(t.Context(), DryRunConfig{ToolsLock: lock}))
+	})
+	t.Run("Should fail on a mismatch when tools_lock_action is fail", func(t *testing.T) {
+		orch, _ := newOrchestrator("2.9.0")
+		cfg := DryRunConfig{ToolsLock: lock, ToolsLockAction: ToolsLockActionFail}
+		err := orch.stepVerifyTools(t.Context(), cfg)
+		assert.ErrorContains(t, err, "git-cliff 2.9.0 does not match the 2.8 pinned in tools_lock")
+	})
+	t.Run("Should skip the check without tools_lock", func(t *testing.T) {
+		orch, svc := newOrchestrator("2.9.0")
+		require.NoError(t, orch.stepVerifyTools(t.Context(), DryRunConfig{ToolsLockAction: ToolsLockActionFail}))
+		svc.AssertNotCalled(t, "InstalledVersion", mock.Anything, mock.Anything)
+	})
+}
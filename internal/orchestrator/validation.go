@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/compozy/releasepr/internal/config"
 	"github.com/compozy/releasepr/internal/logger"
 	"go.uber.org/zap"
 )
@@ -89,3 +90,14 @@ func ValidateEnvironmentVariables(ctx context.Context, requiredVars []string) er
 	}
 	return nil
 }
+
+// ValidateAllowedRepository refuses to change a repository that allowed_repositories does not list,
+// so a workflow copied into another repository cannot release it with an organization-wide token.
+func ValidateAllowedRepository(ctx context.Context) error {
+	cfg := config.FromContext(ctx)
+	if cfg.RepositoryAllowed() {
+		return nil
+	}
+	return fmt.Errorf("refusing to change %s/%s: it is not in allowed_repositories (%s)",
+		cfg.GithubOwner, cfg.GithubRepo, strings.Join(cfg.AllowedRepositories, ", "))
+}
@@ -0,0 +1,39 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAllowedRepository(t *testing.T) {
+	t.Run("Should allow any repository without an allowlist", func(t *testing.T) {
+		require.NoError(t, ValidateAllowedRepository(testReleaseContext(t)))
+	})
+	t.Run("Should allow a listed repository whatever its case", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.AllowedRepositories = []string{"compozy/other", "Compozy/ReleasePR"}
+		require.NoError(t, ValidateAllowedRepository(testReleaseContextWithConfig(t, cfg)))
+	})
+	t.Run("Should refuse to release a repository missing from the allowlist", func(t *testing.T) {
+		t.Setenv("GITHUB_TOKEN", "ghp_test")
+		cfg := testReleaseConfig()
+		cfg.AllowedRepositories = []string{"compozy/other"}
+		ctx := testReleaseContextWithConfig(t, cfg)
+		gitRepo := new(mockGitExtendedRepository)
+		githubRepo := new(mockGithubExtendedRepository)
+		orch := NewPRReleaseOrchestrator(gitRepo, githubRepo, afero.NewMemMapFs(), new(mockCliffService),
+			new(mockNpmService))
+		err := orch.Execute(ctx, PRReleaseConfig{EnableRollback: true})
+		require.ErrorContains(t, err, "refusing to change compozy/releasepr: it is not in allowed_repositories")
+		gitRepo.AssertExpectations(t)
+		githubRepo.AssertExpectations(t)
+		err = newTestPublishOrchestrator(gitRepo, new(mockGoReleaserService)).Execute(ctx, PublishConfig{
+			Version: "v1.2.0",
+		})
+		require.ErrorContains(t, err, "not in allowed_repositories")
+		assert.Empty(t, gitRepo.Calls)
+	})
+}
@@ -0,0 +1,42 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/usecase"
+)
+
+// Values for the version_scheme setting.
+const (
+	VersionSchemeSemver = "semver"
+	VersionSchemeCalVer = "calver"
+)
+
+// versionStrategy returns the strategy of the configured version_scheme. bump fixes the semver bump
+// level, as change files do; CalVer ignores it since its versions follow the release date.
+func (o *PRReleaseOrchestrator) versionStrategy(
+	ctx context.Context,
+	bump domain.BumpLevel,
+) (usecase.VersionStrategy, error) {
+	cfg := config.FromContext(ctx)
+	if !strings.EqualFold(strings.TrimSpace(cfg.VersionScheme), VersionSchemeCalVer) {
+		return &usecase.SemverStrategy{CliffSvc: o.cliffSvc, Bump: bump}, nil
+	}
+	location, err := time.LoadLocation(cfg.ReleaseTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid release_timezone: %w", err)
+	}
+	format := cfg.CalVerFormat
+	if strings.TrimSpace(format) == "" {
+		format = domain.CalVerYearMonthMicro
+	}
+	return &usecase.CalVerStrategy{
+		Format: format,
+		Now:    func() time.Time { return o.now().In(location) },
+	}, nil
+}
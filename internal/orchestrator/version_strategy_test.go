@@ -0,0 +1,36 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRReleaseOrchestrator_versionStrategy(t *testing.T) {
+	t.Run("Should default to the semver strategy", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		strategy, err := orch.versionStrategy(ctx, domain.BumpLevelMinor)
+		require.NoError(t, err)
+		assert.Equal(t, &usecase.SemverStrategy{CliffSvc: orch.cliffSvc, Bump: domain.BumpLevelMinor}, strategy)
+	})
+	t.Run("Should date CalVer releases in the release timezone", func(t *testing.T) {
+		cfg := testReleaseConfig()
+		cfg.VersionScheme = "CalVer"
+		cfg.CalVerFormat = domain.CalVerShortYearMonthDay
+		cfg.ReleaseTimezone = "Asia/Tokyo"
+		ctx := testReleaseContextWithConfig(t, cfg)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		orch.now = func() time.Time { return time.Date(2026, 10, 16, 20, 0, 0, 0, time.UTC) }
+		orch.gitRepo.(*mockGitExtendedRepository).On("LatestTag", mock.Anything).Return("v26.10.16", nil)
+		version, err := orch.calculateVersion(ctx, "v26.10.16")
+		require.NoError(t, err)
+		assert.Equal(t, "v26.10.17", version)
+		orch.cliffSvc.(*mockCliffService).AssertNotCalled(t, "CalculateNextVersion", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,127 @@
+package orchestrator
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	"go.uber.org/zap"
+)
+
+// VersionUpdatersDir is the repository directory holding custom version updater executables.
+const VersionUpdatersDir = ".releasepr/updaters"
+
+const (
+	defaultVersionUpdaterTimeout = 5 * time.Minute
+	executablePermissionBits     = 0o111
+)
+
+// versionUpdaterRunner runs one updater and returns what it printed on stdout.
+type versionUpdaterRunner func(ctx context.Context, updater, oldVersion, newVersion string) ([]byte, error)
+
+func defaultVersionUpdaterRunner(ctx context.Context, updater, oldVersion, newVersion string) ([]byte, error) {
+	workingDirectory, err := releaseArtifactWorkingDirectory()
+	if err != nil {
+		return nil, err
+	}
+	commandCtx, cancel := context.WithTimeout(ctx, defaultVersionUpdaterTimeout)
+	defer cancel()
+	executable := filepath.Join(workingDirectory, filepath.FromSlash(updater))
+	// #nosec G204 -- updaters are executables committed to the repository being released.
+	cmd := exec.CommandContext(commandCtx, executable, oldVersion, newVersion)
+	cmd.Dir = workingDirectory
+	cmd.Env = os.Environ()
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if commandCtx.Err() != nil {
+			return nil, fmt.Errorf("updater timed out after %s: %w", defaultVersionUpdaterTimeout, commandCtx.Err())
+		}
+		return nil, fmt.Errorf("updater failed: %w (output: %s)", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// versionUpdaters lists the executables in the updaters directory in the order they run.
+func (o *PRReleaseOrchestrator) versionUpdaters(ctx context.Context) ([]string, error) {
+	entries, err := afero.ReadDir(o.fsRepo, VersionUpdatersDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", VersionUpdatersDir, err)
+	}
+	updaters := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if entry.Mode()&fs.ModeType != 0 || entry.Mode().Perm()&executablePermissionBits == 0 {
+			o.logger(ctx).Warn("Skipping non-executable version updater", zap.String("file", entry.Name()))
+			continue
+		}
+		updaters = append(updaters, path.Join(VersionUpdatersDir, entry.Name()))
+	}
+	sort.Strings(updaters)
+	return updaters, nil
+}
+
+// runVersionUpdaters runs every custom updater and returns the files they report as modified.
+func (o *PRReleaseOrchestrator) runVersionUpdaters(
+	ctx context.Context,
+	oldVersion, newVersion string,
+) ([]string, error) {
+	updaters, err := o.versionUpdaters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, updater := range updaters {
+		o.logger(ctx).Info("Running version updater", zap.String("updater", updater))
+		output, err := o.updaterRunner(ctx, updater, oldVersion, newVersion)
+		if err != nil {
+			return nil, fmt.Errorf("version updater %s failed: %w", updater, err)
+		}
+		reported, err := parseUpdaterOutput(output)
+		if err != nil {
+			return nil, fmt.Errorf("version updater %s: %w", updater, err)
+		}
+		files = append(files, reported...)
+	}
+	return files, nil
+}
+
+// parseUpdaterOutput reads one repository-relative path per non-empty line.
+func parseUpdaterOutput(output []byte) ([]string, error) {
+	var files []string
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if filepath.IsAbs(line) || strings.HasPrefix(line, "/") {
+			return nil, fmt.Errorf("reported path %q must be relative to the repository root", line)
+		}
+		cleaned := path.Clean(filepath.ToSlash(line))
+		if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return nil, fmt.Errorf("reported path %q is outside the repository", line)
+		}
+		files = append(files, cleaned)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read updater output: %w", err)
+	}
+	return files, nil
+}
@@ -0,0 +1,109 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newVersionUpdaterTestOrchestrator(t *testing.T) (*PRReleaseOrchestrator, afero.Fs) {
+	t.Helper()
+	fsRepo := afero.NewMemMapFs()
+	orch := NewPRReleaseOrchestrator(
+		new(mockGitExtendedRepository),
+		new(mockGithubExtendedRepository),
+		fsRepo,
+		new(mockCliffService),
+		new(mockNpmService),
+	)
+	return orch, fsRepo
+}
+
+func TestPRReleaseOrchestrator_runVersionUpdaters(t *testing.T) {
+	t.Run("Should run executable updaters in name order with old and new versions", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, afero.WriteFile(fsRepo, ".releasepr/updaters/20-helm", []byte("#!/bin/sh\n"), 0755))
+		require.NoError(t, afero.WriteFile(fsRepo, ".releasepr/updaters/10-docs", []byte("#!/bin/sh\n"), 0755))
+		require.NoError(t, afero.WriteFile(fsRepo, ".releasepr/updaters/README.md", []byte("notes\n"), 0644))
+		var calls []string
+		orch.updaterRunner = func(_ context.Context, updater, oldVersion, newVersion string) ([]byte, error) {
+			calls = append(calls, updater)
+			assert.Equal(t, "v1.1.0", oldVersion)
+			assert.Equal(t, "v1.2.0", newVersion)
+			if updater == ".releasepr/updaters/10-docs" {
+				return []byte("docs/install.md\n\n./docs/version.txt\n"), nil
+			}
+			return []byte("charts/app/Chart.yaml\n"), nil
+		}
+
+		files, err := orch.runVersionUpdaters(ctx, "v1.1.0", "v1.2.0")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{".releasepr/updaters/10-docs", ".releasepr/updaters/20-helm"}, calls)
+		assert.Equal(t, []string{"docs/install.md", "docs/version.txt", "charts/app/Chart.yaml"}, files)
+	})
+
+	t.Run("Should do nothing when the updaters directory is missing", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, _ := newVersionUpdaterTestOrchestrator(t)
+		orch.updaterRunner = func(context.Context, string, string, string) ([]byte, error) {
+			t.Fatal("updater runner must not be called")
+			return nil, nil
+		}
+
+		files, err := orch.runVersionUpdaters(ctx, "v1.1.0", "v1.2.0")
+
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+
+	t.Run("Should fail when an updater fails", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, afero.WriteFile(fsRepo, ".releasepr/updaters/bump", []byte("#!/bin/sh\n"), 0755))
+		orch.updaterRunner = func(context.Context, string, string, string) ([]byte, error) {
+			return nil, errors.New("exit status 1")
+		}
+
+		_, err := orch.runVersionUpdaters(ctx, "v1.1.0", "v1.2.0")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "version updater .releasepr/updaters/bump failed")
+	})
+
+	t.Run("Should reject reported paths outside the repository", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, afero.WriteFile(fsRepo, ".releasepr/updaters/bump", []byte("#!/bin/sh\n"), 0755))
+		orch.updaterRunner = func(context.Context, string, string, string) ([]byte, error) {
+			return []byte("../outside.txt\n"), nil
+		}
+
+		_, err := orch.runVersionUpdaters(ctx, "v1.1.0", "v1.2.0")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "outside the repository")
+	})
+}
+
+func TestPRReleaseOrchestrator_updatePackageVersionsWithUpdaters(t *testing.T) {
+	t.Run("Should include files reported by updaters alongside package.json", func(t *testing.T) {
+		ctx := testReleaseContext(t)
+		orch, fsRepo := newVersionUpdaterTestOrchestrator(t)
+		require.NoError(t, afero.WriteFile(fsRepo, "package.json", []byte(`{"version":"1.1.0"}`), 0644))
+		require.NoError(t, afero.WriteFile(fsRepo, ".releasepr/updaters/bump", []byte("#!/bin/sh\n"), 0755))
+		orch.updaterRunner = func(context.Context, string, string, string) ([]byte, error) {
+			return []byte("VERSION\n"), nil
+		}
+
+		files, err := orch.updatePackageVersions(ctx, "v1.2.0", "v1.1.0")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"package.json", "VERSION"}, files)
+	})
+}
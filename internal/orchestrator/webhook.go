@@ -0,0 +1,57 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/compozy/releasepr/internal/repository"
+	"go.uber.org/zap"
+)
+
+// WebhookOrchestrator runs the release workflows triggered by GitHub webhook events.
+type WebhookOrchestrator struct {
+	gitRepo     repository.GitExtendedRepository
+	prOrch      *PRReleaseOrchestrator
+	publishOrch *PublishOrchestrator
+}
+
+// NewWebhookOrchestrator creates a new WebhookOrchestrator.
+func NewWebhookOrchestrator(
+	gitRepo repository.GitExtendedRepository,
+	prOrch *PRReleaseOrchestrator,
+	publishOrch *PublishOrchestrator,
+) *WebhookOrchestrator {
+	return &WebhookOrchestrator{
+		gitRepo:     gitRepo,
+		prOrch:      prOrch,
+		publishOrch: publishOrch,
+	}
+}
+
+func (o *WebhookOrchestrator) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx).Named("orchestrator.webhook")
+}
+
+// ReleasePR syncs the pushed branch and creates or updates the release pull request when it has
+// releasable changes, rolling back automatically on failure.
+func (o *WebhookOrchestrator) ReleasePR(ctx context.Context, branch string) error {
+	if err := o.gitRepo.SyncBranch(ctx, branch); err != nil {
+		return fmt.Errorf("failed to sync %s: %w", branch, err)
+	}
+	o.logger(ctx).Info("Running release PR workflow", zap.String("branch", branch))
+	return o.prOrch.Execute(ctx, PRReleaseConfig{EnableRollback: true})
+}
+
+// Publish syncs the base branch, then tags and publishes the merged release commit.
+func (o *WebhookOrchestrator) Publish(ctx context.Context, branch, version, commit string) error {
+	if err := o.gitRepo.SyncBranch(ctx, branch); err != nil {
+		return fmt.Errorf("failed to sync %s: %w", branch, err)
+	}
+	o.logger(ctx).Info("Publishing merged release",
+		zap.String("branch", branch),
+		zap.String("version", version),
+		zap.String("commit", commit),
+	)
+	return o.publishOrch.Execute(ctx, PublishConfig{Version: version, Ref: commit})
+}
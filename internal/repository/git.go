@@ -7,6 +7,8 @@ import "context"
 type GitRepository interface {
 	LatestTag(ctx context.Context) (string, error)
 	CommitsSinceTag(ctx context.Context, tag string) (int, error)
+	// CommitSubjectsSinceTag returns the subject lines of the commits since tag, newest first.
+	CommitSubjectsSinceTag(ctx context.Context, tag string) ([]string, error)
 	TagExists(ctx context.Context, tag string) (bool, error)
 	CreateBranch(ctx context.Context, name string) error
 	CreateTag(ctx context.Context, tag, msg string) error
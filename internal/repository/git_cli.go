@@ -0,0 +1,863 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+const (
+	gitCLICommandTimeout  = 2 * time.Minute
+	gitCLICheckoutTimeout = 5 * time.Minute
+	gitCLIFetchTimeout    = 30 * time.Second
+	// gitlinkMode is the index mode of submodule entries.
+	gitlinkMode = "160000"
+)
+
+// gitCLIRepository implements GitExtendedRepository by shelling out to the system git binary.
+// It covers features go-git does not support (sparse checkouts, partial clones, exotic refspecs).
+type gitCLIRepository struct {
+	dir                string
+	pushTimeoutMinutes int
+	remoteName         string
+	tags               *tagFetchPolicy
+}
+
+// NewGitCLIRepository creates a GitExtendedRepository backed by the native git CLI that fetches
+// remote tags according to tagFetch.
+func NewGitCLIRepository(remoteName string, timeoutMinutes int, tagFetch string) (GitExtendedRepository, error) {
+	tags, err := newTagFetchPolicy(tagFetch)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, fmt.Errorf("git executable not found: %w", err)
+	}
+	output, err := exec.Command("git", "rev-parse", "--show-toplevel").CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open git repository: %w (output: %s)", err, strings.TrimSpace(string(output)))
+	}
+	if timeoutMinutes < 1 {
+		timeoutMinutes = 2
+	}
+	return &gitCLIRepository{
+		dir:                strings.TrimSpace(string(output)),
+		pushTimeoutMinutes: timeoutMinutes,
+		remoteName:         remoteName,
+		tags:               tags,
+	}, nil
+}
+
+// remote returns the configured remote name, defaulting to origin.
+func (r *gitCLIRepository) remote() string {
+	if r.remoteName == "" {
+		return DefaultGitRemote
+	}
+	return r.remoteName
+}
+
+// run executes a git command in the repository directory and returns its trimmed combined output.
+func (r *gitCLIRepository) run(ctx context.Context, timeout time.Duration, args ...string) (string, error) {
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	cmd := exec.CommandContext(runCtx, "git", args...)
+	cmd.Dir = r.dir
+	cmd.Env = append(append(os.Environ(), "GIT_TERMINAL_PROMPT=0"), gitTransportEnv...)
+	output, err := cmd.CombinedOutput()
+	return strings.TrimSpace(string(output)), err
+}
+
+// authenticatedRemoteURL resolves the remote URL with embedded credentials when a token is available.
+func (r *gitCLIRepository) authenticatedRemoteURL(ctx context.Context) (string, *http.BasicAuth, error) {
+	rawURL, err := r.run(ctx, gitCLICommandTimeout, "remote", "get-url", r.remote())
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get remote '%s': %w (output: %s)", r.remote(), err, rawURL)
+	}
+	return authenticateRemoteURL(rawURL)
+}
+
+// push runs git push against the authenticated remote URL and sanitizes any failure output.
+func (r *gitCLIRepository) push(ctx context.Context, timeout time.Duration, force bool, refSpec string) error {
+	authURL, auth, err := r.authenticatedRemoteURL(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to prepare authenticated URL for push: %w", err)
+	}
+	args := []string{"push"}
+	if force {
+		args = append(args, "--force")
+	}
+	output, err := r.run(ctx, timeout, append(args, authURL, refSpec)...)
+	if err != nil {
+		return fmt.Errorf("%w (output: %s)", err, sanitizeOutput(output, authURL, auth))
+	}
+	return nil
+}
+
+// fetchTags fetches tags from the remote with a short timeout.
+func (r *gitCLIRepository) fetchTags(ctx context.Context) error {
+	authURL, auth, err := r.authenticatedRemoteURL(ctx)
+	if err != nil {
+		return err
+	}
+	output, err := r.run(ctx, gitCLIFetchTimeout, "fetch", "--quiet", authURL, "+refs/tags/*:refs/tags/*")
+	if err != nil {
+		return fmt.Errorf("failed to fetch tags from remote: %w (output: %s)", err, sanitizeOutput(output, authURL, auth))
+	}
+	return nil
+}
+
+// LatestTag returns the tag pointing at the most recently committed commit.
+func (r *gitCLIRepository) LatestTag(ctx context.Context) (string, error) {
+	fetch := func() error { return r.fetchTags(ctx) }
+	//nolint:errcheck // We intentionally ignore the error as local tags are sufficient
+	_ = r.tags.beforeRead(fetch)
+	latest, err := r.latestLocalTag(ctx)
+	if err != nil || latest != "" {
+		return latest, err
+	}
+	if fetched, err := r.tags.onMiss(fetch); !fetched || err != nil {
+		return "", nil
+	}
+	return r.latestLocalTag(ctx)
+}
+
+// latestLocalTag returns the local tag pointing at the most recently committed commit.
+func (r *gitCLIRepository) latestLocalTag(ctx context.Context) (string, error) {
+	output, err := r.run(
+		ctx,
+		gitCLICommandTimeout,
+		"for-each-ref",
+		"--format=%(refname:short) %(*committerdate:unix) %(committerdate:unix)",
+		"refs/tags",
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tags: %w (output: %s)", err, output)
+	}
+	var latestTag string
+	var latestCommitTime int64
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		// Annotated tags report the dereferenced commit date first; lightweight tags only the commit date.
+		commitTime, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		if latestTag == "" || commitTime > latestCommitTime {
+			latestCommitTime = commitTime
+			latestTag = fields[0]
+		}
+	}
+	return latestTag, nil
+}
+
+// CommitsSinceTag returns the number of commits since the given tag.
+func (r *gitCLIRepository) CommitsSinceTag(ctx context.Context, tag string) (int, error) {
+	exists, err := r.TagExists(ctx, tag)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		if _, err := r.tags.onMiss(func() error { return r.fetchTags(ctx) }); err != nil {
+			return 0, fmt.Errorf("failed to get tag %s: %w", tag, err)
+		}
+	}
+	output, err := r.run(ctx, gitCLICommandTimeout, "rev-list", "--count", "refs/tags/"+tag+"..HEAD")
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve tag %s: %w (output: %s)", tag, err, output)
+	}
+	count, err := strconv.Atoi(output)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse commit count %q: %w", output, err)
+	}
+	return count, nil
+}
+
+// CommitSubjectsSinceTag returns the subject lines of the commits since the given tag, newest first.
+func (r *gitCLIRepository) CommitSubjectsSinceTag(ctx context.Context, tag string) ([]string, error) {
+	if _, err := r.CommitsSinceTag(ctx, tag); err != nil {
+		return nil, err
+	}
+	output, err := r.run(ctx, gitCLICommandTimeout, "log", "--format=%s", "refs/tags/"+tag+"..HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits since tag %s: %w (output: %s)", tag, err, output)
+	}
+	if output == "" {
+		return nil, nil
+	}
+	return strings.Split(output, "\n"), nil
+}
+
+// CommitMessagesSinceTag returns the full messages of the commits since the given tag, newest first.
+// An empty tag covers the whole history.
+func (r *gitCLIRepository) CommitMessagesSinceTag(ctx context.Context, tag string) ([]string, error) {
+	revision := "HEAD"
+	if tag != "" {
+		if _, err := r.CommitsSinceTag(ctx, tag); err != nil {
+			return nil, err
+		}
+		revision = "refs/tags/" + tag + "..HEAD"
+	}
+	output, err := r.run(ctx, gitCLICommandTimeout, "log", "--format=%B%x00", revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits since tag %s: %w (output: %s)", tag, err, output)
+	}
+	var messages []string
+	for message := range strings.SplitSeq(output, "\x00") {
+		if message = strings.TrimSpace(message); message != "" {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+// ReleaseStats computes commit, contributor and diff statistics for the commits since tag with git
+// log and git diff. Contributors are counted by lowercased author email, or name when it is empty,
+// and renames are detected like the go-git backend does.
+func (r *gitCLIRepository) ReleaseStats(ctx context.Context, tag string) (domain.ReleaseStats, error) {
+	var stats domain.ReleaseStats
+	since, err := r.tagCommit(ctx, tag)
+	if err != nil {
+		return stats, err
+	}
+	revision := "HEAD"
+	if !since.IsZero() {
+		revision = since.String() + "..HEAD"
+	}
+	output, err := r.run(ctx, gitCLICommandTimeout, "log", "--format=%ae%x00%an", revision)
+	if err != nil {
+		return stats, fmt.Errorf("failed to get commits: %w (output: %s)", err, output)
+	}
+	authors := make(map[string]struct{})
+	for line := range strings.SplitSeq(output, "\n") {
+		if line == "" {
+			continue
+		}
+		email, name, _ := strings.Cut(line, "\x00")
+		stats.Commits++
+		author := strings.ToLower(strings.TrimSpace(email))
+		if author == "" {
+			author = name
+		}
+		authors[author] = struct{}{}
+	}
+	stats.Contributors = len(authors)
+	from := since.String()
+	if since.IsZero() {
+		if from, err = r.emptyTree(ctx); err != nil {
+			return stats, err
+		}
+	}
+	fileStats, err := r.diffStats(ctx, from, "HEAD")
+	if err != nil {
+		return stats, err
+	}
+	for _, fileStat := range fileStats {
+		stats.FilesChanged++
+		stats.Insertions += fileStat.Insertions
+		stats.Deletions += fileStat.Deletions
+	}
+	return stats, nil
+}
+
+// emptyTree returns the ID of the empty tree in the object format of the repository.
+func (r *gitCLIRepository) emptyTree(ctx context.Context) (string, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout, "hash-object", "-t", "tree", "--stdin")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the empty tree: %w (output: %s)", err, output)
+	}
+	return output, nil
+}
+
+// diffStats returns the per-file insertions and deletions between the trees of from and to. A renamed
+// file is reported as "from => to", and a binary file with no line changes, as go-git reports them.
+func (r *gitCLIRepository) diffStats(ctx context.Context, from, to string) ([]domain.FileDiffStat, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout, "diff", "--numstat", "-z", "--find-renames=60%",
+		"--ignore-submodules", from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff trees: %w (output: %s)", err, output)
+	}
+	fields := strings.Split(output, "\x00")
+	stats := make([]domain.FileDiffStat, 0, len(fields))
+	for i := 0; i < len(fields); i++ {
+		counts := strings.SplitN(fields[i], "\t", 3)
+		if len(counts) != 3 {
+			continue
+		}
+		path := counts[2]
+		if path == "" && i+2 < len(fields) {
+			// Renames list their source and destination as the next two fields
+			path = fields[i+1] + " => " + fields[i+2]
+			i += 2
+		}
+		insertions, _ := strconv.Atoi(counts[0])
+		deletions, _ := strconv.Atoi(counts[1])
+		stats = append(stats, domain.FileDiffStat{Path: path, Insertions: insertions, Deletions: deletions})
+	}
+	return stats, nil
+}
+
+// tagCommit resolves tag to its commit, fetching tags when it is missing locally.
+// An empty tag resolves to the zero hash.
+func (r *gitCLIRepository) tagCommit(ctx context.Context, tag string) (plumbing.Hash, error) {
+	if tag == "" {
+		return plumbing.ZeroHash, nil
+	}
+	exists, err := r.TagExists(ctx, tag)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if !exists {
+		if _, err := r.tags.onMiss(func() error { return r.fetchTags(ctx) }); err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("failed to get tag %s: %w", tag, err)
+		}
+	}
+	output, err := r.run(ctx, gitCLICommandTimeout, "rev-parse", "refs/tags/"+tag+"^{commit}")
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to resolve tag %s: %w (output: %s)", tag, err, output)
+	}
+	return plumbing.NewHash(output), nil
+}
+
+// SubmoduleUpdates lists the submodule pointers that moved between tag and HEAD, comparing the
+// gitlinks git ls-tree lists at both commits.
+func (r *gitCLIRepository) SubmoduleUpdates(ctx context.Context, tag string) (domain.SubmoduleUpdates, error) {
+	since, err := r.tagCommit(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+	current, err := r.gitlinks(ctx, "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	previous := make(map[string]string)
+	if !since.IsZero() {
+		if previous, err = r.gitlinks(ctx, since.String()); err != nil {
+			return nil, err
+		}
+	}
+	var updates domain.SubmoduleUpdates
+	for path, to := range current {
+		if from := previous[path]; from != to {
+			updates = append(updates, domain.SubmoduleUpdate{Path: path, From: from, To: to})
+		}
+	}
+	for path, from := range previous {
+		if _, ok := current[path]; !ok {
+			updates = append(updates, domain.SubmoduleUpdate{Path: path, From: from})
+		}
+	}
+	sort.Slice(updates, func(i, j int) bool { return updates[i].Path < updates[j].Path })
+	return updates, nil
+}
+
+// gitlinks maps the path of every submodule in the tree of revision to the commit it points at.
+func (r *gitCLIRepository) gitlinks(ctx context.Context, revision string) (map[string]string, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout, "ls-tree", "-r", "-z", revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the tree of %s: %w (output: %s)", revision, err, output)
+	}
+	links := make(map[string]string)
+	for entry := range strings.SplitSeq(output, "\x00") {
+		meta, path, ok := strings.Cut(entry, "\t")
+		fields := strings.Fields(meta)
+		if ok && len(fields) == 3 && fields[0] == gitlinkMode {
+			links[path] = fields[2]
+		}
+	}
+	return links, nil
+}
+
+// BumpSubmodules checks out the highest semver tag of every submodule and stages the new pointers.
+func (r *gitCLIRepository) BumpSubmodules(ctx context.Context) (domain.SubmoduleUpdates, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout, "ls-files", "--stage")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list submodules: %w (output: %s)", err, output)
+	}
+	var updates domain.SubmoduleUpdates
+	for _, line := range strings.Split(output, "\n") {
+		meta, path, ok := strings.Cut(line, "\t")
+		fields := strings.Fields(meta)
+		if !ok || len(fields) < 2 || fields[0] != gitlinkMode {
+			continue
+		}
+		update, err := r.bumpSubmodule(ctx, path, fields[1])
+		if err != nil {
+			return updates, err
+		}
+		if update.To != update.From {
+			updates = append(updates, update)
+		}
+	}
+	return updates, nil
+}
+
+// bumpSubmodule moves the submodule at path from commit current to its highest stable semver tag.
+func (r *gitCLIRepository) bumpSubmodule(ctx context.Context, path, current string) (domain.SubmoduleUpdate, error) {
+	update := domain.SubmoduleUpdate{Path: path, From: current, To: current}
+	dir := filepath.Join(r.dir, path)
+	if _, err := os.Stat(filepath.Join(dir, ".git")); err != nil {
+		return update, fmt.Errorf("submodule %s is not initialized, run git submodule update --init", path)
+	}
+	sub := &gitCLIRepository{dir: dir}
+	if err := sub.fetchTags(ctx); err != nil {
+		return update, fmt.Errorf("failed to fetch tags of submodule %s: %w", path, err)
+	}
+	output, err := sub.run(ctx, gitCLICommandTimeout, "tag", "--list")
+	if err != nil {
+		return update, fmt.Errorf("failed to list tags of submodule %s: %w (output: %s)", path, err, output)
+	}
+	var latest *domain.Version
+	for _, tag := range strings.Fields(output) {
+		version, err := domain.NewVersion(tag)
+		if err != nil || version.Prerelease() != "" {
+			continue
+		}
+		if latest == nil || version.Compare(latest) > 0 {
+			latest, update.Tag = version, tag
+		}
+	}
+	if latest == nil {
+		return update, nil
+	}
+	target, err := sub.tagCommit(ctx, update.Tag)
+	if err != nil {
+		return update, fmt.Errorf("submodule %s: %w", path, err)
+	}
+	update.To = target.String()
+	if update.To == current {
+		return update, nil
+	}
+	if output, err := sub.run(ctx, gitCLICheckoutTimeout, "checkout", "--quiet", update.Tag); err != nil {
+		return update, fmt.Errorf("failed to check out %s in submodule %s: %w (output: %s)",
+			update.Tag, path, err, output)
+	}
+	if output, err := r.run(ctx, gitCLICommandTimeout, "add", "--", path); err != nil {
+		return update, fmt.Errorf("failed to stage submodule %s: %w (output: %s)", path, err, output)
+	}
+	return update, nil
+}
+
+// HeadCommitDiff returns the per-file diff of HEAD against its first parent, or against the empty
+// tree for a root commit.
+func (r *gitCLIRepository) HeadCommitDiff(ctx context.Context) ([]domain.FileDiffStat, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout, "rev-list", "--parents", "-n", "1", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD commit: %w (output: %s)", err, output)
+	}
+	commits := strings.Fields(output)
+	if len(commits) > 1 {
+		return r.diffStats(ctx, commits[1], "HEAD")
+	}
+	emptyTree, err := r.emptyTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.diffStats(ctx, emptyTree, "HEAD")
+}
+
+// TagExists checks if a tag exists.
+func (r *gitCLIRepository) TagExists(ctx context.Context, tag string) (bool, error) {
+	return r.refExists(ctx, "refs/tags/"+tag)
+}
+
+func (r *gitCLIRepository) refExists(ctx context.Context, ref string) (bool, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout, "rev-parse", "--quiet", "--verify", ref)
+	if err == nil {
+		return true, nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to check ref %s: %w (output: %s)", ref, err, output)
+}
+
+// CreateBranch creates a new branch at HEAD.
+func (r *gitCLIRepository) CreateBranch(ctx context.Context, name string) error {
+	exists, err := r.refExists(ctx, "refs/heads/"+name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("branch %s already exists", name)
+	}
+	if output, err := r.run(ctx, gitCLICommandTimeout, "branch", name); err != nil {
+		return fmt.Errorf("failed to create branch %s: %w (output: %s)", name, err, output)
+	}
+	return nil
+}
+
+// CreateTag creates a new annotated tag at HEAD.
+func (r *gitCLIRepository) CreateTag(ctx context.Context, tag, msg string) error {
+	if output, err := r.run(ctx, gitCLICommandTimeout, "tag", "--annotate", "--cleanup=whitespace", tag,
+		"--message", msg); err != nil {
+		return fmt.Errorf("failed to create tag %s: %w (output: %s)", tag, err, output)
+	}
+	return nil
+}
+
+// PushTag pushes a tag to the remote.
+func (r *gitCLIRepository) PushTag(ctx context.Context, tag string) error {
+	refSpec := fmt.Sprintf("refs/tags/%s:refs/tags/%s", tag, tag)
+	if err := r.push(ctx, gitCLICommandTimeout, false, refSpec); err != nil {
+		return fmt.Errorf("failed to push tag %s: %w", tag, err)
+	}
+	return nil
+}
+
+// TagCommit returns the SHA of the commit tag points to.
+func (r *gitCLIRepository) TagCommit(ctx context.Context, tag string) (string, error) {
+	hash, err := r.tagCommit(ctx, tag)
+	if err != nil {
+		return "", err
+	}
+	return hash.String(), nil
+}
+
+// ReleaseTags returns every local tag with its release date, oldest first. The creator date is the
+// tagger date of annotated tags and the commit date of lightweight tags.
+func (r *gitCLIRepository) ReleaseTags(ctx context.Context) ([]domain.ReleaseTag, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout,
+		"for-each-ref", "--sort=creatordate", "--format=%(refname:short) %(creatordate:unix)", "refs/tags")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w (output: %s)", err, output)
+	}
+	var tags []domain.ReleaseTag
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		seconds, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date of tag %s: %w", fields[0], err)
+		}
+		tags = append(tags, domain.ReleaseTag{Name: fields[0], Date: time.Unix(seconds, 0)})
+	}
+	return tags, nil
+}
+
+// TagAnnotation returns the message of tag and true when it is annotated, or false for a lightweight tag.
+func (r *gitCLIRepository) TagAnnotation(ctx context.Context, tag string) (string, bool, error) {
+	objectType, err := r.run(ctx, gitCLICommandTimeout, "cat-file", "-t", "refs/tags/"+tag)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read tag %s: %w (output: %s)", tag, err, objectType)
+	}
+	if objectType != "tag" {
+		return "", false, nil
+	}
+	msg, err := r.run(ctx, gitCLICommandTimeout, "for-each-ref", "--format=%(contents)", "refs/tags/"+tag)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read annotation of tag %s: %w (output: %s)", tag, err, msg)
+	}
+	return msg, true, nil
+}
+
+// CreateTagForce creates the annotated tag at commit, or at HEAD when commit is empty, replacing an existing tag.
+// An empty msg creates a lightweight tag.
+func (r *gitCLIRepository) CreateTagForce(ctx context.Context, tag, commit, msg string) error {
+	args := []string{"tag", "--force", tag}
+	if msg != "" {
+		args = append(args, "--annotate", "--cleanup=whitespace", "--message", msg)
+	}
+	if commit != "" {
+		args = append(args, commit)
+	}
+	if output, err := r.run(ctx, gitCLICommandTimeout, args...); err != nil {
+		return fmt.Errorf("failed to force create tag %s: %w (output: %s)", tag, err, output)
+	}
+	return nil
+}
+
+// PushTagForce pushes a tag to the remote with force.
+func (r *gitCLIRepository) PushTagForce(ctx context.Context, tag string) error {
+	refSpec := fmt.Sprintf("refs/tags/%s:refs/tags/%s", tag, tag)
+	if err := r.push(ctx, gitCLICommandTimeout, true, refSpec); err != nil {
+		return fmt.Errorf("failed to force push tag %s: %w", tag, err)
+	}
+	return nil
+}
+
+// PushBranch pushes a branch to the remote.
+func (r *gitCLIRepository) PushBranch(ctx context.Context, name string) error {
+	refSpec := fmt.Sprintf("refs/heads/%s:refs/heads/%s", name, name)
+	if err := r.push(ctx, time.Duration(r.pushTimeoutMinutes)*time.Minute, false, refSpec); err != nil {
+		return fmt.Errorf("failed to push branch %s: %w", name, err)
+	}
+	return nil
+}
+
+// PushBranchForce pushes a branch to the remote with force.
+func (r *gitCLIRepository) PushBranchForce(ctx context.Context, name string) error {
+	refSpec := fmt.Sprintf("refs/heads/%s:refs/heads/%s", name, name)
+	if err := r.push(ctx, time.Duration(r.pushTimeoutMinutes)*time.Minute, true, refSpec); err != nil {
+		return fmt.Errorf("failed to force push branch %s: %w", name, err)
+	}
+	return nil
+}
+
+// CheckoutBranch switches to the specified branch.
+func (r *gitCLIRepository) CheckoutBranch(ctx context.Context, name string) error {
+	if output, err := r.run(ctx, gitCLICheckoutTimeout, "checkout", name); err != nil {
+		return fmt.Errorf("failed to checkout branch %s: %w (output: %s)", name, err, output)
+	}
+	return nil
+}
+
+// SyncBranch fetches a branch from the remote, checks it out, and hard-resets it to the remote head.
+func (r *gitCLIRepository) SyncBranch(ctx context.Context, name string) error {
+	authURL, auth, err := r.authenticatedRemoteURL(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to prepare authenticated URL for fetch: %w", err)
+	}
+	remoteRef := fmt.Sprintf("refs/remotes/%s/%s", r.remote(), name)
+	refSpec := fmt.Sprintf("+refs/heads/%s:%s", name, remoteRef)
+	if output, err := r.run(ctx, gitCLICheckoutTimeout, "fetch", "--quiet", authURL, refSpec); err != nil {
+		return fmt.Errorf("failed to fetch branch %s: %w (output: %s)", name, err, sanitizeOutput(output, authURL, auth))
+	}
+	if output, err := r.run(ctx, gitCLICheckoutTimeout, "checkout", "-B", name, remoteRef); err != nil {
+		return fmt.Errorf("failed to checkout branch %s: %w (output: %s)", name, err, output)
+	}
+	return r.ResetHard(ctx, remoteRef)
+}
+
+// ConfigureUser sets the repository-local git user configuration.
+func (r *gitCLIRepository) ConfigureUser(ctx context.Context, name, email string) error {
+	if output, err := r.run(ctx, gitCLICommandTimeout, "config", "user.name", name); err != nil {
+		return fmt.Errorf("failed to set user.name: %w (output: %s)", err, output)
+	}
+	if output, err := r.run(ctx, gitCLICommandTimeout, "config", "user.email", email); err != nil {
+		return fmt.Errorf("failed to set user.email: %w (output: %s)", err, output)
+	}
+	return nil
+}
+
+// AddFiles stages files matching the pattern; patterns that match nothing are ignored.
+func (r *gitCLIRepository) AddFiles(ctx context.Context, pattern string) error {
+	output, err := r.run(ctx, gitCLICommandTimeout, "add", "--", pattern)
+	if err != nil && !strings.Contains(output, "did not match any files") {
+		return fmt.Errorf("failed to add files with pattern %s: %w (output: %s)", pattern, err, output)
+	}
+	return nil
+}
+
+// Commit creates a commit with the given message, even when nothing is staged.
+func (r *gitCLIRepository) Commit(ctx context.Context, message string) error {
+	if output, err := r.run(ctx, gitCLICommandTimeout, "commit", "--allow-empty", "--message", message); err != nil {
+		return fmt.Errorf("failed to create commit: %w (output: %s)", err, output)
+	}
+	return nil
+}
+
+// GetHeadCommit returns the SHA of the current HEAD commit.
+func (r *gitCLIRepository) GetHeadCommit(ctx context.Context) (string, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout, "rev-parse", "--verify", "--quiet", "HEAD")
+	if err != nil && output == "" {
+		return "", ErrEmptyRepository
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get HEAD: %w (output: %s)", err, output)
+	}
+	return output, nil
+}
+
+// GetCurrentBranch returns the name of the current branch.
+func (r *gitCLIRepository) GetCurrentBranch(ctx context.Context) (string, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		if unborn, refErr := r.run(ctx, gitCLICommandTimeout, "symbolic-ref", "--short", "HEAD"); refErr == nil {
+			return unborn, nil
+		}
+		return "", fmt.Errorf("failed to get HEAD: %w (output: %s)", err, output)
+	}
+	return output, nil
+}
+
+// DeleteBranch deletes a local branch.
+func (r *gitCLIRepository) DeleteBranch(ctx context.Context, name string) error {
+	if output, err := r.run(ctx, gitCLICommandTimeout, "branch", "-D", name); err != nil {
+		return fmt.Errorf("failed to delete branch %s: %w (output: %s)", name, err, output)
+	}
+	return nil
+}
+
+// DeleteRemoteBranch deletes a remote branch.
+func (r *gitCLIRepository) DeleteRemoteBranch(ctx context.Context, name string) error {
+	if err := r.push(ctx, gitCLICommandTimeout, false, ":refs/heads/"+name); err != nil {
+		return fmt.Errorf("failed to delete remote branch %s: %w", name, err)
+	}
+	return nil
+}
+
+// ListLocalBranches returns a list of all local branch names.
+func (r *gitCLIRepository) ListLocalBranches(ctx context.Context) ([]string, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout, "for-each-ref", "--format=%(refname:short)", "refs/heads")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w (output: %s)", err, output)
+	}
+	var branches []string
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			branches = append(branches, line)
+		}
+	}
+	return branches, nil
+}
+
+// remoteHeads returns branch names advertised by the remote, optionally filtered to the refs matching
+// the ls-remote patterns.
+func (r *gitCLIRepository) remoteHeads(ctx context.Context, refs ...string) ([]string, error) {
+	authURL, auth, err := r.authenticatedRemoteURL(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get remote: %w", err)
+	}
+	args := append([]string{"ls-remote", "--heads", authURL}, refs...)
+	output, err := r.run(ctx, gitCLICommandTimeout, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote refs: %w (output: %s)", err, sanitizeOutput(output, authURL, auth))
+	}
+	var branches []string
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		branches = append(branches, strings.TrimPrefix(fields[1], "refs/heads/"))
+	}
+	sort.Strings(branches)
+	return branches, nil
+}
+
+// ListRemoteBranches returns the remote branch names starting with prefix in "<remote>/<branch>" form.
+// The prefix is sent to ls-remote as a pattern so the remote only advertises the matching heads.
+func (r *gitCLIRepository) ListRemoteBranches(ctx context.Context, prefix string) ([]string, error) {
+	var patterns []string
+	if prefix != "" {
+		patterns = append(patterns, "refs/heads/"+prefix+"*")
+	}
+	heads, err := r.remoteHeads(ctx, patterns...)
+	if err != nil {
+		return nil, err
+	}
+	branches := make([]string, 0, len(heads))
+	for _, head := range heads {
+		if strings.HasPrefix(head, prefix) {
+			branches = append(branches, r.remote()+"/"+head)
+		}
+	}
+	return branches, nil
+}
+
+// RemoteBranchExists checks if a specific branch exists on the remote.
+func (r *gitCLIRepository) RemoteBranchExists(ctx context.Context, branchName string) (bool, error) {
+	heads, err := r.remoteHeads(ctx, "refs/heads/"+branchName)
+	if err != nil {
+		return false, err
+	}
+	for _, head := range heads {
+		if head == branchName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MoveFile moves a tracked file so rename state is preserved.
+func (r *gitCLIRepository) MoveFile(ctx context.Context, from, to string) error {
+	if output, err := r.run(ctx, gitCLICommandTimeout, "mv", from, to); err != nil {
+		return fmt.Errorf("failed to move file from %s to %s: %w (output: %s)", from, to, err, output)
+	}
+	return nil
+}
+
+// RemoveFile deletes a tracked file from the worktree and the index.
+func (r *gitCLIRepository) RemoveFile(ctx context.Context, path string) error {
+	if output, err := r.run(ctx, gitCLICommandTimeout, "rm", "--quiet", "--", path); err != nil {
+		return fmt.Errorf("failed to remove file %s: %w (output: %s)", path, err, output)
+	}
+	return nil
+}
+
+// RestoreFile restores a file in the index and the working tree to its state in HEAD, undoing staged
+// moves and deletions and removing a file HEAD does not have.
+func (r *gitCLIRepository) RestoreFile(ctx context.Context, path string) error {
+	args := []string{"restore", "--source=HEAD", "--staged", "--worktree", "--", path}
+	if output, err := r.run(ctx, gitCLICommandTimeout, args...); err != nil {
+		return fmt.Errorf("failed to restore file %s: %w (output: %s)", path, err, output)
+	}
+	return nil
+}
+
+// ResetHard performs a hard reset to the specified reference.
+func (r *gitCLIRepository) ResetHard(ctx context.Context, ref string) error {
+	if output, err := r.run(ctx, gitCLICommandTimeout, "reset", "--hard", ref); err != nil {
+		return fmt.Errorf("failed to reset to %s: %w (output: %s)", ref, err, output)
+	}
+	return nil
+}
+
+// GetFileStatus returns "clean" if the file has no changes, "modified" otherwise.
+func (r *gitCLIRepository) GetFileStatus(ctx context.Context, path string) (string, error) {
+	output, err := r.run(ctx, gitCLICommandTimeout, "status", "--porcelain", "--", path)
+	if err != nil {
+		return "", fmt.Errorf("failed to get status: %w (output: %s)", err, output)
+	}
+	if output == "" {
+		return "clean", nil
+	}
+	return "modified", nil
+}
+
+// IsSyncedWithBase fetches base from the remote and reports whether HEAD contains its latest commit.
+func (r *gitCLIRepository) IsSyncedWithBase(ctx context.Context, base string) (bool, error) {
+	authURL, auth, err := r.authenticatedRemoteURL(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to prepare authenticated URL for fetch: %w", err)
+	}
+	remoteRef := fmt.Sprintf("refs/remotes/%s/%s", r.remote(), base)
+	refSpec := fmt.Sprintf("+refs/heads/%s:%s", base, remoteRef)
+	if output, err := r.run(ctx, gitCLICheckoutTimeout, "fetch", "--quiet", authURL, refSpec); err != nil {
+		return false, fmt.Errorf("failed to fetch branch %s: %w (output: %s)",
+			base, err, sanitizeOutput(output, authURL, auth))
+	}
+	output, err := r.run(ctx, gitCLICommandTimeout, "merge-base", "--is-ancestor", remoteRef, "HEAD")
+	return ancestorCheckResult(remoteRef, output, err)
+}
+
+// RebaseOntoBase rebases HEAD onto the fetched base, aborting the rebase when it fails.
+func (r *gitCLIRepository) RebaseOntoBase(ctx context.Context, base string) error {
+	remoteRef := fmt.Sprintf("refs/remotes/%s/%s", r.remote(), base)
+	output, err := r.run(ctx, gitCLICheckoutTimeout, "rebase", remoteRef)
+	if err == nil {
+		return nil
+	}
+	//nolint:errcheck // The rebase error below is what matters; abort only restores the branch.
+	r.run(ctx, gitCLICommandTimeout, "rebase", "--abort")
+	return fmt.Errorf("failed to rebase onto %s: %w (output: %s)", remoteRef, err, output)
+}
+
+// ancestorCheckResult interprets git merge-base --is-ancestor, which exits 1 when ref is not an ancestor.
+func ancestorCheckResult(ref, output string, err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to compare HEAD with %s: %w (output: %s)", ref, err, output)
+}
@@ -0,0 +1,589 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitCLIRepository_Tags(t *testing.T) {
+	t.Run("Should create tag and report it as latest", func(t *testing.T) {
+		dir, repo := setupTestRepo(t)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		exists, err := gitRepo.TagExists(t.Context(), "v1.0.0")
+		require.NoError(t, err)
+		assert.False(t, exists)
+		require.NoError(t, gitRepo.ConfigureUser(t.Context(), "Test User", "test@example.com"))
+		require.NoError(t, gitRepo.CreateTag(t.Context(), "v1.0.0", "Release v1.0.0"))
+		exists, err = gitRepo.TagExists(t.Context(), "v1.0.0")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		_, err = repo.Tag("v1.0.0")
+		assert.NoError(t, err)
+		tag, err := gitRepo.LatestTag(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "v1.0.0", tag)
+	})
+	t.Run("Should keep markdown headings in the tag message", func(t *testing.T) {
+		dir, repo := setupTestRepo(t)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		require.NoError(t, gitRepo.ConfigureUser(t.Context(), "Test User", "test@example.com"))
+		msg := "Release v1.0.0\n\n### Features\n- add widgets"
+		require.NoError(t, gitRepo.CreateTag(t.Context(), "v1.0.0", msg))
+		ref, err := repo.Tag("v1.0.0")
+		require.NoError(t, err)
+		tag, err := repo.TagObject(ref.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, msg+"\n", tag.Message)
+	})
+	t.Run("Should count commits since tag", func(t *testing.T) {
+		dir, repo := setupTestRepo(t)
+		head, err := repo.Head()
+		require.NoError(t, err)
+		_, err = repo.CreateTag("v1.0.0", head.Hash(), nil)
+		require.NoError(t, err)
+		wt, err := repo.Worktree()
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "test2.txt"), []byte("test content 2"), 0644))
+		_, err = wt.Add("test2.txt")
+		require.NoError(t, err)
+		_, err = wt.Commit("Second commit", &git.CommitOptions{
+			Author: &object.Signature{Name: "Test User", Email: "test@example.com"},
+		})
+		require.NoError(t, err)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		count, err := gitRepo.CommitsSinceTag(t.Context(), "v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+	t.Run("Should list commit subjects since tag on both backends", func(t *testing.T) {
+		dir, repo := setupTestRepo(t)
+		head, err := repo.Head()
+		require.NoError(t, err)
+		_, err = repo.CreateTag("v1.0.0", head.Hash(), nil)
+		require.NoError(t, err)
+		wt, err := repo.Worktree()
+		require.NoError(t, err)
+		for i, message := range []string{"fix: handle nil\n\nDetails.", "chore: tidy"} {
+			name := fmt.Sprintf("change%d.txt", i)
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(message), 0644))
+			_, err = wt.Add(name)
+			require.NoError(t, err)
+			_, err = wt.Commit(message, &git.CommitOptions{
+				Author: &object.Signature{Name: "Test User", Email: "test@example.com"},
+			})
+			require.NoError(t, err)
+		}
+		cliRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		for _, gitRepo := range []GitExtendedRepository{cliRepo, &gitRepository{repo: repo}} {
+			subjects, err := gitRepo.CommitSubjectsSinceTag(t.Context(), "v1.0.0")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"chore: tidy", "fix: handle nil"}, subjects)
+			messages, err := gitRepo.CommitMessagesSinceTag(t.Context(), "v1.0.0")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"chore: tidy", "fix: handle nil\n\nDetails."}, messages)
+		}
+	})
+	t.Run("Should list tags with their release dates on both backends", func(t *testing.T) {
+		dir, repo := setupTestRepo(t)
+		head, err := repo.Head()
+		require.NoError(t, err)
+		commit, err := repo.CommitObject(head.Hash())
+		require.NoError(t, err)
+		_, err = repo.CreateTag("v1.0.0", head.Hash(), nil)
+		require.NoError(t, err)
+		tagged := commit.Committer.When.Add(48 * time.Hour)
+		_, err = repo.CreateTag("v1.1.0", head.Hash(), &git.CreateTagOptions{
+			Message: "Release v1.1.0",
+			Tagger:  &object.Signature{Name: "Test User", Email: "test@example.com", When: tagged},
+		})
+		require.NoError(t, err)
+		cliRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		for _, gitRepo := range []GitExtendedRepository{cliRepo, &gitRepository{repo: repo}} {
+			tags, err := gitRepo.ReleaseTags(t.Context())
+			require.NoError(t, err)
+			require.Len(t, tags, 2)
+			assert.Equal(t, "v1.0.0", tags[0].Name)
+			assert.Equal(t, commit.Committer.When.Unix(), tags[0].Date.Unix())
+			assert.Equal(t, "v1.1.0", tags[1].Name)
+			assert.Equal(t, tagged.Unix(), tags[1].Date.Unix())
+		}
+	})
+	t.Run("Should move and restore an existing tag on both backends", func(t *testing.T) {
+		dir, repo := setupTestRepo(t)
+		first, err := repo.Head()
+		require.NoError(t, err)
+		_, err = repo.CreateTag("v1.0.0", first.Hash(), &git.CreateTagOptions{
+			Message: "Release v1.0.0",
+			Tagger:  &object.Signature{Name: "Test User", Email: "test@example.com"},
+		})
+		require.NoError(t, err)
+		wt, err := repo.Worktree()
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "test2.txt"), []byte("test content 2"), 0644))
+		_, err = wt.Add("test2.txt")
+		require.NoError(t, err)
+		second, err := wt.Commit("Second commit", &git.CommitOptions{
+			Author: &object.Signature{Name: "Test User", Email: "test@example.com"},
+		})
+		require.NoError(t, err)
+		cliRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		require.NoError(t, cliRepo.ConfigureUser(t.Context(), "Test User", "test@example.com"))
+		for _, gitRepo := range []GitExtendedRepository{cliRepo, &gitRepository{repo: repo}} {
+			commit, err := gitRepo.TagCommit(t.Context(), "v1.0.0")
+			require.NoError(t, err)
+			assert.Equal(t, first.Hash().String(), commit)
+			require.NoError(t, gitRepo.CreateTagForce(t.Context(), "v1.0.0", "", "Release v1.0.0"))
+			commit, err = gitRepo.TagCommit(t.Context(), "v1.0.0")
+			require.NoError(t, err)
+			assert.Equal(t, second.String(), commit)
+			require.NoError(t, gitRepo.CreateTagForce(t.Context(), "v1.0.0", first.Hash().String(), "Release v1.0.0"))
+			commit, err = gitRepo.TagCommit(t.Context(), "v1.0.0")
+			require.NoError(t, err)
+			assert.Equal(t, first.Hash().String(), commit)
+			msg, annotated, err := gitRepo.TagAnnotation(t.Context(), "v1.0.0")
+			require.NoError(t, err)
+			assert.True(t, annotated)
+			assert.Equal(t, "Release v1.0.0", msg)
+			require.NoError(t, gitRepo.CreateTagForce(t.Context(), "v1.0.0", first.Hash().String(), ""))
+			msg, annotated, err = gitRepo.TagAnnotation(t.Context(), "v1.0.0")
+			require.NoError(t, err)
+			assert.False(t, annotated)
+			assert.Empty(t, msg)
+			commit, err = gitRepo.TagCommit(t.Context(), "v1.0.0")
+			require.NoError(t, err)
+			assert.Equal(t, first.Hash().String(), commit)
+		}
+	})
+}
+
+func TestGitCLIRepository_ReleaseStats(t *testing.T) {
+	t.Run("Should match the go-git backend", func(t *testing.T) {
+		dir, repo := setupReleaseStatsRepo(t)
+		expected, err := (&gitRepository{repo: repo}).ReleaseStats(t.Context(), "v1.0.0")
+		require.NoError(t, err)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		stats, err := gitRepo.ReleaseStats(t.Context(), "v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, expected, stats)
+	})
+	t.Run("Should match the go-git backend for the whole history", func(t *testing.T) {
+		dir, repo := setupReleaseStatsRepo(t)
+		expected, err := (&gitRepository{repo: repo}).ReleaseStats(t.Context(), "")
+		require.NoError(t, err)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		stats, err := gitRepo.ReleaseStats(t.Context(), "")
+		require.NoError(t, err)
+		assert.Equal(t, expected, stats)
+	})
+}
+
+func TestGitCLIRepository_HeadCommitDiff(t *testing.T) {
+	t.Run("Should diff HEAD against its parent like the go-git backend", func(t *testing.T) {
+		dir, repo := setupReleaseStatsRepo(t)
+		expected, err := (&gitRepository{repo: repo}).HeadCommitDiff(t.Context())
+		require.NoError(t, err)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		stats, err := gitRepo.HeadCommitDiff(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, expected, stats)
+	})
+	t.Run("Should report renames like the go-git backend", func(t *testing.T) {
+		dir, repo := setupReleaseStatsRepo(t)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		ctx := t.Context()
+		require.NoError(t, gitRepo.ConfigureUser(ctx, "Test User", "test@example.com"))
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "docs"), 0o755))
+		output, err := gitRepo.run(ctx, gitCLICommandTimeout, "mv", "notes.txt", "docs/notes.txt")
+		require.NoError(t, err, output)
+		require.NoError(t, gitRepo.Commit(ctx, "docs: move notes"))
+		expected, err := (&gitRepository{repo: repo}).HeadCommitDiff(ctx)
+		require.NoError(t, err)
+		stats, err := gitRepo.HeadCommitDiff(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, expected, stats)
+	})
+}
+
+func TestGitCLIRepository_Branches(t *testing.T) {
+	t.Run("Should create, list and delete local branches", func(t *testing.T) {
+		dir, repo := setupTestRepo(t)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		require.NoError(t, gitRepo.CreateBranch(t.Context(), "release/v1.0.0"))
+		_, err := repo.Reference(plumbing.NewBranchReferenceName("release/v1.0.0"), false)
+		require.NoError(t, err)
+		err = gitRepo.CreateBranch(t.Context(), "release/v1.0.0")
+		assert.ErrorContains(t, err, "already exists")
+		branches, err := gitRepo.ListLocalBranches(t.Context())
+		require.NoError(t, err)
+		assert.Contains(t, branches, "release/v1.0.0")
+		require.NoError(t, gitRepo.DeleteBranch(t.Context(), "release/v1.0.0"))
+		branches, err = gitRepo.ListLocalBranches(t.Context())
+		require.NoError(t, err)
+		assert.NotContains(t, branches, "release/v1.0.0")
+	})
+}
+
+func TestGitRepositories_EmptyRepository(t *testing.T) {
+	t.Run("Should report an empty repository and its unborn branch on both backends", func(t *testing.T) {
+		dir := t.TempDir()
+		repo, err := git.PlainInit(dir, false)
+		require.NoError(t, err)
+		unborn := plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName("main"))
+		require.NoError(t, repo.Storer.SetReference(unborn))
+		backends := map[string]GitExtendedRepository{
+			GitBackendGoGit: &gitRepository{repo: repo},
+			GitBackendCLI:   &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2},
+		}
+		for name, gitRepo := range backends {
+			_, err := gitRepo.GetHeadCommit(t.Context())
+			assert.ErrorIs(t, err, ErrEmptyRepository, name)
+			branch, err := gitRepo.GetCurrentBranch(t.Context())
+			require.NoError(t, err, name)
+			assert.Equal(t, "main", branch, name)
+		}
+	})
+}
+
+func TestGitCLIRepository_GetFileStatus(t *testing.T) {
+	t.Run("Should report clean and modified files", func(t *testing.T) {
+		dir, _ := setupTestRepo(t)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		status, err := gitRepo.GetFileStatus(t.Context(), "test.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "clean", status)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "test.txt"), []byte("changed"), 0644))
+		status, err = gitRepo.GetFileStatus(t.Context(), "test.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "modified", status)
+		require.NoError(t, gitRepo.RestoreFile(t.Context(), "test.txt"))
+		status, err = gitRepo.GetFileStatus(t.Context(), "test.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "clean", status)
+	})
+}
+
+func TestGitCLIRepository_RestoreFile(t *testing.T) {
+	t.Run("Should undo staged moves, deletions and additions", func(t *testing.T) {
+		dir, _ := setupTestRepo(t)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		require.NoError(t, gitRepo.MoveFile(t.Context(), "test.txt", "moved.txt"))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "added.txt"), []byte("added"), 0644))
+		require.NoError(t, gitRepo.AddFiles(t.Context(), "added.txt"))
+		for _, path := range []string{"test.txt", "moved.txt", "added.txt"} {
+			require.NoError(t, gitRepo.RestoreFile(t.Context(), path), path)
+		}
+		output, err := gitRepo.run(t.Context(), gitCLICommandTimeout, "status", "--porcelain")
+		require.NoError(t, err)
+		assert.Empty(t, output)
+		content, err := os.ReadFile(filepath.Join(dir, "test.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "test content", string(content))
+		require.NoError(t, gitRepo.RemoveFile(t.Context(), "test.txt"))
+		require.NoError(t, gitRepo.RestoreFile(t.Context(), "test.txt"))
+		status, err := gitRepo.GetFileStatus(t.Context(), "test.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "clean", status)
+	})
+}
+
+func TestGitCLIRepository_Commit(t *testing.T) {
+	t.Run("Should create the commit when nothing is staged", func(t *testing.T) {
+		dir, _ := setupTestRepo(t)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		require.NoError(t, gitRepo.ConfigureUser(t.Context(), "Test User", "test@example.com"))
+		before, err := gitRepo.GetHeadCommit(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, gitRepo.Commit(t.Context(), "release: prepare release v1.1.0"))
+		after, err := gitRepo.GetHeadCommit(t.Context())
+		require.NoError(t, err)
+		assert.NotEqual(t, before, after)
+	})
+}
+
+type failingGitRepository struct {
+	GitExtendedRepository
+	err   error
+	calls int
+}
+
+func (r *failingGitRepository) TagExists(_ context.Context, _ string) (bool, error) {
+	r.calls++
+	return false, r.err
+}
+
+func (r *failingGitRepository) CreateBranch(_ context.Context, _ string) error {
+	r.calls++
+	return r.err
+}
+
+func TestFallbackGitRepository(t *testing.T) {
+	missingObject := fmt.Errorf("failed to resolve tag v1.0.0: %w", plumbing.ErrObjectNotFound)
+	t.Run("Should retry read-only operations go-git does not support with the fallback repository", func(t *testing.T) {
+		dir, repo := setupTestRepo(t)
+		head, err := repo.Head()
+		require.NoError(t, err)
+		_, err = repo.CreateTag("v1.0.0", head.Hash(), nil)
+		require.NoError(t, err)
+		primary := &failingGitRepository{err: missingObject}
+		gitRepo := NewFallbackGitRepository(primary, &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2})
+		exists, err := gitRepo.TagExists(t.Context(), "v1.0.0")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+	t.Run("Should return both errors when fallback also fails", func(t *testing.T) {
+		primary := &failingGitRepository{err: missingObject}
+		fallback := &failingGitRepository{err: errors.New("fallback failed")}
+		gitRepo := NewFallbackGitRepository(primary, fallback)
+		_, err := gitRepo.TagExists(t.Context(), "v1.0.0")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, primary.err)
+		assert.ErrorIs(t, err, fallback.err)
+	})
+	t.Run("Should return other primary failures without falling back", func(t *testing.T) {
+		for _, primaryErr := range []error{
+			fmt.Errorf("failed to get tag v1.0.0: %w", plumbing.ErrReferenceNotFound),
+			ErrEmptyRepository,
+			errors.New("non-fast-forward update rejected"),
+		} {
+			fallback := &failingGitRepository{}
+			gitRepo := NewFallbackGitRepository(&failingGitRepository{err: primaryErr}, fallback)
+			_, err := gitRepo.TagExists(t.Context(), "v1.0.0")
+			require.ErrorIs(t, err, primaryErr)
+			assert.Zero(t, fallback.calls)
+		}
+	})
+	t.Run("Should never retry operations that change the repository", func(t *testing.T) {
+		primary := &failingGitRepository{err: missingObject}
+		fallback := &failingGitRepository{}
+		err := NewFallbackGitRepository(primary, fallback).CreateBranch(t.Context(), "release/v1.0.0")
+		require.ErrorIs(t, err, plumbing.ErrObjectNotFound)
+		assert.Equal(t, 1, primary.calls)
+		assert.Zero(t, fallback.calls)
+	})
+	t.Run("Should run partial clones go-git cannot open on the git CLI", func(t *testing.T) {
+		t.Chdir(setupPartialCloneRepo(t))
+		_, err := NewGitExtendedRepositoryForBackend(GitBackendGoGit, DefaultGitRemote, 2, TagFetchAlways)
+		require.ErrorIs(t, err, git.ErrUnsupportedExtensionRepositoryFormatVersion)
+		gitRepo, err := NewGitExtendedRepositoryForBackend(GitBackendAuto, DefaultGitRemote, 2, TagFetchAlways)
+		require.NoError(t, err)
+		assert.IsType(t, &gitCLIRepository{}, gitRepo)
+		exists, err := gitRepo.TagExists(t.Context(), "v1.0.0")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+	t.Run("Should reject unsupported backends", func(t *testing.T) {
+		_, err := NewGitExtendedRepositoryForBackend("libgit2", DefaultGitRemote, 2, TagFetchAlways)
+		assert.ErrorContains(t, err, "unsupported git backend")
+	})
+}
+
+func TestGitCLIRepository_Remote(t *testing.T) {
+	t.Run("Should push and list branches against the configured remote", func(t *testing.T) {
+		t.Setenv("GITHUB_TOKEN", "")
+		t.Setenv("COMPOZY_RELEASE_GITHUB_TOKEN", "")
+		dir, repo := setupTestRepo(t)
+		mirrorDir := t.TempDir()
+		_, err := git.PlainInit(mirrorDir, true)
+		require.NoError(t, err)
+		_, err = repo.CreateRemote(&gitconfig.RemoteConfig{Name: "mirror", URLs: []string{mirrorDir}})
+		require.NoError(t, err)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2, remoteName: "mirror"}
+		branch := "release/v1.0.0"
+		require.NoError(t, gitRepo.CreateBranch(t.Context(), branch))
+		require.NoError(t, gitRepo.PushBranch(t.Context(), branch))
+		branches, err := gitRepo.ListRemoteBranches(t.Context(), "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"mirror/" + branch}, branches)
+		branches, err = gitRepo.ListRemoteBranches(t.Context(), "release/")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"mirror/" + branch}, branches)
+		branches, err = gitRepo.ListRemoteBranches(t.Context(), "hotfix/")
+		require.NoError(t, err)
+		assert.Empty(t, branches)
+		exists, err := gitRepo.RemoteBranchExists(t.Context(), branch)
+		require.NoError(t, err)
+		assert.True(t, exists)
+		require.NoError(t, gitRepo.DeleteRemoteBranch(t.Context(), branch))
+		exists, err = gitRepo.RemoteBranchExists(t.Context(), branch)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+	t.Run("Should sync a local branch to the remote head", func(t *testing.T) {
+		t.Setenv("GITHUB_TOKEN", "")
+		t.Setenv("COMPOZY_RELEASE_GITHUB_TOKEN", "")
+		dir, repo := setupTestRepo(t)
+		mirrorDir := t.TempDir()
+		_, err := git.PlainInit(mirrorDir, true)
+		require.NoError(t, err)
+		_, err = repo.CreateRemote(&gitconfig.RemoteConfig{Name: "mirror", URLs: []string{mirrorDir}})
+		require.NoError(t, err)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2, remoteName: "mirror"}
+		require.NoError(t, gitRepo.ConfigureUser(t.Context(), "Test User", "test@example.com"))
+		initial, err := gitRepo.GetHeadCommit(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "next.txt"), []byte("next"), 0644))
+		require.NoError(t, gitRepo.AddFiles(t.Context(), "next.txt"))
+		require.NoError(t, gitRepo.Commit(t.Context(), "feat: next"))
+		remoteHead, err := gitRepo.GetHeadCommit(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, gitRepo.PushBranch(t.Context(), "master"))
+		require.NoError(t, gitRepo.ResetHard(t.Context(), initial))
+		require.NoError(t, gitRepo.CreateBranch(t.Context(), "feature"))
+		require.NoError(t, gitRepo.SyncBranch(t.Context(), "master"))
+		head, err := gitRepo.GetHeadCommit(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, remoteHead, head)
+		current, err := gitRepo.GetCurrentBranch(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "master", current)
+	})
+}
+
+func TestGitCLIRepository_BaseSync(t *testing.T) {
+	setup := func(t *testing.T, baseFile, releaseFile string) (*gitCLIRepository, string) {
+		t.Helper()
+		t.Setenv("GITHUB_TOKEN", "")
+		t.Setenv("COMPOZY_RELEASE_GITHUB_TOKEN", "")
+		dir, repo := setupTestRepo(t)
+		mirrorDir := t.TempDir()
+		_, err := git.PlainInit(mirrorDir, true)
+		require.NoError(t, err)
+		_, err = repo.CreateRemote(&gitconfig.RemoteConfig{Name: "mirror", URLs: []string{mirrorDir}})
+		require.NoError(t, err)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2, remoteName: "mirror"}
+		ctx := t.Context()
+		require.NoError(t, gitRepo.ConfigureUser(ctx, "Test User", "test@example.com"))
+		require.NoError(t, gitRepo.PushBranch(ctx, "master"))
+		require.NoError(t, gitRepo.CreateBranch(ctx, "release/v1.1.0"))
+		require.NoError(t, gitRepo.CheckoutBranch(ctx, "release/v1.1.0"))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, releaseFile), []byte("release"), 0644))
+		require.NoError(t, gitRepo.AddFiles(ctx, releaseFile))
+		require.NoError(t, gitRepo.Commit(ctx, "release: prepare release v1.1.0"))
+		releaseHead, err := gitRepo.GetHeadCommit(ctx)
+		require.NoError(t, err)
+		require.NoError(t, gitRepo.CheckoutBranch(ctx, "master"))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, baseFile), []byte("base moved"), 0644))
+		require.NoError(t, gitRepo.AddFiles(ctx, baseFile))
+		require.NoError(t, gitRepo.Commit(ctx, "fix: land during release"))
+		require.NoError(t, gitRepo.PushBranch(ctx, "master"))
+		require.NoError(t, gitRepo.CheckoutBranch(ctx, "release/v1.1.0"))
+		return gitRepo, releaseHead
+	}
+	t.Run("Should detect a moved base and rebase onto it", func(t *testing.T) {
+		gitRepo, _ := setup(t, "hotfix.txt", "CHANGELOG.md")
+		synced, err := gitRepo.IsSyncedWithBase(t.Context(), "master")
+		require.NoError(t, err)
+		assert.False(t, synced)
+		require.NoError(t, gitRepo.RebaseOntoBase(t.Context(), "master"))
+		synced, err = gitRepo.IsSyncedWithBase(t.Context(), "master")
+		require.NoError(t, err)
+		assert.True(t, synced)
+	})
+	t.Run("Should abort a conflicting rebase and keep the release commit", func(t *testing.T) {
+		gitRepo, releaseHead := setup(t, "CHANGELOG.md", "CHANGELOG.md")
+		synced, err := gitRepo.IsSyncedWithBase(t.Context(), "master")
+		require.NoError(t, err)
+		assert.False(t, synced)
+		err = gitRepo.RebaseOntoBase(t.Context(), "master")
+		assert.ErrorContains(t, err, "failed to rebase onto refs/remotes/mirror/master")
+		head, err := gitRepo.GetHeadCommit(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, releaseHead, head)
+		current, err := gitRepo.GetCurrentBranch(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "release/v1.1.0", current)
+	})
+}
+
+func TestGitCLIRepository_Submodules(t *testing.T) {
+	t.Run("Should report pointers moved since the tag like the go-git backend", func(t *testing.T) {
+		dir, repo := setupTestRepo(t)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		ctx := t.Context()
+		require.NoError(t, gitRepo.ConfigureUser(ctx, "Test User", "test@example.com"))
+		from := "1111111111111111111111111111111111111111"
+		to := "2222222222222222222222222222222222222222"
+		gitlink := func(path, sha string) {
+			output, err := gitRepo.run(ctx, gitCLICommandTimeout, "update-index", "--add", "--cacheinfo",
+				gitlinkMode+","+sha+","+path)
+			require.NoError(t, err, output)
+		}
+		gitlink("libs/parser", from)
+		gitlink("libs/legacy", from)
+		require.NoError(t, gitRepo.Commit(ctx, "chore: add submodules"))
+		require.NoError(t, gitRepo.CreateTag(ctx, "v1.0.0", "Release v1.0.0"))
+		gitlink("libs/parser", to)
+		_, err := gitRepo.run(ctx, gitCLICommandTimeout, "rm", "--cached", "--quiet", "libs/legacy")
+		require.NoError(t, err)
+		gitlink("libs/render", to)
+		require.NoError(t, gitRepo.Commit(ctx, "chore: update submodules"))
+		updates, err := gitRepo.SubmoduleUpdates(ctx, "v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, domain.SubmoduleUpdates{
+			{Path: "libs/legacy", From: from},
+			{Path: "libs/parser", From: from, To: to},
+			{Path: "libs/render", To: to},
+		}, updates)
+		expected, err := (&gitRepository{repo: repo}).SubmoduleUpdates(ctx, "v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, expected, updates)
+	})
+	t.Run("Should move submodules to their highest stable tag", func(t *testing.T) {
+		t.Setenv("GITHUB_TOKEN", "")
+		t.Setenv("COMPOZY_RELEASE_GITHUB_TOKEN", "")
+		ctx := t.Context()
+		upstreamDir, _ := setupTestRepo(t)
+		upstream := &gitCLIRepository{dir: upstreamDir, pushTimeoutMinutes: 2}
+		require.NoError(t, upstream.ConfigureUser(ctx, "Test User", "test@example.com"))
+		require.NoError(t, upstream.CreateTag(ctx, "v1.0.0", "Release v1.0.0"))
+		for _, tag := range []string{"v1.1.0", "v2.0.0-rc.1"} {
+			require.NoError(t, os.WriteFile(filepath.Join(upstreamDir, "test.txt"), []byte(tag), 0644))
+			require.NoError(t, upstream.AddFiles(ctx, "test.txt"))
+			require.NoError(t, upstream.Commit(ctx, "feat: "+tag))
+			require.NoError(t, upstream.CreateTag(ctx, tag, "Release "+tag))
+		}
+		stable, err := upstream.tagCommit(ctx, "v1.1.0")
+		require.NoError(t, err)
+		dir, _ := setupTestRepo(t)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		output, err := gitRepo.run(ctx, gitCLICommandTimeout, "clone", "--quiet", upstreamDir, "libs/parser")
+		require.NoError(t, err, output)
+		sub := &gitCLIRepository{dir: filepath.Join(dir, "libs", "parser")}
+		output, err = sub.run(ctx, gitCLICommandTimeout, "checkout", "--quiet", "v1.0.0")
+		require.NoError(t, err, output)
+		current, err := sub.GetHeadCommit(ctx)
+		require.NoError(t, err)
+		output, err = gitRepo.run(ctx, gitCLICommandTimeout, "add", "libs/parser")
+		require.NoError(t, err, output)
+		updates, err := gitRepo.BumpSubmodules(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, domain.SubmoduleUpdates{
+			{Path: "libs/parser", From: current, To: stable.String(), Tag: "v1.1.0"},
+		}, updates)
+		staged, err := gitRepo.run(ctx, gitCLICommandTimeout, "ls-files", "--stage", "libs/parser")
+		require.NoError(t, err)
+		assert.Contains(t, staged, stable.String())
+	})
+	t.Run("Should reject uninitialized submodules", func(t *testing.T) {
+		dir, _ := setupTestRepo(t)
+		gitRepo := &gitCLIRepository{dir: dir, pushTimeoutMinutes: 2}
+		output, err := gitRepo.run(t.Context(), gitCLICommandTimeout, "update-index", "--add", "--cacheinfo",
+			gitlinkMode+",1111111111111111111111111111111111111111,libs/parser")
+		require.NoError(t, err, output)
+		_, err = gitRepo.BumpSubmodules(t.Context())
+		assert.ErrorContains(t, err, "submodule libs/parser is not initialized")
+	})
+}
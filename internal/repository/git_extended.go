@@ -1,33 +1,79 @@
 package repository
 
-import "context"
+import (
+	"context"
+	"errors"
+
+	"github.com/compozy/releasepr/internal/domain"
+)
+
+// ErrEmptyRepository reports a repository without any commit, where HEAD points to an unborn branch.
+var ErrEmptyRepository = errors.New("repository has no commits")
 
 // GitExtendedRepository extends GitRepository with additional operations needed for orchestration.
 type GitExtendedRepository interface {
 	GitRepository
 	// Checkout operations
 	CheckoutBranch(ctx context.Context, name string) error
+	SyncBranch(ctx context.Context, name string) error
 	// Git configuration
 	ConfigureUser(ctx context.Context, name, email string) error
 	// Staging operations
 	AddFiles(ctx context.Context, pattern string) error
-	// Commit operations
+	// Commit creates a commit of the index with message. The commit is created even when nothing is
+	// staged, so a release that changed no file still gets its release commit.
 	Commit(ctx context.Context, message string) error
+	// GetHeadCommit returns the SHA of HEAD, or ErrEmptyRepository before the first commit.
 	GetHeadCommit(ctx context.Context) (string, error)
 	// Branch operations
+	// GetCurrentBranch returns the branch HEAD points to, including the unborn branch of an empty repository.
 	GetCurrentBranch(ctx context.Context) (string, error)
 	PushBranch(ctx context.Context, branch string) error
 	PushBranchForce(ctx context.Context, branch string) error
 	DeleteBranch(ctx context.Context, name string) error
 	DeleteRemoteBranch(ctx context.Context, name string) error
 	ListLocalBranches(ctx context.Context) ([]string, error)
-	ListRemoteBranches(ctx context.Context) ([]string, error)
+	// ListRemoteBranches returns the remote branches whose name starts with prefix, all of them when
+	// prefix is empty, in "<remote>/<branch>" form.
+	ListRemoteBranches(ctx context.Context, prefix string) ([]string, error)
 	RemoteBranchExists(ctx context.Context, branchName string) (bool, error)
 	// Tag operations
 	TagExists(ctx context.Context, tag string) (bool, error)
+	// TagCommit returns the SHA of the commit tag points to.
+	TagCommit(ctx context.Context, tag string) (string, error)
+	// ReleaseTags returns every local tag with its release date, oldest first.
+	ReleaseTags(ctx context.Context) ([]domain.ReleaseTag, error)
+	// TagAnnotation returns the message of tag and true when it is annotated, or false for a lightweight tag.
+	TagAnnotation(ctx context.Context, tag string) (string, bool, error)
+	// CreateTagForce creates the annotated tag at commit, or at HEAD when commit is empty, moving it
+	// when it already exists. An empty msg creates a lightweight tag.
+	CreateTagForce(ctx context.Context, tag, commit, msg string) error
+	// PushTagForce pushes a tag to the remote, overwriting the remote tag when it points elsewhere.
+	PushTagForce(ctx context.Context, tag string) error
+	// CommitMessagesSinceTag returns the full messages of the commits since tag, newest first.
+	// An empty tag covers the whole history.
+	CommitMessagesSinceTag(ctx context.Context, tag string) ([]string, error)
+	// ReleaseStats counts the commits and contributors since tag and diffs its tree against HEAD.
+	// An empty tag covers the whole history.
+	ReleaseStats(ctx context.Context, tag string) (domain.ReleaseStats, error)
+	// HeadCommitDiff returns the per-file diff of HEAD against its first parent.
+	HeadCommitDiff(ctx context.Context) ([]domain.FileDiffStat, error)
+	// SubmoduleUpdates lists the submodule pointers that moved between tag and HEAD.
+	// An empty tag lists every submodule as added.
+	SubmoduleUpdates(ctx context.Context, tag string) (domain.SubmoduleUpdates, error)
+	// BumpSubmodules checks out the highest semver tag of every submodule and stages the new
+	// pointers, returning the submodules that moved. Submodules must be initialized.
+	BumpSubmodules(ctx context.Context) (domain.SubmoduleUpdates, error)
 	// File operations
 	MoveFile(ctx context.Context, from, to string) error
+	// RemoveFile deletes a tracked file and stages the deletion.
+	RemoveFile(ctx context.Context, path string) error
 	RestoreFile(ctx context.Context, path string) error
 	ResetHard(ctx context.Context, ref string) error
 	GetFileStatus(ctx context.Context, path string) (string, error)
+	// Base branch synchronization
+	// IsSyncedWithBase fetches base from the remote and reports whether HEAD contains its latest commit
+	IsSyncedWithBase(ctx context.Context, base string) (bool, error)
+	// RebaseOntoBase rebases HEAD onto the fetched base, aborting the rebase when it fails
+	RebaseOntoBase(ctx context.Context, base string) error
 }
@@ -0,0 +1,335 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"go.uber.org/zap"
+)
+
+// Supported git backends.
+const (
+	GitBackendGoGit = "go-git"
+	GitBackendCLI   = "cli"
+	GitBackendAuto  = "auto"
+)
+
+// NewGitExtendedRepositoryForBackend creates a GitExtendedRepository for the configured backend that
+// fetches remote tags according to tagFetch. The auto backend uses go-git and retries read-only
+// operations with the native git CLI when go-git does not support the repository; a repository
+// go-git cannot open at all, such as a partial clone, runs on the git CLI alone.
+func NewGitExtendedRepositoryForBackend(
+	backend, remoteName string,
+	timeoutMinutes int,
+	tagFetch string,
+) (GitExtendedRepository, error) {
+	switch backend {
+	case "", GitBackendGoGit:
+		return NewGitExtendedRepositoryWithRemote(remoteName, timeoutMinutes, tagFetch)
+	case GitBackendCLI:
+		return NewGitCLIRepository(remoteName, timeoutMinutes, tagFetch)
+	case GitBackendAuto:
+		primary, err := NewGitExtendedRepositoryWithRemote(remoteName, timeoutMinutes, tagFetch)
+		if unsupportedByPrimary(err) {
+			return NewGitCLIRepository(remoteName, timeoutMinutes, tagFetch)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fallback, err := NewGitCLIRepository(remoteName, timeoutMinutes, tagFetch)
+		if err != nil {
+			return nil, err
+		}
+		return NewFallbackGitRepository(primary, fallback), nil
+	}
+	return nil, fmt.Errorf("unsupported git backend: %s", backend)
+}
+
+// unsupportedPrimaryErrors are the go-git failures on repository features it does not implement:
+// format extensions such as partial clones, objects missing from shallow or partial clones, and index
+// versions such as sparse indexes.
+var unsupportedPrimaryErrors = []error{
+	git.ErrUnsupportedExtensionRepositoryFormatVersion,
+	git.ErrUnsupportedRepositoryFormatVersion,
+	plumbing.ErrObjectNotFound,
+	index.ErrUnsupportedVersion,
+}
+
+// unsupportedByPrimary reports whether err is a go-git failure on a repository feature it does not
+// implement, rather than a failure the fallback would report as well.
+func unsupportedByPrimary(err error) bool {
+	for _, target := range unsupportedPrimaryErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// fallbackGitRepository delegates to a primary repository and retries read-only operations on a
+// fallback when the primary does not support the repository. Operations that change the repository,
+// its working tree or the remote run on the primary only: retrying them could repeat a change the
+// primary applied in part.
+type fallbackGitRepository struct {
+	primary  GitExtendedRepository
+	fallback GitExtendedRepository
+}
+
+// NewFallbackGitRepository creates a GitExtendedRepository that falls back when the primary does not
+// support the repository.
+func NewFallbackGitRepository(primary, fallback GitExtendedRepository) GitExtendedRepository {
+	return &fallbackGitRepository{primary: primary, fallback: fallback}
+}
+
+func (r *fallbackGitRepository) do(ctx context.Context, op string, primary, fallback func() error) error {
+	primaryErr := primary()
+	if primaryErr == nil || !unsupportedByPrimary(primaryErr) {
+		return primaryErr
+	}
+	logger.FromContext(ctx).Named("repository.git").Warn(
+		"Git operation failed; retrying with fallback backend",
+		zap.String("operation", op),
+		zap.Error(primaryErr),
+	)
+	if err := fallback(); err != nil {
+		return fmt.Errorf("%s failed on primary backend (%w) and fallback backend (%w)", op, primaryErr, err)
+	}
+	return nil
+}
+
+func fallbackValue[T any](
+	ctx context.Context,
+	r *fallbackGitRepository,
+	op string,
+	primary, fallback func() (T, error),
+) (T, error) {
+	var result T
+	err := r.do(ctx, op, func() error {
+		var err error
+		result, err = primary()
+		return err
+	}, func() error {
+		var err error
+		result, err = fallback()
+		return err
+	})
+	return result, err
+}
+
+func (r *fallbackGitRepository) LatestTag(ctx context.Context) (string, error) {
+	return fallbackValue(ctx, r, "LatestTag",
+		func() (string, error) { return r.primary.LatestTag(ctx) },
+		func() (string, error) { return r.fallback.LatestTag(ctx) },
+	)
+}
+
+func (r *fallbackGitRepository) CommitsSinceTag(ctx context.Context, tag string) (int, error) {
+	return fallbackValue(ctx, r, "CommitsSinceTag",
+		func() (int, error) { return r.primary.CommitsSinceTag(ctx, tag) },
+		func() (int, error) { return r.fallback.CommitsSinceTag(ctx, tag) },
+	)
+}
+
+func (r *fallbackGitRepository) CommitSubjectsSinceTag(ctx context.Context, tag string) ([]string, error) {
+	return fallbackValue(ctx, r, "CommitSubjectsSinceTag",
+		func() ([]string, error) { return r.primary.CommitSubjectsSinceTag(ctx, tag) },
+		func() ([]string, error) { return r.fallback.CommitSubjectsSinceTag(ctx, tag) },
+	)
+}
+
+func (r *fallbackGitRepository) CommitMessagesSinceTag(ctx context.Context, tag string) ([]string, error) {
+	return fallbackValue(ctx, r, "CommitMessagesSinceTag",
+		func() ([]string, error) { return r.primary.CommitMessagesSinceTag(ctx, tag) },
+		func() ([]string, error) { return r.fallback.CommitMessagesSinceTag(ctx, tag) },
+	)
+}
+
+func (r *fallbackGitRepository) TagExists(ctx context.Context, tag string) (bool, error) {
+	return fallbackValue(ctx, r, "TagExists",
+		func() (bool, error) { return r.primary.TagExists(ctx, tag) },
+		func() (bool, error) { return r.fallback.TagExists(ctx, tag) },
+	)
+}
+
+func (r *fallbackGitRepository) CreateBranch(ctx context.Context, name string) error {
+	return r.primary.CreateBranch(ctx, name)
+}
+
+func (r *fallbackGitRepository) CreateTag(ctx context.Context, tag, msg string) error {
+	return r.primary.CreateTag(ctx, tag, msg)
+}
+
+func (r *fallbackGitRepository) PushTag(ctx context.Context, tag string) error {
+	return r.primary.PushTag(ctx, tag)
+}
+
+func (r *fallbackGitRepository) PushBranch(ctx context.Context, name string) error {
+	return r.primary.PushBranch(ctx, name)
+}
+
+func (r *fallbackGitRepository) TagCommit(ctx context.Context, tag string) (string, error) {
+	return fallbackValue(ctx, r, "TagCommit",
+		func() (string, error) { return r.primary.TagCommit(ctx, tag) },
+		func() (string, error) { return r.fallback.TagCommit(ctx, tag) },
+	)
+}
+
+func (r *fallbackGitRepository) ReleaseTags(ctx context.Context) ([]domain.ReleaseTag, error) {
+	return fallbackValue(ctx, r, "ReleaseTags",
+		func() ([]domain.ReleaseTag, error) { return r.primary.ReleaseTags(ctx) },
+		func() ([]domain.ReleaseTag, error) { return r.fallback.ReleaseTags(ctx) },
+	)
+}
+
+func (r *fallbackGitRepository) TagAnnotation(ctx context.Context, tag string) (msg string, annotated bool, err error) {
+	err = r.do(ctx, "TagAnnotation", func() error {
+		var callErr error
+		msg, annotated, callErr = r.primary.TagAnnotation(ctx, tag)
+		return callErr
+	}, func() error {
+		var callErr error
+		msg, annotated, callErr = r.fallback.TagAnnotation(ctx, tag)
+		return callErr
+	})
+	return msg, annotated, err
+}
+
+func (r *fallbackGitRepository) CreateTagForce(ctx context.Context, tag, commit, msg string) error {
+	return r.primary.CreateTagForce(ctx, tag, commit, msg)
+}
+
+func (r *fallbackGitRepository) PushTagForce(ctx context.Context, tag string) error {
+	return r.primary.PushTagForce(ctx, tag)
+}
+
+func (r *fallbackGitRepository) PushBranchForce(ctx context.Context, name string) error {
+	return r.primary.PushBranchForce(ctx, name)
+}
+
+func (r *fallbackGitRepository) CheckoutBranch(ctx context.Context, name string) error {
+	return r.primary.CheckoutBranch(ctx, name)
+}
+
+func (r *fallbackGitRepository) SyncBranch(ctx context.Context, name string) error {
+	return r.primary.SyncBranch(ctx, name)
+}
+
+func (r *fallbackGitRepository) ConfigureUser(ctx context.Context, name, email string) error {
+	return r.primary.ConfigureUser(ctx, name, email)
+}
+
+func (r *fallbackGitRepository) AddFiles(ctx context.Context, pattern string) error {
+	return r.primary.AddFiles(ctx, pattern)
+}
+
+func (r *fallbackGitRepository) Commit(ctx context.Context, message string) error {
+	return r.primary.Commit(ctx, message)
+}
+
+func (r *fallbackGitRepository) GetHeadCommit(ctx context.Context) (string, error) {
+	return fallbackValue(ctx, r, "GetHeadCommit",
+		func() (string, error) { return r.primary.GetHeadCommit(ctx) },
+		func() (string, error) { return r.fallback.GetHeadCommit(ctx) },
+	)
+}
+
+func (r *fallbackGitRepository) GetCurrentBranch(ctx context.Context) (string, error) {
+	return fallbackValue(ctx, r, "GetCurrentBranch",
+		func() (string, error) { return r.primary.GetCurrentBranch(ctx) },
+		func() (string, error) { return r.fallback.GetCurrentBranch(ctx) },
+	)
+}
+
+func (r *fallbackGitRepository) DeleteBranch(ctx context.Context, name string) error {
+	return r.primary.DeleteBranch(ctx, name)
+}
+
+func (r *fallbackGitRepository) DeleteRemoteBranch(ctx context.Context, name string) error {
+	return r.primary.DeleteRemoteBranch(ctx, name)
+}
+
+func (r *fallbackGitRepository) ListLocalBranches(ctx context.Context) ([]string, error) {
+	return fallbackValue(ctx, r, "ListLocalBranches",
+		func() ([]string, error) { return r.primary.ListLocalBranches(ctx) },
+		func() ([]string, error) { return r.fallback.ListLocalBranches(ctx) },
+	)
+}
+
+func (r *fallbackGitRepository) ListRemoteBranches(ctx context.Context, prefix string) ([]string, error) {
+	return fallbackValue(ctx, r, "ListRemoteBranches",
+		func() ([]string, error) { return r.primary.ListRemoteBranches(ctx, prefix) },
+		func() ([]string, error) { return r.fallback.ListRemoteBranches(ctx, prefix) },
+	)
+}
+
+func (r *fallbackGitRepository) RemoteBranchExists(ctx context.Context, branchName string) (bool, error) {
+	return fallbackValue(ctx, r, "RemoteBranchExists",
+		func() (bool, error) { return r.primary.RemoteBranchExists(ctx, branchName) },
+		func() (bool, error) { return r.fallback.RemoteBranchExists(ctx, branchName) },
+	)
+}
+
+func (r *fallbackGitRepository) MoveFile(ctx context.Context, from, to string) error {
+	return r.primary.MoveFile(ctx, from, to)
+}
+
+func (r *fallbackGitRepository) RemoveFile(ctx context.Context, path string) error {
+	return r.primary.RemoveFile(ctx, path)
+}
+
+func (r *fallbackGitRepository) RestoreFile(ctx context.Context, path string) error {
+	return r.primary.RestoreFile(ctx, path)
+}
+
+func (r *fallbackGitRepository) ResetHard(ctx context.Context, ref string) error {
+	return r.primary.ResetHard(ctx, ref)
+}
+
+func (r *fallbackGitRepository) GetFileStatus(ctx context.Context, path string) (string, error) {
+	return fallbackValue(ctx, r, "GetFileStatus",
+		func() (string, error) { return r.primary.GetFileStatus(ctx, path) },
+		func() (string, error) { return r.fallback.GetFileStatus(ctx, path) },
+	)
+}
+
+func (r *fallbackGitRepository) IsSyncedWithBase(ctx context.Context, base string) (bool, error) {
+	return fallbackValue(ctx, r, "IsSyncedWithBase",
+		func() (bool, error) { return r.primary.IsSyncedWithBase(ctx, base) },
+		func() (bool, error) { return r.fallback.IsSyncedWithBase(ctx, base) },
+	)
+}
+
+func (r *fallbackGitRepository) RebaseOntoBase(ctx context.Context, base string) error {
+	return r.primary.RebaseOntoBase(ctx, base)
+}
+
+func (r *fallbackGitRepository) HeadCommitDiff(ctx context.Context) ([]domain.FileDiffStat, error) {
+	return fallbackValue(ctx, r, "HeadCommitDiff",
+		func() ([]domain.FileDiffStat, error) { return r.primary.HeadCommitDiff(ctx) },
+		func() ([]domain.FileDiffStat, error) { return r.fallback.HeadCommitDiff(ctx) },
+	)
+}
+
+func (r *fallbackGitRepository) SubmoduleUpdates(ctx context.Context, tag string) (domain.SubmoduleUpdates, error) {
+	return fallbackValue(ctx, r, "SubmoduleUpdates",
+		func() (domain.SubmoduleUpdates, error) { return r.primary.SubmoduleUpdates(ctx, tag) },
+		func() (domain.SubmoduleUpdates, error) { return r.fallback.SubmoduleUpdates(ctx, tag) },
+	)
+}
+
+func (r *fallbackGitRepository) BumpSubmodules(ctx context.Context) (domain.SubmoduleUpdates, error) {
+	return r.primary.BumpSubmodules(ctx)
+}
+
+func (r *fallbackGitRepository) ReleaseStats(ctx context.Context, tag string) (domain.ReleaseStats, error) {
+	return fallbackValue(ctx, r, "ReleaseStats",
+		func() (domain.ReleaseStats, error) { return r.primary.ReleaseStats(ctx, tag) },
+		func() (domain.ReleaseStats, error) { return r.fallback.ReleaseStats(ctx, tag) },
+	)
+}
@@ -2,16 +2,22 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"os/exec"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/compozy/releasepr/internal/domain"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/storer"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
@@ -22,11 +28,20 @@ import (
 type gitRepository struct {
 	repo               *git.Repository
 	pushTimeoutMinutes int
+	remoteName         string
+	tags               *tagFetchPolicy
 }
 
-// NewGitRepository creates a new GitRepository.
+// DefaultGitRemote is the remote used when no git_remote is configured.
+const DefaultGitRemote = "origin"
+
+// NewGitRepository creates a new GitRepository. A repository go-git does not support, such as a
+// partial clone, is served by the git CLI instead.
 func NewGitRepository() (GitRepository, error) {
 	repo, err := git.PlainOpen(".")
+	if unsupportedByPrimary(err) {
+		return NewGitCLIRepository(DefaultGitRemote, 2, TagFetchAlways)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to open git repository: %w", err)
 	}
@@ -44,6 +59,20 @@ func NewGitExtendedRepository() (GitExtendedRepository, error) {
 
 // NewGitExtendedRepositoryWithTimeout creates a new GitExtendedRepository with custom timeout.
 func NewGitExtendedRepositoryWithTimeout(timeoutMinutes int) (GitExtendedRepository, error) {
+	return NewGitExtendedRepositoryWithRemote(DefaultGitRemote, timeoutMinutes, TagFetchAlways)
+}
+
+// NewGitExtendedRepositoryWithRemote creates a new GitExtendedRepository that pushes, fetches,
+// and lists branches against the named remote, fetching its tags according to tagFetch.
+func NewGitExtendedRepositoryWithRemote(
+	remoteName string,
+	timeoutMinutes int,
+	tagFetch string,
+) (GitExtendedRepository, error) {
+	tags, err := newTagFetchPolicy(tagFetch)
+	if err != nil {
+		return nil, err
+	}
 	repo, err := git.PlainOpen(".")
 	if err != nil {
 		return nil, fmt.Errorf("failed to open git repository: %w", err)
@@ -51,25 +80,53 @@ func NewGitExtendedRepositoryWithTimeout(timeoutMinutes int) (GitExtendedReposit
 	if timeoutMinutes < 1 {
 		timeoutMinutes = 2
 	}
-	return &gitRepository{repo: repo, pushTimeoutMinutes: timeoutMinutes}, nil
+	return &gitRepository{repo: repo, pushTimeoutMinutes: timeoutMinutes, remoteName: remoteName, tags: tags}, nil
+}
+
+// remote returns the configured remote name, defaulting to origin.
+func (r *gitRepository) remote() string {
+	if r.remoteName == "" {
+		return DefaultGitRemote
+	}
+	return r.remoteName
+}
+
+// fetchTags fetches the tags of the remote with a short timeout. A remote without new tags is not an error.
+func (r *gitRepository) fetchTags(ctx context.Context) error {
+	remote, err := r.repo.Remote(r.remote())
+	if err != nil {
+		return fmt.Errorf("failed to get remote: %w", err)
+	}
+	fetchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	if err := remote.FetchContext(fetchCtx, &git.FetchOptions{
+		RefSpecs: []config.RefSpec{
+			config.RefSpec("+refs/tags/*:refs/tags/*"),
+		},
+		Auth: r.getAuth(),
+	}); err != nil && err != git.NoErrAlreadyUpToDate {
+		return fmt.Errorf("failed to fetch tags from remote: %w", err)
+	}
+	return nil
 }
 
 // LatestTag returns the latest git tag.
 func (r *gitRepository) LatestTag(ctx context.Context) (string, error) {
-	// First, try to fetch tags from remote to ensure we have the latest
-	remote, err := r.repo.Remote("origin")
-	if err == nil {
-		// Fetch tags from remote with timeout (ignore error if already up to date)
-		fetchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-		defer cancel()
-		//nolint:errcheck // We intentionally ignore the error as local tags are sufficient
-		_ = remote.FetchContext(fetchCtx, &git.FetchOptions{
-			RefSpecs: []config.RefSpec{
-				config.RefSpec("+refs/tags/*:refs/tags/*"),
-			},
-			Auth: r.getAuth(),
-		})
+	fetch := func() error { return r.fetchTags(ctx) }
+	//nolint:errcheck // We intentionally ignore the error as local tags are sufficient
+	_ = r.tags.beforeRead(fetch)
+	latest, err := r.latestLocalTag()
+	if err != nil || latest != "" {
+		return latest, err
 	}
+	if fetched, err := r.tags.onMiss(fetch); !fetched || err != nil {
+		return "", nil
+	}
+	return r.latestLocalTag()
+}
+
+// latestLocalTag returns the local tag pointing at the most recently committed commit.
+func (r *gitRepository) latestLocalTag() (string, error) {
 	tagRefs, err := r.repo.Tags()
 	if err != nil {
 		return "", fmt.Errorf("failed to get tags: %w", err)
@@ -108,25 +165,13 @@ func (r *gitRepository) fetchTagIfNeeded(ctx context.Context, tag string) (*plum
 		return tagRef, nil
 	}
 	// Tag doesn't exist locally, try to fetch it from remote
-	remote, err := r.repo.Remote("origin")
-	if err != nil {
-		return nil, fmt.Errorf("failed to get remote: %w", err)
-	}
-	// Fetch tags from remote with timeout
-	fetchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
-	if err := remote.FetchContext(fetchCtx, &git.FetchOptions{
-		RefSpecs: []config.RefSpec{
-			config.RefSpec("+refs/tags/*:refs/tags/*"),
-		},
-		Auth: r.getAuth(),
-	}); err != nil && err != git.NoErrAlreadyUpToDate {
-		return nil, fmt.Errorf("failed to fetch tags from remote: %w", err)
+	if _, err := r.tags.onMiss(func() error { return r.fetchTags(ctx) }); err != nil {
+		return nil, err
 	}
 	// Try to get the tag again
 	tagRef, err = r.repo.Tag(tag)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tag %s after fetching: %w", tag, err)
+		return nil, fmt.Errorf("failed to get tag %s: %w", tag, err)
 	}
 	return tagRef, nil
 }
@@ -187,6 +232,266 @@ func (r *gitRepository) CommitsSinceTag(ctx context.Context, tag string) (int, e
 	return r.countCommitsSince(tagCommitHash)
 }
 
+// CommitSubjectsSinceTag returns the subject lines of the commits since the given tag, newest first.
+func (r *gitRepository) CommitSubjectsSinceTag(ctx context.Context, tag string) ([]string, error) {
+	messages, err := r.CommitMessagesSinceTag(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+	subjects := make([]string, 0, len(messages))
+	for _, message := range messages {
+		subject, _, _ := strings.Cut(message, "\n")
+		subjects = append(subjects, strings.TrimSpace(subject))
+	}
+	return subjects, nil
+}
+
+// CommitMessagesSinceTag returns the full messages of the commits since the given tag, newest first.
+// An empty tag covers the whole history.
+func (r *gitRepository) CommitMessagesSinceTag(ctx context.Context, tag string) ([]string, error) {
+	since, err := r.tagCommit(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+	head, err := r.repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+	commits, err := r.repo.Log(&git.LogOptions{From: head.Hash()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commits: %w", err)
+	}
+	var messages []string
+	err = commits.ForEach(func(c *object.Commit) error {
+		if c.Hash == since {
+			return storer.ErrStop
+		}
+		messages = append(messages, strings.TrimSpace(c.Message))
+		return nil
+	})
+	if err != nil && err != storer.ErrStop {
+		return nil, fmt.Errorf("failed to iterate commits: %w", err)
+	}
+	return messages, nil
+}
+
+// tagCommit resolves tag to its commit, fetching the tag when it is missing locally.
+// An empty tag resolves to the zero hash.
+func (r *gitRepository) tagCommit(ctx context.Context, tag string) (plumbing.Hash, error) {
+	if tag == "" {
+		return plumbing.ZeroHash, nil
+	}
+	tagRef, err := r.fetchTagIfNeeded(ctx, tag)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	hash, err := r.resolveTagCommit(tagRef)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to resolve tag %s: %w", tag, err)
+	}
+	return hash, nil
+}
+
+// ReleaseStats computes commit, contributor and diff statistics for the commits since tag.
+func (r *gitRepository) ReleaseStats(ctx context.Context, tag string) (domain.ReleaseStats, error) {
+	since, err := r.tagCommit(ctx, tag)
+	if err != nil {
+		return domain.ReleaseStats{}, err
+	}
+	return releaseStatsSince(ctx, r.repo, since)
+}
+
+// releaseStatsSince walks HEAD back to since counting commits and distinct authors, then diffs the
+// tree at since against HEAD. A zero since diffs against the empty tree.
+func releaseStatsSince(ctx context.Context, repo *git.Repository, since plumbing.Hash) (domain.ReleaseStats, error) {
+	var stats domain.ReleaseStats
+	head, err := repo.Head()
+	if err != nil {
+		return stats, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+	headCommit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return stats, fmt.Errorf("failed to get HEAD commit: %w", err)
+	}
+	commits, err := repo.Log(&git.LogOptions{From: headCommit.Hash})
+	if err != nil {
+		return stats, fmt.Errorf("failed to get commits: %w", err)
+	}
+	authors := make(map[string]struct{})
+	err = commits.ForEach(func(c *object.Commit) error {
+		if c.Hash == since {
+			return storer.ErrStop
+		}
+		stats.Commits++
+		author := strings.ToLower(strings.TrimSpace(c.Author.Email))
+		if author == "" {
+			author = c.Author.Name
+		}
+		authors[author] = struct{}{}
+		return nil
+	})
+	if err != nil && err != storer.ErrStop {
+		return stats, fmt.Errorf("failed to iterate commits: %w", err)
+	}
+	stats.Contributors = len(authors)
+	headTree, err := headCommit.Tree()
+	if err != nil {
+		return stats, fmt.Errorf("failed to get HEAD tree: %w", err)
+	}
+	var sinceTree *object.Tree
+	if !since.IsZero() {
+		sinceCommit, err := repo.CommitObject(since)
+		if err != nil {
+			return stats, fmt.Errorf("failed to get commit %s: %w", since, err)
+		}
+		if sinceTree, err = sinceCommit.Tree(); err != nil {
+			return stats, fmt.Errorf("failed to get tree of %s: %w", since, err)
+		}
+	}
+	fileStats, err := diffTreeStats(ctx, sinceTree, headTree)
+	if err != nil {
+		return stats, err
+	}
+	for _, fileStat := range fileStats {
+		stats.FilesChanged++
+		stats.Insertions += fileStat.Insertions
+		stats.Deletions += fileStat.Deletions
+	}
+	return stats, nil
+}
+
+// SubmoduleUpdates lists the submodule pointers that moved between tag and HEAD.
+func (r *gitRepository) SubmoduleUpdates(ctx context.Context, tag string) (domain.SubmoduleUpdates, error) {
+	since, err := r.tagCommit(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+	return submoduleUpdatesSince(r.repo, since)
+}
+
+// submoduleUpdatesSince compares the gitlinks of the tree at since with those of HEAD. A zero since
+// compares against the empty tree.
+func submoduleUpdatesSince(repo *git.Repository, since plumbing.Hash) (domain.SubmoduleUpdates, error) {
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+	headCommit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD commit: %w", err)
+	}
+	current, err := commitGitlinks(headCommit)
+	if err != nil {
+		return nil, err
+	}
+	previous := make(map[string]string)
+	if !since.IsZero() {
+		sinceCommit, err := repo.CommitObject(since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commit %s: %w", since, err)
+		}
+		if previous, err = commitGitlinks(sinceCommit); err != nil {
+			return nil, err
+		}
+	}
+	var updates domain.SubmoduleUpdates
+	for path, to := range current {
+		if from := previous[path]; from != to {
+			updates = append(updates, domain.SubmoduleUpdate{Path: path, From: from, To: to})
+		}
+	}
+	for path, from := range previous {
+		if _, ok := current[path]; !ok {
+			updates = append(updates, domain.SubmoduleUpdate{Path: path, From: from})
+		}
+	}
+	sort.Slice(updates, func(i, j int) bool { return updates[i].Path < updates[j].Path })
+	return updates, nil
+}
+
+// commitGitlinks maps the path of every submodule in the tree of commit to the commit it points at.
+func commitGitlinks(commit *object.Commit) (map[string]string, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree of %s: %w", commit.Hash, err)
+	}
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+	links := make(map[string]string)
+	for {
+		name, entry, err := walker.Next()
+		if errors.Is(err, io.EOF) {
+			return links, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk tree of %s: %w", commit.Hash, err)
+		}
+		if entry.Mode == filemode.Submodule {
+			links[name] = entry.Hash.String()
+		}
+	}
+}
+
+// BumpSubmodules moves every submodule to its highest semver tag through the git CLI, which
+// handles the nested repositories go-git cannot update.
+func (r *gitRepository) BumpSubmodules(ctx context.Context) (domain.SubmoduleUpdates, error) {
+	return (&gitCLIRepository{dir: r.getWorkingDirectory()}).BumpSubmodules(ctx)
+}
+
+// HeadCommitDiff returns the per-file diff of HEAD against its first parent.
+func (r *gitRepository) HeadCommitDiff(ctx context.Context) ([]domain.FileDiffStat, error) {
+	return headCommitDiff(ctx, r.repo)
+}
+
+// headCommitDiff diffs HEAD against its first parent, or against the empty tree for a root commit.
+func headCommitDiff(ctx context.Context, repo *git.Repository) ([]domain.FileDiffStat, error) {
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+	headCommit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD commit: %w", err)
+	}
+	headTree, err := headCommit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD tree: %w", err)
+	}
+	var parentTree *object.Tree
+	if headCommit.NumParents() > 0 {
+		parent, err := headCommit.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent of HEAD: %w", err)
+		}
+		if parentTree, err = parent.Tree(); err != nil {
+			return nil, fmt.Errorf("failed to get parent tree: %w", err)
+		}
+	}
+	return diffTreeStats(ctx, parentTree, headTree)
+}
+
+// diffTreeStats counts the lines each file adds and removes between two trees. A nil from tree
+// stands for the empty tree.
+func diffTreeStats(ctx context.Context, from, to *object.Tree) ([]domain.FileDiffStat, error) {
+	changes, err := object.DiffTreeWithOptions(ctx, from, to, object.DefaultDiffTreeOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff trees: %w", err)
+	}
+	patch, err := changes.PatchContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute patch: %w", err)
+	}
+	stats := make([]domain.FileDiffStat, 0, len(patch.Stats()))
+	for _, fileStat := range patch.Stats() {
+		stats = append(stats, domain.FileDiffStat{
+			Path:       fileStat.Name,
+			Insertions: fileStat.Addition,
+			Deletions:  fileStat.Deletion,
+		})
+	}
+	return stats, nil
+}
+
 // TagExists checks if a tag exists.
 func (r *gitRepository) TagExists(_ context.Context, tag string) (bool, error) {
 	_, err := r.repo.Tag(tag)
@@ -238,8 +543,101 @@ func (r *gitRepository) CreateTag(_ context.Context, tag, msg string) error {
 	return nil
 }
 
+// TagCommit returns the SHA of the commit tag points to.
+func (r *gitRepository) TagCommit(ctx context.Context, tag string) (string, error) {
+	hash, err := r.tagCommit(ctx, tag)
+	if err != nil {
+		return "", err
+	}
+	return hash.String(), nil
+}
+
+// ReleaseTags returns every local tag with its release date, oldest first: the tagger date of
+// annotated tags and the commit date of lightweight tags.
+func (r *gitRepository) ReleaseTags(_ context.Context) ([]domain.ReleaseTag, error) {
+	tagRefs, err := r.repo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	var tags []domain.ReleaseTag
+	if err := tagRefs.ForEach(func(ref *plumbing.Reference) error {
+		if tag, err := r.repo.TagObject(ref.Hash()); err == nil {
+			tags = append(tags, domain.ReleaseTag{Name: ref.Name().Short(), Date: tag.Tagger.When})
+			return nil
+		}
+		commit, err := r.repo.CommitObject(ref.Hash())
+		if err != nil {
+			return fmt.Errorf("failed to resolve tag %s: %w", ref.Name().Short(), err)
+		}
+		tags = append(tags, domain.ReleaseTag{Name: ref.Name().Short(), Date: commit.Committer.When})
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to iterate tags: %w", err)
+	}
+	slices.SortStableFunc(tags, func(a, b domain.ReleaseTag) int {
+		if c := a.Date.Compare(b.Date); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return tags, nil
+}
+
+// TagAnnotation returns the message of tag and true when it is annotated, or false for a lightweight tag.
+func (r *gitRepository) TagAnnotation(_ context.Context, tag string) (string, bool, error) {
+	ref, err := r.repo.Tag(tag)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get tag %s: %w", tag, err)
+	}
+	annotation, err := r.repo.TagObject(ref.Hash())
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read tag %s: %w", tag, err)
+	}
+	return strings.TrimSpace(annotation.Message), true, nil
+}
+
+// CreateTagForce creates the annotated tag at commit, or at HEAD when commit is empty, replacing an existing tag.
+// An empty msg creates a lightweight tag.
+func (r *gitRepository) CreateTagForce(_ context.Context, tag, commit, msg string) error {
+	target := plumbing.NewHash(commit)
+	if commit == "" {
+		head, err := r.repo.Head()
+		if err != nil {
+			return fmt.Errorf("failed to get HEAD: %w", err)
+		}
+		target = head.Hash()
+	}
+	if err := r.repo.DeleteTag(tag); err != nil && err != git.ErrTagNotFound {
+		return fmt.Errorf("failed to delete tag %s: %w", tag, err)
+	}
+	var opts *git.CreateTagOptions
+	if msg != "" {
+		opts = &git.CreateTagOptions{
+			Message: msg,
+			Tagger: &object.Signature{
+				Name:  "Test User",
+				Email: "test@example.com",
+				When:  time.Now(),
+			},
+		}
+	}
+	_, err := r.repo.CreateTag(tag, target, opts)
+	if err != nil {
+		return fmt.Errorf("failed to force create tag %s: %w", tag, err)
+	}
+	return nil
+}
+
 // getAuth returns authentication configuration for GitHub Actions
 func (r *gitRepository) getAuth() *http.BasicAuth {
+	return githubTokenAuth()
+}
+
+// githubTokenAuth builds basic auth from the GitHub token environment variables, if any.
+func githubTokenAuth() *http.BasicAuth {
 	// Check for GITHUB_TOKEN environment variable (used in GitHub Actions)
 	token := os.Getenv("GITHUB_TOKEN")
 	if token == "" {
@@ -259,7 +657,7 @@ func (r *gitRepository) getAuth() *http.BasicAuth {
 // getGitEnv returns environment variables for native git CLI authentication
 func (r *gitRepository) getGitEnv() []string {
 	// Disable terminal prompts to prevent hanging on auth failures
-	return []string{"GIT_TERMINAL_PROMPT=0"}
+	return append([]string{"GIT_TERMINAL_PROMPT=0"}, gitTransportEnv...)
 }
 
 // getWorkingDirectory returns the git repository working directory
@@ -274,15 +672,19 @@ func (r *gitRepository) getWorkingDirectory() string {
 // getAuthenticatedURL constructs a git remote URL with embedded credentials.
 // Returns the authenticated URL, the auth object (for sanitization), and any error.
 func (r *gitRepository) getAuthenticatedURL() (string, *http.BasicAuth, error) {
-	remote, err := r.repo.Remote("origin")
+	remote, err := r.repo.Remote(r.remote())
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to get remote 'origin': %w", err)
+		return "", nil, fmt.Errorf("failed to get remote '%s': %w", r.remote(), err)
 	}
 	if len(remote.Config().URLs) == 0 {
-		return "", nil, fmt.Errorf("no URL found for remote 'origin'")
+		return "", nil, fmt.Errorf("no URL found for remote '%s'", r.remote())
 	}
-	rawURL := remote.Config().URLs[0]
-	auth := r.getAuth()
+	return authenticateRemoteURL(remote.Config().URLs[0])
+}
+
+// authenticateRemoteURL embeds the GitHub token credentials into a remote URL when available.
+func authenticateRemoteURL(rawURL string) (string, *http.BasicAuth, error) {
+	auth := githubTokenAuth()
 	if auth == nil {
 		return rawURL, nil, nil
 	}
@@ -309,8 +711,21 @@ func (r *gitRepository) PushTag(ctx context.Context, tag string) error {
 	pushCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 	defer cancel()
 	return r.repo.PushContext(pushCtx, &git.PushOptions{
-		RefSpecs: []config.RefSpec{config.RefSpec(fmt.Sprintf("refs/tags/%s:refs/tags/%s", tag, tag))},
-		Auth:     r.getAuth(),
+		RemoteName: r.remote(),
+		RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("refs/tags/%s:refs/tags/%s", tag, tag))},
+		Auth:       r.getAuth(),
+	})
+}
+
+// PushTagForce pushes a tag to the remote with force.
+func (r *gitRepository) PushTagForce(ctx context.Context, tag string) error {
+	pushCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	return r.repo.PushContext(pushCtx, &git.PushOptions{
+		RemoteName: r.remote(),
+		RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("+refs/tags/%s:refs/tags/%s", tag, tag))},
+		Auth:       r.getAuth(),
+		Force:      true,
 	})
 }
 
@@ -370,6 +785,31 @@ func (r *gitRepository) CheckoutBranch(ctx context.Context, name string) error {
 	return nil
 }
 
+// SyncBranch fetches a branch from the remote, checks it out, and hard-resets it to the remote head.
+func (r *gitRepository) SyncBranch(ctx context.Context, name string) error {
+	syncCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	authURL, auth, err := r.getAuthenticatedURL()
+	if err != nil {
+		return fmt.Errorf("failed to prepare authenticated URL for fetch: %w", err)
+	}
+	remoteRef := fmt.Sprintf("refs/remotes/%s/%s", r.remote(), name)
+	for _, args := range [][]string{
+		{"fetch", "--quiet", authURL, fmt.Sprintf("+refs/heads/%s:%s", name, remoteRef)},
+		{"checkout", "-B", name, remoteRef},
+		{"reset", "--hard", remoteRef},
+	} {
+		cmd := exec.CommandContext(syncCtx, "git", args...)
+		cmd.Dir = r.getWorkingDirectory()
+		cmd.Env = append(os.Environ(), r.getGitEnv()...)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			sanitizedOutput := sanitizeOutput(string(output), authURL, auth)
+			return fmt.Errorf("failed to sync branch %s: %w (output: %s)", name, err, sanitizedOutput)
+		}
+	}
+	return nil
+}
+
 // ConfigureUser sets the git user configuration.
 func (r *gitRepository) ConfigureUser(_ context.Context, name, email string) error {
 	cfg, err := r.repo.Config()
@@ -381,8 +821,16 @@ func (r *gitRepository) ConfigureUser(_ context.Context, name, email string) err
 	return r.repo.Storer.SetConfig(cfg)
 }
 
-// AddFiles stages files matching the pattern.
-func (r *gitRepository) AddFiles(_ context.Context, pattern string) error {
+// AddFiles stages files matching the pattern. Files tracked by git LFS are staged with the git CLI
+// so their clean filter turns them into pointers.
+func (r *gitRepository) AddFiles(ctx context.Context, pattern string) error {
+	cli, err := r.lfsCLI(ctx, pattern)
+	if err != nil {
+		return err
+	}
+	if cli != nil {
+		return cli.AddFiles(ctx, pattern)
+	}
 	w, err := r.repo.Worktree()
 	if err != nil {
 		return fmt.Errorf("failed to get worktree: %w", err)
@@ -396,13 +844,13 @@ func (r *gitRepository) AddFiles(_ context.Context, pattern string) error {
 	return nil
 }
 
-// Commit creates a commit with the given message.
+// Commit creates a commit with the given message, even when nothing is staged.
 func (r *gitRepository) Commit(_ context.Context, message string) error {
 	w, err := r.repo.Worktree()
 	if err != nil {
 		return fmt.Errorf("failed to get worktree: %w", err)
 	}
-	_, err = w.Commit(message, &git.CommitOptions{})
+	_, err = w.Commit(message, &git.CommitOptions{AllowEmptyCommits: true})
 	if err != nil {
 		return fmt.Errorf("failed to create commit: %w", err)
 	}
@@ -412,6 +860,12 @@ func (r *gitRepository) Commit(_ context.Context, message string) error {
 // GetCurrentBranch returns the name of the current branch.
 func (r *gitRepository) GetCurrentBranch(_ context.Context) (string, error) {
 	head, err := r.repo.Head()
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		unborn, refErr := r.repo.Reference(plumbing.HEAD, false)
+		if refErr == nil && unborn.Type() == plumbing.SymbolicReference {
+			return unborn.Target().Short(), nil
+		}
+	}
 	if err != nil {
 		return "", fmt.Errorf("failed to get HEAD: %w", err)
 	}
@@ -433,7 +887,7 @@ func (r *gitRepository) DeleteRemoteBranch(ctx context.Context, name string) err
 	defer cancel()
 	refSpec := config.RefSpec(":refs/heads/" + name)
 	err := r.repo.PushContext(deleteCtx, &git.PushOptions{
-		RemoteName: "origin",
+		RemoteName: r.remote(),
 		RefSpecs:   []config.RefSpec{refSpec},
 		Auth:       r.getAuth(),
 	})
@@ -456,11 +910,25 @@ func (r *gitRepository) MoveFile(ctx context.Context, from, to string) error {
 	return nil
 }
 
-// RestoreFile restores a file to its state in HEAD.
+// RemoveFile deletes a tracked file from the worktree and the index.
+func (r *gitRepository) RemoveFile(_ context.Context, path string) error {
+	w, err := r.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get worktree: %w", err)
+	}
+	if _, err := w.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove file %s: %w", path, err)
+	}
+	return nil
+}
+
+// RestoreFile restores a file in the index and the working tree to its state in HEAD, undoing staged
+// moves and deletions and removing a file HEAD does not have. The native git restore runs the smudge
+// filter of files tracked by git LFS.
 func (r *gitRepository) RestoreFile(ctx context.Context, path string) error {
 	restoreCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 	defer cancel()
-	cmd := exec.CommandContext(restoreCtx, "git", "checkout", "--", path)
+	cmd := exec.CommandContext(restoreCtx, "git", "restore", "--source=HEAD", "--staged", "--worktree", "--", path)
 	cmd.Dir = r.getWorkingDirectory()
 	cmd.Env = append(os.Environ(), r.getGitEnv()...)
 	if output, err := cmd.CombinedOutput(); err != nil {
@@ -485,6 +953,9 @@ func (r *gitRepository) ResetHard(ctx context.Context, ref string) error {
 // GetHeadCommit returns the SHA of the current HEAD commit.
 func (r *gitRepository) GetHeadCommit(_ context.Context) (string, error) {
 	head, err := r.repo.Head()
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return "", ErrEmptyRepository
+	}
 	if err != nil {
 		return "", fmt.Errorf("failed to get HEAD: %w", err)
 	}
@@ -508,9 +979,9 @@ func (r *gitRepository) ListLocalBranches(_ context.Context) ([]string, error) {
 	return branches, nil
 }
 
-// ListRemoteBranches returns a list of all remote branch names.
-func (r *gitRepository) ListRemoteBranches(ctx context.Context) ([]string, error) {
-	remote, err := r.repo.Remote("origin")
+// ListRemoteBranches returns the remote branch names starting with prefix, prefixed with the remote name.
+func (r *gitRepository) ListRemoteBranches(ctx context.Context, prefix string) ([]string, error) {
+	remote, err := r.repo.Remote(r.remote())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get remote: %w", err)
 	}
@@ -523,9 +994,9 @@ func (r *gitRepository) ListRemoteBranches(ctx context.Context) ([]string, error
 	}
 	var branches []string
 	for _, ref := range refs {
-		if ref.Name().IsBranch() {
-			// Returns in format "origin/branch-name"
-			branches = append(branches, "origin/"+ref.Name().Short())
+		if ref.Name().IsBranch() && strings.HasPrefix(ref.Name().Short(), prefix) {
+			// Returns in format "<remote>/branch-name"
+			branches = append(branches, r.remote()+"/"+ref.Name().Short())
 		}
 	}
 	return branches, nil
@@ -534,7 +1005,7 @@ func (r *gitRepository) ListRemoteBranches(ctx context.Context) ([]string, error
 // RemoteBranchExists checks if a specific branch exists on the remote.
 // This is more efficient than ListRemoteBranches when checking a single branch.
 func (r *gitRepository) RemoteBranchExists(ctx context.Context, branchName string) (bool, error) {
-	remote, err := r.repo.Remote("origin")
+	remote, err := r.repo.Remote(r.remote())
 	if err != nil {
 		return false, fmt.Errorf("failed to get remote: %w", err)
 	}
@@ -555,7 +1026,16 @@ func (r *gitRepository) RemoteBranchExists(ctx context.Context, branchName strin
 
 // GetFileStatus returns the git status of a specific file.
 // Returns "clean" if the file has no changes, "modified" if it has uncommitted changes.
-func (r *gitRepository) GetFileStatus(_ context.Context, path string) (string, error) {
+// Files tracked by git LFS are compared by the git CLI, since go-git sees their smudged content
+// as a change to the committed pointer.
+func (r *gitRepository) GetFileStatus(ctx context.Context, path string) (string, error) {
+	cli, err := r.lfsCLI(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	if cli != nil {
+		return cli.GetFileStatus(ctx, path)
+	}
 	w, err := r.repo.Worktree()
 	if err != nil {
 		return "", fmt.Errorf("failed to get worktree: %w", err)
@@ -570,3 +1050,47 @@ func (r *gitRepository) GetFileStatus(_ context.Context, path string) (string, e
 	}
 	return "modified", nil
 }
+
+// IsSyncedWithBase fetches base from the remote and reports whether HEAD contains its latest commit.
+func (r *gitRepository) IsSyncedWithBase(ctx context.Context, base string) (bool, error) {
+	syncCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	authURL, auth, err := r.getAuthenticatedURL()
+	if err != nil {
+		return false, fmt.Errorf("failed to prepare authenticated URL for fetch: %w", err)
+	}
+	remoteRef := fmt.Sprintf("refs/remotes/%s/%s", r.remote(), base)
+	fetch := exec.CommandContext(syncCtx, "git", "fetch", "--quiet", authURL,
+		fmt.Sprintf("+refs/heads/%s:%s", base, remoteRef))
+	fetch.Dir = r.getWorkingDirectory()
+	fetch.Env = append(os.Environ(), r.getGitEnv()...)
+	if output, err := fetch.CombinedOutput(); err != nil {
+		sanitizedOutput := sanitizeOutput(string(output), authURL, auth)
+		return false, fmt.Errorf("failed to fetch branch %s: %w (output: %s)", base, err, sanitizedOutput)
+	}
+	check := exec.CommandContext(syncCtx, "git", "merge-base", "--is-ancestor", remoteRef, "HEAD")
+	check.Dir = r.getWorkingDirectory()
+	check.Env = append(os.Environ(), r.getGitEnv()...)
+	output, err := check.CombinedOutput()
+	return ancestorCheckResult(remoteRef, strings.TrimSpace(string(output)), err)
+}
+
+// RebaseOntoBase rebases HEAD onto the fetched base, aborting the rebase when it fails.
+func (r *gitRepository) RebaseOntoBase(ctx context.Context, base string) error {
+	rebaseCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	remoteRef := fmt.Sprintf("refs/remotes/%s/%s", r.remote(), base)
+	cmd := exec.CommandContext(rebaseCtx, "git", "rebase", remoteRef)
+	cmd.Dir = r.getWorkingDirectory()
+	cmd.Env = append(os.Environ(), r.getGitEnv()...)
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	abort := exec.CommandContext(rebaseCtx, "git", "rebase", "--abort")
+	abort.Dir = r.getWorkingDirectory()
+	abort.Env = append(os.Environ(), r.getGitEnv()...)
+	//nolint:errcheck // The rebase error below is what matters; abort only restores the branch.
+	abort.Run()
+	return fmt.Errorf("failed to rebase onto %s: %w (output: %s)", remoteRef, err, string(output))
+}
@@ -3,10 +3,12 @@ package repository
 import (
 	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/compozy/releasepr/internal/domain"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
@@ -40,6 +42,25 @@ func setupTestRepo(t *testing.T) (string, *git.Repository) {
 	return dir, repo
 }
 
+// setupPartialCloneRepo creates a tagged repository that declares the partialClone extension.
+func setupPartialCloneRepo(t *testing.T) string {
+	dir, repo := setupTestRepo(t)
+	head, err := repo.Head()
+	require.NoError(t, err)
+	_, err = repo.CreateTag("v1.0.0", head.Hash(), nil)
+	require.NoError(t, err)
+	for _, args := range [][]string{
+		{"config", "core.repositoryformatversion", "1"},
+		{"config", "extensions.partialClone", "origin"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+	return dir
+}
+
 func TestNewGitRepository(t *testing.T) {
 	t.Run("Should create git repository for existing repo", func(t *testing.T) {
 		dir, _ := setupTestRepo(t)
@@ -51,6 +72,20 @@ func TestNewGitRepository(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, gitRepo)
 	})
+	t.Run("Should open partial clones go-git does not support with the git CLI", func(t *testing.T) {
+		dir := setupPartialCloneRepo(t)
+		oldPwd, _ := os.Getwd()
+		err := os.Chdir(dir)
+		require.NoError(t, err)
+		defer os.Chdir(oldPwd)
+		_, err = git.PlainOpen(".")
+		require.ErrorIs(t, err, git.ErrUnsupportedExtensionRepositoryFormatVersion)
+		gitRepo, err := NewGitRepository()
+		require.NoError(t, err)
+		tag, err := gitRepo.LatestTag(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "v1.0.0", tag)
+	})
 	t.Run("Should return error for non-git directory", func(t *testing.T) {
 		dir, err := os.MkdirTemp("", "non-git-*")
 		require.NoError(t, err)
@@ -233,6 +268,70 @@ func TestGitRepository_CommitsSinceTag(t *testing.T) {
 	})
 }
 
+// setupReleaseStatsRepo tags the initial commit v1.0.0 and adds two commits by two authors after it:
+// one rewriting test.txt and one adding a two-line file.
+func setupReleaseStatsRepo(t *testing.T) (string, *git.Repository) {
+	t.Helper()
+	dir, repo := setupTestRepo(t)
+	head, err := repo.Head()
+	require.NoError(t, err)
+	_, err = repo.CreateTag("v1.0.0", head.Hash(), nil)
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	commit := func(name, content, email string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+		_, err := wt.Add(name)
+		require.NoError(t, err)
+		_, err = wt.Commit("Update "+name, &git.CommitOptions{
+			Author: &object.Signature{Name: email, Email: email, When: time.Now()},
+		})
+		require.NoError(t, err)
+	}
+	commit("test.txt", "updated content\n", "Test@Example.com")
+	commit("notes.txt", "first\nsecond\n", "other@example.com")
+	return dir, repo
+}
+
+func TestGitRepository_ReleaseStats(t *testing.T) {
+	t.Run("Should summarize commits, contributors and diff since tag", func(t *testing.T) {
+		_, repo := setupReleaseStatsRepo(t)
+		gitRepo := &gitRepository{repo: repo}
+		stats, err := gitRepo.ReleaseStats(t.Context(), "v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, domain.ReleaseStats{
+			Commits:      2,
+			Contributors: 2,
+			FilesChanged: 2,
+			Insertions:   3,
+			Deletions:    1,
+		}, stats)
+	})
+	t.Run("Should cover the whole history without a tag", func(t *testing.T) {
+		_, repo := setupReleaseStatsRepo(t)
+		gitRepo := &gitRepository{repo: repo}
+		stats, err := gitRepo.ReleaseStats(t.Context(), "")
+		require.NoError(t, err)
+		assert.Equal(t, domain.ReleaseStats{
+			Commits:      3,
+			Contributors: 2,
+			FilesChanged: 2,
+			Insertions:   3,
+			Deletions:    0,
+		}, stats)
+	})
+}
+
+func TestGitRepository_HeadCommitDiff(t *testing.T) {
+	t.Run("Should diff HEAD against its parent only", func(t *testing.T) {
+		_, repo := setupReleaseStatsRepo(t)
+		gitRepo := &gitRepository{repo: repo}
+		stats, err := gitRepo.HeadCommitDiff(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []domain.FileDiffStat{{Path: "notes.txt", Insertions: 2}}, stats)
+	})
+}
+
 func TestGitRepository_MoveFile(t *testing.T) {
 	t.Run("Should move tracked file with git mv", func(t *testing.T) {
 		dir, repo := setupTestRepo(t)
@@ -269,3 +368,25 @@ func TestGitRepository_MoveFile(t *testing.T) {
 		assert.Error(t, statErr)
 	})
 }
+
+func TestGitRepository_RemoveFile(t *testing.T) {
+	t.Run("Should delete a tracked file and stage the deletion", func(t *testing.T) {
+		dir, repo := setupTestRepo(t)
+		gitRepo := &gitRepository{repo: repo}
+		wt, err := repo.Worktree()
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, ".changes"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".changes", "fix.md"), []byte("fix"), 0644))
+		_, err = wt.Add(".changes/fix.md")
+		require.NoError(t, err)
+		_, err = wt.Commit("Add change file", &git.CommitOptions{
+			Author: &object.Signature{Name: "Test User", Email: "test@example.com"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, gitRepo.RemoveFile(context.Background(), ".changes/fix.md"))
+		assert.NoFileExists(t, filepath.Join(dir, ".changes", "fix.md"))
+		status, err := wt.Status()
+		require.NoError(t, err)
+		assert.Equal(t, git.Deleted, status.File(".changes/fix.md").Staging)
+	})
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// lfsFilterAttribute is the check-attr output of a path git LFS tracks.
+const lfsFilterAttribute = ": filter: lfs"
+
+// lfsTracked reports whether git LFS filters any of paths. It asks git check-attr, which honours
+// nested .gitattributes files and info/attributes exactly like the clean and smudge filters do.
+func (r *gitCLIRepository) lfsTracked(ctx context.Context, paths ...string) (bool, error) {
+	if len(paths) == 0 {
+		return false, nil
+	}
+	args := append([]string{"check-attr", "filter", "--"}, paths...)
+	output, err := r.run(ctx, gitCLICommandTimeout, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to read git attributes: %w (output: %s)", err, output)
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasSuffix(line, lfsFilterAttribute) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// lfsCLI returns the git CLI repository that must handle pattern because it covers paths git LFS
+// tracks, or nil otherwise. go-git runs no clean or smudge filters: it would stage the smudged
+// content of such files instead of their pointers and report them as modified after a restore.
+// Without a git binary there are no filters either, so go-git keeps handling every path.
+func (r *gitRepository) lfsCLI(ctx context.Context, pattern string) (*gitCLIRepository, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, nil
+	}
+	cli := &gitCLIRepository{
+		dir:                r.getWorkingDirectory(),
+		pushTimeoutMinutes: r.pushTimeoutMinutes,
+		remoteName:         r.remoteName,
+	}
+	paths := []string{pattern}
+	if strings.ContainsAny(pattern, "*?[") {
+		matches, err := filepath.Glob(filepath.Join(cli.dir, pattern))
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %s: %w", pattern, err)
+		}
+		paths = paths[:0]
+		for _, match := range matches {
+			rel, err := filepath.Rel(cli.dir, match)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve %s: %w", match, err)
+			}
+			paths = append(paths, filepath.ToSlash(rel))
+		}
+	}
+	tracked, err := cli.lfsTracked(ctx, paths...)
+	if err != nil || !tracked {
+		return nil, err
+	}
+	return cli, nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupLFSRepo tracks *.bin with a stand-in for the git LFS filters: clean upper-cases the content
+// like a pointer would replace it, and smudge restores it.
+func setupLFSRepo(t *testing.T) (string, *git.Repository) {
+	t.Helper()
+	dir, repo := setupTestRepo(t)
+	cfg, err := repo.Config()
+	require.NoError(t, err)
+	filter := cfg.Raw.Section("filter").Subsection("lfs")
+	filter.SetOption("clean", "tr a-z A-Z")
+	filter.SetOption("smudge", "tr A-Z a-z")
+	require.NoError(t, repo.Storer.SetConfig(cfg))
+	attributes := "*.bin filter=lfs diff=lfs merge=lfs -text\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitattributes"), []byte(attributes), 0644))
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = wt.Add(".gitattributes")
+	require.NoError(t, err)
+	_, err = wt.Commit("Track binaries with LFS", &git.CommitOptions{
+		Author: &object.Signature{Name: "Test User", Email: "test@example.com"},
+	})
+	require.NoError(t, err)
+	return dir, repo
+}
+
+func stagedContent(t *testing.T, dir, path string) string {
+	t.Helper()
+	cmd := exec.Command("git", "cat-file", "blob", ":"+path)
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	require.NoError(t, err)
+	return string(output)
+}
+
+func TestGitRepository_LFS(t *testing.T) {
+	t.Run("Should stage LFS-tracked files through the clean filter", func(t *testing.T) {
+		dir, repo := setupLFSRepo(t)
+		gitRepo := &gitRepository{repo: repo}
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "asset.bin"), []byte("payload"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("notes"), 0644))
+		require.NoError(t, gitRepo.AddFiles(t.Context(), "*.bin"))
+		require.NoError(t, gitRepo.AddFiles(t.Context(), "notes.txt"))
+		assert.Equal(t, "PAYLOAD", stagedContent(t, dir, "asset.bin"))
+		assert.Equal(t, "notes", stagedContent(t, dir, "notes.txt"))
+	})
+	t.Run("Should report restored LFS-tracked files as clean", func(t *testing.T) {
+		dir, repo := setupLFSRepo(t)
+		gitRepo := &gitRepository{repo: repo}
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "asset.bin"), []byte("payload"), 0644))
+		require.NoError(t, gitRepo.AddFiles(t.Context(), "asset.bin"))
+		require.NoError(t, gitRepo.ConfigureUser(t.Context(), "Test User", "test@example.com"))
+		require.NoError(t, gitRepo.Commit(t.Context(), "Add asset"))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "asset.bin"), []byte("changed"), 0644))
+		status, err := gitRepo.GetFileStatus(t.Context(), "asset.bin")
+		require.NoError(t, err)
+		assert.Equal(t, "modified", status)
+		require.NoError(t, gitRepo.RestoreFile(t.Context(), "asset.bin"))
+		content, err := os.ReadFile(filepath.Join(dir, "asset.bin"))
+		require.NoError(t, err)
+		assert.Equal(t, "payload", string(content))
+		status, err = gitRepo.GetFileStatus(t.Context(), "asset.bin")
+		require.NoError(t, err)
+		assert.Equal(t, "clean", status)
+	})
+}
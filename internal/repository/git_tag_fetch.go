@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Tag fetch modes of git_fetch_tags.
+const (
+	// TagFetchAlways fetches the remote tags before the latest tag is looked up.
+	TagFetchAlways = "always"
+	// TagFetchNever only reads local tags, for offline runs and checkouts that already have them.
+	TagFetchNever = "never"
+	// TagFetchOnMiss fetches the remote tags only when a needed tag is missing locally.
+	TagFetchOnMiss = "on-miss"
+)
+
+// tagFetchPolicy decides when a repository fetches the remote tags. The outcome of a fetch is
+// remembered for the rest of the run, so the tag list is fetched at most once per invocation and a
+// failing remote does not cost a timeout on every lookup.
+type tagFetchPolicy struct {
+	mode      string
+	mu        sync.Mutex
+	attempted bool
+	err       error
+}
+
+// newTagFetchPolicy returns the policy for mode, where an empty mode fetches always.
+func newTagFetchPolicy(mode string) (*tagFetchPolicy, error) {
+	switch mode {
+	case "":
+		mode = TagFetchAlways
+	case TagFetchAlways, TagFetchNever, TagFetchOnMiss:
+	default:
+		return nil, fmt.Errorf("unsupported tag fetch mode: %s", mode)
+	}
+	return &tagFetchPolicy{mode: mode}, nil
+}
+
+// beforeRead fetches the remote tags ahead of reading the local ones, in always mode.
+func (p *tagFetchPolicy) beforeRead(fetch func() error) error {
+	if p.currentMode() != TagFetchAlways {
+		return nil
+	}
+	_, err := p.once(fetch)
+	return err
+}
+
+// onMiss fetches the remote tags after the local ones lacked a needed tag, unless the mode is never.
+// It reports whether a fetch ran now, so the caller reads the local tags again, and the error of the
+// fetch of the run.
+func (p *tagFetchPolicy) onMiss(fetch func() error) (bool, error) {
+	if p.currentMode() == TagFetchNever {
+		return false, nil
+	}
+	return p.once(fetch)
+}
+
+// currentMode returns the mode of p; a repository built without a policy fetches always.
+func (p *tagFetchPolicy) currentMode() string {
+	if p == nil {
+		return TagFetchAlways
+	}
+	return p.mode
+}
+
+func (p *tagFetchPolicy) once(fetch func() error) (bool, error) {
+	if p == nil {
+		return true, fetch()
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.attempted {
+		return false, p.err
+	}
+	p.attempted = true
+	p.err = fetch()
+	return true, p.err
+}
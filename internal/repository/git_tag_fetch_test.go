@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagFetchPolicy(t *testing.T) {
+	t.Run("Should fetch once per run in always mode", func(t *testing.T) {
+		policy, err := newTagFetchPolicy("")
+		require.NoError(t, err)
+		fetches := 0
+		fetch := func() error {
+			fetches++
+			return errors.New("proxy timeout")
+		}
+		require.ErrorContains(t, policy.beforeRead(fetch), "proxy timeout")
+		fetched, err := policy.onMiss(fetch)
+		assert.False(t, fetched)
+		require.ErrorContains(t, err, "proxy timeout")
+		assert.Equal(t, 1, fetches)
+	})
+	t.Run("Should fetch only on a miss in on-miss mode and never in never mode", func(t *testing.T) {
+		fetches := 0
+		fetch := func() error {
+			fetches++
+			return nil
+		}
+		onMiss, err := newTagFetchPolicy(TagFetchOnMiss)
+		require.NoError(t, err)
+		require.NoError(t, onMiss.beforeRead(fetch))
+		assert.Equal(t, 0, fetches)
+		fetched, err := onMiss.onMiss(fetch)
+		require.NoError(t, err)
+		assert.True(t, fetched)
+		never, err := newTagFetchPolicy(TagFetchNever)
+		require.NoError(t, err)
+		require.NoError(t, never.beforeRead(fetch))
+		fetched, err = never.onMiss(fetch)
+		require.NoError(t, err)
+		assert.False(t, fetched)
+		assert.Equal(t, 1, fetches)
+	})
+	t.Run("Should reject unknown modes", func(t *testing.T) {
+		_, err := newTagFetchPolicy("sometimes")
+		require.ErrorContains(t, err, "unsupported tag fetch mode: sometimes")
+	})
+}
+
+func TestGitRepository_LatestTagFetchModes(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("COMPOZY_RELEASE_GITHUB_TOKEN", "")
+	upstreamDir, upstream := setupTestRepo(t)
+	head, err := upstream.Head()
+	require.NoError(t, err)
+	_, err = upstream.CreateTag("v2.0.0", head.Hash(), nil)
+	require.NoError(t, err)
+	for _, mode := range []string{TagFetchNever, TagFetchOnMiss} {
+		dir, repo := setupTestRepo(t)
+		_, err = repo.CreateRemote(&gitconfig.RemoteConfig{Name: "mirror", URLs: []string{upstreamDir}})
+		require.NoError(t, err)
+		policy := func() *tagFetchPolicy {
+			p, err := newTagFetchPolicy(mode)
+			require.NoError(t, err)
+			return p
+		}
+		backends := map[string]GitExtendedRepository{
+			GitBackendGoGit: &gitRepository{repo: repo, remoteName: "mirror", tags: policy()},
+			GitBackendCLI:   &gitCLIRepository{dir: dir, remoteName: "mirror", tags: policy()},
+		}
+		expected := map[string]string{TagFetchNever: "", TagFetchOnMiss: "v2.0.0"}[mode]
+		for name, gitRepo := range backends {
+			t.Run("Should look up the latest tag in "+mode+" mode with "+name, func(t *testing.T) {
+				tag, err := gitRepo.LatestTag(t.Context())
+				require.NoError(t, err)
+				assert.Equal(t, expected, tag)
+			})
+		}
+	}
+}
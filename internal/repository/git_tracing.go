@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// tracingGitRepository opens an OpenTelemetry span around every call of the wrapped repository.
+type tracingGitRepository struct {
+	inner GitExtendedRepository
+}
+
+// NewTracingGitRepository traces the git operations of repo. Spans are only exported when tracing
+// is configured, so wrapping is cheap otherwise.
+func NewTracingGitRepository(repo GitExtendedRepository) GitExtendedRepository {
+	return &tracingGitRepository{inner: repo}
+}
+
+func traced(ctx context.Context, op string, call func(ctx context.Context) error) error {
+	ctx, span := telemetry.Start(ctx, "git."+op, attribute.String("git.operation", op))
+	err := call(ctx)
+	telemetry.End(span, err)
+	return err
+}
+
+func tracedValue[T any](ctx context.Context, op string, call func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := traced(ctx, op, func(ctx context.Context) error {
+		var err error
+		result, err = call(ctx)
+		return err
+	})
+	return result, err
+}
+
+func (r *tracingGitRepository) LatestTag(ctx context.Context) (string, error) {
+	return tracedValue(ctx, "LatestTag",
+		func(ctx context.Context) (string, error) { return r.inner.LatestTag(ctx) },
+	)
+}
+
+func (r *tracingGitRepository) CommitsSinceTag(ctx context.Context, tag string) (int, error) {
+	return tracedValue(ctx, "CommitsSinceTag",
+		func(ctx context.Context) (int, error) { return r.inner.CommitsSinceTag(ctx, tag) },
+	)
+}
+
+func (r *tracingGitRepository) CommitSubjectsSinceTag(ctx context.Context, tag string) ([]string, error) {
+	return tracedValue(ctx, "CommitSubjectsSinceTag",
+		func(ctx context.Context) ([]string, error) { return r.inner.CommitSubjectsSinceTag(ctx, tag) },
+	)
+}
+
+func (r *tracingGitRepository) CommitMessagesSinceTag(ctx context.Context, tag string) ([]string, error) {
+	return tracedValue(ctx, "CommitMessagesSinceTag",
+		func(ctx context.Context) ([]string, error) { return r.inner.CommitMessagesSinceTag(ctx, tag) },
+	)
+}
+
+func (r *tracingGitRepository) TagExists(ctx context.Context, tag string) (bool, error) {
+	return tracedValue(ctx, "TagExists",
+		func(ctx context.Context) (bool, error) { return r.inner.TagExists(ctx, tag) },
+	)
+}
+
+func (r *tracingGitRepository) CreateBranch(ctx context.Context, name string) error {
+	return traced(ctx, "CreateBranch",
+		func(ctx context.Context) error { return r.inner.CreateBranch(ctx, name) },
+	)
+}
+
+func (r *tracingGitRepository) CreateTag(ctx context.Context, tag, msg string) error {
+	return traced(ctx, "CreateTag",
+		func(ctx context.Context) error { return r.inner.CreateTag(ctx, tag, msg) },
+	)
+}
+
+func (r *tracingGitRepository) PushTag(ctx context.Context, tag string) error {
+	return traced(ctx, "PushTag",
+		func(ctx context.Context) error { return r.inner.PushTag(ctx, tag) },
+	)
+}
+
+func (r *tracingGitRepository) PushBranch(ctx context.Context, name string) error {
+	return traced(ctx, "PushBranch",
+		func(ctx context.Context) error { return r.inner.PushBranch(ctx, name) },
+	)
+}
+
+func (r *tracingGitRepository) TagCommit(ctx context.Context, tag string) (string, error) {
+	return tracedValue(ctx, "TagCommit",
+		func(ctx context.Context) (string, error) { return r.inner.TagCommit(ctx, tag) },
+	)
+}
+
+func (r *tracingGitRepository) ReleaseTags(ctx context.Context) ([]domain.ReleaseTag, error) {
+	return tracedValue(ctx, "ReleaseTags", r.inner.ReleaseTags)
+}
+
+func (r *tracingGitRepository) TagAnnotation(ctx context.Context, tag string) (msg string, annotated bool, err error) {
+	err = traced(ctx, "TagAnnotation", func(ctx context.Context) error {
+		var callErr error
+		msg, annotated, callErr = r.inner.TagAnnotation(ctx, tag)
+		return callErr
+	})
+	return msg, annotated, err
+}
+
+func (r *tracingGitRepository) CreateTagForce(ctx context.Context, tag, commit, msg string) error {
+	return traced(ctx, "CreateTagForce",
+		func(ctx context.Context) error { return r.inner.CreateTagForce(ctx, tag, commit, msg) },
+	)
+}
+
+func (r *tracingGitRepository) PushTagForce(ctx context.Context, tag string) error {
+	return traced(ctx, "PushTagForce",
+		func(ctx context.Context) error { return r.inner.PushTagForce(ctx, tag) },
+	)
+}
+
+func (r *tracingGitRepository) PushBranchForce(ctx context.Context, name string) error {
+	return traced(ctx, "PushBranchForce",
+		func(ctx context.Context) error { return r.inner.PushBranchForce(ctx, name) },
+	)
+}
+
+func (r *tracingGitRepository) CheckoutBranch(ctx context.Context, name string) error {
+	return traced(ctx, "CheckoutBranch",
+		func(ctx context.Context) error { return r.inner.CheckoutBranch(ctx, name) },
+	)
+}
+
+func (r *tracingGitRepository) SyncBranch(ctx context.Context, name string) error {
+	return traced(ctx, "SyncBranch",
+		func(ctx context.Context) error { return r.inner.SyncBranch(ctx, name) },
+	)
+}
+
+func (r *tracingGitRepository) ConfigureUser(ctx context.Context, name, email string) error {
+	return traced(ctx, "ConfigureUser",
+		func(ctx context.Context) error { return r.inner.ConfigureUser(ctx, name, email) },
+	)
+}
+
+func (r *tracingGitRepository) AddFiles(ctx context.Context, pattern string) error {
+	return traced(ctx, "AddFiles",
+		func(ctx context.Context) error { return r.inner.AddFiles(ctx, pattern) },
+	)
+}
+
+func (r *tracingGitRepository) Commit(ctx context.Context, message string) error {
+	return traced(ctx, "Commit",
+		func(ctx context.Context) error { return r.inner.Commit(ctx, message) },
+	)
+}
+
+func (r *tracingGitRepository) GetHeadCommit(ctx context.Context) (string, error) {
+	return tracedValue(ctx, "GetHeadCommit",
+		func(ctx context.Context) (string, error) { return r.inner.GetHeadCommit(ctx) },
+	)
+}
+
+func (r *tracingGitRepository) GetCurrentBranch(ctx context.Context) (string, error) {
+	return tracedValue(ctx, "GetCurrentBranch",
+		func(ctx context.Context) (string, error) { return r.inner.GetCurrentBranch(ctx) },
+	)
+}
+
+func (r *tracingGitRepository) DeleteBranch(ctx context.Context, name string) error {
+	return traced(ctx, "DeleteBranch",
+		func(ctx context.Context) error { return r.inner.DeleteBranch(ctx, name) },
+	)
+}
+
+func (r *tracingGitRepository) DeleteRemoteBranch(ctx context.Context, name string) error {
+	return traced(ctx, "DeleteRemoteBranch",
+		func(ctx context.Context) error { return r.inner.DeleteRemoteBranch(ctx, name) },
+	)
+}
+
+func (r *tracingGitRepository) ListLocalBranches(ctx context.Context) ([]string, error) {
+	return tracedValue(ctx, "ListLocalBranches",
+		func(ctx context.Context) ([]string, error) { return r.inner.ListLocalBranches(ctx) },
+	)
+}
+
+func (r *tracingGitRepository) ListRemoteBranches(ctx context.Context, prefix string) ([]string, error) {
+	return tracedValue(ctx, "ListRemoteBranches",
+		func(ctx context.Context) ([]string, error) { return r.inner.ListRemoteBranches(ctx, prefix) },
+	)
+}
+
+func (r *tracingGitRepository) RemoteBranchExists(ctx context.Context, branchName string) (bool, error) {
+	return tracedValue(ctx, "RemoteBranchExists",
+		func(ctx context.Context) (bool, error) { return r.inner.RemoteBranchExists(ctx, branchName) },
+	)
+}
+
+func (r *tracingGitRepository) MoveFile(ctx context.Context, from, to string) error {
+	return traced(ctx, "MoveFile",
+		func(ctx context.Context) error { return r.inner.MoveFile(ctx, from, to) },
+	)
+}
+
+func (r *tracingGitRepository) RemoveFile(ctx context.Context, path string) error {
+	return traced(ctx, "RemoveFile",
+		func(ctx context.Context) error { return r.inner.RemoveFile(ctx, path) },
+	)
+}
+
+func (r *tracingGitRepository) RestoreFile(ctx context.Context, path string) error {
+	return traced(ctx, "RestoreFile",
+		func(ctx context.Context) error { return r.inner.RestoreFile(ctx, path) },
+	)
+}
+
+func (r *tracingGitRepository) ResetHard(ctx context.Context, ref string) error {
+	return traced(ctx, "ResetHard",
+		func(ctx context.Context) error { return r.inner.ResetHard(ctx, ref) },
+	)
+}
+
+func (r *tracingGitRepository) GetFileStatus(ctx context.Context, path string) (string, error) {
+	return tracedValue(ctx, "GetFileStatus",
+		func(ctx context.Context) (string, error) { return r.inner.GetFileStatus(ctx, path) },
+	)
+}
+
+func (r *tracingGitRepository) IsSyncedWithBase(ctx context.Context, base string) (bool, error) {
+	return tracedValue(ctx, "IsSyncedWithBase",
+		func(ctx context.Context) (bool, error) { return r.inner.IsSyncedWithBase(ctx, base) },
+	)
+}
+
+func (r *tracingGitRepository) RebaseOntoBase(ctx context.Context, base string) error {
+	return traced(ctx, "RebaseOntoBase",
+		func(ctx context.Context) error { return r.inner.RebaseOntoBase(ctx, base) },
+	)
+}
+
+func (r *tracingGitRepository) HeadCommitDiff(ctx context.Context) ([]domain.FileDiffStat, error) {
+	return tracedValue(ctx, "HeadCommitDiff",
+		func(ctx context.Context) ([]domain.FileDiffStat, error) { return r.inner.HeadCommitDiff(ctx) },
+	)
+}
+
+func (r *tracingGitRepository) SubmoduleUpdates(ctx context.Context, tag string) (domain.SubmoduleUpdates, error) {
+	return tracedValue(ctx, "SubmoduleUpdates",
+		func(ctx context.Context) (domain.SubmoduleUpdates, error) { return r.inner.SubmoduleUpdates(ctx, tag) },
+	)
+}
+
+func (r *tracingGitRepository) BumpSubmodules(ctx context.Context) (domain.SubmoduleUpdates, error) {
+	return tracedValue(ctx, "BumpSubmodules",
+		func(ctx context.Context) (domain.SubmoduleUpdates, error) { return r.inner.BumpSubmodules(ctx) },
+	)
+}
+
+func (r *tracingGitRepository) ReleaseStats(ctx context.Context, tag string) (domain.ReleaseStats, error) {
+	return tracedValue(ctx, "ReleaseStats",
+		func(ctx context.Context) (domain.ReleaseStats, error) { return r.inner.ReleaseStats(ctx, tag) },
+	)
+}
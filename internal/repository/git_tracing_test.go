@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// recordSpans installs a tracer provider that keeps ended spans in memory for the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+// latestTagRepo answers LatestTag and leaves the other operations unimplemented.
+type latestTagRepo struct {
+	GitExtendedRepository
+	err error
+}
+
+func (r *latestTagRepo) LatestTag(context.Context) (string, error) {
+	return "v1.2.3", r.err
+}
+
+func TestTracingGitRepository(t *testing.T) {
+	t.Run("Should open a span per operation and record failures", func(t *testing.T) {
+		recorder := recordSpans(t)
+		tag, err := NewTracingGitRepository(&latestTagRepo{}).LatestTag(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "v1.2.3", tag)
+		_, err = NewTracingGitRepository(&latestTagRepo{err: errors.New("no tags")}).LatestTag(t.Context())
+		require.Error(t, err)
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		assert.Equal(t, "git.LatestTag", spans[0].Name())
+		assert.Contains(t, spans[0].Attributes(), attribute.String("git.operation", "LatestTag"))
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+		assert.Equal(t, codes.Error, spans[1].Status().Code)
+	})
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// GitHubRateLimit describes the rate-limit state GitHub reported with a failed request.
+type GitHubRateLimit struct {
+	Limit      int
+	Remaining  int
+	Reset      time.Time
+	RetryAfter time.Duration
+}
+
+// GitHubAPIError carries the response details of a failed GitHub API call.
+// StatusCode is zero when the request never produced a response (for example a network failure).
+type GitHubAPIError struct {
+	Operation        string
+	StatusCode       int
+	Message          string
+	DocumentationURL string
+	RateLimit        *GitHubRateLimit
+	// PendingHead marks a pull request rejected because GitHub has not indexed its just-pushed head
+	// branch yet, which resolves itself after a short delay.
+	PendingHead bool
+	Err         error
+}
+
+// Error returns a user-facing message including the status code, API message and rate-limit hint.
+func (e *GitHubAPIError) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("failed to %s: %v", e.Operation, e.Err)
+	}
+	msg := fmt.Sprintf("failed to %s: GitHub API returned %d", e.Operation, e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.PendingHead {
+		msg += " (the head branch is not visible to GitHub yet)"
+	}
+	switch {
+	case e.RateLimit == nil:
+	case e.RateLimit.RetryAfter > 0:
+		msg += fmt.Sprintf(" (secondary rate limit, retry after %s)", e.RateLimit.RetryAfter)
+	case e.RateLimited() && !e.RateLimit.Reset.IsZero():
+		msg += fmt.Sprintf(" (rate limit exceeded, resets at %s)", e.RateLimit.Reset.UTC().Format(time.RFC3339))
+	}
+	return msg
+}
+
+// Unwrap returns the underlying go-github error.
+func (e *GitHubAPIError) Unwrap() error {
+	return e.Err
+}
+
+// RateLimited reports whether the request was rejected by a primary or secondary rate limit.
+func (e *GitHubAPIError) RateLimited() bool {
+	if e.RateLimit == nil {
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return e.RateLimit.RetryAfter > 0 || e.RateLimit.Remaining == 0
+}
+
+// Retryable reports whether repeating the request may succeed: network failures, server errors,
+// secondary rate limits and pull requests on a head branch GitHub has not indexed yet are transient,
+// while other client errors and exhausted primary rate limits are not.
+func (e *GitHubAPIError) Retryable() bool {
+	switch {
+	case e.StatusCode == 0:
+		return !errors.Is(e.Err, context.Canceled) && !errors.Is(e.Err, context.DeadlineExceeded)
+	case e.StatusCode >= http.StatusInternalServerError, e.StatusCode == http.StatusTooManyRequests:
+		return true
+	case e.PendingHead:
+		return true
+	case e.RateLimit != nil && e.RateLimit.RetryAfter > 0:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRetryableGitHubError reports whether err wraps a transient GitHub API failure.
+func IsRetryableGitHubError(err error) bool {
+	var apiErr *GitHubAPIError
+	return errors.As(err, &apiErr) && apiErr.Retryable()
+}
+
+// IsPermanentGitHubError reports whether err wraps a GitHub API failure that retrying cannot fix.
+func IsPermanentGitHubError(err error) bool {
+	var apiErr *GitHubAPIError
+	return errors.As(err, &apiErr) && !apiErr.Retryable()
+}
+
+// newGitHubAPIError converts a non-nil go-github error into a GitHubAPIError for the named operation.
+func newGitHubAPIError(operation string, err error) *GitHubAPIError {
+	apiErr := &GitHubAPIError{Operation: operation, Err: err}
+	var rateErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	var respErr *github.ErrorResponse
+	switch {
+	case errors.As(err, &rateErr):
+		apiErr.StatusCode = statusCode(rateErr.Response)
+		apiErr.Message = rateErr.Message
+		apiErr.RateLimit = &GitHubRateLimit{
+			Limit:     rateErr.Rate.Limit,
+			Remaining: rateErr.Rate.Remaining,
+			Reset:     rateErr.Rate.Reset.Time,
+		}
+	case errors.As(err, &abuseErr):
+		apiErr.StatusCode = statusCode(abuseErr.Response)
+		apiErr.Message = abuseErr.Message
+		apiErr.RateLimit = &GitHubRateLimit{RetryAfter: abuseErr.GetRetryAfter()}
+	case errors.As(err, &respErr):
+		apiErr.StatusCode = statusCode(respErr.Response)
+		apiErr.Message = respErr.Message
+		apiErr.DocumentationURL = respErr.DocumentationURL
+		if respErr.Response != nil {
+			apiErr.RateLimit = parseRateLimitHeaders(respErr.Response.Header)
+		}
+		apiErr.PendingHead = pendingHeadBranch(apiErr.StatusCode, respErr)
+	}
+	return apiErr
+}
+
+// pendingHeadBranch reports whether a 422 rejected a pull request because GitHub cannot resolve its
+// head branch, which it answers with "head sha can't be blank" or an invalid head field until a
+// just-pushed branch is indexed.
+func pendingHeadBranch(status int, respErr *github.ErrorResponse) bool {
+	if status != http.StatusUnprocessableEntity {
+		return false
+	}
+	if strings.Contains(strings.ToLower(respErr.Message), "head sha can't be blank") {
+		return true
+	}
+	for _, fieldErr := range respErr.Errors {
+		if strings.Contains(strings.ToLower(fieldErr.Message), "head sha can't be blank") ||
+			(fieldErr.Field == "head" && fieldErr.Code == "invalid") {
+			return true
+		}
+	}
+	return false
+}
+
+func statusCode(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
+
+// parseRateLimitHeaders reads rate-limit headers, returning nil when the quota is not exhausted.
+func parseRateLimitHeaders(header http.Header) *GitHubRateLimit {
+	remaining, err := strconv.Atoi(header.Get(headerRateLimitRemaining))
+	if err != nil || remaining > 0 {
+		return nil
+	}
+	limit, _ := strconv.Atoi(header.Get(headerRateLimitLimit))
+	rate := &GitHubRateLimit{Limit: limit, Remaining: remaining}
+	if reset, err := strconv.ParseInt(header.Get(headerRateLimitReset), 10, 64); err == nil {
+		rate.Reset = time.Unix(reset, 0)
+	}
+	return rate
+}
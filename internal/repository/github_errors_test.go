@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGitHubAPIError(t *testing.T) {
+	t.Run("Should carry status code and API message", func(t *testing.T) {
+		cause := &github.ErrorResponse{
+			Response:         &http.Response{StatusCode: http.StatusUnprocessableEntity, Header: http.Header{}},
+			Message:          "Validation Failed",
+			DocumentationURL: "https://docs.github.com/rest",
+		}
+		err := newGitHubAPIError("create pull request", cause)
+		require.Equal(t, http.StatusUnprocessableEntity, err.StatusCode)
+		require.Equal(t, "https://docs.github.com/rest", err.DocumentationURL)
+		require.EqualError(t, err, "failed to create pull request: GitHub API returned 422: Validation Failed")
+		require.ErrorIs(t, err, cause)
+		require.False(t, err.Retryable())
+	})
+
+	t.Run("Should report primary rate limits with their reset time", func(t *testing.T) {
+		reset := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		cause := &github.RateLimitError{
+			Rate:     github.Rate{Limit: 5000, Remaining: 0, Reset: github.Timestamp{Time: reset}},
+			Response: &http.Response{StatusCode: http.StatusForbidden},
+			Message:  "API rate limit exceeded",
+		}
+		err := newGitHubAPIError("list pull requests", cause)
+		require.True(t, err.RateLimited())
+		require.False(t, err.Retryable())
+		require.Contains(t, err.Error(), "resets at 2026-01-02T03:04:05Z")
+	})
+
+	t.Run("Should treat secondary rate limits as retryable", func(t *testing.T) {
+		retryAfter := 30 * time.Second
+		cause := &github.AbuseRateLimitError{
+			Response:   &http.Response{StatusCode: http.StatusForbidden},
+			Message:    "You have exceeded a secondary rate limit",
+			RetryAfter: &retryAfter,
+		}
+		err := newGitHubAPIError("add comment to PR #1", cause)
+		require.True(t, err.RateLimited())
+		require.True(t, err.Retryable())
+		require.Contains(t, err.Error(), "retry after 30s")
+	})
+
+	t.Run("Should read exhausted rate limits from response headers", func(t *testing.T) {
+		header := http.Header{}
+		header.Set(headerRateLimitLimit, "60")
+		header.Set(headerRateLimitRemaining, "0")
+		header.Set(headerRateLimitReset, "1767323045")
+		cause := &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusForbidden, Header: header}}
+		err := newGitHubAPIError("get PR #1", cause)
+		require.NotNil(t, err.RateLimit)
+		require.Equal(t, 60, err.RateLimit.Limit)
+		require.Equal(t, time.Unix(1767323045, 0), err.RateLimit.Reset)
+	})
+
+	t.Run("Should classify server and network failures as retryable", func(t *testing.T) {
+		serverErr := &github.ErrorResponse{
+			Response: &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}},
+		}
+		require.True(t, newGitHubAPIError("close PR #1", serverErr).Retryable())
+		require.True(t, newGitHubAPIError("close PR #1", errors.New("connection reset")).Retryable())
+		require.False(t, newGitHubAPIError("close PR #1", context.Canceled).Retryable())
+	})
+
+	t.Run("Should retry pull requests whose head branch GitHub has not indexed yet", func(t *testing.T) {
+		response := &http.Response{StatusCode: http.StatusUnprocessableEntity, Header: http.Header{}}
+		blankSHA := &github.ErrorResponse{
+			Response: response,
+			Message:  "Validation Failed",
+			Errors:   []github.Error{{Resource: "PullRequest", Code: "custom", Message: "head sha can't be blank"}},
+		}
+		err := newGitHubAPIError("create pull request", blankSHA)
+		require.True(t, err.PendingHead)
+		require.True(t, err.Retryable())
+		require.EqualError(t, err, "failed to create pull request: GitHub API returned 422: Validation Failed "+
+			"(the head branch is not visible to GitHub yet)")
+		invalidHead := &github.ErrorResponse{
+			Response: response,
+			Message:  "Validation Failed",
+			Errors:   []github.Error{{Resource: "PullRequest", Field: "head", Code: "invalid"}},
+		}
+		require.True(t, newGitHubAPIError("create pull request", invalidHead).Retryable())
+		noCommits := &github.ErrorResponse{
+			Response: response,
+			Message:  "Validation Failed",
+			Errors:   []github.Error{{Resource: "PullRequest", Code: "custom", Message: "No commits between main and x"}},
+		}
+		require.False(t, newGitHubAPIError("create pull request", noCommits).Retryable())
+	})
+}
+
+func TestGitHubErrorClassification(t *testing.T) {
+	t.Run("Should classify wrapped GitHub API errors", func(t *testing.T) {
+		transient := fmt.Errorf("step failed: %w", &GitHubAPIError{StatusCode: http.StatusServiceUnavailable})
+		permanent := fmt.Errorf("step failed: %w", &GitHubAPIError{StatusCode: http.StatusNotFound})
+		require.True(t, IsRetryableGitHubError(transient))
+		require.False(t, IsPermanentGitHubError(transient))
+		require.True(t, IsPermanentGitHubError(permanent))
+		require.False(t, IsRetryableGitHubError(permanent))
+	})
+
+	t.Run("Should ignore errors that are not from the GitHub API", func(t *testing.T) {
+		err := errors.New("boom")
+		require.False(t, IsRetryableGitHubError(err))
+		require.False(t, IsPermanentGitHubError(err))
+	})
+}
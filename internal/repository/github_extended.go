@@ -1,16 +1,74 @@
 package repository
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+)
 
 // GithubExtendedRepository extends GithubRepository with additional operations for orchestration.
 type GithubExtendedRepository interface {
 	GithubRepository
-	// CreateOrUpdatePR creates a new PR or updates an existing one
-	CreateOrUpdatePR(ctx context.Context, head, base, title, body string, labels []string) error
+	// CreateOrUpdatePR creates a new PR or updates an existing one and returns its number and URL.
+	// A scoped label such as release:minor replaces the labels of the same scope already on the PR.
+	CreateOrUpdatePR(
+		ctx context.Context,
+		head, base, title, body string,
+		labels []string,
+	) (domain.PullRequestRef, error)
 	// AddComment adds a comment to a PR/issue
 	AddComment(ctx context.Context, prNumber int, body string) error
 	// ClosePR closes a pull request
 	ClosePR(ctx context.Context, prNumber int) error
 	// GetPRStatus returns the status of a pull request (open, closed, merged)
 	GetPRStatus(ctx context.Context, prNumber int) (string, error)
+	// UploadReleaseAsset attaches a local file to the GitHub release for the tag, which may be a draft
+	UploadReleaseAsset(ctx context.Context, tag, path string) error
+	// AppendReleaseNotes appends a markdown section to the body of the GitHub release for the tag
+	AppendReleaseNotes(ctx context.Context, tag, markdown string) error
+	// PublishRelease publishes the draft GitHub release for the tag
+	PublishRelease(ctx context.Context, tag string) error
+	// ReleasePublishedAt returns when the GitHub release for the tag was published, and false when the
+	// tag has no published release
+	ReleasePublishedAt(ctx context.Context, tag string) (time.Time, bool, error)
+	// ReleaseNotes returns the body of the GitHub release for the tag, draft or published, and when it
+	// was published; the time is zero for a draft
+	ReleaseNotes(ctx context.Context, tag string) (string, time.Time, error)
+	// MarkSecurityRelease prefixes the name of the GitHub release for the tag with a security marker
+	MarkSecurityRelease(ctx context.Context, tag string) error
+	// RequestReviewers requests reviews on the open PR for head, skipping the PR author
+	RequestReviewers(ctx context.Context, head, base string, reviewers domain.Reviewers) error
+	// FindOpenPR returns the number of the open PR from head into base, or 0 when there is none
+	FindOpenPR(ctx context.Context, head, base string) (int, error)
+	// PullRequestHead returns the head branch of a pull request
+	PullRequestHead(ctx context.Context, prNumber int) (string, error)
+	// PullRequestBody returns the body of a pull request
+	PullRequestBody(ctx context.Context, prNumber int) (string, error)
+	// ReleaseContributors returns the GitHub logins of the authors of the commits between base and head
+	ReleaseContributors(ctx context.Context, base, head string) ([]string, error)
+	// MergedPullRequests returns the merged pull requests of the commits between base and head
+	MergedPullRequests(ctx context.Context, base, head string) ([]domain.PullRequest, error)
+	// CreateOrUpdateIssue updates the body and assignees of the open issue titled title, or creates it,
+	// and returns its number
+	CreateOrUpdateIssue(ctx context.Context, title, body string, assignees []string) (int, error)
+	// FindMilestone returns the number of the milestone titled title, or 0 when there is none
+	FindMilestone(ctx context.Context, title string) (int, error)
+	// EnsureLabels creates the labels the repository does not have yet, matching names ignoring case
+	EnsureLabels(ctx context.Context, labels []domain.Label) error
+	// CreateCheckRun creates a completed check run on the commit of run.HeadSHA
+	CreateCheckRun(ctx context.Context, run domain.CheckRun) error
+	// TokenPermissions probes which of the permissions the release workflow uses the token can write with
+	TokenPermissions(ctx context.Context) ([]domain.PermissionCheck, error)
+	// ForRepository returns a repository for owner/repo that uses the same client and token
+	ForRepository(owner, repo string) GithubExtendedRepository
+	// FileContent returns the content of the file at path on the default branch, and false when there is none
+	FileContent(ctx context.Context, path string) (string, bool, error)
+	// ReviewStatus returns the approvers and check runs of the merged pull request that brought commit
+	ReviewStatus(ctx context.Context, commit string) (domain.ReviewStatus, error)
+	// CommitFile creates or updates the file at path on branch, creating branch from the head of base
+	// when it does not exist yet
+	CommitFile(ctx context.Context, branch, base, path, content, message string) error
+	// SearchCode returns the paths of the files of the repository that match the code search query
+	SearchCode(ctx context.Context, query string) ([]string, error)
 }
@@ -2,10 +2,18 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/compozy/releasepr/internal/config"
+	"github.com/compozy/releasepr/internal/domain"
 	"github.com/compozy/releasepr/internal/logger"
 	"github.com/google/go-github/v74/github"
 	"go.uber.org/zap"
@@ -41,16 +49,9 @@ func NewGithubRepository(token, owner, repo string) (GithubRepository, error) {
 		return nil, fmt.Errorf("invalid repository configuration: %w", err)
 	}
 
-	// Create OAuth2 client with the validated token
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: strings.TrimSpace(token)},
-	)
-	tc := oauth2.NewClient(context.Background(), ts)
-	client := github.NewClient(tc)
-
 	// Create and return the repository
 	ghRepo := &githubRepository{
-		client: client,
+		client: newGithubClient(token),
 		owner:  owner,
 		repo:   repo,
 	}
@@ -70,16 +71,9 @@ func NewGithubExtendedRepository(token, owner, repo string) (GithubExtendedRepos
 		return nil, fmt.Errorf("invalid repository configuration: %w", err)
 	}
 
-	// Create OAuth2 client with the validated token
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: strings.TrimSpace(token)},
-	)
-	tc := oauth2.NewClient(context.Background(), ts)
-	client := github.NewClient(tc)
-
 	// Create and return the repository
 	ghRepo := &githubRepository{
-		client: client,
+		client: newGithubClient(token),
 		owner:  owner,
 		repo:   repo,
 	}
@@ -87,6 +81,59 @@ func NewGithubExtendedRepository(token, owner, repo string) (GithubExtendedRepos
 	return ghRepo, nil
 }
 
+// githubInstallationIdentity is reported when a GitHub App installation token is verified.
+const githubInstallationIdentity = "github-app-installation"
+
+// githubMaxPerPage is the largest page size the GitHub REST API accepts.
+const githubMaxPerPage = 100
+
+// maxCheckOutputLength is the largest summary or text GitHub accepts in a check run output.
+const maxCheckOutputLength = 65535
+
+const checkOutputTruncated = "\n\n… (truncated)"
+
+// newGithubClient creates a GitHub API client authenticated with the given token.
+func newGithubClient(token string) *github.Client {
+	ts := oauth2.StaticTokenSource(
+		&oauth2.Token{AccessToken: strings.TrimSpace(token)},
+	)
+	transport := &tracingTransport{base: githubTransport()}
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: transport})
+	return github.NewClient(oauth2.NewClient(ctx, ts))
+}
+
+// VerifyGitHubToken confirms the token is accepted by the GitHub API and returns the identity it belongs to.
+// User tokens are checked with GET /user; GitHub App installation tokens, which cannot read /user, fall back
+// to GET /installation/repositories.
+func VerifyGitHubToken(ctx context.Context, token string) (string, error) {
+	if err := config.ValidateGitHubToken(token); err != nil {
+		return "", fmt.Errorf("invalid GitHub token: %w", err)
+	}
+	return verifyGitHubToken(ctx, newGithubClient(token))
+}
+
+func verifyGitHubToken(ctx context.Context, client *github.Client) (string, error) {
+	user, _, err := client.Users.Get(ctx, "")
+	if err == nil {
+		return user.GetLogin(), nil
+	}
+	apiErr := newGitHubAPIError("verify GitHub token", err)
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		return "", fmt.Errorf("GitHub rejected the token: %w", apiErr)
+	case http.StatusForbidden:
+		if _, _, installErr := client.Apps.ListRepos(ctx, &github.ListOptions{PerPage: 1}); installErr != nil {
+			return "", fmt.Errorf(
+				"GitHub token cannot read the user or installation: %w",
+				newGitHubAPIError("list installation repositories", installErr),
+			)
+		}
+		return githubInstallationIdentity, nil
+	default:
+		return "", apiErr
+	}
+}
+
 // CreatePullRequest creates a new pull request.
 func (r *githubRepository) CreatePullRequest(ctx context.Context, title, body, head, base string) (int, error) {
 	pr, _, err := r.client.PullRequests.Create(ctx, r.owner, r.repo, &github.NewPullRequest{
@@ -96,31 +143,25 @@ func (r *githubRepository) CreatePullRequest(ctx context.Context, title, body, h
 		Base:  &base,
 	})
 	if err != nil {
-		return 0, fmt.Errorf("failed to create pull request: %w", err)
+		return 0, newGitHubAPIError("create pull request", err)
 	}
 	return pr.GetNumber(), nil
 }
 
-// CreateOrUpdatePR creates a new PR or updates an existing one.
+// CreateOrUpdatePR creates a new PR or updates an existing one and returns its number and URL.
 func (r *githubRepository) CreateOrUpdatePR(
 	ctx context.Context,
 	head, base, title, body string,
 	labels []string,
-) error {
+) (domain.PullRequestRef, error) {
 	log := r.logger(ctx)
 	log.Info("CreateOrUpdatePR", zap.String("head", head), zap.String("base", base), zap.String("title", title))
-	prs, _, err := r.client.PullRequests.List(ctx, r.owner, r.repo, &github.PullRequestListOptions{
-		Head:  fmt.Sprintf("%s:%s", r.owner, head),
-		Base:  base,
-		State: "open",
-	})
+	pr, err := r.openPullRequest(ctx, head, base)
 	if err != nil {
 		log.Error("Failed to list pull requests", zap.Error(err))
-		return fmt.Errorf("failed to list pull requests: %w", err)
+		return domain.PullRequestRef{}, err
 	}
-	log.Info("Found existing pull requests", zap.Int("count", len(prs)))
-	if len(prs) > 0 {
-		pr := prs[0]
+	if pr != nil {
 		log.Info("Updating pull request", zap.Int("pr_number", pr.GetNumber()))
 		_, _, err = r.client.PullRequests.Edit(ctx, r.owner, r.repo, pr.GetNumber(), &github.PullRequest{
 			Title: &title,
@@ -128,7 +169,7 @@ func (r *githubRepository) CreateOrUpdatePR(
 		})
 		if err != nil {
 			log.Error("Failed to update pull request", zap.Int("pr_number", pr.GetNumber()), zap.Error(err))
-			return fmt.Errorf("failed to update pull request: %w", err)
+			return domain.PullRequestRef{}, newGitHubAPIError("update pull request", err)
 		}
 		if len(labels) > 0 {
 			log.Info(
@@ -139,14 +180,24 @@ func (r *githubRepository) CreateOrUpdatePR(
 			_, _, err = r.client.Issues.AddLabelsToIssue(ctx, r.owner, r.repo, pr.GetNumber(), labels)
 			if err != nil {
 				log.Error("Failed to add labels", zap.Int("pr_number", pr.GetNumber()), zap.Error(err))
-				return fmt.Errorf("failed to add labels to pull request: %w", err)
+				return domain.PullRequestRef{}, newGitHubAPIError("add labels to pull request", err)
+			}
+		}
+		for _, stale := range staleScopedLabels(pr.Labels, labels) {
+			log.Info("Removing replaced pull request label",
+				zap.Int("pr_number", pr.GetNumber()),
+				zap.String("label", stale),
+			)
+			_, err = r.client.Issues.RemoveLabelForIssue(ctx, r.owner, r.repo, pr.GetNumber(), stale)
+			if err != nil {
+				return domain.PullRequestRef{}, newGitHubAPIError("remove label from pull request", err)
 			}
 		}
 		log.Info("Updated pull request", zap.Int("pr_number", pr.GetNumber()))
-		return nil
+		return pullRequestRef(pr), nil
 	}
 	log.Info("Creating pull request", zap.String("head", head), zap.String("base", base))
-	pr, _, err := r.client.PullRequests.Create(ctx, r.owner, r.repo, &github.NewPullRequest{
+	pr, _, err = r.client.PullRequests.Create(ctx, r.owner, r.repo, &github.NewPullRequest{
 		Title: &title,
 		Body:  &body,
 		Head:  &head,
@@ -154,7 +205,7 @@ func (r *githubRepository) CreateOrUpdatePR(
 	})
 	if err != nil {
 		log.Error("Failed to create pull request", zap.Error(err))
-		return fmt.Errorf("failed to create pull request: %w", err)
+		return domain.PullRequestRef{}, newGitHubAPIError("create pull request", err)
 	}
 	log.Info("Created pull request", zap.Int("pr_number", pr.GetNumber()))
 	if len(labels) > 0 {
@@ -166,11 +217,38 @@ func (r *githubRepository) CreateOrUpdatePR(
 		_, _, err = r.client.Issues.AddLabelsToIssue(ctx, r.owner, r.repo, pr.GetNumber(), labels)
 		if err != nil {
 			log.Error("Failed to add labels to new pull request", zap.Int("pr_number", pr.GetNumber()), zap.Error(err))
-			return fmt.Errorf("failed to add labels to new pull request: %w", err)
+			return domain.PullRequestRef{}, newGitHubAPIError("add labels to new pull request", err)
 		}
 	}
 	log.Info("Completed pull request operation", zap.Int("pr_number", pr.GetNumber()))
-	return nil
+	return pullRequestRef(pr), nil
+}
+
+// pullRequestRef identifies a pull request by its number and web URL.
+func pullRequestRef(pr *github.PullRequest) domain.PullRequestRef {
+	return domain.PullRequestRef{Number: pr.GetNumber(), URL: pr.GetHTMLURL()}
+}
+
+// staleScopedLabels returns the current labels that share the scope of a wanted label, the part
+// before the colon of release:minor, without being wanted themselves.
+func staleScopedLabels(current []*github.Label, wanted []string) []string {
+	scopes := make(map[string]bool)
+	wantedNames := make(map[string]bool)
+	for _, label := range wanted {
+		wantedNames[strings.ToLower(label)] = true
+		if scope, _, ok := strings.Cut(label, ":"); ok {
+			scopes[strings.ToLower(scope)] = true
+		}
+	}
+	var stale []string
+	for _, label := range current {
+		name := label.GetName()
+		scope, _, ok := strings.Cut(name, ":")
+		if ok && scopes[strings.ToLower(scope)] && !wantedNames[strings.ToLower(name)] {
+			stale = append(stale, name)
+		}
+	}
+	return stale
 }
 
 // AddComment implementation
@@ -180,7 +258,7 @@ func (r *githubRepository) AddComment(ctx context.Context, prNumber int, body st
 	}
 	_, _, err := r.client.Issues.CreateComment(ctx, r.owner, r.repo, prNumber, comment)
 	if err != nil {
-		return fmt.Errorf("failed to add comment to PR #%d: %w", prNumber, err)
+		return newGitHubAPIError(fmt.Sprintf("add comment to PR #%d", prNumber), err)
 	}
 	return nil
 }
@@ -192,7 +270,7 @@ func (r *githubRepository) ClosePR(ctx context.Context, prNumber int) error {
 		State: &state,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to close PR #%d: %w", prNumber, err)
+		return newGitHubAPIError(fmt.Sprintf("close PR #%d", prNumber), err)
 	}
 	return nil
 }
@@ -201,10 +279,848 @@ func (r *githubRepository) ClosePR(ctx context.Context, prNumber int) error {
 func (r *githubRepository) GetPRStatus(ctx context.Context, prNumber int) (string, error) {
 	pr, _, err := r.client.PullRequests.Get(ctx, r.owner, r.repo, prNumber)
 	if err != nil {
-		return "", fmt.Errorf("failed to get PR #%d: %w", prNumber, err)
+		return "", newGitHubAPIError(fmt.Sprintf("get PR #%d", prNumber), err)
 	}
 	if pr.GetMerged() {
 		return "merged", nil
 	}
 	return pr.GetState(), nil
 }
+
+// UploadReleaseAsset attaches a local file to the GitHub release for the tag.
+func (r *githubRepository) UploadReleaseAsset(ctx context.Context, tag, path string) error {
+	release, err := r.releaseByTag(ctx, tag)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open release asset %s: %w", path, err)
+	}
+	defer file.Close()
+	name := filepath.Base(path)
+	_, _, err = r.client.Repositories.UploadReleaseAsset(
+		ctx,
+		r.owner,
+		r.repo,
+		release.GetID(),
+		&github.UploadOptions{Name: name},
+		file,
+	)
+	if err != nil {
+		return newGitHubAPIError(fmt.Sprintf("upload %s to release %s", name, tag), err)
+	}
+	r.logger(ctx).Info("Uploaded release asset", zap.String("tag", tag), zap.String("name", name))
+	return nil
+}
+
+// AppendReleaseNotes appends markdown to the body of the GitHub release for the tag.
+func (r *githubRepository) AppendReleaseNotes(ctx context.Context, tag, markdown string) error {
+	release, err := r.releaseByTag(ctx, tag)
+	if err != nil {
+		return err
+	}
+	body := strings.TrimRight(release.GetBody(), "\n")
+	if body != "" {
+		body += "\n\n"
+	}
+	body += markdown
+	_, _, err = r.client.Repositories.EditRelease(ctx, r.owner, r.repo, release.GetID(), &github.RepositoryRelease{
+		Body: github.Ptr(body),
+	})
+	if err != nil {
+		return newGitHubAPIError(fmt.Sprintf("update release %s", tag), err)
+	}
+	r.logger(ctx).Info("Updated release notes", zap.String("tag", tag))
+	return nil
+}
+
+// releaseByTag returns the GitHub release for the tag. A draft is looked up among the releases of the
+// repository, as the tag lookup of the API only finds published releases.
+func (r *githubRepository) releaseByTag(ctx context.Context, tag string) (*github.RepositoryRelease, error) {
+	release, _, err := r.client.Repositories.GetReleaseByTag(ctx, r.owner, r.repo, tag)
+	if err == nil {
+		return release, nil
+	}
+	apiErr := newGitHubAPIError(fmt.Sprintf("get release %s", tag), err)
+	if apiErr.StatusCode != http.StatusNotFound {
+		return nil, apiErr
+	}
+	opts := &github.ListOptions{PerPage: githubMaxPerPage}
+	for {
+		releases, resp, err := r.client.Repositories.ListReleases(ctx, r.owner, r.repo, opts)
+		if err != nil {
+			return nil, newGitHubAPIError("list releases", err)
+		}
+		for _, release := range releases {
+			if release.GetDraft() && release.GetTagName() == tag {
+				return release, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, apiErr
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// PublishRelease publishes the draft GitHub release for the tag. A release already published is
+// left as is.
+func (r *githubRepository) PublishRelease(ctx context.Context, tag string) error {
+	release, err := r.releaseByTag(ctx, tag)
+	if err != nil {
+		return err
+	}
+	if !release.GetDraft() {
+		r.logger(ctx).Info("Release is already published", zap.String("tag", tag))
+		return nil
+	}
+	_, _, err = r.client.Repositories.EditRelease(ctx, r.owner, r.repo, release.GetID(), &github.RepositoryRelease{
+		Draft: github.Ptr(false),
+	})
+	if err != nil {
+		return newGitHubAPIError(fmt.Sprintf("publish release %s", tag), err)
+	}
+	r.logger(ctx).Info("Published draft release", zap.String("tag", tag))
+	return nil
+}
+
+// ReleasePublishedAt returns when the GitHub release for the tag was published. A missing release and
+// a draft, which has no publish date yet, both report false.
+func (r *githubRepository) ReleasePublishedAt(ctx context.Context, tag string) (time.Time, bool, error) {
+	release, _, err := r.client.Repositories.GetReleaseByTag(ctx, r.owner, r.repo, tag)
+	if err != nil {
+		apiErr := newGitHubAPIError(fmt.Sprintf("get release %s", tag), err)
+		if apiErr.StatusCode == http.StatusNotFound {
+			return time.Time{}, false, nil
+		}
+		return time.Time{}, false, apiErr
+	}
+	if release.GetDraft() || release.PublishedAt == nil {
+		return time.Time{}, false, nil
+	}
+	return release.GetPublishedAt().Time, true, nil
+}
+
+// ReleaseNotes returns the body of the GitHub release for the tag and when it was published; the time
+// is zero for a draft.
+func (r *githubRepository) ReleaseNotes(ctx context.Context, tag string) (string, time.Time, error) {
+	release, err := r.releaseByTag(ctx, tag)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if release.GetDraft() {
+		return release.GetBody(), time.Time{}, nil
+	}
+	return release.GetBody(), release.GetPublishedAt().Time, nil
+}
+
+// MarkSecurityRelease prefixes the name of the GitHub release for the tag with the security marker,
+// naming it after the tag when it has no name. A release already marked is left as is.
+func (r *githubRepository) MarkSecurityRelease(ctx context.Context, tag string) error {
+	release, err := r.releaseByTag(ctx, tag)
+	if err != nil {
+		return err
+	}
+	name := release.GetName()
+	if strings.HasPrefix(name, domain.SecurityReleasePrefix) {
+		return nil
+	}
+	if name == "" {
+		name = tag
+	}
+	_, _, err = r.client.Repositories.EditRelease(ctx, r.owner, r.repo, release.GetID(), &github.RepositoryRelease{
+		Name: github.Ptr(domain.SecurityReleasePrefix + name),
+	})
+	if err != nil {
+		return newGitHubAPIError(fmt.Sprintf("update release %s", tag), err)
+	}
+	r.logger(ctx).Info("Marked the release as containing security fixes", zap.String("tag", tag))
+	return nil
+}
+
+// RequestReviewers requests reviews on the open PR for head.
+// The PR author is dropped from the users because GitHub rejects self-review requests.
+func (r *githubRepository) RequestReviewers(
+	ctx context.Context,
+	head, base string,
+	reviewers domain.Reviewers,
+) error {
+	pr, err := r.openPullRequest(ctx, head, base)
+	if err != nil {
+		return err
+	}
+	if pr == nil {
+		return fmt.Errorf("no open pull request for %s into %s", head, base)
+	}
+	author := pr.GetUser().GetLogin()
+	users := slices.DeleteFunc(slices.Clone(reviewers.Users), func(user string) bool {
+		return strings.EqualFold(user, author)
+	})
+	if len(users) == 0 && len(reviewers.Teams) == 0 {
+		return nil
+	}
+	_, _, err = r.client.PullRequests.RequestReviewers(ctx, r.owner, r.repo, pr.GetNumber(), github.ReviewersRequest{
+		Reviewers:     users,
+		TeamReviewers: reviewers.Teams,
+	})
+	if err != nil {
+		return newGitHubAPIError(fmt.Sprintf("request reviewers on PR #%d", pr.GetNumber()), err)
+	}
+	r.logger(ctx).Info("Requested reviewers",
+		zap.Int("pr_number", pr.GetNumber()),
+		zap.Strings("users", users),
+		zap.Strings("teams", reviewers.Teams),
+	)
+	return nil
+}
+
+// FindOpenPR returns the number of the open PR from head into base, or 0 when there is none.
+func (r *githubRepository) FindOpenPR(ctx context.Context, head, base string) (int, error) {
+	pr, err := r.openPullRequest(ctx, head, base)
+	if err != nil {
+		return 0, err
+	}
+	return pr.GetNumber(), nil
+}
+
+// openPullRequest returns the open pull request from head into base, or nil when there is none.
+// The head filter narrows the listing to that branch, but the pages are still walked and the head
+// checked because GitHub ignores a head filter it cannot resolve and lists every open pull request.
+func (r *githubRepository) openPullRequest(ctx context.Context, head, base string) (*github.PullRequest, error) {
+	opts := &github.PullRequestListOptions{
+		Head:        fmt.Sprintf("%s:%s", r.owner, head),
+		Base:        base,
+		State:       "open",
+		ListOptions: github.ListOptions{PerPage: githubMaxPerPage},
+	}
+	for {
+		prs, resp, err := r.client.PullRequests.List(ctx, r.owner, r.repo, opts)
+		if err != nil {
+			return nil, newGitHubAPIError("list pull requests", err)
+		}
+		for _, pr := range prs {
+			if pr.GetHead().GetRef() == head {
+				return pr, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// PullRequestHead returns the head branch of a pull request.
+func (r *githubRepository) PullRequestHead(ctx context.Context, prNumber int) (string, error) {
+	pr, _, err := r.client.PullRequests.Get(ctx, r.owner, r.repo, prNumber)
+	if err != nil {
+		return "", newGitHubAPIError(fmt.Sprintf("get PR #%d", prNumber), err)
+	}
+	return pr.GetHead().GetRef(), nil
+}
+
+// PullRequestBody returns the body of a pull request.
+func (r *githubRepository) PullRequestBody(ctx context.Context, prNumber int) (string, error) {
+	pr, _, err := r.client.PullRequests.Get(ctx, r.owner, r.repo, prNumber)
+	if err != nil {
+		return "", newGitHubAPIError(fmt.Sprintf("get PR #%d", prNumber), err)
+	}
+	return pr.GetBody(), nil
+}
+
+// ReleaseContributors returns the sorted GitHub logins of the authors of the commits between base and head.
+// Commits whose author has no GitHub account are left out.
+func (r *githubRepository) ReleaseContributors(ctx context.Context, base, head string) ([]string, error) {
+	commits, err := r.compareCommits(ctx, base, head)
+	if err != nil {
+		return nil, err
+	}
+	var logins []string
+	for _, commit := range commits {
+		if login := commit.GetAuthor().GetLogin(); login != "" && !slices.Contains(logins, login) {
+			logins = append(logins, login)
+		}
+	}
+	slices.SortFunc(logins, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	return logins, nil
+}
+
+// MergedPullRequests returns the merged pull requests that brought the commits between base and
+// head, ordered by number. A pull request with several commits is listed once.
+func (r *githubRepository) MergedPullRequests(ctx context.Context, base, head string) ([]domain.PullRequest, error) {
+	commits, err := r.compareCommits(ctx, base, head)
+	if err != nil {
+		return nil, err
+	}
+	shas := make([]string, 0, len(commits))
+	for _, commit := range commits {
+		shas = append(shas, commit.GetSHA())
+	}
+	prs, err := r.pullRequestsOfCommits(ctx, shas)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[int]bool{}
+	var merged []domain.PullRequest
+	for _, pr := range prs {
+		if pr.MergedAt == nil || seen[pr.Number] {
+			continue
+		}
+		seen[pr.Number] = true
+		labels := make([]string, 0, len(pr.Labels.Nodes))
+		for _, label := range pr.Labels.Nodes {
+			labels = append(labels, label.Name)
+		}
+		_, closes := domain.LinkIssueReferences(pr.Body, r.owner, r.repo)
+		merged = append(merged, domain.PullRequest{
+			Number: pr.Number,
+			Title:  pr.Title,
+			Author: pr.Author.login(),
+			Labels: labels,
+			Closes: closes,
+		})
+	}
+	slices.SortFunc(merged, func(a, b domain.PullRequest) int { return a.Number - b.Number })
+	return merged, nil
+}
+
+// commitPullRequestsFields selects the pull requests associated with a commit in a GraphQL query.
+const commitPullRequestsFields = `... on Commit { associatedPullRequests(first: 10) { nodes {
+number title body mergedAt author { __typename login } labels(first: 100) { nodes { name } } } } }`
+
+// graphQLPullRequest is a pull request associated with a commit, as the GraphQL API returns it.
+type graphQLPullRequest struct {
+	Number   int            `json:"number"`
+	Title    string         `json:"title"`
+	Body     string         `json:"body"`
+	MergedAt *time.Time     `json:"mergedAt"`
+	Author   *graphQLAuthor `json:"author"`
+	Labels   struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+}
+
+// graphQLAuthor is the author of a pull request; a deleted account is nil.
+type graphQLAuthor struct {
+	Typename string `json:"__typename"`
+	Login    string `json:"login"`
+}
+
+// login returns the login the REST API reports for the author, which suffixes apps with [bot].
+func (a *graphQLAuthor) login() string {
+	if a == nil {
+		return ""
+	}
+	if a.Typename == "Bot" {
+		return a.Login + "[bot]"
+	}
+	return a.Login
+}
+
+// pullRequestsOfCommits returns the pull requests associated with each of shas, in order. Commits are
+// looked up with one GraphQL request per githubMaxPerPage commits instead of one REST request each.
+func (r *githubRepository) pullRequestsOfCommits(ctx context.Context, shas []string) ([]graphQLPullRequest, error) {
+	var prs []graphQLPullRequest
+	for batch := range slices.Chunk(shas, githubMaxPerPage) {
+		var query strings.Builder
+		query.WriteString("query($owner: String!, $name: String!) { repository(owner: $owner, name: $name) {")
+		for i, sha := range batch {
+			fmt.Fprintf(&query, "\nc%d: object(oid: %q) { %s }", i, sha, commitPullRequestsFields)
+		}
+		query.WriteString("\n} }")
+		payload := map[string]any{
+			"query":     query.String(),
+			"variables": map[string]string{"owner": r.owner, "name": r.repo},
+		}
+		req, err := r.client.NewRequest(http.MethodPost, "graphql", payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build the pull requests query: %w", err)
+		}
+		var result struct {
+			Data struct {
+				Repository map[string]*struct {
+					AssociatedPullRequests struct {
+						Nodes []graphQLPullRequest `json:"nodes"`
+					} `json:"associatedPullRequests"`
+				} `json:"repository"`
+			} `json:"data"`
+			Errors []struct {
+				Message string `json:"message"`
+			} `json:"errors"`
+		}
+		if _, err := r.client.Do(ctx, req, &result); err != nil {
+			return nil, newGitHubAPIError("query pull requests of commits", err)
+		}
+		if len(result.Errors) > 0 {
+			return nil, newGitHubAPIError("query pull requests of commits", errors.New(result.Errors[0].Message))
+		}
+		for i := range batch {
+			if commit := result.Data.Repository[fmt.Sprintf("c%d", i)]; commit != nil {
+				prs = append(prs, commit.AssociatedPullRequests.Nodes...)
+			}
+		}
+	}
+	return prs, nil
+}
+
+// pullRequestsWithCommit returns every pull request associated with the commit across the pages of
+// the listing.
+func (r *githubRepository) pullRequestsWithCommit(ctx context.Context, sha string) ([]*github.PullRequest, error) {
+	var prs []*github.PullRequest
+	opts := &github.ListOptions{PerPage: githubMaxPerPage}
+	for {
+		page, resp, err := r.client.PullRequests.ListPullRequestsWithCommit(ctx, r.owner, r.repo, sha, opts)
+		if err != nil {
+			return nil, newGitHubAPIError(fmt.Sprintf("list pull requests of commit %s", sha), err)
+		}
+		prs = append(prs, page...)
+		if resp.NextPage == 0 {
+			return prs, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// compareCommits returns every commit between base and head across the pages of the comparison.
+func (r *githubRepository) compareCommits(
+	ctx context.Context,
+	base, head string,
+) ([]*github.RepositoryCommit, error) {
+	var commits []*github.RepositoryCommit
+	opts := &github.ListOptions{PerPage: githubMaxPerPage}
+	for {
+		comparison, resp, err := r.client.Repositories.CompareCommits(ctx, r.owner, r.repo, base, head, opts)
+		if err != nil {
+			return nil, newGitHubAPIError(fmt.Sprintf("compare %s...%s", base, head), err)
+		}
+		commits = append(commits, comparison.Commits...)
+		if resp.NextPage == 0 {
+			return commits, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// CreateOrUpdateIssue updates the body and assignees of the open issue titled title, or creates it when
+// there is none, so repeated reports of the same problem share one issue. Pull requests are ignored.
+func (r *githubRepository) CreateOrUpdateIssue(
+	ctx context.Context,
+	title, body string,
+	assignees []string,
+) (int, error) {
+	number, err := r.findOpenIssue(ctx, title)
+	if err != nil {
+		return 0, err
+	}
+	request := &github.IssueRequest{Title: &title, Body: &body, Assignees: &assignees}
+	if number != 0 {
+		if _, _, err := r.client.Issues.Edit(ctx, r.owner, r.repo, number, request); err != nil {
+			return 0, newGitHubAPIError("update issue", err)
+		}
+		return number, nil
+	}
+	issue, _, err := r.client.Issues.Create(ctx, r.owner, r.repo, request)
+	if err != nil {
+		return 0, newGitHubAPIError("create issue", err)
+	}
+	return issue.GetNumber(), nil
+}
+
+// findOpenIssue returns the number of the open issue titled title, or 0 when there is none.
+func (r *githubRepository) findOpenIssue(ctx context.Context, title string) (int, error) {
+	opts := &github.IssueListByRepoOptions{State: "open", ListOptions: github.ListOptions{PerPage: githubMaxPerPage}}
+	for {
+		issues, resp, err := r.client.Issues.ListByRepo(ctx, r.owner, r.repo, opts)
+		if err != nil {
+			return 0, newGitHubAPIError("list issues", err)
+		}
+		for _, issue := range issues {
+			if !issue.IsPullRequest() && issue.GetTitle() == title {
+				return issue.GetNumber(), nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return 0, nil
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+}
+
+// ForRepository returns a repository for owner/repo that shares the client, and so the token, of r.
+func (r *githubRepository) ForRepository(owner, repo string) GithubExtendedRepository {
+	return &githubRepository{client: r.client, owner: owner, repo: repo}
+}
+
+// FileContent returns the content of the file at path on the default branch. A missing file, or a
+// repository the token cannot see, returns false rather than an error.
+func (r *githubRepository) FileContent(ctx context.Context, path string) (string, bool, error) {
+	file, _, _, err := r.client.Repositories.GetContents(ctx, r.owner, r.repo, path, nil)
+	if err != nil {
+		apiErr := newGitHubAPIError("read "+path, err)
+		if apiErr.StatusCode == http.StatusNotFound {
+			return "", false, nil
+		}
+		return "", false, apiErr
+	}
+	if file == nil {
+		return "", false, fmt.Errorf("%s is a directory", path)
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return content, true, nil
+}
+
+// CommitFile creates or updates the file at path on branch with a commit of message, creating branch
+// from the head of base first when it does not exist.
+func (r *githubRepository) CommitFile(ctx context.Context, branch, base, path, content, message string) error {
+	if err := r.ensureBranch(ctx, branch, base); err != nil {
+		return err
+	}
+	opts := &github.RepositoryContentFileOptions{
+		Message: github.Ptr(message),
+		Content: []byte(content),
+		Branch:  github.Ptr(branch),
+	}
+	file, _, _, err := r.client.Repositories.GetContents(ctx, r.owner, r.repo, path,
+		&github.RepositoryContentGetOptions{Ref: branch})
+	if err != nil {
+		if apiErr := newGitHubAPIError("read "+path, err); apiErr.StatusCode != http.StatusNotFound {
+			return apiErr
+		}
+		if _, _, err := r.client.Repositories.CreateFile(ctx, r.owner, r.repo, path, opts); err != nil {
+			return newGitHubAPIError("create "+path, err)
+		}
+		return nil
+	}
+	if file == nil {
+		return fmt.Errorf("%s is a directory", path)
+	}
+	opts.SHA = file.SHA
+	if _, _, err := r.client.Repositories.UpdateFile(ctx, r.owner, r.repo, path, opts); err != nil {
+		return newGitHubAPIError("update "+path, err)
+	}
+	return nil
+}
+
+// ensureBranch creates branch at the head of base unless it already exists.
+func (r *githubRepository) ensureBranch(ctx context.Context, branch, base string) error {
+	_, _, err := r.client.Git.GetRef(ctx, r.owner, r.repo, "heads/"+branch)
+	if err == nil {
+		return nil
+	}
+	if apiErr := newGitHubAPIError("read branch "+branch, err); apiErr.StatusCode != http.StatusNotFound {
+		return apiErr
+	}
+	baseRef, _, err := r.client.Git.GetRef(ctx, r.owner, r.repo, "heads/"+base)
+	if err != nil {
+		return newGitHubAPIError("read branch "+base, err)
+	}
+	_, _, err = r.client.Git.CreateRef(ctx, r.owner, r.repo, &github.Reference{
+		Ref:    github.Ptr("refs/heads/" + branch),
+		Object: &github.GitObject{SHA: baseRef.Object.SHA},
+	})
+	if err != nil {
+		return newGitHubAPIError("create branch "+branch, err)
+	}
+	return nil
+}
+
+// SearchCode returns the sorted paths of the files of the repository matching query, which is scoped
+// to the repository with a repo: qualifier.
+func (r *githubRepository) SearchCode(ctx context.Context, query string) ([]string, error) {
+	query = fmt.Sprintf("%s repo:%s/%s", query, r.owner, r.repo)
+	opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: githubMaxPerPage}}
+	var paths []string
+	for {
+		result, resp, err := r.client.Search.Code(ctx, query, opts)
+		if err != nil {
+			return nil, newGitHubAPIError("search code", err)
+		}
+		for _, match := range result.CodeResults {
+			if !slices.Contains(paths, match.GetPath()) {
+				paths = append(paths, match.GetPath())
+			}
+		}
+		if resp.NextPage == 0 {
+			slices.Sort(paths)
+			return paths, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// ReviewStatus returns the review state of the merged pull request that brought commit, such as the
+// merge commit of a release pull request: the reviewers whose latest review approves it and the
+// latest check run of every name on its head commit.
+func (r *githubRepository) ReviewStatus(ctx context.Context, commit string) (domain.ReviewStatus, error) {
+	prs, err := r.pullRequestsWithCommit(ctx, commit)
+	if err != nil {
+		return domain.ReviewStatus{}, err
+	}
+	var pr *github.PullRequest
+	for _, candidate := range prs {
+		if candidate.MergedAt != nil && (pr == nil || candidate.GetMergeCommitSHA() == commit) {
+			pr = candidate
+		}
+	}
+	if pr == nil {
+		return domain.ReviewStatus{}, fmt.Errorf("no merged pull request brought commit %s", commit)
+	}
+	approvers, err := r.approvers(ctx, pr.GetNumber())
+	if err != nil {
+		return domain.ReviewStatus{}, err
+	}
+	checks, err := r.checkConclusions(ctx, pr.GetHead().GetSHA())
+	if err != nil {
+		return domain.ReviewStatus{}, err
+	}
+	return domain.ReviewStatus{PullRequest: pr.GetNumber(), Approvers: approvers, Checks: checks}, nil
+}
+
+// approvers returns the sorted logins of the reviewers whose latest review of the pull request approves
+// it. Comments do not change a review, while a dismissal or a change request withdraws an approval.
+func (r *githubRepository) approvers(ctx context.Context, prNumber int) ([]string, error) {
+	latest := map[string]string{}
+	opts := &github.ListOptions{PerPage: githubMaxPerPage}
+	for {
+		reviews, resp, err := r.client.PullRequests.ListReviews(ctx, r.owner, r.repo, prNumber, opts)
+		if err != nil {
+			return nil, newGitHubAPIError(fmt.Sprintf("list reviews of PR #%d", prNumber), err)
+		}
+		for _, review := range reviews {
+			switch state := review.GetState(); state {
+			case "APPROVED", "CHANGES_REQUESTED", "DISMISSED":
+				latest[review.GetUser().GetLogin()] = state
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	approvers := make([]string, 0, len(latest))
+	for login, state := range latest {
+		if state == "APPROVED" && login != "" {
+			approvers = append(approvers, login)
+		}
+	}
+	slices.Sort(approvers)
+	return approvers, nil
+}
+
+// checkConclusions maps the name of every check run on the commit to its conclusion, or to its status
+// while it has not completed. GitHub lists the latest run of each name.
+func (r *githubRepository) checkConclusions(ctx context.Context, sha string) (map[string]string, error) {
+	checks := map[string]string{}
+	opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: githubMaxPerPage}}
+	for {
+		result, resp, err := r.client.Checks.ListCheckRunsForRef(ctx, r.owner, r.repo, sha, opts)
+		if err != nil {
+			return nil, newGitHubAPIError(fmt.Sprintf("list check runs of commit %s", sha), err)
+		}
+		for _, run := range result.CheckRuns {
+			if _, seen := checks[run.GetName()]; seen {
+				continue
+			}
+			checks[run.GetName()] = run.GetStatus()
+			if run.GetStatus() == "completed" {
+				checks[run.GetName()] = run.GetConclusion()
+			}
+		}
+		if resp.NextPage == 0 {
+			return checks, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// FindMilestone returns the number of the open or closed milestone titled title, or 0 when there is none.
+func (r *githubRepository) FindMilestone(ctx context.Context, title string) (int, error) {
+	opts := &github.MilestoneListOptions{State: "all", ListOptions: github.ListOptions{PerPage: githubMaxPerPage}}
+	for {
+		milestones, resp, err := r.client.Issues.ListMilestones(ctx, r.owner, r.repo, opts)
+		if err != nil {
+			return 0, newGitHubAPIError("list milestones", err)
+		}
+		for _, milestone := range milestones {
+			if milestone.GetTitle() == title {
+				return milestone.GetNumber(), nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return 0, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// EnsureLabels creates the labels the repository does not have yet, matching names ignoring case.
+// A label created concurrently by another run counts as existing.
+func (r *githubRepository) EnsureLabels(ctx context.Context, labels []domain.Label) error {
+	existing, err := r.labelNames(ctx)
+	if err != nil {
+		return err
+	}
+	for _, label := range labels {
+		if existing[strings.ToLower(label.Name)] {
+			continue
+		}
+		_, _, err := r.client.Issues.CreateLabel(ctx, r.owner, r.repo, &github.Label{
+			Name:        github.Ptr(label.Name),
+			Color:       github.Ptr(label.Color),
+			Description: github.Ptr(label.Description),
+		})
+		switch {
+		case err == nil:
+			r.logger(ctx).Info("Created label", zap.String("label", label.Name), zap.String("color", label.Color))
+		case !alreadyExists(err):
+			return newGitHubAPIError(fmt.Sprintf("create label %s", label.Name), err)
+		}
+		existing[strings.ToLower(label.Name)] = true
+	}
+	return nil
+}
+
+// labelNames returns the lowercased names of the repository labels.
+func (r *githubRepository) labelNames(ctx context.Context) (map[string]bool, error) {
+	names := make(map[string]bool)
+	opts := &github.ListOptions{PerPage: githubMaxPerPage}
+	for {
+		labels, resp, err := r.client.Issues.ListLabels(ctx, r.owner, r.repo, opts)
+		if err != nil {
+			return nil, newGitHubAPIError("list labels", err)
+		}
+		for _, label := range labels {
+			names[strings.ToLower(label.GetName())] = true
+		}
+		if resp.NextPage == 0 {
+			return names, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// CreateCheckRun creates a completed check run on the commit of run.HeadSHA. Annotations beyond
+// the GitHub limit of a single request are dropped, and output beyond its size limit is truncated.
+func (r *githubRepository) CreateCheckRun(ctx context.Context, run domain.CheckRun) error {
+	annotations := make([]*github.CheckRunAnnotation, 0, min(len(run.Annotations), domain.MaxCheckAnnotations))
+	for _, annotation := range run.Annotations[:min(len(run.Annotations), domain.MaxCheckAnnotations)] {
+		annotations = append(annotations, &github.CheckRunAnnotation{
+			Path:            github.Ptr(annotation.Path),
+			StartLine:       github.Ptr(annotation.StartLine),
+			EndLine:         github.Ptr(annotation.EndLine),
+			AnnotationLevel: github.Ptr(annotation.Level),
+			Title:           github.Ptr(annotation.Title),
+			Message:         github.Ptr(truncateCheckOutput(annotation.Message)),
+		})
+	}
+	_, _, err := r.client.Checks.CreateCheckRun(ctx, r.owner, r.repo, github.CreateCheckRunOptions{
+		Name:        run.Name,
+		HeadSHA:     run.HeadSHA,
+		Status:      github.Ptr("completed"),
+		Conclusion:  github.Ptr(run.Conclusion),
+		CompletedAt: &github.Timestamp{Time: time.Now()},
+		Output: &github.CheckRunOutput{
+			Title:       github.Ptr(run.Title),
+			Summary:     github.Ptr(truncateCheckOutput(run.Summary)),
+			Text:        github.Ptr(truncateCheckOutput(run.Text)),
+			Annotations: annotations,
+		},
+	})
+	if err != nil {
+		return newGitHubAPIError(fmt.Sprintf("create check run %q", run.Name), err)
+	}
+	return nil
+}
+
+// permissionProbe is a write request that GitHub authorizes before it validates the body: sent with an
+// empty body it fails with 422 when the token may write and with 403 or 404 when it may not, and
+// never writes anything.
+type permissionProbe struct {
+	permission string
+	method     string
+	path       string
+}
+
+// workflowProbePath is a workflow file the workflows probe addresses; it is never created.
+const workflowProbePath = ".github/workflows/releasepr-permission-probe.yml"
+
+// TokenPermissions probes the write access of the token for every permission the release workflow
+// uses. Bad credentials and rate limits fail the whole probe; other unexpected answers leave the
+// access unknown.
+func (r *githubRepository) TokenPermissions(ctx context.Context) ([]domain.PermissionCheck, error) {
+	base := fmt.Sprintf("repos/%s/%s", r.owner, r.repo)
+	probes := []permissionProbe{
+		{permission: domain.PermissionContents, method: http.MethodPost, path: base + "/git/refs"},
+		{permission: domain.PermissionPullRequests, method: http.MethodPost, path: base + "/pulls"},
+		{permission: domain.PermissionIssues, method: http.MethodPost, path: base + "/issues"},
+		{permission: domain.PermissionWorkflows, method: http.MethodPut, path: base + "/contents/" + workflowProbePath},
+	}
+	checks := make([]domain.PermissionCheck, 0, len(probes))
+	for _, probe := range probes {
+		check, err := r.probePermission(ctx, probe)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+func (r *githubRepository) probePermission(ctx context.Context, probe permissionProbe) (domain.PermissionCheck, error) {
+	check := domain.PermissionCheck{Permission: probe.permission, Access: domain.PermissionAccessWrite}
+	req, err := r.client.NewRequest(probe.method, probe.path, struct{}{})
+	if err != nil {
+		return check, fmt.Errorf("failed to build %s permission probe: %w", probe.permission, err)
+	}
+	if _, err = r.client.Do(ctx, req, nil); err == nil {
+		return check, nil
+	}
+	apiErr := newGitHubAPIError(fmt.Sprintf("probe %s permission", probe.permission), err)
+	switch {
+	case apiErr.StatusCode == http.StatusUnauthorized, apiErr.RateLimited(), apiErr.StatusCode == 0:
+		return check, apiErr
+	case apiErr.StatusCode == http.StatusUnprocessableEntity:
+		// The token was authorized and only the empty body was rejected.
+	case apiErr.StatusCode == http.StatusForbidden, apiErr.StatusCode == http.StatusNotFound:
+		check.Access = domain.PermissionAccessNone
+		check.Detail = apiErr.Message
+	default:
+		check.Access = domain.PermissionAccessUnknown
+		check.Detail = fmt.Sprintf("GitHub API returned %d: %s", apiErr.StatusCode, apiErr.Message)
+	}
+	return check, nil
+}
+
+// truncateCheckOutput cuts text to the size GitHub accepts for check run output, on a rune boundary.
+func truncateCheckOutput(text string) string {
+	if len(text) <= maxCheckOutputLength {
+		return text
+	}
+	cut := maxCheckOutputLength - len(checkOutputTruncated)
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut] + checkOutputTruncated
+}
+
+// alreadyExists reports whether GitHub rejected a create request because the resource exists.
+func alreadyExists(err error) bool {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) {
+		return false
+	}
+	for _, detail := range errResp.Errors {
+		if detail.Code == "already_exists" {
+			return true
+		}
+	}
+	return false
+}
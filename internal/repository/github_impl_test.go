@@ -0,0 +1,774 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGithubClient(t *testing.T, handler http.Handler) *github.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client := github.NewClient(server.Client())
+	client.BaseURL = baseURL
+	return client
+}
+
+func TestVerifyGitHubToken(t *testing.T) {
+	t.Run("Should return the user login for user tokens", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /user", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"login":"octocat"}`))
+		})
+		identity, err := verifyGitHubToken(context.Background(), newTestGithubClient(t, mux))
+		require.NoError(t, err)
+		require.Equal(t, "octocat", identity)
+	})
+
+	t.Run("Should fall back to the installation for app tokens", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /user", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"Resource not accessible by integration"}`))
+		})
+		mux.HandleFunc("GET /installation/repositories", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"total_count":1,"repositories":[{"name":"widgets"}]}`))
+		})
+		identity, err := verifyGitHubToken(context.Background(), newTestGithubClient(t, mux))
+		require.NoError(t, err)
+		require.Equal(t, githubInstallationIdentity, identity)
+	})
+
+	t.Run("Should report rejected tokens", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /user", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"Bad credentials"}`))
+		})
+		_, err := verifyGitHubToken(context.Background(), newTestGithubClient(t, mux))
+		require.ErrorContains(t, err, "GitHub rejected the token")
+	})
+
+	t.Run("Should fail when neither the user nor the installation is readable", func(t *testing.T) {
+		mux := http.NewServeMux()
+		forbidden := func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"Forbidden"}`))
+		}
+		mux.HandleFunc("GET /user", forbidden)
+		mux.HandleFunc("GET /installation/repositories", forbidden)
+		_, err := verifyGitHubToken(context.Background(), newTestGithubClient(t, mux))
+		require.ErrorContains(t, err, "cannot read the user or installation")
+	})
+}
+
+func TestGithubRepository_RequestReviewers(t *testing.T) {
+	t.Run("Should request users and teams on the open PR without the author", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/pulls", func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "compozy:release/v1.2.0", r.URL.Query().Get("head"))
+			_, _ = w.Write([]byte(`[{"number":42,"head":{"ref":"release/v1.2.0"},"user":{"login":"release-bot"}}]`))
+		})
+		var requested github.ReviewersRequest
+		mux.HandleFunc("POST /repos/compozy/releasepr/pulls/42/requested_reviewers",
+			func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&requested))
+				_, _ = w.Write([]byte(`{"number":42}`))
+			})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		err := repo.RequestReviewers(context.Background(), "release/v1.2.0", "main", domain.Reviewers{
+			Users: []string{"Release-Bot", "alice"},
+			Teams: []string{"maintainers"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"alice"}, requested.Reviewers)
+		require.Equal(t, []string{"maintainers"}, requested.TeamReviewers)
+	})
+
+	t.Run("Should fail when there is no open release PR", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/pulls", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[]`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		err := repo.RequestReviewers(context.Background(), "release/v1.2.0", "main", domain.Reviewers{
+			Users: []string{"alice"},
+		})
+		require.ErrorContains(t, err, "no open pull request for release/v1.2.0 into main")
+	})
+}
+
+func TestGithubRepository_AppendReleaseNotes(t *testing.T) {
+	t.Run("Should append the section to the existing release body", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases/tags/v1.2.0",
+			func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"id":7,"body":"## What's Changed\n"}`))
+			})
+		var edited github.RepositoryRelease
+		mux.HandleFunc("PATCH /repos/compozy/releasepr/releases/7", func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&edited))
+			_, _ = w.Write([]byte(`{"id":7}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		err := repo.AppendReleaseNotes(context.Background(), "v1.2.0", "### Verifying this release")
+		require.NoError(t, err)
+		require.Equal(t, "## What's Changed\n\n### Verifying this release", edited.GetBody())
+	})
+
+	t.Run("Should fail when the release does not exist", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases/tags/v1.2.0",
+			func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+			})
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"id":6,"tag_name":"v1.1.0"}]`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		err := repo.AppendReleaseNotes(context.Background(), "v1.2.0", "### Verifying this release")
+		require.ErrorContains(t, err, "get release v1.2.0")
+	})
+}
+
+func TestGithubRepository_PublishRelease(t *testing.T) {
+	t.Run("Should find the draft release of the tag and publish it", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases/tags/v1.2.0",
+			func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+			})
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"id":6,"tag_name":"v1.1.0"},{"id":7,"tag_name":"v1.2.0","draft":true}]`))
+		})
+		var edited github.RepositoryRelease
+		mux.HandleFunc("PATCH /repos/compozy/releasepr/releases/7", func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&edited))
+			_, _ = w.Write([]byte(`{"id":7}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		require.NoError(t, repo.PublishRelease(context.Background(), "v1.2.0"))
+		require.NotNil(t, edited.Draft)
+		require.False(t, edited.GetDraft())
+	})
+
+	t.Run("Should leave a published release as is", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases/tags/v1.2.0",
+			func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"id":7,"tag_name":"v1.2.0"}`))
+			})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		require.NoError(t, repo.PublishRelease(context.Background(), "v1.2.0"))
+	})
+}
+
+func TestGithubRepository_ReleasePublishedAt(t *testing.T) {
+	t.Run("Should return the publish time of the release", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases/tags/v1.2.0-rc.1",
+			func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"id":7,"prerelease":true,"published_at":"2026-10-01T12:00:00Z"}`))
+			})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		publishedAt, published, err := repo.ReleasePublishedAt(context.Background(), "v1.2.0-rc.1")
+		require.NoError(t, err)
+		require.True(t, published)
+		require.Equal(t, time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC), publishedAt.UTC())
+	})
+
+	t.Run("Should report missing and draft releases as unpublished", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases/tags/v1.2.0-rc.1",
+			func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+			})
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases/tags/v1.2.0-rc.2",
+			func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"id":8,"draft":true}`))
+			})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		for _, tag := range []string{"v1.2.0-rc.1", "v1.2.0-rc.2"} {
+			_, published, err := repo.ReleasePublishedAt(context.Background(), tag)
+			require.NoError(t, err)
+			require.False(t, published)
+		}
+	})
+}
+
+func TestGithubRepository_ReleaseNotes(t *testing.T) {
+	t.Run("Should return the body and publish date of the release", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases/tags/v1.2.0",
+			func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"id":7,"body":"## 1.2.0","published_at":"2026-10-01T10:00:00Z"}`))
+			})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		body, publishedAt, err := repo.ReleaseNotes(context.Background(), "v1.2.0")
+		require.NoError(t, err)
+		require.Equal(t, "## 1.2.0", body)
+		require.Equal(t, time.Date(2026, time.October, 1, 10, 0, 0, 0, time.UTC), publishedAt.UTC())
+	})
+}
+
+func TestGithubRepository_MarkSecurityRelease(t *testing.T) {
+	t.Run("Should prefix the release name once", func(t *testing.T) {
+		mux := http.NewServeMux()
+		name := "v1.2.0"
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases/tags/v1.2.0",
+			func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]any{"id": 7, "name": name})
+			})
+		edits := 0
+		mux.HandleFunc("PATCH /repos/compozy/releasepr/releases/7", func(w http.ResponseWriter, r *http.Request) {
+			var edited github.RepositoryRelease
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&edited))
+			name = edited.GetName()
+			edits++
+			_, _ = w.Write([]byte(`{"id":7}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		require.NoError(t, repo.MarkSecurityRelease(context.Background(), "v1.2.0"))
+		require.NoError(t, repo.MarkSecurityRelease(context.Background(), "v1.2.0"))
+		require.Equal(t, domain.SecurityReleasePrefix+"v1.2.0", name)
+		require.Equal(t, 1, edits)
+	})
+}
+
+func TestGithubRepository_ReviewStatus(t *testing.T) {
+	t.Run("Should report the latest approvals and check runs of the merged pull request", func(t *testing.T) {
+		mux := http.NewServeMux()
+		pulls := "GET /repos/compozy/releasepr/commits/abc123/pulls"
+		mux.HandleFunc(pulls, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"number":41,"merged_at":null},` +
+				`{"number":42,"merged_at":"2026-10-01T10:00:00Z","merge_commit_sha":"abc123","head":{"sha":"fff"}}]`))
+		})
+		mux.HandleFunc("GET /repos/compozy/releasepr/pulls/42/reviews", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"user":{"login":"bob"},"state":"APPROVED"},` +
+				`{"user":{"login":"alice"},"state":"CHANGES_REQUESTED"},` +
+				`{"user":{"login":"bob"},"state":"COMMENTED"},` +
+				`{"user":{"login":"alice"},"state":"APPROVED"},` +
+				`{"user":{"login":"carol"},"state":"APPROVED"},{"user":{"login":"carol"},"state":"DISMISSED"}]`))
+		})
+		checkRuns := "GET /repos/compozy/releasepr/commits/fff/check-runs"
+		mux.HandleFunc(checkRuns, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"total_count":3,"check_runs":[` +
+				`{"name":"test","status":"completed","conclusion":"success"},` +
+				`{"name":"e2e","status":"in_progress"},` +
+				`{"name":"test","status":"completed","conclusion":"failure"}]}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		status, err := repo.ReviewStatus(context.Background(), "abc123")
+		require.NoError(t, err)
+		require.Equal(t, domain.ReviewStatus{
+			PullRequest: 42,
+			Approvers:   []string{"alice", "bob"},
+			Checks:      map[string]string{"test": "success", "e2e": "in_progress"},
+		}, status)
+	})
+	t.Run("Should fail when no merged pull request brought the commit", func(t *testing.T) {
+		mux := http.NewServeMux()
+		pulls := "GET /repos/compozy/releasepr/commits/abc123/pulls"
+		mux.HandleFunc(pulls, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[]`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		_, err := repo.ReviewStatus(context.Background(), "abc123")
+		require.ErrorContains(t, err, "no merged pull request brought commit abc123")
+	})
+}
+
+func TestGithubRepository_ReleaseContributors(t *testing.T) {
+	t.Run("Should list each commit author once across pages", func(t *testing.T) {
+		mux := http.NewServeMux()
+		compare := "GET /repos/compozy/releasepr/compare/v1.1.0...main"
+		mux.HandleFunc(compare, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`{"commits":[{"author":{"login":"alice"}},{"author":null}]}`))
+				return
+			}
+			w.Header().Set("Link", `<`+r.URL.Path+`?page=2>; rel="next"`)
+			_, _ = w.Write([]byte(`{"commits":[{"author":{"login":"zoe"}},{"author":{"login":"Bob"}}]}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		logins, err := repo.ReleaseContributors(context.Background(), "v1.1.0", "main")
+		require.NoError(t, err)
+		require.Equal(t, []string{"alice", "Bob", "zoe"}, logins)
+	})
+}
+
+func TestGithubRepository_MergedPullRequests(t *testing.T) {
+	type graphQLRequest struct {
+		Query     string            `json:"query"`
+		Variables map[string]string `json:"variables"`
+	}
+	t.Run("Should list each merged pull request of the commits once", func(t *testing.T) {
+		mux := http.NewServeMux()
+		compare := "GET /repos/compozy/releasepr/compare/v1.1.0...main"
+		mux.HandleFunc(compare, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"commits":[{"sha":"aaa"},{"sha":"bbb"},{"sha":"ccc"}]}`))
+		})
+		requests := 0
+		mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			var request graphQLRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			require.Equal(t, map[string]string{"owner": "compozy", "name": "releasepr"}, request.Variables)
+			require.Contains(t, request.Query, `c0: object(oid: "aaa")`)
+			require.Contains(t, request.Query, `c2: object(oid: "ccc")`)
+			retry := `{"number":15,"title":"fix: retry uploads","mergedAt":"2026-10-01T10:00:00Z",` +
+				`"author":{"__typename":"User","login":"bob"}`
+			_, _ = w.Write([]byte(`{"data":{"repository":{` +
+				`"c0":{"associatedPullRequests":{"nodes":[` + retry + `,"labels":{"nodes":[{"name":"bug"}]}}]}},` +
+				`"c1":{"associatedPullRequests":{"nodes":[` + retry + `},` +
+				`{"number":16,"title":"wip","author":{"__typename":"User","login":"eve"}}]}},` +
+				`"c2":{"associatedPullRequests":{"nodes":[` +
+				`{"number":12,"title":"feat: add doctor","mergedAt":"2026-09-30T10:00:00Z",` +
+				`"body":"Fixes #7, see #3","author":{"__typename":"User","login":"alice"},` +
+				`"labels":{"nodes":[{"name":"enhancement"},{"name":"cli"}]}},` +
+				`{"number":18,"title":"chore(deps): bump zap","mergedAt":"2026-10-02T10:00:00Z",` +
+				`"author":{"__typename":"Bot","login":"renovate"}}]}}}}}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		prs, err := repo.MergedPullRequests(context.Background(), "v1.1.0", "main")
+		require.NoError(t, err)
+		require.Equal(t, 1, requests)
+		require.Equal(t, []domain.PullRequest{
+			{
+				Number: 12,
+				Title:  "feat: add doctor",
+				Author: "alice",
+				Labels: []string{"enhancement", "cli"},
+				Closes: []int{7},
+			},
+			{Number: 15, Title: "fix: retry uploads", Author: "bob", Labels: []string{"bug"}},
+			{Number: 18, Title: "chore(deps): bump zap", Author: "renovate[bot]", Labels: []string{}},
+		}, prs)
+	})
+	t.Run("Should query the commits in batches", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/compare/v1.1.0...main", func(w http.ResponseWriter, _ *http.Request) {
+			commits := make([]string, 0, githubMaxPerPage+1)
+			for i := range githubMaxPerPage + 1 {
+				commits = append(commits, fmt.Sprintf(`{"sha":"%040d"}`, i))
+			}
+			_, _ = w.Write([]byte(`{"commits":[` + strings.Join(commits, ",") + `]}`))
+		})
+		var batches []int
+		mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+			var request graphQLRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			batches = append(batches, strings.Count(request.Query, "object(oid:"))
+			_, _ = w.Write([]byte(`{"data":{"repository":{}}}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		prs, err := repo.MergedPullRequests(context.Background(), "v1.1.0", "main")
+		require.NoError(t, err)
+		require.Empty(t, prs)
+		require.Equal(t, []int{githubMaxPerPage, 1}, batches)
+	})
+	t.Run("Should fail on GraphQL errors", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/compare/v1.1.0...main", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"commits":[{"sha":"aaa"}]}`))
+		})
+		mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"data":null,"errors":[{"message":"Resource not accessible by integration"}]}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		_, err := repo.MergedPullRequests(context.Background(), "v1.1.0", "main")
+		require.ErrorContains(t, err, "Resource not accessible by integration")
+	})
+}
+
+func TestGithubRepository_FindMilestone(t *testing.T) {
+	t.Run("Should return the number of the milestone with the title", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/milestones", func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "all", r.URL.Query().Get("state"))
+			_, _ = w.Write([]byte(`[{"number":3,"title":"v1.1.0"},{"number":4,"title":"v1.2.0"}]`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		number, err := repo.FindMilestone(context.Background(), "v1.2.0")
+		require.NoError(t, err)
+		require.Equal(t, 4, number)
+		number, err = repo.FindMilestone(context.Background(), "v9.0.0")
+		require.NoError(t, err)
+		require.Zero(t, number)
+	})
+}
+
+func TestGithubRepository_CreateOrUpdateIssue(t *testing.T) {
+	t.Run("Should update the open issue with the title", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/issues", func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "open", r.URL.Query().Get("state"))
+			_, _ = w.Write([]byte(`[{"number":5,"title":"Release failure: v1.2.0","pull_request":{}},` +
+				`{"number":6,"title":"Release failure: v1.2.0"}]`))
+		})
+		mux.HandleFunc("PATCH /repos/compozy/releasepr/issues/6", func(w http.ResponseWriter, r *http.Request) {
+			var request map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			require.Equal(t, "failed again", request["body"])
+			require.Equal(t, []any{"octocat"}, request["assignees"])
+			_, _ = w.Write([]byte(`{"number":6}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		number, err := repo.CreateOrUpdateIssue(
+			context.Background(), "Release failure: v1.2.0", "failed again", []string{"octocat"})
+		require.NoError(t, err)
+		require.Equal(t, 6, number)
+	})
+	t.Run("Should create the issue when none is open", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/issues", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"number":5,"title":"Release failure: v1.1.0"}]`))
+		})
+		mux.HandleFunc("POST /repos/compozy/releasepr/issues", func(w http.ResponseWriter, r *http.Request) {
+			var request map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			require.Equal(t, "Release failure: v1.2.0", request["title"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"number":7}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		number, err := repo.CreateOrUpdateIssue(context.Background(), "Release failure: v1.2.0", "failed", nil)
+		require.NoError(t, err)
+		require.Equal(t, 7, number)
+	})
+}
+
+func TestGithubRepository_FileContent(t *testing.T) {
+	t.Run("Should read the file of another repository with the same client", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/cli/contents/go.mod", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"type":"file","encoding":"base64","content":"bW9kdWxlIGNsaQo="}`))
+		})
+		mux.HandleFunc("GET /repos/compozy/cli/contents/package.json", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		downstream := repo.ForRepository("compozy", "cli")
+		content, exists, err := downstream.FileContent(context.Background(), "go.mod")
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, "module cli\n", content)
+		_, exists, err = downstream.FileContent(context.Background(), "package.json")
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
+
+func TestGithubRepository_SearchCode(t *testing.T) {
+	t.Run("Should scope the query to the repository and return unique paths", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /search/code", func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, `"@compozy/sdk" repo:compozy/web`, r.URL.Query().Get("q"))
+			_, _ = w.Write([]byte(`{"total_count":3,"items":[{"path":"package.json"},` +
+				`{"path":"apps/site/package.json"},{"path":"package.json"}]}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "web"}
+		paths, err := repo.SearchCode(context.Background(), `"@compozy/sdk"`)
+		require.NoError(t, err)
+		require.Equal(t, []string{"apps/site/package.json", "package.json"}, paths)
+	})
+}
+
+func TestGithubRepository_FindOpenPR(t *testing.T) {
+	t.Run("Should return zero when no PR is open for the branch", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/pulls", func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "compozy:release/v1.2.0", r.URL.Query().Get("head"))
+			_, _ = w.Write([]byte(`[]`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		number, err := repo.FindOpenPR(context.Background(), "release/v1.2.0", "main")
+		require.NoError(t, err)
+		require.Zero(t, number)
+	})
+	t.Run("Should find the PR of the branch across pages of unrelated PRs", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/pulls", func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "100", r.URL.Query().Get("per_page"))
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`[{"number":41,"head":{"ref":"release/v1.2.0"}}]`))
+				return
+			}
+			w.Header().Set("Link", `<`+r.URL.Path+`?page=2>; rel="next"`)
+			_, _ = w.Write([]byte(`[{"number":7,"head":{"ref":"feature/x"}},{"number":8,"head":{"ref":"fix/y"}}]`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		number, err := repo.FindOpenPR(context.Background(), "release/v1.2.0", "main")
+		require.NoError(t, err)
+		require.Equal(t, 41, number)
+	})
+}
+
+func TestGithubRepository_PullRequestHead(t *testing.T) {
+	t.Run("Should return the head branch of the pull request", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/pulls/42", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"number":42,"head":{"ref":"release/v1.2.0"}}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		head, err := repo.PullRequestHead(context.Background(), 42)
+		require.NoError(t, err)
+		require.Equal(t, "release/v1.2.0", head)
+	})
+}
+
+func TestGithubRepository_PullRequestBody(t *testing.T) {
+	t.Run("Should return the body of the pull request", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/pulls/42", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"number":42,"body":"## Changelog\n\n- feat: add x"}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		body, err := repo.PullRequestBody(context.Background(), 42)
+		require.NoError(t, err)
+		require.Equal(t, "## Changelog\n\n- feat: add x", body)
+	})
+}
+
+func TestGithubRepository_CreateOrUpdatePR(t *testing.T) {
+	t.Run("Should replace labels of the same scope on an existing PR", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/pulls", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"number":42,"html_url":"https://github.com/compozy/releasepr/pull/42",` +
+				`"head":{"ref":"release/v1.2.0"},"labels":[{"name":"release:patch"},{"name":"needs-review"}]}]`))
+		})
+		mux.HandleFunc("PATCH /repos/compozy/releasepr/pulls/42", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"number":42,"html_url":"https://github.com/compozy/releasepr/pull/42"}`))
+		})
+		var added []string
+		mux.HandleFunc("POST /repos/compozy/releasepr/issues/42/labels", func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&added))
+			_, _ = w.Write([]byte(`[]`))
+		})
+		var removed []string
+		mux.HandleFunc("DELETE /repos/compozy/releasepr/issues/42/labels/{label}",
+			func(w http.ResponseWriter, r *http.Request) {
+				removed = append(removed, r.PathValue("label"))
+				_, _ = w.Write([]byte(`[]`))
+			})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		pr, err := repo.CreateOrUpdatePR(context.Background(), "release/v1.2.0", "main", "release: Release v1.2.0",
+			"body", []string{"release-pending", "release:minor"})
+		require.NoError(t, err)
+		require.Equal(t, domain.PullRequestRef{Number: 42, URL: "https://github.com/compozy/releasepr/pull/42"}, pr)
+		require.Equal(t, []string{"release-pending", "release:minor"}, added)
+		require.Equal(t, []string{"release:patch"}, removed)
+	})
+}
+
+func TestGithubRepository_EnsureLabels(t *testing.T) {
+	t.Run("Should create only the labels the repository lacks", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/labels", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"name":"Automated"}]`))
+		})
+		var created []string
+		mux.HandleFunc("POST /repos/compozy/releasepr/labels", func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			created = append(created, string(body))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write(body)
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		err := repo.EnsureLabels(context.Background(), []domain.Label{
+			{Name: "automated", Color: "c5def5"},
+			{Name: "release:minor", Color: "0e8a16", Description: "Release with new features"},
+		})
+		require.NoError(t, err)
+		require.Len(t, created, 1)
+		require.JSONEq(t, `{"name":"release:minor","color":"0e8a16","description":"Release with new features"}`,
+			created[0])
+	})
+	t.Run("Should treat labels created meanwhile as existing", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/labels", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[]`))
+		})
+		mux.HandleFunc("POST /repos/compozy/releasepr/labels", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"message":"Validation Failed",` +
+				`"errors":[{"resource":"Label","code":"already_exists"}]}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		err := repo.EnsureLabels(context.Background(), []domain.Label{{Name: "automated", Color: "c5def5"}})
+		require.NoError(t, err)
+	})
+	t.Run("Should report other failures", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/labels", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"Resource not accessible by integration"}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		err := repo.EnsureLabels(context.Background(), []domain.Label{{Name: "automated", Color: "c5def5"}})
+		require.ErrorContains(t, err, "list labels")
+	})
+}
+
+func TestGithubRepository_CreateCheckRun(t *testing.T) {
+	t.Run("Should create a completed check run with its annotations", func(t *testing.T) {
+		mux := http.NewServeMux()
+		var payload github.CreateCheckRunOptions
+		mux.HandleFunc("POST /repos/compozy/releasepr/check-runs", func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":1}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		annotations := make([]domain.CheckAnnotation, domain.MaxCheckAnnotations+1)
+		for i := range annotations {
+			annotations[i] = domain.CheckAnnotation{
+				Path: "cliff.toml", StartLine: 1, EndLine: 1, Level: domain.AnnotationLevelFailure, Message: "boom",
+			}
+		}
+		err := repo.CreateCheckRun(context.Background(), domain.CheckRun{
+			Name:        "Release Dry-Run",
+			HeadSHA:     "abc123",
+			Conclusion:  domain.CheckConclusionFailure,
+			Title:       "1 validation failed",
+			Summary:     "summary",
+			Text:        strings.Repeat("x", maxCheckOutputLength+1),
+			Annotations: annotations,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "Release Dry-Run", payload.Name)
+		require.Equal(t, "abc123", payload.HeadSHA)
+		require.Equal(t, "completed", payload.GetStatus())
+		require.Equal(t, domain.CheckConclusionFailure, payload.GetConclusion())
+		require.NotNil(t, payload.CompletedAt)
+		require.Len(t, payload.Output.Annotations, domain.MaxCheckAnnotations)
+		require.Equal(t, "cliff.toml", payload.Output.Annotations[0].GetPath())
+		require.LessOrEqual(t, len(payload.Output.GetText()), maxCheckOutputLength)
+		require.True(t, strings.HasSuffix(payload.Output.GetText(), checkOutputTruncated))
+	})
+	t.Run("Should report API failures", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /repos/compozy/releasepr/check-runs", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"Resource not accessible by integration"}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		err := repo.CreateCheckRun(context.Background(), domain.CheckRun{Name: "Release Dry-Run", HeadSHA: "abc123"})
+		require.ErrorContains(t, err, `create check run "Release Dry-Run"`)
+	})
+}
+
+func TestGithubRepository_TokenPermissions(t *testing.T) {
+	deny := func(status int, message string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"message":"` + message + `"}`))
+		}
+	}
+	t.Run("Should build the permission matrix from the probe responses", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /repos/compozy/releasepr/git/refs", deny(http.StatusUnprocessableEntity, "Invalid"))
+		mux.HandleFunc("POST /repos/compozy/releasepr/pulls", deny(http.StatusUnprocessableEntity, "Invalid"))
+		mux.HandleFunc("POST /repos/compozy/releasepr/issues", deny(http.StatusGone, "Issues are disabled"))
+		mux.HandleFunc(
+			"PUT /repos/compozy/releasepr/contents/"+workflowProbePath,
+			deny(http.StatusForbidden, "Resource not accessible by integration"),
+		)
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		checks, err := repo.TokenPermissions(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []domain.PermissionCheck{
+			{Permission: domain.PermissionContents, Access: domain.PermissionAccessWrite},
+			{Permission: domain.PermissionPullRequests, Access: domain.PermissionAccessWrite},
+			{
+				Permission: domain.PermissionIssues,
+				Access:     domain.PermissionAccessUnknown,
+				Detail:     "GitHub API returned 410: Issues are disabled",
+			},
+			{
+				Permission: domain.PermissionWorkflows,
+				Access:     domain.PermissionAccessNone,
+				Detail:     "Resource not accessible by integration",
+			},
+		}, checks)
+	})
+	t.Run("Should fail on bad credentials", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/", deny(http.StatusUnauthorized, "Bad credentials"))
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "releasepr"}
+		_, err := repo.TokenPermissions(context.Background())
+		require.ErrorContains(t, err, "probe contents permission: GitHub API returned 401: Bad credentials")
+	})
+}
+
+func TestGithubRepository_CommitFile(t *testing.T) {
+	notFound := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+	}
+	t.Run("Should create the branch from the base and add the file", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/site/git/ref/heads/blog/v1.2.0", notFound)
+		mux.HandleFunc("GET /repos/compozy/site/git/ref/heads/main", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"ref":"refs/heads/main","object":{"sha":"abc123"}}`))
+		})
+		var created map[string]string
+		mux.HandleFunc("POST /repos/compozy/site/git/refs", func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			_, _ = w.Write([]byte(`{"ref":"refs/heads/blog/v1.2.0"}`))
+		})
+		mux.HandleFunc("GET /repos/compozy/site/contents/blog/v1.2.0.md", notFound)
+		var file github.RepositoryContentFileOptions
+		mux.HandleFunc("PUT /repos/compozy/site/contents/blog/v1.2.0.md", func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&file))
+			_, _ = w.Write([]byte(`{}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "site"}
+		err := repo.CommitFile(context.Background(), "blog/v1.2.0", "main", "blog/v1.2.0.md", "# Post", "Add post")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"ref": "refs/heads/blog/v1.2.0", "sha": "abc123"}, created)
+		require.Equal(t, "# Post", string(file.Content))
+		require.Equal(t, "blog/v1.2.0", file.GetBranch())
+		require.Empty(t, file.GetSHA())
+	})
+
+	t.Run("Should update the file on an existing branch", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/site/git/ref/heads/blog/v1.2.0", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"ref":"refs/heads/blog/v1.2.0","object":{"sha":"def456"}}`))
+		})
+		mux.HandleFunc("GET /repos/compozy/site/contents/blog/v1.2.0.md", func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "blog/v1.2.0", r.URL.Query().Get("ref"))
+			_, _ = w.Write([]byte(`{"type":"file","sha":"old-sha","content":""}`))
+		})
+		var file github.RepositoryContentFileOptions
+		mux.HandleFunc("PUT /repos/compozy/site/contents/blog/v1.2.0.md", func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&file))
+			_, _ = w.Write([]byte(`{}`))
+		})
+		repo := &githubRepository{client: newTestGithubClient(t, mux), owner: "compozy", repo: "site"}
+		err := repo.CommitFile(context.Background(), "blog/v1.2.0", "main", "blog/v1.2.0.md", "# Post", "Update post")
+		require.NoError(t, err)
+		require.Equal(t, "old-sha", file.GetSHA())
+		require.Equal(t, "Update post", file.GetMessage())
+	})
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
 )
 
 var ErrGithubTokenRequired = errors.New("github token is required for GitHub operations")
@@ -29,8 +32,8 @@ func (r *githubNoopRepository) CreateOrUpdatePR(
 	_ context.Context,
 	_, _, _, _ string,
 	_ []string,
-) error {
-	return r.operationError("create or update pull request")
+) (domain.PullRequestRef, error) {
+	return domain.PullRequestRef{}, r.operationError("create or update pull request")
 }
 
 func (r *githubNoopRepository) AddComment(_ context.Context, _ int, _ string) error {
@@ -45,6 +48,94 @@ func (r *githubNoopRepository) GetPRStatus(_ context.Context, _ int) (string, er
 	return "", r.operationError("query pull request status")
 }
 
+func (r *githubNoopRepository) UploadReleaseAsset(_ context.Context, _, _ string) error {
+	return r.operationError("upload release asset")
+}
+
+func (r *githubNoopRepository) AppendReleaseNotes(_ context.Context, _, _ string) error {
+	return r.operationError("update release notes")
+}
+
+func (r *githubNoopRepository) MarkSecurityRelease(_ context.Context, _ string) error {
+	return r.operationError("mark security release")
+}
+
+func (r *githubNoopRepository) RequestReviewers(_ context.Context, _, _ string, _ domain.Reviewers) error {
+	return r.operationError("request reviewers")
+}
+
+func (r *githubNoopRepository) FindOpenPR(_ context.Context, _, _ string) (int, error) {
+	return 0, r.operationError("find pull request")
+}
+
+func (r *githubNoopRepository) PullRequestHead(_ context.Context, _ int) (string, error) {
+	return "", r.operationError("query pull request")
+}
+
+func (r *githubNoopRepository) PullRequestBody(_ context.Context, _ int) (string, error) {
+	return "", r.operationError("query pull request")
+}
+
+func (r *githubNoopRepository) ReleaseContributors(_ context.Context, _, _ string) ([]string, error) {
+	return nil, r.operationError("list release contributors")
+}
+
+func (r *githubNoopRepository) MergedPullRequests(_ context.Context, _, _ string) ([]domain.PullRequest, error) {
+	return nil, r.operationError("list merged pull requests")
+}
+
+func (r *githubNoopRepository) CreateOrUpdateIssue(_ context.Context, _, _ string, _ []string) (int, error) {
+	return 0, r.operationError("create or update issue")
+}
+
+func (r *githubNoopRepository) FindMilestone(_ context.Context, _ string) (int, error) {
+	return 0, r.operationError("find milestone")
+}
+
+func (r *githubNoopRepository) EnsureLabels(_ context.Context, _ []domain.Label) error {
+	return r.operationError("create labels")
+}
+
+func (r *githubNoopRepository) CreateCheckRun(_ context.Context, _ domain.CheckRun) error {
+	return r.operationError("create check run")
+}
+
+func (r *githubNoopRepository) TokenPermissions(_ context.Context) ([]domain.PermissionCheck, error) {
+	return nil, r.operationError("probe token permissions")
+}
+
+func (r *githubNoopRepository) ForRepository(owner, repo string) GithubExtendedRepository {
+	return &githubNoopRepository{owner: owner, repo: repo}
+}
+
+func (r *githubNoopRepository) FileContent(_ context.Context, _ string) (string, bool, error) {
+	return "", false, r.operationError("read file")
+}
+
+func (r *githubNoopRepository) CommitFile(_ context.Context, _, _, _, _, _ string) error {
+	return r.operationError("commit file")
+}
+
+func (r *githubNoopRepository) PublishRelease(_ context.Context, _ string) error {
+	return r.operationError("publish release")
+}
+
+func (r *githubNoopRepository) ReleasePublishedAt(_ context.Context, _ string) (time.Time, bool, error) {
+	return time.Time{}, false, r.operationError("read release")
+}
+
+func (r *githubNoopRepository) ReleaseNotes(_ context.Context, _ string) (string, time.Time, error) {
+	return "", time.Time{}, r.operationError("read release")
+}
+
+func (r *githubNoopRepository) SearchCode(_ context.Context, _ string) ([]string, error) {
+	return nil, r.operationError("search code")
+}
+
+func (r *githubNoopRepository) ReviewStatus(_ context.Context, _ string) (domain.ReviewStatus, error) {
+	return domain.ReviewStatus{}, r.operationError("read review status")
+}
+
 func (r *githubNoopRepository) operationError(action string) error {
 	return fmt.Errorf("%w: unable to %s for %s/%s", ErrGithubTokenRequired, action, r.owner, r.repo)
 }
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+const (
+	httpCacheDirPermissions  = 0o700
+	httpCacheFilePermissions = 0o600
+	// httpCacheStatusHeader tells callers whether a response was served from the cache.
+	httpCacheStatusHeader = "X-Releasepr-Cache"
+	httpCacheHit          = "hit"
+)
+
+// githubCacheDir holds cached GitHub GET responses; ConfigureGitHubCache sets it.
+var githubCacheDir string
+
+// ConfigureGitHubCache enables the ETag-aware cache for GitHub API reads. Cached responses are
+// revalidated with If-None-Match, and GitHub does not count 304 answers against the rate limit,
+// so frequently scheduled runs mostly spend no quota on unchanged PR lists, statuses and branches.
+// An empty dir disables caching. It must run before any repository is created.
+func ConfigureGitHubCache(dir string) error {
+	if dir == "" {
+		githubCacheDir = ""
+		return nil
+	}
+	if err := os.MkdirAll(dir, httpCacheDirPermissions); err != nil {
+		return fmt.Errorf("failed to create GitHub cache directory: %w", err)
+	}
+	githubCacheDir = dir
+	return nil
+}
+
+// githubTransport returns the transport GitHub API clients use.
+func githubTransport() http.RoundTripper {
+	if githubCacheDir == "" {
+		return outboundTransport
+	}
+	return &cachingTransport{base: outboundTransport, dir: githubCacheDir}
+}
+
+// cachedResponse is the on-disk form of a cached response.
+type cachedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+// cachingTransport revalidates GET requests against responses cached by ETag. Entries are keyed by
+// URL, Accept header and credentials, so tokens never read each other's responses.
+type cachingTransport struct {
+	base http.RoundTripper
+	dir  string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return t.base.RoundTrip(req)
+	}
+	path := t.entryPath(req)
+	entry := t.load(path)
+	outgoing := req
+	if entry != nil {
+		outgoing = req.Clone(req.Context())
+		outgoing.Header.Set("If-None-Match", entry.Header.Get("ETag"))
+	}
+	resp, err := t.base.RoundTrip(outgoing)
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil && resp.StatusCode == http.StatusNotModified {
+		return entry.response(req, resp), nil
+	}
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("ETag") == "" {
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	t.store(path, &cachedResponse{StatusCode: resp.StatusCode, Header: resp.Header.Clone(), Body: body})
+	return resp, nil
+}
+
+// entryPath returns the cache file of a request.
+func (t *cachingTransport) entryPath(req *http.Request) string {
+	hash := sha256.New()
+	for _, part := range []string{req.URL.String(), req.Header.Get("Accept"), req.Header.Get("Authorization")} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return filepath.Join(t.dir, hex.EncodeToString(hash.Sum(nil))+".json")
+}
+
+// load returns the cached entry at path, or nil when it is missing or unreadable.
+func (t *cachingTransport) load(path string) *cachedResponse {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var entry cachedResponse
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Header.Get("ETag") == "" {
+		return nil
+	}
+	return &entry
+}
+
+// store writes an entry atomically. Failures only cost a future cache miss, so they are ignored.
+func (t *cachingTransport) store(path string, entry *cachedResponse) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(t.dir, ".entry-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err := tmp.Chmod(httpCacheFilePermissions); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	_ = os.Rename(tmp.Name(), path)
+}
+
+// response rebuilds the cached response for req. Headers of the 304, such as the current rate
+// limit, replace the cached ones.
+func (e *cachedResponse) response(req *http.Request, notModified *http.Response) *http.Response {
+	_ = notModified.Body.Close()
+	header := e.Header.Clone()
+	for key, values := range notModified.Header {
+		header[key] = values
+	}
+	header.Set(httpCacheStatusHeader, httpCacheHit)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         notModified.Proto,
+		ProtoMajor:    notModified.ProtoMajor,
+		ProtoMinor:    notModified.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// etagServer serves a fixed body with an ETag and answers matching If-None-Match with 304.
+func etagServer(t *testing.T, notModified *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.Header().Set("X-RateLimit-Remaining", "4998")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"number":1}]`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func cachedGet(t *testing.T, client *http.Client, url, token string) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+func TestCachingTransport(t *testing.T) {
+	t.Run("Should serve revalidated responses from the cache", func(t *testing.T) {
+		var notModified atomic.Int32
+		server := etagServer(t, &notModified)
+		client := &http.Client{Transport: &cachingTransport{base: http.DefaultTransport, dir: t.TempDir()}}
+		first, body := cachedGet(t, client, server.URL+"/pulls", "token-a")
+		assert.Equal(t, `[{"number":1}]`, body)
+		assert.Empty(t, first.Header.Get(httpCacheStatusHeader))
+		second, body := cachedGet(t, client, server.URL+"/pulls", "token-a")
+		assert.Equal(t, http.StatusOK, second.StatusCode)
+		assert.Equal(t, `[{"number":1}]`, body)
+		assert.Equal(t, httpCacheHit, second.Header.Get(httpCacheStatusHeader))
+		assert.Equal(t, "4998", second.Header.Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "application/json", second.Header.Get("Content-Type"))
+		assert.Equal(t, int32(1), notModified.Load())
+	})
+	t.Run("Should keep entries of different tokens apart", func(t *testing.T) {
+		var notModified atomic.Int32
+		server := etagServer(t, &notModified)
+		client := &http.Client{Transport: &cachingTransport{base: http.DefaultTransport, dir: t.TempDir()}}
+		cachedGet(t, client, server.URL+"/pulls", "token-a")
+		resp, _ := cachedGet(t, client, server.URL+"/pulls", "token-b")
+		assert.Empty(t, resp.Header.Get(httpCacheStatusHeader))
+		assert.Equal(t, int32(0), notModified.Load())
+	})
+	t.Run("Should not cache other methods", func(t *testing.T) {
+		var notModified atomic.Int32
+		server := etagServer(t, &notModified)
+		dir := t.TempDir()
+		client := &http.Client{Transport: &cachingTransport{base: http.DefaultTransport, dir: dir}}
+		resp, err := client.Post(server.URL+"/pulls", "application/json", nil)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+	t.Run("Should ignore corrupt entries", func(t *testing.T) {
+		var notModified atomic.Int32
+		server := etagServer(t, &notModified)
+		dir := t.TempDir()
+		transport := &cachingTransport{base: http.DefaultTransport, dir: dir}
+		client := &http.Client{Transport: transport}
+		cachedGet(t, client, server.URL+"/pulls", "token-a")
+		entries, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.NoError(t, os.WriteFile(entries[0], []byte("{"), 0o600))
+		_, body := cachedGet(t, client, server.URL+"/pulls", "token-a")
+		assert.Equal(t, `[{"number":1}]`, body)
+		assert.Equal(t, int32(0), notModified.Load())
+	})
+}
+
+func TestConfigureGitHubCache(t *testing.T) {
+	t.Run("Should wrap the GitHub transport only when a directory is set", func(t *testing.T) {
+		t.Cleanup(func() { githubCacheDir = "" })
+		require.NoError(t, ConfigureGitHubCache(""))
+		assert.Equal(t, outboundTransport, githubTransport())
+		dir := filepath.Join(t.TempDir(), "cache")
+		require.NoError(t, ConfigureGitHubCache(dir))
+		assert.DirExists(t, dir)
+		assert.IsType(t, &cachingTransport{}, githubTransport())
+	})
+}
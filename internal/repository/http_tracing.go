@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/compozy/releasepr/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingTransport opens an OpenTelemetry span around every GitHub API request, recording whether
+// the response came from the cache.
+type tracingTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := telemetry.Start(req.Context(), "GitHub "+req.Method,
+		attribute.String("http.request.method", req.Method),
+		attribute.String("url.path", req.URL.Path),
+	)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		telemetry.End(span, err)
+		return nil, err
+	}
+	recordResponse(span, resp)
+	return resp, nil
+}
+
+func recordResponse(span trace.Span, resp *http.Response) {
+	span.SetAttributes(
+		attribute.Int("http.response.status_code", resp.StatusCode),
+		attribute.Bool("http.cache_hit", resp.Header.Get(httpCacheStatusHeader) == httpCacheHit),
+	)
+	var err error
+	if resp.StatusCode >= http.StatusBadRequest {
+		err = fmt.Errorf("GitHub API returned %s", resp.Status)
+	}
+	telemetry.End(span, err)
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func TestTracingTransport(t *testing.T) {
+	t.Run("Should open a span per GitHub request with its status", func(t *testing.T) {
+		recorder := recordSpans(t)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/missing" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+		client := &http.Client{Transport: &tracingTransport{base: http.DefaultTransport}}
+		for _, path := range []string{"/repos/a/b/pulls", "/missing"} {
+			resp, err := client.Get(server.URL + path)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+		}
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		assert.Equal(t, "GitHub GET", spans[0].Name())
+		assert.Contains(t, spans[0].Attributes(), attribute.String("url.path", "/repos/a/b/pulls"))
+		assert.Contains(t, spans[0].Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+		assert.Equal(t, codes.Error, spans[1].Status().Code)
+	})
+}
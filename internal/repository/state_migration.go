@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/compozy/releasepr/internal/domain"
+)
+
+// stateMigration upgrades the JSON of a state saved with schema version from to schema version to.
+type stateMigration struct {
+	from    string
+	to      string
+	migrate func(state map[string]any) error
+}
+
+// stateMigrations chains every supported schema version up to StateSchemaVersion, so sessions
+// recorded by an older binary can still be resumed and rolled back after an upgrade. Changing the
+// state layout appends a step here and bumps StateSchemaVersion to its target.
+var stateMigrations = []stateMigration{
+	{from: "1.0.0", to: "1.1.0", migrate: addStateBaseBranch},
+}
+
+// addStateBaseBranch records main as the base of sessions saved before release channels, when every
+// release PR targeted main.
+func addStateBaseBranch(state map[string]any) error {
+	if _, ok := state["base_branch"]; !ok {
+		state["base_branch"] = "main"
+	}
+	return nil
+}
+
+// decodeState migrates data, the JSON of a state saved with schemaVersion, to StateSchemaVersion
+// and decodes it.
+func decodeState(schemaVersion string, data []byte) (*domain.RollbackState, error) {
+	migrated, err := migrateState(schemaVersion, data)
+	if err != nil {
+		return nil, err
+	}
+	var state domain.RollbackState
+	if err := json.Unmarshal(migrated, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	return &state, nil
+}
+
+// migrateState applies the migrations from schemaVersion to StateSchemaVersion in order. States of
+// a newer schema, written by a newer binary, are rejected rather than downgraded.
+func migrateState(schemaVersion string, data []byte) ([]byte, error) {
+	if schemaVersion == StateSchemaVersion {
+		return data, nil
+	}
+	if err := checkStateSchemaVersion(schemaVersion); err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var state map[string]any
+	if err := decoder.Decode(&state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal state for migration: %w", err)
+	}
+	for version := schemaVersion; version != StateSchemaVersion; {
+		step, ok := findStateMigration(version)
+		if !ok {
+			return nil, fmt.Errorf("incompatible schema version: no migration from %s to %s",
+				version, StateSchemaVersion)
+		}
+		if err := step.migrate(state); err != nil {
+			return nil, fmt.Errorf("failed to migrate state from schema %s to %s: %w", step.from, step.to, err)
+		}
+		version = step.to
+	}
+	migrated, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal migrated state: %w", err)
+	}
+	return migrated, nil
+}
+
+func checkStateSchemaVersion(schemaVersion string) error {
+	version, err := semver.StrictNewVersion(schemaVersion)
+	if err != nil {
+		return fmt.Errorf("incompatible schema version: invalid version %q", schemaVersion)
+	}
+	if version.GreaterThan(semver.MustParse(StateSchemaVersion)) {
+		return fmt.Errorf("incompatible schema version: %s is newer than %s, upgrade releasepr to load it",
+			schemaVersion, StateSchemaVersion)
+	}
+	return nil
+}
+
+func findStateMigration(from string) (stateMigration, bool) {
+	for _, step := range stateMigrations {
+		if step.from == from {
+			return step, true
+		}
+	}
+	return stateMigration{}, false
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyState is a session as the 1.0.0 schema recorded it, before base_branch existed.
+const legacyState = `{"session_id":"abc","started_at":"2026-10-01T10:00:00Z","updated_at":"2026-10-01T10:05:00Z",` +
+	`"version":"v1.2.0","branch_name":"release/v1.2.0","original_branch":"main","operations":[{"id":"create_pr_1",` +
+	`"type":"create_pr","status":"completed","started_at":"2026-10-01T10:04:00Z","rollback_data":{"pr_number":42}}],` +
+	`"status":"failed"}`
+
+func TestMigrateState(t *testing.T) {
+	t.Run("Should migrate 1.0.0 states to the current schema", func(t *testing.T) {
+		state, err := decodeState("1.0.0", []byte(legacyState))
+		require.NoError(t, err)
+		assert.Equal(t, "main", state.BaseBranch)
+		assert.Equal(t, "release/v1.2.0", state.BranchName)
+		require.Len(t, state.Operations, 1)
+		assert.InDelta(t, 42, state.Operations[0].RollbackData["pr_number"], 0)
+	})
+	t.Run("Should leave states of the current schema alone", func(t *testing.T) {
+		data := []byte(`{"session_id":"abc","base_branch":"next"}`)
+		migrated, err := migrateState(StateSchemaVersion, data)
+		require.NoError(t, err)
+		assert.Equal(t, data, migrated)
+	})
+	t.Run("Should reject states of newer or unknown schemas", func(t *testing.T) {
+		_, err := migrateState("9.0.0", []byte(legacyState))
+		require.ErrorContains(t, err, "9.0.0 is newer than "+StateSchemaVersion)
+		_, err = migrateState("0.9.0", []byte(legacyState))
+		require.ErrorContains(t, err, "no migration from 0.9.0")
+		_, err = migrateState("", []byte(legacyState))
+		require.ErrorContains(t, err, "invalid version")
+	})
+}
+
+func TestJSONStateRepository_LoadLegacyState(t *testing.T) {
+	t.Run("Should load and resave a session recorded with schema 1.0.0", func(t *testing.T) {
+		dir := t.TempDir()
+		fs := afero.NewOsFs()
+		wrapper := map[string]any{
+			"metadata": map[string]any{"schema_version": "1.0.0", "checksum": stateChecksum([]byte(legacyState))},
+			"state":    json.RawMessage(legacyState),
+		}
+		data, err := json.MarshalIndent(wrapper, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "state-abc.json"), data, StateFilePermissions))
+		repo := NewJSONStateRepository(fs, dir)
+		state, err := repo.Load(t.Context(), "abc")
+		require.NoError(t, err)
+		assert.Equal(t, "main", state.BaseBranch)
+		assert.Equal(t, domain.WorkflowStatusFailed, state.Status)
+		require.NoError(t, repo.Save(t.Context(), state))
+		reloaded, err := repo.Load(t.Context(), "abc")
+		require.NoError(t, err)
+		assert.Equal(t, "main", reloaded.BaseBranch)
+	})
+	t.Run("Should reject a legacy session whose state was altered", func(t *testing.T) {
+		dir := t.TempDir()
+		fs := afero.NewOsFs()
+		wrapper := map[string]any{
+			"metadata": map[string]any{"schema_version": "1.0.0", "checksum": stateChecksum([]byte("{}"))},
+			"state":    json.RawMessage(legacyState),
+		}
+		data, err := json.Marshal(wrapper)
+		require.NoError(t, err)
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "state-abc.json"), data, StateFilePermissions))
+		_, err = NewJSONStateRepository(fs, dir).Load(t.Context(), "abc")
+		require.ErrorContains(t, err, "state checksum mismatch")
+	})
+}
+
+func TestSQLiteStateRepository_MigrateDatabase(t *testing.T) {
+	t.Run("Should migrate a version 1 database and its 1.0.0 sessions", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "state.db")
+		db, err := sql.Open("sqlite", "file:"+filepath.ToSlash(path))
+		require.NoError(t, err)
+		_, err = db.ExecContext(t.Context(), sqliteMigrations[0])
+		require.NoError(t, err)
+		_, err = db.ExecContext(t.Context(), "PRAGMA user_version = 1")
+		require.NoError(t, err)
+		now := time.Now().UnixNano()
+		_, err = db.ExecContext(t.Context(), `INSERT INTO release_states
+(session_id, status, version, started_at, updated_at, saved_at, checksum, state) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			"abc", "failed", "v1.2.0", now, now, now, stateChecksum([]byte(legacyState)), legacyState)
+		require.NoError(t, err)
+		require.NoError(t, db.Close())
+		repo, err := NewSQLiteStateRepository(path)
+		require.NoError(t, err)
+		defer repo.Close()
+		state, err := repo.Load(t.Context(), "abc")
+		require.NoError(t, err)
+		assert.Equal(t, "main", state.BaseBranch)
+		var version int
+		require.NoError(t, repo.db.QueryRowContext(t.Context(), "PRAGMA user_version").Scan(&version))
+		assert.Equal(t, sqliteSchemaVersion, version)
+	})
+}
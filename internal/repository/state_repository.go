@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
@@ -8,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
@@ -20,7 +22,7 @@ import (
 
 const (
 	// StateSchemaVersion defines the current schema version for state files
-	StateSchemaVersion = "1.0.0"
+	StateSchemaVersion = "1.1.0"
 	// StateFilePermissions defines the permissions for state files
 	StateFilePermissions = 0600
 	// StateDirPermissions defines the permissions for state directory
@@ -38,6 +40,27 @@ type StateRepository interface {
 	LoadLatest(ctx context.Context) (*domain.RollbackState, error)
 	Delete(ctx context.Context, sessionID string) error
 	Exists(ctx context.Context, sessionID string) (bool, error)
+	List(ctx context.Context) ([]*domain.RollbackState, error)
+	ListByStatus(ctx context.Context, status domain.WorkflowStatus) ([]*domain.RollbackState, error)
+	Prune(ctx context.Context, cutoff time.Time) (int, error)
+}
+
+// State backends selectable with the state_backend setting.
+const (
+	StateBackendJSON   = "json"
+	StateBackendSQLite = "sqlite"
+)
+
+// NewStateRepositoryForBackend creates the StateRepository of the configured backend. JSON states
+// live in stateDir; the SQLite database lives at dbPath.
+func NewStateRepositoryForBackend(backend string, fs afero.Fs, stateDir, dbPath string) (StateRepository, error) {
+	switch backend {
+	case "", StateBackendJSON:
+		return NewJSONStateRepository(fs, stateDir), nil
+	case StateBackendSQLite:
+		return NewSQLiteStateRepository(dbPath)
+	}
+	return nil, fmt.Errorf("unsupported state backend: %s", backend)
 }
 
 // StateMetadata contains metadata about the state file
@@ -169,26 +192,23 @@ func (r *JSONStateRepository) Load(ctx context.Context, sessionID string) (*doma
 		}
 		return nil, fmt.Errorf("failed to read state file: %w", err)
 	}
-	// Unmarshal and validate
-	var wrapper StateWrapper
+	// Unmarshal, keeping the state as saved so older schema versions can be migrated
+	var wrapper struct {
+		Metadata StateMetadata   `json:"metadata"`
+		State    json.RawMessage `json:"state"`
+	}
 	if err := json.Unmarshal(data, &wrapper); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal state wrapper: %w", err)
 	}
-	// Validate schema version
-	if wrapper.Metadata.SchemaVersion != StateSchemaVersion {
-		return nil, fmt.Errorf("incompatible schema version: expected %s, got %s",
-			StateSchemaVersion, wrapper.Metadata.SchemaVersion)
-	}
-	// Validate checksum
-	stateData, err := json.Marshal(wrapper.State)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal state for checksum validation: %w", err)
+	// Validate checksum against the state as saved, before any migration
+	var stateData bytes.Buffer
+	if err := json.Compact(&stateData, wrapper.State); err != nil {
+		return nil, fmt.Errorf("failed to read state for checksum validation: %w", err)
 	}
-	expectedChecksum := r.calculateChecksum(stateData)
-	if wrapper.Metadata.Checksum != expectedChecksum {
+	if wrapper.Metadata.Checksum != r.calculateChecksum(stateData.Bytes()) {
 		return nil, fmt.Errorf("state checksum mismatch: data may be corrupted")
 	}
-	return wrapper.State, nil
+	return decodeState(wrapper.Metadata.SchemaVersion, stateData.Bytes())
 }
 
 // LoadLatest retrieves the most recent rollback state with validation
@@ -259,6 +279,75 @@ func (r *JSONStateRepository) Exists(_ context.Context, sessionID string) (bool,
 	return true, nil
 }
 
+// List returns all readable rollback states, most recently started first
+func (r *JSONStateRepository) List(ctx context.Context) ([]*domain.RollbackState, error) {
+	log := r.logger(ctx)
+	entries, err := afero.ReadDir(r.fs, r.stateDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*domain.RollbackState{}, nil
+		}
+		return nil, fmt.Errorf("failed to read state directory: %w", err)
+	}
+	states := make([]*domain.RollbackState, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		sessionID := r.extractSessionID(entry.Name())
+		if sessionID == "" {
+			continue
+		}
+		state, err := r.Load(ctx, sessionID)
+		if err != nil {
+			log.Warn("Skipping unreadable state file", zap.String("file", entry.Name()), zap.Error(err))
+			continue
+		}
+		states = append(states, state)
+	}
+	sort.SliceStable(states, func(i, j int) bool {
+		return states[i].StartedAt.After(states[j].StartedAt)
+	})
+	return states, nil
+}
+
+// ListByStatus returns the readable rollback states with the given status, most recently started first
+func (r *JSONStateRepository) ListByStatus(
+	ctx context.Context,
+	status domain.WorkflowStatus,
+) ([]*domain.RollbackState, error) {
+	states, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matching := make([]*domain.RollbackState, 0, len(states))
+	for _, state := range states {
+		if state.Status == status {
+			matching = append(matching, state)
+		}
+	}
+	return matching, nil
+}
+
+// Prune deletes the readable rollback states last updated before cutoff and returns how many it removed
+func (r *JSONStateRepository) Prune(ctx context.Context, cutoff time.Time) (int, error) {
+	states, err := r.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, state := range states {
+		if !state.UpdatedAt.Before(cutoff) {
+			continue
+		}
+		if err := r.Delete(ctx, state.SessionID); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
 // acquireLockWithContext attempts to acquire an exclusive lock with context support
 func (r *JSONStateRepository) acquireLockWithContext(ctx context.Context, lock *flock.Flock) (bool, error) {
 	ticker := time.NewTicker(LockRetryInterval)
@@ -301,6 +390,11 @@ func (r *JSONStateRepository) acquireSharedLockWithContext(ctx context.Context,
 
 // calculateChecksum calculates SHA-256 checksum of data
 func (r *JSONStateRepository) calculateChecksum(data []byte) string {
+	return stateChecksum(data)
+}
+
+// stateChecksum returns the hex SHA-256 checksum of serialized state
+func stateChecksum(data []byte) string {
 	hash := sha256.Sum256(data)
 	return hex.EncodeToString(hash[:])
 }
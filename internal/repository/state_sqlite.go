@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	_ "modernc.org/sqlite" // registers the pure Go sqlite driver
+)
+
+// sqliteSchemaVersion is stored in PRAGMA user_version: the number of sqliteMigrations applied.
+var sqliteSchemaVersion = len(sqliteMigrations)
+
+// sqliteBusyTimeout is how long a connection waits for another process to release the database.
+const sqliteBusyTimeout = LockTimeout
+
+// sqliteMigrations are the schema changes of the database in order; migration N moves a database
+// from user_version N-1 to N.
+var sqliteMigrations = []string{
+	`
+CREATE TABLE IF NOT EXISTS release_states (
+	session_id TEXT PRIMARY KEY,
+	status     TEXT NOT NULL,
+	version    TEXT NOT NULL,
+	started_at INTEGER NOT NULL,
+	updated_at INTEGER NOT NULL,
+	saved_at   INTEGER NOT NULL,
+	checksum   TEXT NOT NULL,
+	state      TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS release_states_status ON release_states (status, started_at);
+CREATE INDEX IF NOT EXISTS release_states_saved_at ON release_states (saved_at);
+`,
+	// Rows saved before the column existed hold schema 1.0.0 states.
+	`ALTER TABLE release_states ADD COLUMN schema_version TEXT NOT NULL DEFAULT '1.0.0'`,
+}
+
+// SQLiteStateRepository implements StateRepository on a SQLite database. SQLite serializes writers
+// across processes, so runners keeping a persistent workspace can share one database between many
+// concurrent releases without the per-file locks of JSONStateRepository.
+type SQLiteStateRepository struct {
+	db   *sql.DB
+	path string
+}
+
+// NewSQLiteStateRepository opens the database at path, creating it and its schema when missing.
+func NewSQLiteStateRepository(path string) (*SQLiteStateRepository, error) {
+	if path == "" {
+		path = filepath.Join(".release-state", "state.db")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), StateDirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create state directory: %w", err)
+	}
+	dsn := fmt.Sprintf("file:%s?_txlock=immediate&_pragma=busy_timeout(%d)&_pragma=journal_mode(WAL)",
+		filepath.ToSlash(path), sqliteBusyTimeout.Milliseconds())
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open state database: %w", err)
+	}
+	repo := &SQLiteStateRepository{db: db, path: path}
+	if err := repo.migrate(context.Background()); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	if err := os.Chmod(path, StateFilePermissions); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to restrict state database permissions: %w", err)
+	}
+	return repo, nil
+}
+
+// Close releases the database.
+func (r *SQLiteStateRepository) Close() error {
+	return r.db.Close()
+}
+
+// migrate applies the pending sqliteMigrations in one transaction, which holds the write lock so
+// processes opening the database together do not migrate it twice.
+func (r *SQLiteStateRepository) migrate(ctx context.Context) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin state schema migration: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	var version int
+	if err := tx.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("failed to read state database version: %w", err)
+	}
+	if version > sqliteSchemaVersion {
+		return fmt.Errorf("incompatible state database %s: schema version %d is newer than %d",
+			r.path, version, sqliteSchemaVersion)
+	}
+	if version == sqliteSchemaVersion {
+		return nil
+	}
+	for _, migration := range sqliteMigrations[version:] {
+		version++
+		if _, err := tx.ExecContext(ctx, migration); err != nil {
+			return fmt.Errorf("failed to migrate state schema to version %d: %w", version, err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", version)); err != nil {
+		return fmt.Errorf("failed to record state schema version: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit state schema migration: %w", err)
+	}
+	return nil
+}
+
+// Save inserts or replaces the state of its session in a single transaction.
+func (r *SQLiteStateRepository) Save(ctx context.Context, state *domain.RollbackState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %w", err)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin state transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	_, err = tx.ExecContext(ctx, `
+INSERT INTO release_states (
+	session_id, status, version, started_at, updated_at, saved_at, checksum, state, schema_version
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (session_id) DO UPDATE SET
+	status = excluded.status,
+	version = excluded.version,
+	started_at = excluded.started_at,
+	updated_at = excluded.updated_at,
+	saved_at = excluded.saved_at,
+	checksum = excluded.checksum,
+	state = excluded.state,
+	schema_version = excluded.schema_version`,
+		state.SessionID, string(state.Status), state.Version, state.StartedAt.UnixNano(),
+		state.UpdatedAt.UnixNano(), time.Now().UnixNano(), stateChecksum(data), string(data), StateSchemaVersion)
+	if err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit state: %w", err)
+	}
+	return nil
+}
+
+// Load retrieves the state of a session, verifying its checksum.
+func (r *SQLiteStateRepository) Load(ctx context.Context, sessionID string) (*domain.RollbackState, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT schema_version, checksum, state FROM release_states WHERE session_id = ?", sessionID)
+	state, err := scanState(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("state not found for session %s", sessionID)
+	}
+	return state, err
+}
+
+// LoadLatest retrieves the most recently saved state.
+func (r *SQLiteStateRepository) LoadLatest(ctx context.Context) (*domain.RollbackState, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT schema_version, checksum, state FROM release_states ORDER BY saved_at DESC, rowid DESC LIMIT 1")
+	state, err := scanState(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("no latest state found")
+	}
+	return state, err
+}
+
+// Delete removes the state of a session. Deleting an unknown session is not an error.
+func (r *SQLiteStateRepository) Delete(ctx context.Context, sessionID string) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM release_states WHERE session_id = ?", sessionID); err != nil {
+		return fmt.Errorf("failed to delete state: %w", err)
+	}
+	return nil
+}
+
+// Exists checks if a session has a recorded state.
+func (r *SQLiteStateRepository) Exists(ctx context.Context, sessionID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM release_states WHERE session_id = ?)", sessionID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check state: %w", err)
+	}
+	return exists, nil
+}
+
+// List returns all recorded states, most recently started first.
+func (r *SQLiteStateRepository) List(ctx context.Context) ([]*domain.RollbackState, error) {
+	return r.query(ctx, "SELECT schema_version, checksum, state FROM release_states ORDER BY started_at DESC")
+}
+
+// ListByStatus returns the states with the given workflow status, most recently started first.
+func (r *SQLiteStateRepository) ListByStatus(
+	ctx context.Context,
+	status domain.WorkflowStatus,
+) ([]*domain.RollbackState, error) {
+	return r.query(ctx, "SELECT schema_version, checksum, state FROM release_states "+
+		"WHERE status = ? ORDER BY started_at DESC", string(status))
+}
+
+// Prune deletes the states last updated before cutoff and returns how many it removed.
+func (r *SQLiteStateRepository) Prune(ctx context.Context, cutoff time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM release_states WHERE updated_at < ?", cutoff.UnixNano())
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune states: %w", err)
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pruned states: %w", err)
+	}
+	return int(removed), nil
+}
+
+func (r *SQLiteStateRepository) query(ctx context.Context, query string, args ...any) ([]*domain.RollbackState, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query states: %w", err)
+	}
+	defer rows.Close()
+	states := []*domain.RollbackState{}
+	for rows.Next() {
+		state, err := scanState(rows)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read states: %w", err)
+	}
+	return states, nil
+}
+
+// scanState decodes a schema version, checksum and state row, rejecting rows whose state does not
+// match its checksum and migrating states of older schema versions.
+func scanState(row interface{ Scan(dest ...any) error }) (*domain.RollbackState, error) {
+	var schemaVersion, checksum, data string
+	if err := row.Scan(&schemaVersion, &checksum, &data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to read state: %w", err)
+	}
+	if stateChecksum([]byte(data)) != checksum {
+		return nil, fmt.Errorf("state checksum mismatch: data may be corrupted")
+	}
+	return decodeState(schemaVersion, []byte(data))
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func newTestSQLiteStateRepository(t *testing.T) *SQLiteStateRepository {
+	t.Helper()
+	repo, err := NewSQLiteStateRepository(filepath.Join(t.TempDir(), "state", "state.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, repo.Close()) })
+	return repo
+}
+
+func testRollbackState(sessionID string, status domain.WorkflowStatus, startedAt time.Time) *domain.RollbackState {
+	state := domain.NewRollbackState(sessionID)
+	state.Status = status
+	state.StartedAt = startedAt
+	state.UpdatedAt = startedAt
+	state.Version = "v1.2.0"
+	return state
+}
+
+func TestSQLiteStateRepository(t *testing.T) {
+	t.Run("Should save, update and load a session", func(t *testing.T) {
+		repo := newTestSQLiteStateRepository(t)
+		state := testRollbackState("abc", domain.WorkflowStatusRunning, time.Now())
+		state.AddOperation(domain.OperationTypeCreateBranch)
+		require.NoError(t, repo.Save(t.Context(), state))
+		state.Status = domain.WorkflowStatusCompleted
+		require.NoError(t, repo.Save(t.Context(), state))
+		loaded, err := repo.Load(t.Context(), "abc")
+		require.NoError(t, err)
+		assert.Equal(t, domain.WorkflowStatusCompleted, loaded.Status)
+		require.Len(t, loaded.Operations, 1)
+		assert.Equal(t, domain.OperationTypeCreateBranch, loaded.Operations[0].Type)
+		exists, err := repo.Exists(t.Context(), "abc")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("Should report missing sessions", func(t *testing.T) {
+		repo := newTestSQLiteStateRepository(t)
+		_, err := repo.Load(t.Context(), "missing")
+		require.ErrorContains(t, err, "state not found for session missing")
+		_, err = repo.LoadLatest(t.Context())
+		require.ErrorContains(t, err, "no latest state found")
+		exists, err := repo.Exists(t.Context(), "missing")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Should load the most recently saved session as latest", func(t *testing.T) {
+		repo := newTestSQLiteStateRepository(t)
+		now := time.Now()
+		require.NoError(t, repo.Save(t.Context(), testRollbackState("newer", domain.WorkflowStatusFailed, now)))
+		require.NoError(t, repo.Save(t.Context(),
+			testRollbackState("older", domain.WorkflowStatusFailed, now.Add(-time.Hour))))
+		latest, err := repo.LoadLatest(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "older", latest.SessionID)
+	})
+
+	t.Run("Should list sessions by status, most recently started first", func(t *testing.T) {
+		repo := newTestSQLiteStateRepository(t)
+		now := time.Now()
+		older := testRollbackState("a", domain.WorkflowStatusFailed, now.Add(-time.Hour))
+		require.NoError(t, repo.Save(t.Context(), older))
+		require.NoError(t, repo.Save(t.Context(), testRollbackState("b", domain.WorkflowStatusCompleted, now)))
+		require.NoError(t, repo.Save(t.Context(), testRollbackState("c", domain.WorkflowStatusFailed, now)))
+		all, err := repo.List(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, all, 3)
+		failed, err := repo.ListByStatus(t.Context(), domain.WorkflowStatusFailed)
+		require.NoError(t, err)
+		require.Len(t, failed, 2)
+		assert.Equal(t, "c", failed[0].SessionID)
+		assert.Equal(t, "a", failed[1].SessionID)
+	})
+
+	t.Run("Should prune sessions last updated before the cutoff", func(t *testing.T) {
+		repo := newTestSQLiteStateRepository(t)
+		now := time.Now()
+		stale := testRollbackState("stale", domain.WorkflowStatusCompleted, now.Add(-48*time.Hour))
+		require.NoError(t, repo.Save(t.Context(), stale))
+		require.NoError(t, repo.Save(t.Context(), testRollbackState("fresh", domain.WorkflowStatusCompleted, now)))
+		removed, err := repo.Prune(t.Context(), now.Add(-24*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, removed)
+		states, err := repo.List(t.Context())
+		require.NoError(t, err)
+		require.Len(t, states, 1)
+		assert.Equal(t, "fresh", states[0].SessionID)
+	})
+
+	t.Run("Should delete a session", func(t *testing.T) {
+		repo := newTestSQLiteStateRepository(t)
+		require.NoError(t, repo.Save(t.Context(), testRollbackState("abc", domain.WorkflowStatusFailed, time.Now())))
+		require.NoError(t, repo.Delete(t.Context(), "abc"))
+		require.NoError(t, repo.Delete(t.Context(), "abc"))
+		exists, err := repo.Exists(t.Context(), "abc")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Should reject a session whose state was altered", func(t *testing.T) {
+		repo := newTestSQLiteStateRepository(t)
+		require.NoError(t, repo.Save(t.Context(), testRollbackState("abc", domain.WorkflowStatusFailed, time.Now())))
+		_, err := repo.db.ExecContext(t.Context(),
+			"UPDATE release_states SET state = replace(state, 'v1.2.0', 'v9.9.9')")
+		require.NoError(t, err)
+		_, err = repo.Load(t.Context(), "abc")
+		require.ErrorContains(t, err, "state checksum mismatch")
+	})
+
+	t.Run("Should serialize concurrent writers sharing the database", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "state.db")
+		first, err := NewSQLiteStateRepository(path)
+		require.NoError(t, err)
+		defer first.Close()
+		second, err := NewSQLiteStateRepository(path)
+		require.NoError(t, err)
+		defer second.Close()
+		const sessions = 20
+		var g errgroup.Group
+		for i := range sessions {
+			repo := first
+			if i%2 == 1 {
+				repo = second
+			}
+			g.Go(func() error {
+				state := testRollbackState(fmt.Sprintf("s%d", i), domain.WorkflowStatusRunning, time.Now())
+				return repo.Save(t.Context(), state)
+			})
+		}
+		require.NoError(t, g.Wait())
+		states, err := first.List(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, states, sessions)
+	})
+
+	t.Run("Should keep sessions when the database is reopened", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "state.db")
+		repo, err := NewSQLiteStateRepository(path)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(t.Context(), testRollbackState("abc", domain.WorkflowStatusFailed, time.Now())))
+		require.NoError(t, repo.Close())
+		reopened, err := NewSQLiteStateRepository(path)
+		require.NoError(t, err)
+		defer reopened.Close()
+		exists, err := reopened.Exists(t.Context(), "abc")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/google/go-github/v74/github"
+)
+
+const (
+	// ToolReleaseOwner and ToolReleaseRepo identify the repository pr-release itself is released from.
+	ToolReleaseOwner = "compozy"
+	ToolReleaseRepo  = "releasepr"
+	// maxToolAssetBytes bounds a downloaded release asset, well above the size of a release archive.
+	maxToolAssetBytes = 256 << 20
+)
+
+// ToolReleaseRepository reads the GitHub Releases of pr-release itself, for self-update.
+type ToolReleaseRepository interface {
+	// LatestRelease returns the latest published, non-prerelease release.
+	LatestRelease(ctx context.Context) (domain.ToolRelease, error)
+	// DownloadAsset returns the content of the release asset at url.
+	DownloadAsset(ctx context.Context, url string) ([]byte, error)
+}
+
+type toolReleaseRepository struct {
+	client     *github.Client
+	httpClient *http.Client
+}
+
+// NewToolReleaseRepository creates a ToolReleaseRepository. The releases are public, so token may be
+// empty; a token raises the API rate limit.
+func NewToolReleaseRepository(token string) ToolReleaseRepository {
+	client := github.NewClient(&http.Client{Transport: &tracingTransport{base: githubTransport()}})
+	if token != "" {
+		client = newGithubClient(token)
+	}
+	return &toolReleaseRepository{client: client, httpClient: &http.Client{Transport: outboundTransport}}
+}
+
+func (r *toolReleaseRepository) LatestRelease(ctx context.Context) (domain.ToolRelease, error) {
+	release, _, err := r.client.Repositories.GetLatestRelease(ctx, ToolReleaseOwner, ToolReleaseRepo)
+	if err != nil {
+		return domain.ToolRelease{}, newGitHubAPIError("get latest pr-release release", err)
+	}
+	result := domain.ToolRelease{Tag: release.GetTagName()}
+	for _, asset := range release.Assets {
+		result.Assets = append(result.Assets, domain.ReleaseAsset{
+			Name: asset.GetName(),
+			URL:  asset.GetBrowserDownloadURL(),
+		})
+	}
+	return result, nil
+}
+
+func (r *toolReleaseRepository) DownloadAsset(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create download request: %w", err)
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: unexpected status %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxToolAssetBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	if len(data) > maxToolAssetBytes {
+		return nil, fmt.Errorf("failed to download %s: asset exceeds %d bytes", url, maxToolAssetBytes)
+	}
+	return data, nil
+}
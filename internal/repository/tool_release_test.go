@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolReleaseRepository(t *testing.T) {
+	t.Run("Should read the latest release with its assets", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/compozy/releasepr/releases/latest", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"tag_name":"v1.4.0","assets":[` +
+				`{"name":"checksums.txt","browser_download_url":"https://example.com/checksums.txt"}]}`))
+		})
+		repo := &toolReleaseRepository{client: newTestGithubClient(t, mux)}
+		release, err := repo.LatestRelease(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, domain.ToolRelease{
+			Tag:    "v1.4.0",
+			Assets: []domain.ReleaseAsset{{Name: "checksums.txt", URL: "https://example.com/checksums.txt"}},
+		}, release)
+	})
+	t.Run("Should download assets and reject failed downloads", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /asset", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("archive"))
+		})
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		repo := &toolReleaseRepository{httpClient: server.Client()}
+		data, err := repo.DownloadAsset(t.Context(), server.URL+"/asset")
+		require.NoError(t, err)
+		assert.Equal(t, "archive", string(data))
+		_, err = repo.DownloadAsset(t.Context(), server.URL+"/missing")
+		assert.ErrorContains(t, err, "unexpected status 404")
+	})
+}
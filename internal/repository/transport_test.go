@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransportOptions_httpTransport(t *testing.T) {
+	t.Run("Should route requests through the configured proxy", func(t *testing.T) {
+		transport, err := TransportOptions{ProxyURL: "http://proxy.corp:3128"}.httpTransport()
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodGet, "https://api.github.com/user", nil)
+		proxyURL, err := transport.Proxy(req)
+		require.NoError(t, err)
+		assert.Equal(t, "http://proxy.corp:3128", proxyURL.String())
+		if transport.TLSClientConfig != nil {
+			assert.Nil(t, transport.TLSClientConfig.RootCAs)
+			assert.False(t, transport.TLSClientConfig.InsecureSkipVerify)
+		}
+	})
+	t.Run("Should trust servers signed by the CA bundle", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+		bundle := filepath.Join(t.TempDir(), "ca.pem")
+		certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		require.NoError(t, os.WriteFile(bundle, certPEM, 0o600))
+		untrusted, err := TransportOptions{}.httpTransport()
+		require.NoError(t, err)
+		_, err = (&http.Client{Transport: untrusted}).Get(server.URL)
+		require.Error(t, err)
+		trusted, err := TransportOptions{CABundle: bundle}.httpTransport()
+		require.NoError(t, err)
+		resp, err := (&http.Client{Transport: trusted}).Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+	t.Run("Should fail when the CA bundle has no certificates", func(t *testing.T) {
+		bundle := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(bundle, []byte("not a certificate"), 0o600))
+		_, err := TransportOptions{CABundle: bundle}.httpTransport()
+		require.ErrorContains(t, err, "contains no PEM certificates")
+		_, err = TransportOptions{CABundle: filepath.Join(t.TempDir(), "missing.pem")}.httpTransport()
+		require.ErrorContains(t, err, "failed to read CA bundle")
+	})
+	t.Run("Should skip certificate verification only when asked to", func(t *testing.T) {
+		transport, err := TransportOptions{InsecureSkipVerify: true}.httpTransport()
+		require.NoError(t, err)
+		assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+	})
+}
+
+func TestTransportOptions_gitEnv(t *testing.T) {
+	t.Run("Should translate the options into git environment variables", func(t *testing.T) {
+		options := TransportOptions{
+			ProxyURL:           "http://proxy.corp:3128",
+			CABundle:           "/etc/ssl/corp-ca.pem",
+			InsecureSkipVerify: true,
+		}
+		assert.Equal(t, []string{
+			"http_proxy=http://proxy.corp:3128",
+			"https_proxy=http://proxy.corp:3128",
+			"GIT_SSL_CAINFO=/etc/ssl/corp-ca.pem",
+			"GIT_SSL_NO_VERIFY=true",
+		}, options.gitEnv())
+		assert.Empty(t, TransportOptions{}.gitEnv())
+		assert.True(t, TransportOptions{}.IsZero())
+	})
+}
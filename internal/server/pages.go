@@ -0,0 +1,161 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"go.uber.org/zap"
+)
+
+const pageLayout = `{{define "layout"}}<!doctype html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>pr-release{{if .Title}} · {{.Title}}{{end}}</title>
+<style>
+body{font-family:system-ui,sans-serif;margin:2rem;color:#1f2328}
+table{border-collapse:collapse;width:100%}
+th,td{border-bottom:1px solid #d0d7de;padding:.4rem .6rem;text-align:left;vertical-align:top}
+code{font-size:.9em}
+.status{font-weight:600}
+.completed,.succeeded{color:#1a7f37}
+.failed{color:#cf222e}
+.running,.pending{color:#9a6700}
+.rolled_back{color:#6e7781}
+.notice{padding:.6rem;border:1px solid #d0d7de;margin-bottom:1rem}
+form{display:inline}
+</style>
+</head>
+<body>
+<h1><a href="/">pr-release</a>{{if .Title}} · {{.Title}}{{end}}</h1>
+{{with .Action}}<p class="notice">Last action: <strong>{{.Action}}</strong> on
+<a href="/sessions/{{.SessionID}}"><code>{{.SessionID}}</code></a> —
+<span class="status {{.Status}}">{{.Status}}</span>{{with .Error}}: {{.}}{{end}}</p>{{end}}
+{{with .Message}}<p class="notice">{{.}}</p>{{end}}
+{{template "content" .}}
+</body>
+</html>{{end}}`
+
+const indexContent = `{{define "content"}}
+{{if .Sessions}}
+<table>
+<thead><tr><th>Session</th><th>Version</th><th>Branch</th><th>Status</th><th>Started</th><th>Steps</th></tr></thead>
+<tbody>
+{{range .Sessions}}<tr>
+<td><a href="/sessions/{{.SessionID}}"><code>{{.SessionID}}</code></a></td>
+<td>{{.Version}}</td>
+<td>{{.BranchName}}</td>
+<td class="status {{.Status}}">{{.Status}}</td>
+<td>{{.StartedAt.Format "2006-01-02 15:04:05 MST"}}</td>
+<td>{{.Operations}}</td>
+</tr>{{end}}
+</tbody>
+</table>
+{{else}}<p>No release sessions recorded yet.</p>{{end}}
+{{end}}`
+
+const sessionContent = `{{define "content"}}
+{{with .Session}}
+<p>Version <strong>{{.Version}}</strong> on <code>{{.BranchName}}</code> (from <code>{{.OriginalBranch}}</code>) —
+<span class="status {{.Status}}">{{.Status}}</span></p>
+{{if .PRNumber}}<p>Release PR <a href="{{.PRURL}}">#{{.PRNumber}}</a></p>{{end}}
+{{with .Error}}<p class="failed">{{.}}</p>{{end}}
+<form method="post" action="/sessions/{{.SessionID}}/rollback"><button type="submit">Rollback</button></form>
+<form method="post" action="/sessions/{{.SessionID}}/resume"><button type="submit">Resume</button></form>
+<h2>Steps</h2>
+<table>
+<thead><tr><th>Step</th><th>Status</th><th>Started</th><th>Completed</th><th>Error</th></tr></thead>
+<tbody>
+{{range .Operations}}<tr>
+<td>{{.Type}}</td>
+<td class="status {{.Status}}">{{.Status}}</td>
+<td>{{.StartedAt.Format "15:04:05"}}</td>
+<td>{{with .CompletedAt}}{{.Format "15:04:05"}}{{end}}</td>
+<td>{{.Error}}</td>
+</tr>{{end}}
+</tbody>
+</table>
+{{end}}
+{{end}}`
+
+var (
+	indexPage   = template.Must(template.Must(template.New("index").Parse(pageLayout)).Parse(indexContent))
+	sessionPage = template.Must(template.Must(template.New("session").Parse(pageLayout)).Parse(sessionContent))
+)
+
+type pageData struct {
+	Title    string
+	Message  string
+	Action   *ActionState
+	Sessions []SessionSummary
+	Session  *domain.RollbackState
+}
+
+func (s *Server) handleIndexPage(w http.ResponseWriter, r *http.Request) {
+	states, err := s.controller.Sessions(r.Context())
+	if err != nil {
+		s.renderError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	s.render(w, r, indexPage, http.StatusOK, pageData{
+		Action:   s.currentAction(),
+		Sessions: summarize(states),
+	})
+}
+
+func (s *Server) handleSessionPage(w http.ResponseWriter, r *http.Request) {
+	state, status, err := s.loadSession(r)
+	if err != nil {
+		s.renderError(w, r, status, err)
+		return
+	}
+	data := pageData{
+		Title:   state.SessionID,
+		Action:  s.currentAction(),
+		Session: state,
+	}
+	if r.URL.Query().Has("busy") {
+		data.Message = errActionRunning.Error()
+	}
+	s.render(w, r, sessionPage, http.StatusOK, data)
+}
+
+func (s *Server) handleActionForm(w http.ResponseWriter, r *http.Request) {
+	sessionID, status, err := s.actionSession(r)
+	if err != nil {
+		s.renderError(w, r, status, err)
+		return
+	}
+	target := "/sessions/" + url.PathEscape(sessionID)
+	if _, err := s.startAction(r.PathValue("action"), sessionID); err != nil {
+		if errors.Is(err, errUnknownAction) {
+			s.renderError(w, r, http.StatusNotFound, err)
+			return
+		}
+		target += "?busy=1"
+	}
+	http.Redirect(w, r, target, http.StatusSeeOther)
+}
+
+func (s *Server) renderError(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if status >= http.StatusInternalServerError {
+		s.logger(r.Context()).Error("Dashboard request failed", zap.String("path", r.URL.Path), zap.Error(err))
+	}
+	s.render(w, r, indexPage, status, pageData{Title: http.StatusText(status), Message: err.Error()})
+}
+
+func (s *Server) render(w http.ResponseWriter, r *http.Request, page *template.Template, status int, data pageData) {
+	var buf bytes.Buffer
+	if err := page.ExecuteTemplate(&buf, "layout", data); err != nil {
+		s.logger(r.Context()).Error("Failed to render dashboard page", zap.Error(err))
+		http.Error(w, "failed to render page", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
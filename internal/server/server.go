@@ -0,0 +1,321 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// ActionRollback compensates the completed steps of a session.
+	ActionRollback = "rollback"
+	// ActionResume re-runs the release workflow for a failed session.
+	ActionResume = "resume"
+	// ActionStatusRunning marks an action that has not finished yet.
+	ActionStatusRunning = "running"
+	// ActionStatusSucceeded marks an action that finished without error.
+	ActionStatusSucceeded = "succeeded"
+	// ActionStatusFailed marks an action that finished with an error.
+	ActionStatusFailed = "failed"
+	// readHeaderTimeout bounds how long a client may take to send request headers.
+	readHeaderTimeout = 10 * time.Second
+	// shutdownTimeout bounds how long in-flight requests may take once the server stops.
+	shutdownTimeout = 15 * time.Second
+)
+
+var sessionIDPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]*$`)
+
+// SessionController exposes release sessions and the actions that can be taken on them.
+type SessionController interface {
+	Sessions(ctx context.Context) ([]*domain.RollbackState, error)
+	Session(ctx context.Context, sessionID string) (*domain.RollbackState, error)
+	Rollback(ctx context.Context, sessionID string) error
+	Resume(ctx context.Context, sessionID string) error
+}
+
+// ActionState describes the most recent rollback or resume triggered through the server.
+type ActionState struct {
+	Action     string     `json:"action"`
+	SessionID  string     `json:"session_id"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+// SessionSummary is the list view of a release session.
+type SessionSummary struct {
+	SessionID  string                `json:"session_id"`
+	Version    string                `json:"version"`
+	BranchName string                `json:"branch_name"`
+	Status     domain.WorkflowStatus `json:"status"`
+	StartedAt  time.Time             `json:"started_at"`
+	UpdatedAt  time.Time             `json:"updated_at"`
+	Operations int                   `json:"operations"`
+	Error      string                `json:"error,omitempty"`
+}
+
+// Server serves the release dashboard and its JSON API.
+type Server struct {
+	baseCtx    context.Context
+	controller SessionController
+	token      string
+	mu         sync.Mutex
+	action     *ActionState
+	wg         sync.WaitGroup
+}
+
+// New creates a dashboard server. Actions run detached from requests using ctx, and every
+// route requires the token (as a bearer token or basic auth password) when it is non-empty.
+func New(ctx context.Context, controller SessionController, token string) *Server {
+	return &Server{
+		baseCtx:    ctx,
+		controller: controller,
+		token:      token,
+	}
+}
+
+func (s *Server) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx).Named("server")
+}
+
+// Handler returns the HTTP handler for the dashboard and API routes.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.handleIndexPage)
+	mux.HandleFunc("GET /sessions/{id}", s.handleSessionPage)
+	mux.HandleFunc("POST /sessions/{id}/{action}", s.handleActionForm)
+	mux.HandleFunc("GET /api/sessions", s.handleListSessions)
+	mux.HandleFunc("GET /api/sessions/{id}", s.handleGetSession)
+	mux.HandleFunc("POST /api/sessions/{id}/{action}", s.handleStartAction)
+	mux.HandleFunc("GET /api/action", s.handleGetAction)
+	return s.authenticate(http.NewCrossOriginProtection().Handler(mux))
+}
+
+// ListenAndServe serves on addr until ctx is canceled, then shuts down gracefully and waits
+// for running actions to finish.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	log := s.logger(ctx)
+	log.Info("Serving release dashboard", zap.String("addr", addr), zap.Bool("auth", s.token != ""))
+	if err := serveHTTP(ctx, addr, s.Handler()); err != nil {
+		return err
+	}
+	s.wg.Wait()
+	log.Info("Dashboard server stopped")
+	return nil
+}
+
+// serveHTTP serves handler on addr until ctx is canceled, then shuts down gracefully.
+func serveHTTP(ctx context.Context, addr string, handler http.Handler) error {
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.ListenAndServe()
+	}()
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("http server on %s failed: %w", addr, err)
+	case <-ctx.Done():
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down http server: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	if s.token == "" {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		provided := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if _, password, ok := r.BasicAuth(); ok {
+			provided = password
+		}
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(s.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="pr-release"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// startAction runs a rollback or resume in the background; only one action runs at a time.
+func (s *Server) startAction(action, sessionID string) (ActionState, error) {
+	var run func(context.Context, string) error
+	switch action {
+	case ActionRollback:
+		run = s.controller.Rollback
+	case ActionResume:
+		run = s.controller.Resume
+	default:
+		return ActionState{}, errUnknownAction
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.action != nil && s.action.Status == ActionStatusRunning {
+		return *s.action, errActionRunning
+	}
+	state := &ActionState{
+		Action:    action,
+		SessionID: sessionID,
+		Status:    ActionStatusRunning,
+		StartedAt: time.Now(),
+	}
+	s.action = state
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		err := run(s.baseCtx, sessionID)
+		s.finishAction(state, err)
+	}()
+	return *state, nil
+}
+
+func (s *Server) finishAction(state *ActionState, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	state.FinishedAt = &now
+	state.Status = ActionStatusSucceeded
+	log := s.logger(s.baseCtx).With(zap.String("action", state.Action), zap.String("session_id", state.SessionID))
+	if err != nil {
+		state.Status = ActionStatusFailed
+		state.Error = err.Error()
+		log.Error("Dashboard action failed", zap.Error(err))
+		return
+	}
+	log.Info("Dashboard action completed")
+}
+
+func (s *Server) currentAction() *ActionState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.action == nil {
+		return nil
+	}
+	action := *s.action
+	return &action
+}
+
+var (
+	errUnknownAction = errors.New("unknown action")
+	errActionRunning = errors.New("another action is already running")
+)
+
+func summarize(states []*domain.RollbackState) []SessionSummary {
+	summaries := make([]SessionSummary, 0, len(states))
+	for _, state := range states {
+		summaries = append(summaries, SessionSummary{
+			SessionID:  state.SessionID,
+			Version:    state.Version,
+			BranchName: state.BranchName,
+			Status:     state.Status,
+			StartedAt:  state.StartedAt,
+			UpdatedAt:  state.UpdatedAt,
+			Operations: len(state.Operations),
+			Error:      state.Error,
+		})
+	}
+	return summaries
+}
+
+func (s *Server) handleListSessions(w http.ResponseWriter, r *http.Request) {
+	states, err := s.controller.Sessions(r.Context())
+	if err != nil {
+		s.writeError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, summarize(states))
+}
+
+func (s *Server) handleGetSession(w http.ResponseWriter, r *http.Request) {
+	state, status, err := s.loadSession(r)
+	if err != nil {
+		s.writeError(w, r, status, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, state)
+}
+
+func (s *Server) handleStartAction(w http.ResponseWriter, r *http.Request) {
+	sessionID, status, err := s.actionSession(r)
+	if err != nil {
+		s.writeError(w, r, status, err)
+		return
+	}
+	action, err := s.startAction(r.PathValue("action"), sessionID)
+	switch {
+	case errors.Is(err, errUnknownAction):
+		s.writeError(w, r, http.StatusNotFound, err)
+	case errors.Is(err, errActionRunning):
+		writeJSON(w, http.StatusConflict, map[string]any{"error": err.Error(), "action": action})
+	default:
+		writeJSON(w, http.StatusAccepted, action)
+	}
+}
+
+func (s *Server) handleGetAction(w http.ResponseWriter, _ *http.Request) {
+	action := s.currentAction()
+	if action == nil {
+		writeJSON(w, http.StatusOK, map[string]any{})
+		return
+	}
+	writeJSON(w, http.StatusOK, action)
+}
+
+// actionSession validates that the session targeted by an action exists.
+func (s *Server) actionSession(r *http.Request) (string, int, error) {
+	state, status, err := s.loadSession(r)
+	if err != nil {
+		return "", status, err
+	}
+	return state.SessionID, http.StatusOK, nil
+}
+
+func (s *Server) loadSession(r *http.Request) (*domain.RollbackState, int, error) {
+	sessionID := r.PathValue("id")
+	if !sessionIDPattern.MatchString(sessionID) {
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid session id: %q", sessionID)
+	}
+	state, err := s.controller.Session(r.Context(), sessionID)
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+	return state, http.StatusOK, nil
+}
+
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if status >= http.StatusInternalServerError {
+		s.logger(r.Context()).Error("Dashboard request failed", zap.String("path", r.URL.Path), zap.Error(err))
+	}
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(body)
+}
@@ -0,0 +1,240 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSessionController struct{ mock.Mock }
+
+func (m *mockSessionController) Sessions(ctx context.Context) ([]*domain.RollbackState, error) {
+	args := m.Called(ctx)
+	if states := args.Get(0); states != nil {
+		return states.([]*domain.RollbackState), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockSessionController) Session(ctx context.Context, sessionID string) (*domain.RollbackState, error) {
+	args := m.Called(ctx, sessionID)
+	if state := args.Get(0); state != nil {
+		return state.(*domain.RollbackState), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockSessionController) Rollback(ctx context.Context, sessionID string) error {
+	return m.Called(ctx, sessionID).Error(0)
+}
+
+func (m *mockSessionController) Resume(ctx context.Context, sessionID string) error {
+	return m.Called(ctx, sessionID).Error(0)
+}
+
+func testSession(id string, status domain.WorkflowStatus) *domain.RollbackState {
+	state := domain.NewRollbackState(id)
+	state.Version = "v1.2.0"
+	state.BranchName = "release/v1.2.0"
+	state.OriginalBranch = "main"
+	state.Status = status
+	state.AddOperation(domain.OperationTypeCreateBranch)
+	return state
+}
+
+func waitForAction(t *testing.T, srv *Server) *ActionState {
+	t.Helper()
+	var action *ActionState
+	require.Eventually(t, func() bool {
+		action = srv.currentAction()
+		return action != nil && action.Status != ActionStatusRunning
+	}, time.Second, 10*time.Millisecond)
+	return action
+}
+
+func TestServer_API(t *testing.T) {
+	t.Run("Should list session summaries", func(t *testing.T) {
+		controller := new(mockSessionController)
+		controller.On("Sessions", mock.Anything).Return([]*domain.RollbackState{
+			testSession("abc-1", domain.WorkflowStatusFailed),
+		}, nil)
+		srv := New(t.Context(), controller, "")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/sessions", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var summaries []SessionSummary
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &summaries))
+		require.Len(t, summaries, 1)
+		assert.Equal(t, "abc-1", summaries[0].SessionID)
+		assert.Equal(t, domain.WorkflowStatusFailed, summaries[0].Status)
+		assert.Equal(t, 1, summaries[0].Operations)
+	})
+	t.Run("Should return session details with step status", func(t *testing.T) {
+		controller := new(mockSessionController)
+		controller.On("Session", mock.Anything, "abc-1").Return(testSession("abc-1", domain.WorkflowStatusFailed), nil)
+		srv := New(t.Context(), controller, "")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/sessions/abc-1", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var state domain.RollbackState
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &state))
+		require.Len(t, state.Operations, 1)
+		assert.Equal(t, domain.OperationTypeCreateBranch, state.Operations[0].Type)
+	})
+	t.Run("Should reject session ids that are not plain identifiers", func(t *testing.T) {
+		controller := new(mockSessionController)
+		srv := New(t.Context(), controller, "")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/sessions/..%2Fsecrets", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		controller.AssertNotCalled(t, "Session", mock.Anything, mock.Anything)
+	})
+	t.Run("Should return not found for unknown sessions", func(t *testing.T) {
+		controller := new(mockSessionController)
+		controller.On("Session", mock.Anything, "missing").Return(nil, errors.New("state not found"))
+		srv := New(t.Context(), controller, "")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/sessions/missing", nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+	t.Run("Should trigger rollback in the background", func(t *testing.T) {
+		controller := new(mockSessionController)
+		controller.On("Session", mock.Anything, "abc-1").Return(testSession("abc-1", domain.WorkflowStatusFailed), nil)
+		controller.On("Rollback", mock.Anything, "abc-1").Return(nil).Once()
+		srv := New(t.Context(), controller, "")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/sessions/abc-1/rollback", nil))
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		action := waitForAction(t, srv)
+		assert.Equal(t, ActionRollback, action.Action)
+		assert.Equal(t, ActionStatusSucceeded, action.Status)
+		controller.AssertExpectations(t)
+	})
+	t.Run("Should report failed resume actions", func(t *testing.T) {
+		controller := new(mockSessionController)
+		controller.On("Session", mock.Anything, "abc-1").Return(testSession("abc-1", domain.WorkflowStatusFailed), nil)
+		controller.On("Resume", mock.Anything, "abc-1").Return(errors.New("push rejected")).Once()
+		srv := New(t.Context(), controller, "")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/sessions/abc-1/resume", nil))
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		waitForAction(t, srv)
+		rec = httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/action", nil))
+		var action ActionState
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &action))
+		assert.Equal(t, ActionStatusFailed, action.Status)
+		assert.Equal(t, "push rejected", action.Error)
+	})
+	t.Run("Should refuse a second action while one is running", func(t *testing.T) {
+		controller := new(mockSessionController)
+		release := make(chan struct{})
+		controller.On("Session", mock.Anything, "abc-1").Return(testSession("abc-1", domain.WorkflowStatusFailed), nil)
+		controller.On("Rollback", mock.Anything, "abc-1").Run(func(mock.Arguments) { <-release }).Return(nil).Once()
+		srv := New(t.Context(), controller, "")
+		handler := srv.Handler()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/sessions/abc-1/rollback", nil))
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/sessions/abc-1/resume", nil))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		close(release)
+		waitForAction(t, srv)
+		controller.AssertNotCalled(t, "Resume", mock.Anything, mock.Anything)
+	})
+	t.Run("Should return not found for unknown actions", func(t *testing.T) {
+		controller := new(mockSessionController)
+		controller.On("Session", mock.Anything, "abc-1").Return(testSession("abc-1", domain.WorkflowStatusFailed), nil)
+		srv := New(t.Context(), controller, "")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/sessions/abc-1/delete", nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestServer_Auth(t *testing.T) {
+	controller := new(mockSessionController)
+	controller.On("Sessions", mock.Anything).Return([]*domain.RollbackState{}, nil)
+	srv := New(t.Context(), controller, "s3cret")
+	t.Run("Should reject requests without the token", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/sessions", nil))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "Basic")
+	})
+	t.Run("Should accept a bearer token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/sessions", nil)
+		req.Header.Set("Authorization", "Bearer s3cret")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+	t.Run("Should accept the token as basic auth password", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.SetBasicAuth("release", "s3cret")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestServer_Pages(t *testing.T) {
+	t.Run("Should render sessions with escaped content", func(t *testing.T) {
+		controller := new(mockSessionController)
+		state := testSession("abc-1", domain.WorkflowStatusFailed)
+		state.BranchName = "<script>alert(1)</script>"
+		controller.On("Sessions", mock.Anything).Return([]*domain.RollbackState{state}, nil)
+		srv := New(t.Context(), controller, "")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		assert.Contains(t, body, `href="/sessions/abc-1"`)
+		assert.Contains(t, body, "&lt;script&gt;")
+		assert.NotContains(t, body, "<script>")
+	})
+	t.Run("Should render step status and action buttons", func(t *testing.T) {
+		controller := new(mockSessionController)
+		controller.On("Session", mock.Anything, "abc-1").Return(testSession("abc-1", domain.WorkflowStatusFailed), nil)
+		srv := New(t.Context(), controller, "")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sessions/abc-1", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		assert.Contains(t, body, "create_branch")
+		assert.Contains(t, body, `action="/sessions/abc-1/rollback"`)
+		assert.Contains(t, body, `action="/sessions/abc-1/resume"`)
+	})
+	t.Run("Should redirect back to the session after triggering an action", func(t *testing.T) {
+		controller := new(mockSessionController)
+		controller.On("Session", mock.Anything, "abc-1").Return(testSession("abc-1", domain.WorkflowStatusFailed), nil)
+		controller.On("Resume", mock.Anything, "abc-1").Return(nil).Once()
+		srv := New(t.Context(), controller, "")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/sessions/abc-1/resume", nil))
+		assert.Equal(t, http.StatusSeeOther, rec.Code)
+		assert.Equal(t, "/sessions/abc-1", rec.Header().Get("Location"))
+		waitForAction(t, srv)
+	})
+	t.Run("Should reject cross-origin form submissions", func(t *testing.T) {
+		controller := new(mockSessionController)
+		srv := New(t.Context(), controller, "")
+		req := httptest.NewRequest(http.MethodPost, "/sessions/abc-1/rollback", strings.NewReader(""))
+		req.Header.Set("Sec-Fetch-Site", "cross-site")
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		controller.AssertNotCalled(t, "Rollback", mock.Anything, mock.Anything)
+	})
+}
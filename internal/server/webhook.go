@@ -0,0 +1,263 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/compozy/releasepr/internal/gha"
+	"github.com/compozy/releasepr/internal/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxWebhookPayloadBytes matches the largest payload GitHub delivers.
+	maxWebhookPayloadBytes = 25 << 20
+	// webhookQueueSize bounds how many triggered workflows may wait behind the running one.
+	webhookQueueSize = 16
+	// webhookSignaturePrefix prefixes the hex HMAC in the X-Hub-Signature-256 header.
+	webhookSignaturePrefix = "sha256="
+	// DefaultReleaseLabel is the label pr-release puts on every release PR it opens.
+	DefaultReleaseLabel = "release-pending"
+)
+
+var (
+	releaseVersionPattern   = regexp.MustCompile(`\bv?\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?\b`)
+	skippedPushSubjectRegex = regexp.MustCompile(`^(release:|ci\(release\):|Merge pull request)`)
+	commitSHAPattern        = regexp.MustCompile(`^[0-9a-f]{40}([0-9a-f]{24})?$`)
+)
+
+// WebhookTriggers runs the workflows started by GitHub webhook events.
+type WebhookTriggers interface {
+	ReleasePR(ctx context.Context, branch string) error
+	Publish(ctx context.Context, branch, version, commit string) error
+}
+
+// WebhookOptions configures which events the webhook listener acts on.
+type WebhookOptions struct {
+	Secret       string // Shared secret used to verify X-Hub-Signature-256
+	Repository   string // Expected owner/repo; events from other repositories are ignored
+	ReleaseLabel string // Label marking release pull requests
+}
+
+type webhookJob struct {
+	name     string
+	delivery string
+	run      func(context.Context) error
+}
+
+// Webhook receives GitHub webhooks and runs the matching workflow, one at a time.
+type Webhook struct {
+	triggers WebhookTriggers
+	opts     WebhookOptions
+	jobs     chan webhookJob
+	wg       sync.WaitGroup
+}
+
+// NewWebhook creates a webhook listener; a secret is required so unsigned requests are never trusted.
+func NewWebhook(triggers WebhookTriggers, opts WebhookOptions) (*Webhook, error) {
+	if opts.Secret == "" {
+		return nil, errors.New("webhook secret is required")
+	}
+	if opts.ReleaseLabel == "" {
+		opts.ReleaseLabel = DefaultReleaseLabel
+	}
+	return &Webhook{
+		triggers: triggers,
+		opts:     opts,
+		jobs:     make(chan webhookJob, webhookQueueSize),
+	}, nil
+}
+
+func (h *Webhook) logger(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx).Named("server.webhook")
+}
+
+// Handler returns the HTTP handler receiving webhook deliveries.
+func (h *Webhook) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /webhook", h.handleDelivery)
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	return mux
+}
+
+// ListenAndServe processes queued workflows and serves webhooks on addr until ctx is canceled.
+func (h *Webhook) ListenAndServe(ctx context.Context, addr string) error {
+	log := h.logger(ctx)
+	h.wg.Add(1)
+	go h.work(ctx)
+	log.Info("Listening for GitHub webhooks",
+		zap.String("addr", addr),
+		zap.String("repository", h.opts.Repository),
+		zap.String("release_label", h.opts.ReleaseLabel),
+	)
+	err := serveHTTP(ctx, addr, h.Handler())
+	close(h.jobs)
+	h.wg.Wait()
+	if err != nil {
+		return err
+	}
+	log.Info("Webhook listener stopped")
+	return nil
+}
+
+// work runs queued jobs sequentially because every workflow mutates the same working tree.
+func (h *Webhook) work(ctx context.Context) {
+	defer h.wg.Done()
+	for job := range h.jobs {
+		if ctx.Err() != nil {
+			continue
+		}
+		log := h.logger(ctx).With(zap.String("job", job.name), zap.String("delivery", job.delivery))
+		log.Info("Running webhook job")
+		if err := job.run(ctx); err != nil {
+			log.Error("Webhook job failed", zap.Error(err))
+			continue
+		}
+		log.Info("Webhook job completed")
+	}
+}
+
+func (h *Webhook) handleDelivery(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadBytes))
+	if err != nil {
+		writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "payload too large"})
+		return
+	}
+	if !h.validSignature(body, r.Header.Get("X-Hub-Signature-256")) {
+		h.logger(r.Context()).Warn("Rejected webhook with invalid signature",
+			zap.String("delivery", r.Header.Get("X-GitHub-Delivery")),
+		)
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid signature"})
+		return
+	}
+	event := r.Header.Get("X-GitHub-Event")
+	job, reason, err := h.route(event, body)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+	if job == nil {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ignored", "reason": reason})
+		return
+	}
+	job.delivery = r.Header.Get("X-GitHub-Delivery")
+	select {
+	case h.jobs <- *job:
+		writeJSON(w, http.StatusAccepted, map[string]string{"status": "queued", "job": job.name})
+	default:
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "job queue is full"})
+	}
+}
+
+// validSignature verifies the HMAC-SHA256 signature GitHub computes with the shared secret.
+func (h *Webhook) validSignature(body []byte, header string) bool {
+	signature, ok := strings.CutPrefix(header, webhookSignaturePrefix)
+	if !ok {
+		return false
+	}
+	provided, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(h.opts.Secret))
+	mac.Write(body)
+	return hmac.Equal(provided, mac.Sum(nil))
+}
+
+// route maps a webhook event to a job, or returns the reason it is ignored.
+func (h *Webhook) route(event string, body []byte) (*webhookJob, string, error) {
+	switch event {
+	case "ping":
+		return nil, "ping", nil
+	case gha.EventPush:
+		payload, err := gha.ParsePushEvent(body)
+		if err != nil {
+			return nil, "", err
+		}
+		return h.routePush(payload)
+	case gha.EventPullRequest:
+		payload, err := gha.ParsePullRequestEvent(body)
+		if err != nil {
+			return nil, "", err
+		}
+		return h.routePullRequest(payload)
+	}
+	return nil, fmt.Sprintf("unhandled event %q", event), nil
+}
+
+func (h *Webhook) routePush(payload *gha.PushEvent) (*webhookJob, string, error) {
+	if reason := h.checkRepository(payload.Repository); reason != "" {
+		return nil, reason, nil
+	}
+	branch := payload.Repository.DefaultBranch
+	if pushed, ok := payload.Branch(); payload.Deleted || !ok || pushed != branch {
+		return nil, "push is not to the default branch", nil
+	}
+	// Mirror the CI guard so the release commit itself does not loop.
+	if commit := payload.HeadCommit; commit != nil {
+		if skippedPushSubjectRegex.MatchString(commit.Subject()) {
+			return nil, "release or merge commit", nil
+		}
+		if commit.Author.Username == "github-actions[bot]" || commit.Author.Name == "github-actions[bot]" {
+			return nil, "bot commit", nil
+		}
+	}
+	return &webhookJob{
+		name: "release-pr",
+		run: func(ctx context.Context) error {
+			return h.triggers.ReleasePR(ctx, branch)
+		},
+	}, "", nil
+}
+
+func (h *Webhook) routePullRequest(payload *gha.PullRequestEvent) (*webhookJob, string, error) {
+	if reason := h.checkRepository(payload.Repository); reason != "" {
+		return nil, reason, nil
+	}
+	pr := payload.PullRequest
+	if payload.Action != "closed" || !pr.Merged {
+		return nil, "pull request was not merged", nil
+	}
+	if pr.Base.Ref != payload.Repository.DefaultBranch {
+		return nil, "pull request does not target the default branch", nil
+	}
+	if !pr.HasLabel(h.opts.ReleaseLabel) {
+		return nil, fmt.Sprintf("pull request is not labeled %q", h.opts.ReleaseLabel), nil
+	}
+	version := releaseVersionPattern.FindString(strings.TrimPrefix(pr.Head.Ref, "release/"))
+	if version == "" {
+		version = releaseVersionPattern.FindString(pr.Title)
+	}
+	if version == "" || !commitSHAPattern.MatchString(pr.MergeCommitSHA) {
+		return nil, "", errors.New("merged release pull request has no version or merge commit")
+	}
+	branch := payload.Repository.DefaultBranch
+	commit := pr.MergeCommitSHA
+	return &webhookJob{
+		name: "publish",
+		run: func(ctx context.Context) error {
+			return h.triggers.Publish(ctx, branch, version, commit)
+		},
+	}, "", nil
+}
+
+func (h *Webhook) checkRepository(repo gha.Repository) string {
+	if h.opts.Repository != "" && !strings.EqualFold(repo.FullName, h.opts.Repository) {
+		return fmt.Sprintf("event for repository %q", repo.FullName)
+	}
+	if repo.DefaultBranch == "" || strings.HasPrefix(repo.DefaultBranch, "-") {
+		return "event has no usable default branch"
+	}
+	return ""
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebhookSecret = "webhook-secret"
+
+type mockWebhookTriggers struct{ mock.Mock }
+
+func (m *mockWebhookTriggers) ReleasePR(ctx context.Context, branch string) error {
+	return m.Called(ctx, branch).Error(0)
+}
+
+func (m *mockWebhookTriggers) Publish(ctx context.Context, branch, version, commit string) error {
+	return m.Called(ctx, branch, version, commit).Error(0)
+}
+
+func newTestWebhook(t *testing.T, triggers WebhookTriggers) *Webhook {
+	t.Helper()
+	webhook, err := NewWebhook(triggers, WebhookOptions{Secret: testWebhookSecret, Repository: "compozy/releasepr"})
+	require.NoError(t, err)
+	return webhook
+}
+
+func signedDelivery(t *testing.T, event, body string) *http.Request {
+	t.Helper()
+	mac := hmac.New(sha256.New, []byte(testWebhookSecret))
+	mac.Write([]byte(body))
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(body))
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set("X-GitHub-Delivery", "delivery-1")
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func runQueuedJob(t *testing.T, webhook *Webhook) {
+	t.Helper()
+	require.Len(t, webhook.jobs, 1)
+	job := <-webhook.jobs
+	require.NoError(t, job.run(t.Context()))
+}
+
+const repositoryJSON = `"repository":{"full_name":"compozy/releasepr","default_branch":"main"}`
+
+func TestNewWebhook(t *testing.T) {
+	t.Run("Should require a secret", func(t *testing.T) {
+		_, err := NewWebhook(new(mockWebhookTriggers), WebhookOptions{})
+		assert.ErrorContains(t, err, "webhook secret is required")
+	})
+}
+
+func TestWebhook_Signature(t *testing.T) {
+	t.Run("Should reject deliveries without a valid signature", func(t *testing.T) {
+		webhook := newTestWebhook(t, new(mockWebhookTriggers))
+		body := `{"ref":"refs/heads/main",` + repositoryJSON + `}`
+		for _, signature := range []string{"", "sha256=deadbeef", "sha1=abc", "sha256=zz"} {
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(body))
+			req.Header.Set("X-GitHub-Event", "push")
+			req.Header.Set("X-Hub-Signature-256", signature)
+			rec := httptest.NewRecorder()
+			webhook.Handler().ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusUnauthorized, rec.Code, signature)
+		}
+		assert.Empty(t, webhook.jobs)
+	})
+	t.Run("Should reject payloads that were modified after signing", func(t *testing.T) {
+		webhook := newTestWebhook(t, new(mockWebhookTriggers))
+		req := signedDelivery(t, "push", `{"ref":"refs/heads/main",`+repositoryJSON+`}`)
+		req.Body = http.NoBody
+		rec := httptest.NewRecorder()
+		webhook.Handler().ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+	t.Run("Should answer ping deliveries", func(t *testing.T) {
+		webhook := newTestWebhook(t, new(mockWebhookTriggers))
+		rec := httptest.NewRecorder()
+		webhook.Handler().ServeHTTP(rec, signedDelivery(t, "ping", `{"zen":"hi"}`))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestWebhook_Push(t *testing.T) {
+	t.Run("Should queue the release PR workflow for pushes to the default branch", func(t *testing.T) {
+		triggers := new(mockWebhookTriggers)
+		triggers.On("ReleasePR", mock.Anything, "main").Return(nil).Once()
+		webhook := newTestWebhook(t, triggers)
+		body := `{"ref":"refs/heads/main","head_commit":{"message":"feat: add thing","author":{"username":"dev"}},` +
+			repositoryJSON + `}`
+		rec := httptest.NewRecorder()
+		webhook.Handler().ServeHTTP(rec, signedDelivery(t, "push", body))
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		runQueuedJob(t, webhook)
+		triggers.AssertExpectations(t)
+	})
+	t.Run("Should ignore pushes that must not start a release", func(t *testing.T) {
+		cases := map[string]string{
+			"other branch": `{"ref":"refs/heads/feature",` + repositoryJSON + `}`,
+			"release commit": `{"ref":"refs/heads/main","head_commit":{"message":"release: Release v1.2.0"},` +
+				repositoryJSON + `}`,
+			"bot commit": `{"ref":"refs/heads/main","head_commit":{"message":"chore: x",` +
+				`"author":{"username":"github-actions[bot]"}},` + repositoryJSON + `}`,
+			"other repository": `{"ref":"refs/heads/main",` +
+				`"repository":{"full_name":"someone/else","default_branch":"main"}}`,
+		}
+		for name, body := range cases {
+			webhook := newTestWebhook(t, new(mockWebhookTriggers))
+			rec := httptest.NewRecorder()
+			webhook.Handler().ServeHTTP(rec, signedDelivery(t, "push", body))
+			assert.Equal(t, http.StatusOK, rec.Code, name)
+			assert.Contains(t, rec.Body.String(), "ignored", name)
+			assert.Empty(t, webhook.jobs, name)
+		}
+	})
+}
+
+func TestWebhook_PullRequest(t *testing.T) {
+	mergedRelease := func(labels string) string {
+		return `{"action":"closed","pull_request":{"merged":true,` +
+			`"merge_commit_sha":"0123456789abcdef0123456789abcdef01234567",` +
+			`"title":"release: Release v1.2.0","labels":[` + labels + `],` +
+			`"head":{"ref":"release/v1.2.0"},"base":{"ref":"main"}},` + repositoryJSON + `}`
+	}
+	t.Run("Should queue publish for merged release pull requests", func(t *testing.T) {
+		triggers := new(mockWebhookTriggers)
+		triggers.On("Publish", mock.Anything, "main", "v1.2.0", "0123456789abcdef0123456789abcdef01234567").
+			Return(nil).Once()
+		webhook := newTestWebhook(t, triggers)
+		rec := httptest.NewRecorder()
+		webhook.Handler().ServeHTTP(rec, signedDelivery(t, "pull_request", mergedRelease(`{"name":"Release-Pending"}`)))
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		runQueuedJob(t, webhook)
+		triggers.AssertExpectations(t)
+	})
+	t.Run("Should ignore merged pull requests without the release label", func(t *testing.T) {
+		webhook := newTestWebhook(t, new(mockWebhookTriggers))
+		rec := httptest.NewRecorder()
+		webhook.Handler().ServeHTTP(rec, signedDelivery(t, "pull_request", mergedRelease(`{"name":"bug"}`)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, webhook.jobs)
+	})
+	t.Run("Should ignore pull requests that were closed without merging", func(t *testing.T) {
+		webhook := newTestWebhook(t, new(mockWebhookTriggers))
+		body := `{"action":"closed","pull_request":{"merged":false,"labels":[{"name":"release-pending"}],` +
+			`"base":{"ref":"main"}},` + repositoryJSON + `}`
+		rec := httptest.NewRecorder()
+		webhook.Handler().ServeHTTP(rec, signedDelivery(t, "pull_request", body))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, webhook.jobs)
+	})
+	t.Run("Should reject merged release pull requests without a usable merge commit", func(t *testing.T) {
+		webhook := newTestWebhook(t, new(mockWebhookTriggers))
+		body := `{"action":"closed","pull_request":{"merged":true,"merge_commit_sha":"--upload-pack=x",` +
+			`"labels":[{"name":"release-pending"}],"head":{"ref":"release/v1.2.0"},"base":{"ref":"main"}},` +
+			repositoryJSON + `}`
+		rec := httptest.NewRecorder()
+		webhook.Handler().ServeHTTP(rec, signedDelivery(t, "pull_request", body))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Empty(t, webhook.jobs)
+	})
+}
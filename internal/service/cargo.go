@@ -0,0 +1,32 @@
+package service
+
+import "context"
+
+// CargoService defines the interface for publishing Rust crates with cargo.
+
+type CargoService interface {
+	// ReadCrate returns the name and version of the crate at path as cargo resolves them.
+	ReadCrate(ctx context.Context, path string) (CargoCrate, error)
+	// CheckRegistry verifies that the registry answers and does not have the version of crate yet.
+	CheckRegistry(ctx context.Context, crate CargoCrate, registry string) error
+	// Publish publishes the crate at path; with DryRun it is packaged and verified but not uploaded.
+	Publish(ctx context.Context, path string, opts CargoPublishOptions) error
+}
+
+// CargoCrate is a Rust crate of the workspace.
+type CargoCrate struct {
+	Name    string
+	Version string
+	// Publishable is false for crates with publish = false in Cargo.toml.
+	Publishable bool
+}
+
+// CargoPublishOptions configure cargo publish.
+type CargoPublishOptions struct {
+	Registry string // Registry named in .cargo/config.toml; empty publishes to crates.io
+	Token    string // Registry token; not needed for a dry run
+	DryRun   bool
+	// NoVerify skips building the packaged crate, which fails for a workspace member whose sibling
+	// dependencies are not published yet
+	NoVerify bool
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+)
+
+type cargoExecutor func(ctx context.Context, dir string, env []string, name string, args ...string) ([]byte, error)
+
+// cargoService is the implementation of the CargoService interface.
+type cargoService struct {
+	// timeout for command execution
+	timeout time.Duration
+	// executor replaces runCommand in tests
+	executor cargoExecutor
+	// fs is used to check crate directories; defaults to the OS filesystem
+	fs afero.Fs
+}
+
+// NewCargoService creates a new CargoService that checks crate directories through fsRepo.
+func NewCargoService(fsRepo afero.Fs) CargoService {
+	return &cargoService{
+		timeout: DefaultCargoTimeout,
+		fs:      fsRepo,
+	}
+}
+
+func (s *cargoService) fileSystem() afero.Fs {
+	if s.fs != nil {
+		return s.fs
+	}
+	return afero.NewOsFs()
+}
+
+// cargoMetadata is the part of the cargo metadata output naming the crates of a workspace.
+type cargoMetadata struct {
+	Packages []struct {
+		Name         string    `json:"name"`
+		Version      string    `json:"version"`
+		ManifestPath string    `json:"manifest_path"`
+		Publish      *[]string `json:"publish"`
+	} `json:"packages"`
+}
+
+// ReadCrate runs cargo metadata for the crate at path, so workspace-inherited versions are resolved.
+func (s *cargoService) ReadCrate(ctx context.Context, path string) (CargoCrate, error) {
+	manifest, err := s.manifestPath(path)
+	if err != nil {
+		return CargoCrate{}, err
+	}
+	output, err := s.runCommand(ctx, filepath.Dir(manifest), nil, "cargo", "metadata",
+		"--no-deps", "--format-version", "1", "--manifest-path", manifest)
+	if err != nil {
+		return CargoCrate{}, fmt.Errorf("failed to read crate metadata of %s: %w", path, err)
+	}
+	var metadata cargoMetadata
+	if err := json.Unmarshal(output, &metadata); err != nil {
+		return CargoCrate{}, fmt.Errorf("failed to parse crate metadata of %s: %w", path, err)
+	}
+	for _, pkg := range metadata.Packages {
+		if filepath.Clean(pkg.ManifestPath) != manifest {
+			continue
+		}
+		return CargoCrate{
+			Name:        pkg.Name,
+			Version:     pkg.Version,
+			Publishable: pkg.Publish == nil || len(*pkg.Publish) > 0,
+		}, nil
+	}
+	return CargoCrate{}, fmt.Errorf("%s is a virtual workspace manifest, not a crate", manifest)
+}
+
+// manifestPath returns the absolute path of the Cargo.toml of the crate directory at path.
+func (s *cargoService) manifestPath(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("crate path cannot be empty")
+	}
+	dir, err := filepath.Abs(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve crate path %s: %w", path, err)
+	}
+	manifest := filepath.Join(dir, "Cargo.toml")
+	if _, err := s.fileSystem().Stat(manifest); err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("Cargo.toml not found in directory: %s", dir)
+		}
+		return "", fmt.Errorf("failed to check Cargo.toml: %w", err)
+	}
+	return manifest, nil
+}
+
+// cargoSearchResult matches a line of cargo search output, e.g. `serde = "1.0.210"    # A serialization framework`.
+var cargoSearchResult = regexp.MustCompile(`^([A-Za-z0-9_-]+) = "([^"]+)"`)
+
+// CheckRegistry searches the registry for the crate, which fails when the registry cannot be reached,
+// and compares the latest published version with the version being released.
+func (s *cargoService) CheckRegistry(ctx context.Context, crate CargoCrate, registry string) error {
+	args := []string{"search", crate.Name, "--limit", "1"}
+	if registry != "" {
+		args = append(args, "--registry", registry)
+	}
+	output, err := s.runCommand(ctx, "", nil, "cargo", args...)
+	if err != nil {
+		return fmt.Errorf("crate registry is not available: %w", err)
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		match := cargoSearchResult.FindStringSubmatch(strings.TrimSpace(line))
+		if match != nil && match[1] == crate.Name && match[2] == crate.Version {
+			return fmt.Errorf("%s@%s: %w", crate.Name, crate.Version, ErrPackageVersionPublished)
+		}
+	}
+	return nil
+}
+
+// Publish runs cargo publish in the crate directory, passing the token through the environment
+// variable cargo reads for the registry.
+func (s *cargoService) Publish(ctx context.Context, path string, opts CargoPublishOptions) error {
+	manifest, err := s.manifestPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid crate path: %w", err)
+	}
+	args := []string{"publish", "--manifest-path", manifest}
+	if opts.Registry != "" {
+		args = append(args, "--registry", opts.Registry)
+	}
+	if opts.DryRun {
+		args = append(args, "--dry-run")
+	}
+	if opts.NoVerify {
+		args = append(args, "--no-verify")
+	}
+	var env []string
+	if opts.Token != "" {
+		env = append(env, cargoTokenEnv(opts.Registry)+"="+opts.Token)
+	}
+	if _, err := s.runCommand(ctx, filepath.Dir(manifest), env, "cargo", args...); err != nil {
+		return fmt.Errorf("failed to publish crate at %s: %w", path, err)
+	}
+	return nil
+}
+
+// cargoTokenEnv names the environment variable cargo reads the token of registry from.
+func cargoTokenEnv(registry string) string {
+	if registry == "" {
+		return "CARGO_REGISTRY_TOKEN"
+	}
+	name := strings.ToUpper(strings.ReplaceAll(registry, "-", "_"))
+	return "CARGO_REGISTRIES_" + name + "_TOKEN"
+}
+
+func (s *cargoService) runCommand(
+	ctx context.Context,
+	dir string,
+	env []string,
+	name string,
+	args ...string,
+) ([]byte, error) {
+	if s.executor != nil {
+		return s.executor(ctx, dir, env, name, args...)
+	}
+	ctx, cancel, budget := commandContext(ctx, s.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmdEnv, err := commandEnv(ctx, domain.EnvServiceCargo, append(os.Environ(), env...))
+	if err != nil {
+		return nil, err
+	}
+	cmd.Env = cmdEnv
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, budget.timeoutError()
+		}
+		if errMsg := strings.TrimSpace(stderr.String()); errMsg != "" {
+			return nil, fmt.Errorf("command failed: %w (stderr: %s)", err, errMsg)
+		}
+		return nil, fmt.Errorf("command failed: %w", err)
+	}
+	return stdout.Bytes(), nil
+}
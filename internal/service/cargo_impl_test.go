@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cargoCall is a cargo command the fake executor received.
+type cargoCall struct {
+	command string
+	env     []string
+}
+
+func newTestCargoService(t *testing.T, responses map[string]any) (*cargoService, string, *[]cargoCall) {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Cargo.toml"), []byte("[package]\n"), 0o644))
+	var calls []cargoCall
+	svc := &cargoService{
+		executor: func(_ context.Context, _ string, env []string, name string, args ...string) ([]byte, error) {
+			key := name + " " + strings.Join(args, " ")
+			calls = append(calls, cargoCall{command: key, env: env})
+			for prefix, response := range responses {
+				if !strings.HasPrefix(key, prefix) {
+					continue
+				}
+				if err, ok := response.(error); ok {
+					return nil, err
+				}
+				return []byte(response.(string)), nil
+			}
+			t.Fatalf("unexpected command: %s", key)
+			return nil, nil
+		},
+	}
+	return svc, dir, &calls
+}
+
+func TestCargoService_ReadCrate(t *testing.T) {
+	t.Run("Should read the crate of the manifest from cargo metadata", func(t *testing.T) {
+		svc, dir, _ := newTestCargoService(t, nil)
+		manifest := filepath.Join(dir, "Cargo.toml")
+		svc.executor = func(_ context.Context, _ string, _ []string, _ string, _ ...string) ([]byte, error) {
+			return []byte(`{"packages":[` +
+				`{"name":"acme-core","version":"1.2.0","manifest_path":"` + manifest + `","publish":null},` +
+				`{"name":"acme-bench","version":"0.1.0","manifest_path":"/other/Cargo.toml","publish":[]}]}`), nil
+		}
+		crate, err := svc.ReadCrate(t.Context(), dir)
+		require.NoError(t, err)
+		assert.Equal(t, CargoCrate{Name: "acme-core", Version: "1.2.0", Publishable: true}, crate)
+	})
+	t.Run("Should fail without a Cargo.toml", func(t *testing.T) {
+		svc := &cargoService{}
+		_, err := svc.ReadCrate(t.Context(), t.TempDir())
+		assert.ErrorContains(t, err, "Cargo.toml not found in directory")
+	})
+}
+
+func TestCargoService_CheckRegistry(t *testing.T) {
+	crate := CargoCrate{Name: "acme-core", Version: "1.2.0", Publishable: true}
+	t.Run("Should pass when the registry has an older version", func(t *testing.T) {
+		svc, _, _ := newTestCargoService(t, map[string]any{
+			"cargo search acme-core --limit 1 --registry internal": "acme-core = \"1.1.0\"    # Core library\n",
+		})
+		require.NoError(t, svc.CheckRegistry(t.Context(), crate, "internal"))
+	})
+	t.Run("Should report a version that is already published", func(t *testing.T) {
+		svc, _, _ := newTestCargoService(t, map[string]any{
+			"cargo search acme-core --limit 1": "acme-core = \"1.2.0\"    # Core library\n",
+		})
+		assert.ErrorIs(t, svc.CheckRegistry(t.Context(), crate, ""), ErrPackageVersionPublished)
+	})
+	t.Run("Should fail when the registry cannot be reached", func(t *testing.T) {
+		svc, _, _ := newTestCargoService(t, map[string]any{
+			"cargo search acme-core": errors.New("command failed (stderr: failed to query crates.io)"),
+		})
+		assert.ErrorContains(t, svc.CheckRegistry(t.Context(), crate, ""), "crate registry is not available")
+	})
+}
+
+func TestCargoService_Publish(t *testing.T) {
+	t.Run("Should publish with the token of the registry", func(t *testing.T) {
+		svc, dir, calls := newTestCargoService(t, map[string]any{"cargo publish": ""})
+		err := svc.Publish(t.Context(), dir, CargoPublishOptions{Registry: "my-crates", Token: "secret"})
+		require.NoError(t, err)
+		require.Len(t, *calls, 1)
+		manifest := filepath.Join(dir, "Cargo.toml")
+		assert.Equal(t, "cargo publish --manifest-path "+manifest+" --registry my-crates", (*calls)[0].command)
+		assert.Equal(t, []string{"CARGO_REGISTRIES_MY_CRATES_TOKEN=secret"}, (*calls)[0].env)
+	})
+	t.Run("Should package the crate without uploading on a dry run", func(t *testing.T) {
+		svc, dir, calls := newTestCargoService(t, map[string]any{"cargo publish": ""})
+		require.NoError(t, svc.Publish(t.Context(), dir, CargoPublishOptions{DryRun: true, NoVerify: true}))
+		require.Len(t, *calls, 1)
+		assert.True(t, strings.HasSuffix((*calls)[0].command, " --dry-run --no-verify"))
+		assert.Empty(t, (*calls)[0].env)
+	})
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/compozy/releasepr/internal/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	commitRecordSeparator = "\x1e"
+	commitFieldSeparator  = "\x1f"
+	// commitLogFormat emits "<sep>sha<field>message<field>" followed by the commit patch.
+	commitLogFormat = "--format=" + commitRecordSeparator + "%H" + commitFieldSeparator + "%B" + commitFieldSeparator
+)
+
+var cherryPickTrailerPattern = regexp.MustCompile(`\(cherry picked from commit ([0-9a-f]{7,64})\)`)
+
+// unreleasedCommit is a commit since the last tag with the keys used to detect duplicates.
+type unreleasedCommit struct {
+	sha        string
+	pickedFrom []string
+	patchID    string
+}
+
+// duplicateCommits returns unreleased commits that repeat a change already in the changelog range or
+// already released on this line: later copies of the same cherry-pick source or patch, and picks of
+// commits the last tag already contains. Failures are logged and disable deduplication.
+func (s *cliffService) duplicateCommits(ctx context.Context) []string {
+	log := logger.FromContext(ctx).Named("service.cliff")
+	lastTag := s.lastTag(ctx)
+	logRange := "HEAD"
+	if lastTag != "" {
+		logRange = lastTag + "..HEAD"
+	}
+	output, err := s.runCommand(ctx, "git", "log", "--no-merges", "-p", commitLogFormat, logRange)
+	if err != nil {
+		log.Warn("Skipping cherry-pick deduplication", zap.Error(err))
+		return nil
+	}
+	released := func(sha string) bool {
+		if lastTag == "" {
+			return false
+		}
+		_, err := s.runCommand(ctx, "git", "merge-base", "--is-ancestor", sha, lastTag)
+		return err == nil
+	}
+	duplicates := findDuplicateCommits(parseCommitLog(string(output)), released)
+	if len(duplicates) > 0 {
+		log.Info("Skipping duplicate cherry-picked commits", zap.Strings("commits", duplicates))
+	}
+	return duplicates
+}
+
+// findDuplicateCommits walks commits oldest first and keeps only the first occurrence of each change.
+func findDuplicateCommits(commits []unreleasedCommit, released func(sha string) bool) []string {
+	seen := make(map[string]bool)
+	var duplicates []string
+	for i := len(commits) - 1; i >= 0; i-- {
+		commit := commits[i]
+		keys := append([]string{commit.sha}, commit.pickedFrom...)
+		if commit.patchID != "" {
+			keys = append(keys, "patch:"+commit.patchID)
+		}
+		duplicate := slices.ContainsFunc(keys, func(key string) bool { return seen[key] }) ||
+			slices.ContainsFunc(commit.pickedFrom, released)
+		for _, key := range keys {
+			seen[key] = true
+		}
+		if duplicate {
+			duplicates = append(duplicates, commit.sha)
+		}
+	}
+	return duplicates
+}
+
+// parseCommitLog parses output produced with commitLogFormat.
+func parseCommitLog(output string) []unreleasedCommit {
+	var commits []unreleasedCommit
+	for record := range strings.SplitSeq(output, commitRecordSeparator) {
+		fields := strings.SplitN(record, commitFieldSeparator, 3)
+		if len(fields) != 3 {
+			continue
+		}
+		commit := unreleasedCommit{
+			sha:     strings.TrimSpace(fields[0]),
+			patchID: patchID(fields[2]),
+		}
+		for _, match := range cherryPickTrailerPattern.FindAllStringSubmatch(fields[1], -1) {
+			commit.pickedFrom = append(commit.pickedFrom, match[1])
+		}
+		commits = append(commits, commit)
+	}
+	return commits
+}
+
+// patchID hashes the changed file names and lines with whitespace removed, like git patch-id, so
+// the same change applied on different parents (a cherry-pick) hashes identically.
+func patchID(patch string) string {
+	hash := sha256.New()
+	changed := false
+	for line := range strings.SplitSeq(patch, "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			continue
+		case strings.HasPrefix(line, "+"), strings.HasPrefix(line, "-"):
+			changed = true
+		default:
+			continue
+		}
+		hash.Write([]byte(strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) {
+				return -1
+			}
+			return r
+		}, line)))
+		hash.Write([]byte{'\n'})
+	}
+	if !changed {
+		return ""
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// skipCommitArgs converts duplicate commits into git-cliff arguments.
+func skipCommitArgs(commits []string) []string {
+	if len(commits) == 0 {
+		return nil
+	}
+	return append([]string{"--skip-commit"}, commits...)
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func commitLogRecord(sha, message, patch string) string {
+	return commitRecordSeparator + sha + commitFieldSeparator + message + commitFieldSeparator + patch
+}
+
+func TestParseCommitLog(t *testing.T) {
+	t.Run("Should read cherry-pick trailers and patch ids", func(t *testing.T) {
+		patch := "\ndiff --git a/app.go b/app.go\nindex 1..2 100644\n--- a/app.go\n+++ b/app.go\n" +
+			"@@ -1 +1 @@\n-old line\n+new line\n"
+		output := commitLogRecord("bbb", "fix: backport\n\n(cherry picked from commit aaaaaaa1)\n", patch) +
+			commitLogRecord("aaa", "fix: original\n", strings.ReplaceAll(patch, "@@ -1 +1 @@", "@@ -10 +10 @@"))
+		commits := parseCommitLog(output)
+		require.Len(t, commits, 2)
+		assert.Equal(t, "bbb", commits[0].sha)
+		assert.Equal(t, []string{"aaaaaaa1"}, commits[0].pickedFrom)
+		assert.NotEmpty(t, commits[0].patchID)
+		assert.Equal(t, commits[0].patchID, commits[1].patchID)
+	})
+	t.Run("Should not assign patch ids to empty commits", func(t *testing.T) {
+		commits := parseCommitLog(commitLogRecord("aaa", "chore: empty\n", "\n"))
+		require.Len(t, commits, 1)
+		assert.Empty(t, commits[0].patchID)
+	})
+}
+
+func TestFindDuplicateCommits(t *testing.T) {
+	notReleased := func(string) bool { return false }
+	t.Run("Should keep the oldest copy of a cherry-picked change", func(t *testing.T) {
+		commits := []unreleasedCommit{
+			{sha: "ccc", pickedFrom: []string{"aaa"}},
+			{sha: "bbb", patchID: "p1"},
+			{sha: "aaa", patchID: "p2"},
+		}
+		assert.Equal(t, []string{"ccc"}, findDuplicateCommits(commits, notReleased))
+	})
+	t.Run("Should skip commits with the same patch as an earlier commit", func(t *testing.T) {
+		commits := []unreleasedCommit{
+			{sha: "bbb", patchID: "p1"},
+			{sha: "aaa", patchID: "p1"},
+		}
+		assert.Equal(t, []string{"bbb"}, findDuplicateCommits(commits, notReleased))
+	})
+	t.Run("Should skip picks of changes the last release already contains", func(t *testing.T) {
+		commits := []unreleasedCommit{
+			{sha: "bbb", pickedFrom: []string{"released"}, patchID: "p1"},
+			{sha: "aaa", patchID: "p2"},
+		}
+		released := func(sha string) bool { return sha == "released" }
+		assert.Equal(t, []string{"bbb"}, findDuplicateCommits(commits, released))
+	})
+	t.Run("Should keep distinct changes", func(t *testing.T) {
+		commits := []unreleasedCommit{
+			{sha: "bbb", patchID: "p1"},
+			{sha: "aaa", patchID: "p2"},
+			{sha: "000"},
+			{sha: "111"},
+		}
+		assert.Empty(t, findDuplicateCommits(commits, notReleased))
+	})
+}
+
+func TestCliffService_SkipDuplicateCommits(t *testing.T) {
+	t.Run("Should pass duplicate commits to git-cliff", func(t *testing.T) {
+		command := &capturedCommand{}
+		log := commitLogRecord("bbbbbbb", "fix: pick\n\n(cherry picked from commit aaaaaaa)\n", "") +
+			commitLogRecord("aaaaaaa", "fix: original\n", "")
+		svc := &cliffService{
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				switch {
+				case name == "git" && args[0] == "describe":
+					return []byte("v1.0.0\n"), nil
+				case name == "git" && args[0] == "log":
+					assert.Equal(t, "v1.0.0..HEAD", args[len(args)-1])
+					return []byte(log), nil
+				case name == "git":
+					return nil, assert.AnError
+				}
+				command.name = name
+				command.args = append([]string(nil), args...)
+				return []byte("## 1.0.1"), nil
+			},
+		}
+		_, err := svc.GenerateChangelog(t.Context(), "v1.0.1", "release")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"--unreleased", "--tag", "v1.0.1", "--strip", "all", "--skip-commit", "bbbbbbb"},
+			command.args)
+	})
+	t.Run("Should generate the changelog when the commit log is unavailable", func(t *testing.T) {
+		command := &capturedCommand{}
+		svc := &cliffService{
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				if name == "git" {
+					return nil, assert.AnError
+				}
+				command.args = append([]string(nil), args...)
+				return []byte("# Changelog"), nil
+			},
+		}
+		_, err := svc.GenerateFullChangelog(t.Context(), "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"-o", "-"}, command.args)
+	})
+	t.Run("Should detect cherry-picked commits in a real repository", func(t *testing.T) {
+		if _, err := exec.LookPath("git"); err != nil {
+			t.Skip("git is not installed")
+		}
+		dir := t.TempDir()
+		runGit(t, dir, "init", "-b", "main")
+		runGit(t, dir, "config", "user.name", "Release Test")
+		runGit(t, dir, "config", "user.email", "release@example.com")
+		writeFixtureFile(t, dir, "first")
+		runGit(t, dir, "add", "fixture.txt")
+		runGit(t, dir, "commit", "-m", "feat: first release")
+		runGit(t, dir, "tag", "v1.0.0")
+		runGit(t, dir, "checkout", "-b", "fix")
+		writeFixtureFile(t, dir, "fixed")
+		runGit(t, dir, "commit", "-am", "fix: repair fixture")
+		runGit(t, dir, "checkout", "main")
+		runGit(t, dir, "cherry-pick", "-x", "fix")
+		runGit(t, dir, "merge", "--no-ff", "-s", "ours", "-m", "Merge branch fix", "fix")
+		t.Chdir(dir)
+		svc := &cliffService{timeout: DefaultCliffTimeout}
+		assert.Len(t, svc.duplicateCommits(t.Context()), 1)
+	})
+}
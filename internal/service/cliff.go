@@ -12,4 +12,9 @@ type CliffService interface {
 	CalculateNextVersion(ctx context.Context, latestTag string) (*domain.Version, error)
 	GenerateChangelog(ctx context.Context, version, mode string) (string, error)
 	GenerateFullChangelog(ctx context.Context, version string) (string, error)
+	GenerateFilteredChangelog(ctx context.Context, version, mode string, filter domain.CommitFilter) (string, error)
+	GenerateFilteredFullChangelog(ctx context.Context, version string, filter domain.CommitFilter) (string, error)
+	// GenerateRangeChangelog renders the changelog of the commits reachable from to but not from from,
+	// grouped by the tags in between, without the commits filter excludes.
+	GenerateRangeChangelog(ctx context.Context, from, to string, filter domain.CommitFilter) (string, error)
 }
@@ -3,30 +3,76 @@ package service
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
 )
 
+// stableTagPattern matches final release tags so promotions skip prerelease tags.
+const stableTagPattern = `^v?[0-9]+\.[0-9]+\.[0-9]+$`
+
 type commandExecutor func(ctx context.Context, name string, args ...string) ([]byte, error)
 
+// CliffOptions locates the git-cliff configuration and passes extra flags to every git-cliff invocation.
+type CliffOptions struct {
+	// ConfigPath is passed as --config; git-cliff resolves it relative to WorkDir when both are set.
+	ConfigPath string
+	// WorkDir is passed as --workdir.
+	WorkDir string
+	// ExtraArgs are appended after the options above, before the flags of each command.
+	ExtraArgs []string
+	// PendingNotesPath names the file of manual changelog entries; each entry is passed to git-cliff
+	// as --with-commit so it counts toward the version bump and the changelog like a commit.
+	PendingNotesPath string
+	// CommitRules drop matching commits from the version bump and every changelog.
+	CommitRules domain.CommitRules
+}
+
+// Args prefixes args with the configured git-cliff options.
+func (o CliffOptions) Args(args ...string) []string {
+	var result []string
+	if o.ConfigPath != "" {
+		result = append(result, "--config", o.ConfigPath)
+	}
+	if o.WorkDir != "" {
+		result = append(result, "--workdir", o.WorkDir)
+	}
+	result = append(result, o.ExtraArgs...)
+	return append(result, args...)
+}
+
 // cliffService is the implementation of the CliffService interface.
 type cliffService struct {
 	timeout  time.Duration
 	executor commandExecutor
+	options  CliffOptions
+	// fs is used to read the pending notes file; defaults to the OS filesystem
+	fs afero.Fs
 }
 
-// NewCliffService creates a new CliffService.
-func NewCliffService() CliffService {
+// NewCliffService creates a new CliffService that reads the pending notes file through fsRepo.
+func NewCliffService(fsRepo afero.Fs, options CliffOptions) CliffService {
 	return &cliffService{
 		timeout: DefaultCliffTimeout,
+		options: options,
+		fs:      fsRepo,
 	}
 }
 
+func (s *cliffService) fileSystem() afero.Fs {
+	if s.fs != nil {
+		return s.fs
+	}
+	return afero.NewOsFs()
+}
+
 func (s *cliffService) runCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
 	if s.executor != nil {
 		return s.executor(ctx, name, args...)
@@ -34,6 +80,37 @@ func (s *cliffService) runCommand(ctx context.Context, name string, args ...stri
 	return s.executeCommand(ctx, name, args...)
 }
 
+func (s *cliffService) runCliff(ctx context.Context, args ...string) ([]byte, error) {
+	notes, err := s.pendingNoteArgs()
+	if err != nil {
+		return nil, err
+	}
+	return s.runCommand(ctx, "git-cliff", s.options.Args(append(args, notes...)...)...)
+}
+
+// pendingNoteArgs returns a --with-commit flag per entry of the pending notes file, if any.
+func (s *cliffService) pendingNoteArgs() ([]string, error) {
+	if s.options.PendingNotesPath == "" {
+		return nil, nil
+	}
+	data, err := afero.ReadFile(s.fileSystem(), s.options.PendingNotesPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pending notes: %w", err)
+	}
+	notes, err := domain.ParsePendingNotes(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", s.options.PendingNotesPath, err)
+	}
+	var args []string
+	for _, header := range notes.Headers() {
+		args = append(args, "--with-commit", header)
+	}
+	return args, nil
+}
+
 // sanitizeTag validates and sanitizes a git tag to prevent command injection.
 func (s *cliffService) sanitizeTag(tag string) error {
 	if tag == "" {
@@ -56,6 +133,24 @@ func (s *cliffService) sanitizeTag(tag string) error {
 	return nil
 }
 
+// sanitizeRef validates a git revision such as a tag, branch, commit or HEAD~3 passed in a range.
+func (s *cliffService) sanitizeRef(ref string) error {
+	if ref == "" {
+		return fmt.Errorf("ref cannot be empty")
+	}
+	validRef := regexp.MustCompile(`^[a-zA-Z0-9._/~^][a-zA-Z0-9._/~^\-]*$`)
+	if !validRef.MatchString(ref) {
+		return fmt.Errorf("invalid ref format: %s", ref)
+	}
+	if strings.Contains(ref, "..") {
+		return fmt.Errorf("invalid ref: ranges are built from --from and --to")
+	}
+	if len(ref) > 255 {
+		return fmt.Errorf("ref too long: maximum 255 characters")
+	}
+	return nil
+}
+
 // sanitizeVersion validates and sanitizes a version string.
 func (s *cliffService) sanitizeVersion(version string) error {
 	if version == "" {
@@ -80,6 +175,7 @@ func (s *cliffService) sanitizeMode(mode string) error {
 		"initial":    true,
 		"release":    true,
 		"update":     true,
+		"promotion":  true,
 		"":           true, // Empty mode defaults to current
 	}
 	if !validModes[mode] {
@@ -91,20 +187,25 @@ func (s *cliffService) sanitizeMode(mode string) error {
 // executeCommand runs a command with timeout and proper resource cleanup.
 func (s *cliffService) executeCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	ctx, cancel, budget := commandContext(ctx, s.timeout)
 	defer cancel()
 
 	cmd := exec.CommandContext(ctx, name, args...)
+	env, err := commandEnv(ctx, domain.EnvServiceCliff, nil)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Env = env
 
 	// Capture both stdout and stderr for better error handling
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
-	err := cmd.Run()
+	err = cmd.Run()
 	if err != nil {
 		if ctx.Err() == context.DeadlineExceeded {
-			return nil, fmt.Errorf("command timed out after %v", s.timeout)
+			return nil, budget.timeoutError()
 		}
 		// Include stderr in error message for debugging
 		errMsg := stderr.String()
@@ -129,8 +230,13 @@ func (s *cliffService) CalculateNextVersion(ctx context.Context, latestTag strin
 	// interpret the given tag as the *target* version, which results in the
 	// same tag being echoed back.  Therefore we only need --bumped-version.
 	args := []string{"--bumped-version"}
+	skipped, err := s.ruleSkippedCommits(ctx, s.unreleasedRange(ctx, ""))
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, skipCommitArgs(skipped)...)
 
-	output, err := s.runCommand(ctx, "git-cliff", args...)
+	output, err := s.runCliff(ctx, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute git-cliff: %w", err)
 	}
@@ -166,6 +272,12 @@ func (s *cliffService) changelogArgs(version, mode string) ([]string, error) {
 			return nil, fmt.Errorf("version required for release mode")
 		}
 		return []string{"--unreleased", "--tag", version, "--strip", "all"}, nil
+	case "promotion":
+		if version == "" {
+			return nil, fmt.Errorf("version required for promotion mode")
+		}
+		// Ignoring prerelease tags consolidates every rc since the last final release.
+		return []string{"--unreleased", "--tag", version, "--strip", "all", "--tag-pattern", stableTagPattern}, nil
 	default:
 		return []string{"--unreleased"}, nil
 	}
@@ -191,11 +303,33 @@ func (s *cliffService) validateChangelogOutput(output []byte) (string, error) {
 
 // GenerateChangelog generates a changelog.
 func (s *cliffService) GenerateChangelog(ctx context.Context, version, mode string) (string, error) {
+	return s.GenerateFilteredChangelog(ctx, version, mode, domain.CommitFilter{})
+}
+
+// GenerateFilteredChangelog generates a changelog without the commits filter excludes.
+func (s *cliffService) GenerateFilteredChangelog(
+	ctx context.Context,
+	version, mode string,
+	filter domain.CommitFilter,
+) (string, error) {
 	args, err := s.changelogArgs(version, mode)
 	if err != nil {
 		return "", err
 	}
-	output, err := s.runCommand(ctx, "git-cliff", args...)
+	skipped := s.duplicateCommits(ctx)
+	if !filter.IsZero() {
+		excluded, err := s.excludedCommits(ctx, s.unreleasedRange(ctx, mode), filter)
+		if err != nil {
+			return "", err
+		}
+		skipped = append(skipped, excluded...)
+	}
+	ruled, err := s.ruleSkippedCommits(ctx, s.unreleasedRange(ctx, mode))
+	if err != nil {
+		return "", err
+	}
+	args = append(args, skipCommitArgs(append(skipped, ruled...))...)
+	output, err := s.runCliff(ctx, args...)
 	if err != nil {
 		return "", fmt.Errorf("failed to execute git-cliff: %w", err)
 	}
@@ -204,10 +338,68 @@ func (s *cliffService) GenerateChangelog(ctx context.Context, version, mode stri
 
 // GenerateFullChangelog renders the complete changelog using git-cliff.
 func (s *cliffService) GenerateFullChangelog(ctx context.Context, version string) (string, error) {
+	return s.GenerateFilteredFullChangelog(ctx, version, domain.CommitFilter{})
+}
+
+// GenerateFilteredFullChangelog renders the complete changelog without the commits filter excludes.
+func (s *cliffService) GenerateFilteredFullChangelog(
+	ctx context.Context,
+	version string,
+	filter domain.CommitFilter,
+) (string, error) {
 	args, err := s.fullChangelogArgs(version)
 	if err != nil {
 		return "", err
 	}
+	skipped := s.duplicateCommits(ctx)
+	if !filter.IsZero() {
+		excluded, err := s.excludedCommits(ctx, "HEAD", filter)
+		if err != nil {
+			return "", err
+		}
+		skipped = append(skipped, excluded...)
+	}
+	ruled, err := s.ruleSkippedCommits(ctx, "HEAD")
+	if err != nil {
+		return "", err
+	}
+	args = append(args, skipCommitArgs(append(skipped, ruled...))...)
+	output, err := s.runCliff(ctx, args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute git-cliff: %w", err)
+	}
+	return s.validateChangelogOutput(output)
+}
+
+// GenerateRangeChangelog renders the changelog of the revision range from..to using git-cliff.
+func (s *cliffService) GenerateRangeChangelog(
+	ctx context.Context,
+	from, to string,
+	filter domain.CommitFilter,
+) (string, error) {
+	if err := s.sanitizeRef(from); err != nil {
+		return "", fmt.Errorf("invalid from: %w", err)
+	}
+	if err := s.sanitizeRef(to); err != nil {
+		return "", fmt.Errorf("invalid to: %w", err)
+	}
+	logRange := from + ".." + to
+	var skipped []string
+	if !filter.IsZero() {
+		excluded, err := s.excludedCommits(ctx, logRange, filter)
+		if err != nil {
+			return "", err
+		}
+		skipped = excluded
+	}
+	ruled, err := s.ruleSkippedCommits(ctx, logRange)
+	if err != nil {
+		return "", err
+	}
+	skipped = append(skipped, ruled...)
+	// Pending notes belong to the next release, not to a past range, so runCliff is not used. The
+	// range goes first because --skip-commit takes every value that follows it.
+	args := s.options.Args(append([]string{logRange}, skipCommitArgs(skipped)...)...)
 	output, err := s.runCommand(ctx, "git-cliff", args...)
 	if err != nil {
 		return "", fmt.Errorf("failed to execute git-cliff: %w", err)
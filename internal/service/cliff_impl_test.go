@@ -5,9 +5,12 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -48,6 +51,24 @@ func TestCliffService_GenerateChangelog(t *testing.T) {
 		assert.Equal(t, "git-cliff", command.name)
 		assert.Equal(t, []string{"--unreleased"}, command.args)
 	})
+	t.Run("Should ignore prerelease tags for promotion mode", func(t *testing.T) {
+		command := &capturedCommand{}
+		svc := &cliffService{
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				command.name = name
+				command.args = append([]string(nil), args...)
+				return []byte("## 1.4.0"), nil
+			},
+		}
+		changelog, err := svc.GenerateChangelog(t.Context(), "v1.4.0", "promotion")
+		require.NoError(t, err)
+		assert.Equal(t, "## 1.4.0", changelog)
+		assert.Equal(
+			t,
+			[]string{"--unreleased", "--tag", "v1.4.0", "--strip", "all", "--tag-pattern", stableTagPattern},
+			command.args,
+		)
+	})
 	t.Run("Should fail when release mode has no version", func(t *testing.T) {
 		svc := &cliffService{}
 		changelog, err := svc.GenerateChangelog(t.Context(), "", "release")
@@ -74,7 +95,7 @@ func TestCliffService_GenerateChangelogIntegration(t *testing.T) {
 		dir := t.TempDir()
 		initChangelogFixture(t, dir)
 		t.Chdir(dir)
-		svc := NewCliffService()
+		svc := NewCliffService(afero.NewOsFs(), CliffOptions{})
 		changelog, err := svc.GenerateChangelog(t.Context(), "v1.1.0", "release")
 		require.NoError(t, err)
 		assert.Contains(t, changelog, "## 1.1.0")
@@ -216,6 +237,77 @@ func TestCliffService_CalculateNextVersion(t *testing.T) {
 	})
 }
 
+func TestCliffService_Options(t *testing.T) {
+	options := CliffOptions{
+		ConfigPath: ".github/cliff.toml",
+		WorkDir:    "packages/core",
+		ExtraArgs:  []string{"--include-path", "packages/core/**"},
+	}
+	prefix := []string{
+		"--config", ".github/cliff.toml",
+		"--workdir", "packages/core",
+		"--include-path", "packages/core/**",
+	}
+	t.Run("Should pass config, workdir and extra args before the command flags", func(t *testing.T) {
+		var commands []capturedCommand
+		svc := &cliffService{
+			options: options,
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				commands = append(commands, capturedCommand{name: name, args: append([]string(nil), args...)})
+				if slices.Contains(args, "--bumped-version") {
+					return []byte("v1.2.3"), nil
+				}
+				return []byte("## 1.2.3"), nil
+			},
+		}
+		_, err := svc.CalculateNextVersion(t.Context(), "v1.2.2")
+		require.NoError(t, err)
+		_, err = svc.GenerateFilteredChangelog(t.Context(), "v1.2.3", "release", domain.CommitFilter{})
+		require.NoError(t, err)
+		var cliffArgs [][]string
+		for _, command := range commands {
+			if command.name == "git-cliff" {
+				cliffArgs = append(cliffArgs, command.args)
+			}
+		}
+		assert.Equal(t, [][]string{
+			append(slices.Clone(prefix), "--bumped-version"),
+			append(slices.Clone(prefix), "--unreleased", "--tag", "v1.2.3", "--strip", "all"),
+		}, cliffArgs)
+	})
+	t.Run("Should leave args untouched without options", func(t *testing.T) {
+		assert.Equal(t, []string{"--unreleased"}, CliffOptions{}.Args("--unreleased"))
+	})
+	t.Run("Should pass pending notes as extra commits", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		content := "# Pending changelog entries\n\n- feat(cli): add flag\n- revert: undo cache change\n"
+		require.NoError(t, afero.WriteFile(fs, domain.PendingNotesFile, []byte(content), 0o600))
+		var captured []string
+		svc := &cliffService{
+			options: CliffOptions{PendingNotesPath: domain.PendingNotesFile},
+			fs:      fs,
+			executor: func(_ context.Context, _ string, args ...string) ([]byte, error) {
+				captured = args
+				return []byte("v1.3.0"), nil
+			},
+		}
+		_, err := svc.CalculateNextVersion(t.Context(), "v1.2.2")
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"--bumped-version",
+			"--with-commit", "feat(cli): add flag",
+			"--with-commit", "revert: undo cache change",
+		}, captured)
+	})
+	t.Run("Should fail on a malformed pending notes file", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, domain.PendingNotesFile, []byte("- vendor sync\n"), 0o600))
+		svc := &cliffService{options: CliffOptions{PendingNotesPath: domain.PendingNotesFile}, fs: fs}
+		_, err := svc.GenerateChangelog(t.Context(), "v1.3.0", "release")
+		assert.ErrorContains(t, err, "invalid")
+	})
+}
+
 func TestCliffService_CalculateNextVersion_Compatibility(t *testing.T) {
 	t.Run("Should accept semantic version output with prerelease suffix", func(t *testing.T) {
 		svc := &cliffService{
@@ -231,3 +323,138 @@ func TestCliffService_CalculateNextVersion_Compatibility(t *testing.T) {
 		assert.Equal(t, expected.String(), version.String())
 	})
 }
+
+func TestCliffService_GenerateFilteredChangelog(t *testing.T) {
+	filter := domain.CommitFilter{ExcludeTypes: []string{"chore"}}
+	commits := "aaaaaaa" + commitFieldSeparator + "feat: public\n" +
+		"bbbbbbb" + commitFieldSeparator + "chore: bump deps\n"
+	t.Run("Should skip excluded commits since the last stable tag for promotions", func(t *testing.T) {
+		command := &capturedCommand{}
+		svc := &cliffService{
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				switch {
+				case name == "git" && args[0] == "describe":
+					if slices.Contains(args, "--exclude") {
+						return []byte("v1.3.0\n"), nil
+					}
+					return []byte("v1.4.0-rc.2\n"), nil
+				case name == "git" && args[0] == "log" && !slices.Contains(args, "-p"):
+					assert.Equal(t, "v1.3.0..HEAD", args[len(args)-1])
+					return []byte(commits), nil
+				case name == "git":
+					return nil, assert.AnError
+				}
+				command.args = append([]string(nil), args...)
+				return []byte("## 1.4.0"), nil
+			},
+		}
+		_, err := svc.GenerateFilteredChangelog(t.Context(), "v1.4.0", "promotion", filter)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"--skip-commit", "bbbbbbb"}, command.args[len(command.args)-2:])
+	})
+	t.Run("Should fail instead of leaking excluded commits when the log is unavailable", func(t *testing.T) {
+		svc := &cliffService{
+			executor: func(_ context.Context, name string, _ ...string) ([]byte, error) {
+				if name == "git" {
+					return nil, assert.AnError
+				}
+				return []byte("# Changelog"), nil
+			},
+		}
+		_, err := svc.GenerateFilteredFullChangelog(t.Context(), "", filter)
+		assert.ErrorContains(t, err, "failed to list commits for changelog filter")
+	})
+}
+
+func TestCliffService_CommitRules(t *testing.T) {
+	rules, err := domain.ParseCommitRules([]string{"renovate[bot]"}, []string{`^docs:`}, []string{"go.sum"})
+	require.NoError(t, err)
+	record := func(sha, author, message string, files ...string) string {
+		return commitRecordSeparator + sha + commitFieldSeparator + author + commitFieldSeparator +
+			"bot@example.com" + commitFieldSeparator + message + commitFieldSeparator + "\n" +
+			strings.Join(files, "\n") + "\n"
+	}
+	commits := record("aaaaaaa", "Jane", "feat: add x\n", "main.go") +
+		record("bbbbbbb", "renovate[bot]", "fix(deps): update y\n", "go.mod", "go.sum") +
+		record("ccccccc", "Jane", "docs: typo\n", "README.md") +
+		record("ddddddd", "Jane", "fix: tidy\n", "go.sum")
+	newService := func(command *capturedCommand) *cliffService {
+		return &cliffService{
+			options: CliffOptions{CommitRules: rules},
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				switch {
+				case name == "git" && args[0] == "describe":
+					return []byte("v1.2.0\n"), nil
+				case name == "git" && args[0] == "log" && slices.Contains(args, "--name-only"):
+					assert.Equal(t, "v1.2.0..HEAD", args[len(args)-1])
+					return []byte(commits), nil
+				case name == "git":
+					return nil, assert.AnError
+				}
+				command.args = append([]string(nil), args...)
+				return []byte("v1.3.0\n"), nil
+			},
+		}
+	}
+	t.Run("Should leave skipped commits out of the version bump", func(t *testing.T) {
+		command := &capturedCommand{}
+		_, err := newService(command).CalculateNextVersion(t.Context(), "v1.2.0")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"--bumped-version", "--skip-commit", "bbbbbbb", "ccccccc", "ddddddd"}, command.args)
+	})
+	t.Run("Should leave skipped commits out of the changelog", func(t *testing.T) {
+		command := &capturedCommand{}
+		_, err := newService(command).GenerateChangelog(t.Context(), "v1.3.0", "release")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"--skip-commit", "bbbbbbb", "ccccccc", "ddddddd"}, command.args[len(command.args)-4:])
+	})
+}
+
+func TestCliffService_GenerateRangeChangelog(t *testing.T) {
+	t.Run("Should render the range without pending notes", func(t *testing.T) {
+		dir := t.TempDir()
+		notes := filepath.Join(dir, domain.PendingNotesFile)
+		require.NoError(t, os.WriteFile(notes, []byte("- fix: pending entry\n"), 0o600))
+		command := &capturedCommand{}
+		svc := &cliffService{
+			options: CliffOptions{ConfigPath: "cliff.toml", PendingNotesPath: notes},
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				command.name = name
+				command.args = append([]string(nil), args...)
+				return []byte("## 1.2.3\n"), nil
+			},
+		}
+		changelog, err := svc.GenerateRangeChangelog(t.Context(), "v1.2.0", "hotfix/1.2", domain.CommitFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, "## 1.2.3", changelog)
+		assert.Equal(t, "git-cliff", command.name)
+		assert.Equal(t, []string{"--config", "cliff.toml", "v1.2.0..hotfix/1.2"}, command.args)
+	})
+	t.Run("Should skip the commits of the range the filter excludes", func(t *testing.T) {
+		command := &capturedCommand{}
+		svc := &cliffService{
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				if name == "git" {
+					assert.Equal(t, "v1.2.0..HEAD~2", args[len(args)-1])
+					return []byte("aaaaaaa" + commitFieldSeparator + "feat: public\n" +
+						"bbbbbbb" + commitFieldSeparator + "chore: bump deps\n"), nil
+				}
+				command.args = append([]string(nil), args...)
+				return []byte("## 1.2.1"), nil
+			},
+		}
+		filter := domain.CommitFilter{ExcludeTypes: []string{"chore"}}
+		_, err := svc.GenerateRangeChangelog(t.Context(), "v1.2.0", "HEAD~2", filter)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"v1.2.0..HEAD~2", "--skip-commit", "bbbbbbb"}, command.args)
+	})
+	t.Run("Should reject refs that are ranges or flags", func(t *testing.T) {
+		svc := &cliffService{}
+		_, err := svc.GenerateRangeChangelog(t.Context(), "v1.0.0..v1.1.0", "HEAD", domain.CommitFilter{})
+		assert.ErrorContains(t, err, "invalid from")
+		_, err = svc.GenerateRangeChangelog(t.Context(), "v1.0.0", "--output=x", domain.CommitFilter{})
+		assert.ErrorContains(t, err, "invalid to")
+		_, err = svc.GenerateRangeChangelog(t.Context(), "", "HEAD", domain.CommitFilter{})
+		assert.ErrorContains(t, err, "ref cannot be empty")
+	})
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// EnvResolver returns the extra KEY=value entries configured for the commands of service. It is
+// called each time one of those commands starts, so values are resolved when they are used.
+type EnvResolver func(service string) ([]string, error)
+
+type envResolverKey struct{}
+
+// EnvResolverIntoContext returns a copy of ctx whose external commands get the extra environment
+// resolver returns for their service.
+func EnvResolverIntoContext(ctx context.Context, resolver EnvResolver) context.Context {
+	return context.WithValue(ctx, envResolverKey{}, resolver)
+}
+
+// commandEnv returns the environment of a command of service: base, or the inherited environment
+// when base is nil, followed by the extra entries configured for the service, which take precedence.
+func commandEnv(ctx context.Context, service string, base []string) ([]string, error) {
+	resolve, _ := ctx.Value(envResolverKey{}).(EnvResolver)
+	if resolve == nil {
+		return base, nil
+	}
+	extra, err := resolve(service)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the %s environment: %w", service, err)
+	}
+	if len(extra) == 0 {
+		return base, nil
+	}
+	if base == nil {
+		base = os.Environ()
+	}
+	return append(base, extra...), nil
+}
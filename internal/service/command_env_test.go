@@ -0,0 +1,40 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandEnv(t *testing.T) {
+	t.Run("Should give the commands of a service the environment configured for it", func(t *testing.T) {
+		t.Setenv("RELEASE_TOKEN", "from-workflow")
+		ctx := EnvResolverIntoContext(t.Context(), func(service string) ([]string, error) {
+			if service != domain.EnvServiceCosign {
+				return nil, nil
+			}
+			return []string{"RELEASE_TOKEN=for-cosign"}, nil
+		})
+		svc := &cosignService{timeout: time.Minute}
+		output, err := svc.executeCommand(ctx, "sh", "-c", "echo $RELEASE_TOKEN")
+		require.NoError(t, err)
+		assert.Equal(t, "for-cosign", strings.TrimSpace(string(output)))
+		cliff := &cliffService{timeout: time.Minute}
+		output, err = cliff.executeCommand(ctx, "sh", "-c", "echo $RELEASE_TOKEN")
+		require.NoError(t, err)
+		assert.Equal(t, "from-workflow", strings.TrimSpace(string(output)))
+	})
+	t.Run("Should fail the command when its environment cannot be resolved", func(t *testing.T) {
+		ctx := EnvResolverIntoContext(t.Context(), func(string) ([]string, error) {
+			return nil, errors.New("GORELEASER_TOKEN is unset")
+		})
+		svc := &cosignService{timeout: time.Minute}
+		_, err := svc.executeCommand(ctx, "true")
+		require.ErrorContains(t, err, "failed to resolve the cosign environment: GORELEASER_TOKEN is unset")
+	})
+}
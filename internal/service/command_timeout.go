@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// commandBudget is the time an external command may run: its own timeout, cut short by the deadline
+// of the workflow that runs it when that comes first.
+type commandBudget struct {
+	timeout       time.Duration
+	start         time.Time
+	workflowBound bool
+}
+
+// commandContext bounds ctx by the timeout of one command. The command ends at the earlier of that
+// timeout and the deadline of ctx, so it never outlives the workflow budget.
+func commandContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc, commandBudget) {
+	budget := commandBudget{timeout: timeout, start: time.Now()}
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(budget.start.Add(timeout)) {
+		budget.workflowBound = true
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, budget
+}
+
+// timeoutError reports a command that ran out of time, naming the budget it exhausted. It wraps
+// context.DeadlineExceeded.
+func (b commandBudget) timeoutError() error {
+	elapsed := time.Since(b.start).Round(time.Millisecond)
+	if b.workflowBound {
+		return fmt.Errorf("command stopped after %v: workflow deadline reached before the %v command timeout: %w",
+			elapsed, b.timeout, context.DeadlineExceeded)
+	}
+	return fmt.Errorf("command timed out after %v: command timeout exhausted: %w", b.timeout, context.DeadlineExceeded)
+}
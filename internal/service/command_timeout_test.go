@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandContext(t *testing.T) {
+	t.Run("Should report the command timeout when it is the earlier budget", func(t *testing.T) {
+		svc := &toolVersionService{timeout: 50 * time.Millisecond}
+		_, err := svc.executeCommand(t.Context(), "sleep", "5")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, "command timed out after 50ms: command timeout exhausted")
+	})
+	t.Run("Should stop at the workflow deadline when it comes first", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+		svc := &toolVersionService{timeout: time.Minute}
+		start := time.Now()
+		_, err := svc.executeCommand(ctx, "sleep", "5")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, "workflow deadline reached before the 1m0s command timeout")
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/logger"
+	"go.uber.org/zap"
+)
+
+// lastTag returns the most recent tag reachable from HEAD, or "" when there is none.
+func (s *cliffService) lastTag(ctx context.Context, extraArgs ...string) string {
+	args := append([]string{"describe", "--tags", "--abbrev=0"}, extraArgs...)
+	output, err := s.runCommand(ctx, "git", args...)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// unreleasedRange returns the revision range git-cliff renders for a changelog mode.
+func (s *cliffService) unreleasedRange(ctx context.Context, mode string) string {
+	var describeArgs []string
+	if mode == "promotion" {
+		// Prerelease tags carry a "-" suffix; promotions span every rc since the last final release.
+		describeArgs = []string{"--exclude", "*-*"}
+	}
+	tag := s.lastTag(ctx, describeArgs...)
+	if tag == "" {
+		return "HEAD"
+	}
+	return tag + "..HEAD"
+}
+
+// excludedCommits lists the commits in logRange that filter removes from the changelog.
+func (s *cliffService) excludedCommits(
+	ctx context.Context,
+	logRange string,
+	filter domain.CommitFilter,
+) ([]string, error) {
+	output, err := s.runCommand(ctx, "git", "log", "--no-merges", "--format=%H"+commitFieldSeparator+"%s", logRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits for changelog filter: %w", err)
+	}
+	var excluded []string
+	for line := range strings.SplitSeq(string(output), "\n") {
+		sha, subject, ok := strings.Cut(line, commitFieldSeparator)
+		if ok && filter.Excludes(subject) {
+			excluded = append(excluded, strings.TrimSpace(sha))
+		}
+	}
+	return excluded, nil
+}
+
+// commitRulesLogFormat emits "<sep>sha<field>author<field>email<field>message<field>" followed by
+// the files the commit changed, as listed by --name-only.
+const commitRulesLogFormat = "--format=" + commitRecordSeparator + "%H" + commitFieldSeparator + "%an" +
+	commitFieldSeparator + "%ae" + commitFieldSeparator + "%B" + commitFieldSeparator
+
+// ruleSkippedCommits lists the commits in logRange the configured commit rules drop.
+func (s *cliffService) ruleSkippedCommits(ctx context.Context, logRange string) ([]string, error) {
+	rules := s.options.CommitRules
+	if rules.IsZero() {
+		return nil, nil
+	}
+	output, err := s.runCommand(ctx, "git", "log", "--no-merges", "--name-only", commitRulesLogFormat, logRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits for commit rules: %w", err)
+	}
+	var skipped []string
+	for record := range strings.SplitSeq(string(output), commitRecordSeparator) {
+		fields := strings.SplitN(record, commitFieldSeparator, 5)
+		if len(fields) != 5 {
+			continue
+		}
+		commit := domain.CommitDetails{AuthorName: fields[1], AuthorEmail: fields[2], Message: fields[3]}
+		for file := range strings.SplitSeq(fields[4], "\n") {
+			if file = strings.TrimSpace(file); file != "" {
+				commit.Files = append(commit.Files, file)
+			}
+		}
+		if rules.Skips(commit) {
+			skipped = append(skipped, strings.TrimSpace(fields[0]))
+		}
+	}
+	if len(skipped) > 0 {
+		logger.FromContext(ctx).Named("service.cliff").Info("Skipping commits matched by commit rules",
+			zap.Strings("commits", skipped))
+	}
+	return skipped, nil
+}
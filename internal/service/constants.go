@@ -8,4 +8,12 @@ const (
 	DefaultCliffTimeout = 30 * time.Second
 	// DefaultNPMTimeout is the timeout for npm operations
 	DefaultNPMTimeout = 60 * time.Second
+	// DefaultCargoTimeout is the timeout for cargo operations, which compile the crate before publishing
+	DefaultCargoTimeout = 15 * time.Minute
+	// DefaultPyPITimeout is the timeout for building and uploading Python packages
+	DefaultPyPITimeout = 10 * time.Minute
+	// DefaultCosignTimeout is the timeout for cosign operations, including keyless certificate issuance
+	DefaultCosignTimeout = 2 * time.Minute
+	// DefaultToolVersionTimeout is the timeout for reading the version of an external tool
+	DefaultToolVersionTimeout = 10 * time.Second
 )
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/compozy/releasepr/internal/domain"
+)
+
+// CosignService defines the interface for signing release files with cosign.
+
+type CosignService interface {
+	// SignBlob signs the file at path with key, or keylessly when key is empty, writing the signature
+	// (and keyless certificate) next to the file.
+	SignBlob(ctx context.Context, path, key string) (domain.SignedFile, error)
+	// VerifyBlob verifies the keyless signature and certificate of signed, accepting only certificates
+	// GitHub Actions issued to a workflow of owner/repo.
+	VerifyBlob(ctx context.Context, signed domain.SignedFile, owner, repo string) error
+}
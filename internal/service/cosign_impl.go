@@ -0,0 +1,96 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+)
+
+// Suffixes of the files cosign writes next to a signed blob.
+const (
+	cosignSignatureSuffix   = ".sig"
+	cosignCertificateSuffix = ".pem"
+)
+
+// cosignService is the implementation of the CosignService interface.
+type cosignService struct {
+	timeout  time.Duration
+	executor commandExecutor
+}
+
+// NewCosignService creates a new CosignService.
+func NewCosignService() CosignService {
+	return &cosignService{timeout: DefaultCosignTimeout}
+}
+
+// SignBlob runs cosign sign-blob non-interactively for path.
+func (s *cosignService) SignBlob(ctx context.Context, path, key string) (domain.SignedFile, error) {
+	if path == "" {
+		return domain.SignedFile{}, fmt.Errorf("path required to sign a file")
+	}
+	signed := domain.SignedFile{Path: path, Signature: path + cosignSignatureSuffix}
+	args := []string{"sign-blob", "--yes", "--output-signature", signed.Signature}
+	if key != "" {
+		args = append(args, "--key", key)
+	} else {
+		signed.Certificate = path + cosignCertificateSuffix
+		args = append(args, "--output-certificate", signed.Certificate)
+	}
+	args = append(args, path)
+	if _, err := s.runCommand(ctx, "cosign", args...); err != nil {
+		return domain.SignedFile{}, fmt.Errorf("failed to sign %s: %w", path, err)
+	}
+	return signed, nil
+}
+
+// VerifyBlob runs cosign verify-blob for the keyless signature of signed.
+func (s *cosignService) VerifyBlob(ctx context.Context, signed domain.SignedFile, owner, repo string) error {
+	args := []string{
+		"verify-blob",
+		"--certificate", signed.Certificate,
+		"--signature", signed.Signature,
+		"--certificate-identity-regexp", domain.KeylessIdentityRegexp(owner, repo),
+		"--certificate-oidc-issuer", domain.GitHubActionsOIDCIssuer,
+		signed.Path,
+	}
+	if _, err := s.runCommand(ctx, "cosign", args...); err != nil {
+		return fmt.Errorf("failed to verify the signature of %s: %w", signed.Path, err)
+	}
+	return nil
+}
+
+func (s *cosignService) runCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	if s.executor != nil {
+		return s.executor(ctx, name, args...)
+	}
+	return s.executeCommand(ctx, name, args...)
+}
+
+// executeCommand runs a command with timeout and proper resource cleanup.
+func (s *cosignService) executeCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	ctx, cancel, budget := commandContext(ctx, s.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, name, args...)
+	env, err := commandEnv(ctx, domain.EnvServiceCosign, nil)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Env = env
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, budget.timeoutError()
+		}
+		if errMsg := stderr.String(); errMsg != "" {
+			return nil, fmt.Errorf("command failed: %w (stderr: %s)", err, errMsg)
+		}
+		return nil, fmt.Errorf("command failed: %w", err)
+	}
+	return stdout.Bytes(), nil
+}
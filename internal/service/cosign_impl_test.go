@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCosignService_SignBlob(t *testing.T) {
+	t.Run("Should sign keylessly and write the certificate next to the file", func(t *testing.T) {
+		command := &capturedCommand{}
+		svc := &cosignService{
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				command.name = name
+				command.args = append([]string(nil), args...)
+				return nil, nil
+			},
+		}
+		signed, err := svc.SignBlob(t.Context(), "dist/checksums.txt", "")
+		require.NoError(t, err)
+		assert.Equal(t, domain.SignedFile{
+			Path:        "dist/checksums.txt",
+			Signature:   "dist/checksums.txt.sig",
+			Certificate: "dist/checksums.txt.pem",
+		}, signed)
+		assert.Equal(t, "cosign", command.name)
+		assert.Equal(t, []string{
+			"sign-blob", "--yes",
+			"--output-signature", "dist/checksums.txt.sig",
+			"--output-certificate", "dist/checksums.txt.pem",
+			"dist/checksums.txt",
+		}, command.args)
+	})
+	t.Run("Should sign with the configured key without a certificate", func(t *testing.T) {
+		command := &capturedCommand{}
+		svc := &cosignService{
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				command.name = name
+				command.args = append([]string(nil), args...)
+				return nil, nil
+			},
+		}
+		signed, err := svc.SignBlob(t.Context(), "dist/v1.2.0.tag", "env://COSIGN_PRIVATE_KEY")
+		require.NoError(t, err)
+		assert.Empty(t, signed.Certificate)
+		assert.Equal(t, []string{
+			"sign-blob", "--yes",
+			"--output-signature", "dist/v1.2.0.tag.sig",
+			"--key", "env://COSIGN_PRIVATE_KEY",
+			"dist/v1.2.0.tag",
+		}, command.args)
+	})
+	t.Run("Should wrap cosign failures with the file being signed", func(t *testing.T) {
+		svc := &cosignService{
+			executor: func(_ context.Context, _ string, _ ...string) ([]byte, error) {
+				return nil, assert.AnError
+			},
+		}
+		_, err := svc.SignBlob(t.Context(), "dist/checksums.txt", "")
+		require.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to sign dist/checksums.txt")
+	})
+}
+
+func TestCosignService_VerifyBlob(t *testing.T) {
+	t.Run("Should only accept certificates issued to the workflows of the repository", func(t *testing.T) {
+		command := &capturedCommand{}
+		svc := &cosignService{
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				command.name = name
+				command.args = append([]string(nil), args...)
+				return nil, nil
+			},
+		}
+		signed := domain.SignedFile{
+			Path:        "checksums.txt",
+			Signature:   "checksums.txt.sig",
+			Certificate: "checksums.txt.pem",
+		}
+		require.NoError(t, svc.VerifyBlob(t.Context(), signed, "compozy", "releasepr"))
+		assert.Equal(t, "cosign", command.name)
+		assert.Equal(t, []string{
+			"verify-blob",
+			"--certificate", "checksums.txt.pem",
+			"--signature", "checksums.txt.sig",
+			"--certificate-identity-regexp", "^https://github.com/compozy/releasepr/",
+			"--certificate-oidc-issuer", "https://token.actions.githubusercontent.com",
+			"checksums.txt",
+		}, command.args)
+	})
+}
@@ -6,4 +6,6 @@ import "context"
 
 type GoReleaserService interface {
 	Run(ctx context.Context, args ...string) error
+	// RunWithEnv runs goreleaser with env (KEY=value entries) added to the inherited environment.
+	RunWithEnv(ctx context.Context, env []string, args ...string) error
 }
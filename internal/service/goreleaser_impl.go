@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+
+	"github.com/compozy/releasepr/internal/domain"
 )
 
 // goReleaserService implements the GoReleaserService interface
@@ -17,7 +19,21 @@ func NewGoReleaserService() GoReleaserService {
 
 // Run executes goreleaser with the provided arguments
 func (s *goReleaserService) Run(ctx context.Context, args ...string) error {
+	return s.RunWithEnv(ctx, nil, args...)
+}
+
+// RunWithEnv executes goreleaser with extra environment variables available to its templates
+func (s *goReleaserService) RunWithEnv(ctx context.Context, env []string, args ...string) error {
 	cmd := exec.CommandContext(ctx, "goreleaser", args...)
+	var base []string
+	if len(env) > 0 {
+		base = append(os.Environ(), env...)
+	}
+	cmdEnv, err := commandEnv(ctx, domain.EnvServiceGoReleaser, base)
+	if err != nil {
+		return err
+	}
+	cmd.Env = cmdEnv
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	if err := cmd.Run(); err != nil {
@@ -6,4 +6,7 @@ import "context"
 
 type NpmService interface {
 	Publish(ctx context.Context, path string) error
+	ValidatePackageVersion(ctx context.Context, path, version string) error
+	// AddDistTag points the dist-tag of the package at path to version.
+	AddDistTag(ctx context.Context, path, version, tag string) error
 }
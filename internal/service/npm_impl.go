@@ -3,27 +3,53 @@ package service
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+)
+
+const (
+	githubActionsTrue = "true"
+	// npmReadWrite is the collaborator permission required to publish a package.
+	npmReadWrite = "read-write"
 )
 
-const githubActionsTrue = "true"
+// ErrPackageVersionPublished reports that the registry already has the version being released.
+var ErrPackageVersionPublished = errors.New("package version is already published")
+
+type npmExecutor func(ctx context.Context, dir string, name string, args ...string) ([]byte, error)
 
 // npmService is the implementation of the NpmService interface.
 type npmService struct {
 	// timeout for command execution
 	timeout time.Duration
+	// executor replaces outputCommand in tests
+	executor npmExecutor
+	// fs is used for package checks; defaults to the OS filesystem
+	fs afero.Fs
 }
 
-// NewNpmService creates a new NpmService.
-func NewNpmService() NpmService {
+// NewNpmService creates a new NpmService that reads packages through fsRepo.
+func NewNpmService(fsRepo afero.Fs) NpmService {
 	return &npmService{
 		timeout: DefaultNPMTimeout,
+		fs:      fsRepo,
+	}
+}
+
+func (s *npmService) fileSystem() afero.Fs {
+	if s.fs != nil {
+		return s.fs
 	}
+	return afero.NewOsFs()
 }
 
 // resolvePathWithSymlinks resolves a path and evaluates symlinks.
@@ -90,7 +116,7 @@ func (s *npmService) sanitizePath(path string) (string, error) {
 		return "", err
 	}
 	// Check if the path exists
-	if _, err := os.Stat(absPath); err != nil {
+	if _, err := s.fileSystem().Stat(absPath); err != nil {
 		if os.IsNotExist(err) {
 			return "", fmt.Errorf("path does not exist: %s", absPath)
 		}
@@ -98,7 +124,7 @@ func (s *npmService) sanitizePath(path string) (string, error) {
 	}
 	// Check if package.json exists in the directory
 	packageJSONPath := filepath.Join(absPath, "package.json")
-	if _, err := os.Stat(packageJSONPath); err != nil {
+	if _, err := s.fileSystem().Stat(packageJSONPath); err != nil {
 		if os.IsNotExist(err) {
 			return "", fmt.Errorf("package.json not found in directory: %s", absPath)
 		}
@@ -107,24 +133,63 @@ func (s *npmService) sanitizePath(path string) (string, error) {
 	return absPath, nil
 }
 
+// npmEnv returns the process environment with npm authentication normalized.
+func npmEnv() []string {
+	// Ensure NPM authentication works with both NPM_TOKEN and NODE_AUTH_TOKEN
+	// GitHub Actions setup-node with registry-url uses NODE_AUTH_TOKEN
+	// Standard npm CLI uses NPM_TOKEN
+	env := os.Environ()
+	if npmToken := os.Getenv("NPM_TOKEN"); npmToken != "" && os.Getenv("NODE_AUTH_TOKEN") == "" {
+		// If NPM_TOKEN is set but NODE_AUTH_TOKEN is not, set NODE_AUTH_TOKEN
+		// This ensures compatibility with GitHub Actions setup-node
+		env = append(env, "NODE_AUTH_TOKEN="+npmToken)
+	}
+	return env
+}
+
+// outputCommand runs a command with timeout and returns its stdout.
+func (s *npmService) outputCommand(ctx context.Context, dir string, name string, args ...string) ([]byte, error) {
+	if s.executor != nil {
+		return s.executor(ctx, dir, name, args...)
+	}
+	ctx, cancel, budget := commandContext(ctx, s.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	env, err := commandEnv(ctx, domain.EnvServiceNpm, npmEnv())
+	if err != nil {
+		return nil, err
+	}
+	cmd.Env = env
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, budget.timeoutError()
+		}
+		if errMsg := strings.TrimSpace(stderr.String()); errMsg != "" {
+			return nil, fmt.Errorf("command failed: %w (stderr: %s)", err, errMsg)
+		}
+		return nil, fmt.Errorf("command failed: %w", err)
+	}
+	return stdout.Bytes(), nil
+}
+
 // executeCommand runs a command with timeout and proper resource cleanup.
 func (s *npmService) executeCommand(ctx context.Context, dir string, name string, args ...string) error {
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	ctx, cancel, budget := commandContext(ctx, s.timeout)
 	defer cancel()
 
 	cmd := exec.CommandContext(ctx, name, args...)
 	cmd.Dir = dir
 
-	// Ensure NPM authentication works with both NPM_TOKEN and NODE_AUTH_TOKEN
-	// GitHub Actions setup-node with registry-url uses NODE_AUTH_TOKEN
-	// Standard npm CLI uses NPM_TOKEN
-	cmd.Env = os.Environ()
-	if npmToken := os.Getenv("NPM_TOKEN"); npmToken != "" && os.Getenv("NODE_AUTH_TOKEN") == "" {
-		// If NPM_TOKEN is set but NODE_AUTH_TOKEN is not, set NODE_AUTH_TOKEN
-		// This ensures compatibility with GitHub Actions setup-node
-		cmd.Env = append(cmd.Env, "NODE_AUTH_TOKEN="+npmToken)
+	env, err := commandEnv(ctx, domain.EnvServiceNpm, npmEnv())
+	if err != nil {
+		return err
 	}
+	cmd.Env = env
 
 	// Stream output to stdout/stderr for CI visibility
 	if os.Getenv("GITHUB_ACTIONS") == githubActionsTrue {
@@ -137,10 +202,10 @@ func (s *npmService) executeCommand(ctx context.Context, dir string, name string
 		cmd.Stderr = &stderr
 	}
 
-	err := cmd.Run()
+	err = cmd.Run()
 	if err != nil {
 		if ctx.Err() == context.DeadlineExceeded {
-			return fmt.Errorf("command timed out after %v", s.timeout)
+			return budget.timeoutError()
 		}
 		return fmt.Errorf("command failed: %w", err)
 	}
@@ -167,3 +232,95 @@ func (s *npmService) Publish(ctx context.Context, path string) error {
 
 	return nil
 }
+
+// AddDistTag points tag, such as latest, to the published version of the package at path, making
+// it the version npm installs for that tag. Private packages are never published and are skipped.
+func (s *npmService) AddDistTag(ctx context.Context, path, version, tag string) error {
+	safePath, err := s.sanitizePath(path)
+	if err != nil {
+		return fmt.Errorf("invalid package path: %w", err)
+	}
+	manifest, err := readPackageManifest(s.fileSystem(), safePath)
+	if err != nil {
+		return err
+	}
+	if manifest.Private {
+		return nil
+	}
+	spec := manifest.Name + "@" + strings.TrimPrefix(version, "v")
+	if _, err := s.outputCommand(ctx, safePath, "npm", "dist-tag", "add", spec, tag); err != nil {
+		return fmt.Errorf("failed to tag %s as %s: %w", spec, tag, err)
+	}
+	return nil
+}
+
+// ValidatePackageVersion checks, before anything is published, that the registry does not already
+// have version of the package at path and that the authenticated npm user may publish it.
+// Private packages are never published and always pass.
+func (s *npmService) ValidatePackageVersion(ctx context.Context, path, version string) error {
+	safePath, err := s.sanitizePath(path)
+	if err != nil {
+		return fmt.Errorf("invalid package path: %w", err)
+	}
+	manifest, err := readPackageManifest(s.fileSystem(), safePath)
+	if err != nil {
+		return err
+	}
+	if manifest.Private {
+		return nil
+	}
+	version = strings.TrimPrefix(version, "v")
+	existing, err := s.outputCommand(ctx, safePath, "npm", "view", manifest.Name+"@"+version, "version")
+	newPackage := err != nil && strings.Contains(err.Error(), "E404")
+	if err != nil && !newPackage {
+		return fmt.Errorf("failed to query registry for %s: %w", manifest.Name, err)
+	}
+	if strings.TrimSpace(string(existing)) != "" {
+		return fmt.Errorf("%s@%s: %w", manifest.Name, version, ErrPackageVersionPublished)
+	}
+	user, err := s.outputCommand(ctx, safePath, "npm", "whoami")
+	if err != nil {
+		return fmt.Errorf("npm is not authenticated: %w", err)
+	}
+	if newPackage {
+		// Nobody owns an unpublished name yet; the first publish claims it.
+		return nil
+	}
+	return s.checkPublishRights(ctx, safePath, manifest.Name, strings.TrimSpace(string(user)))
+}
+
+// checkPublishRights verifies that user is a read-write collaborator of the package.
+func (s *npmService) checkPublishRights(ctx context.Context, dir, name, user string) error {
+	output, err := s.outputCommand(ctx, dir, "npm", "access", "list", "collaborators", name, "--json")
+	if err != nil {
+		return fmt.Errorf("failed to list collaborators of %s: %w", name, err)
+	}
+	var collaborators map[string]string
+	if err := json.Unmarshal(output, &collaborators); err != nil {
+		return fmt.Errorf("failed to parse collaborators of %s: %w", name, err)
+	}
+	if collaborators[user] != npmReadWrite {
+		return fmt.Errorf("npm user %s does not have publish rights for %s", user, name)
+	}
+	return nil
+}
+
+type packageManifest struct {
+	Name    string `json:"name"`
+	Private bool   `json:"private"`
+}
+
+func readPackageManifest(fs afero.Fs, dir string) (packageManifest, error) {
+	var manifest packageManifest
+	data, err := afero.ReadFile(fs, filepath.Join(dir, "package.json"))
+	if err != nil {
+		return manifest, fmt.Errorf("failed to read package.json: %w", err)
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return manifest, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+	if manifest.Name == "" && !manifest.Private {
+		return manifest, fmt.Errorf("package.json in %s has no name", dir)
+	}
+	return manifest, nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// npmResponses maps "npm <args>" to the output or error the fake registry returns.
+type npmResponses map[string]any
+
+func newTestNpmService(t *testing.T, manifest string, responses npmResponses) (*npmService, string) {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(manifest), 0o644))
+	t.Chdir(dir)
+	svc := &npmService{
+		executor: func(_ context.Context, _ string, name string, args ...string) ([]byte, error) {
+			key := name + " " + strings.Join(args, " ")
+			switch response := responses[key].(type) {
+			case string:
+				return []byte(response), nil
+			case error:
+				return nil, response
+			}
+			t.Fatalf("unexpected command: %s", key)
+			return nil, nil
+		},
+	}
+	return svc, dir
+}
+
+func TestNpmService_ValidatePackageVersion(t *testing.T) {
+	const manifest = `{"name":"@compozy/cli"}`
+	collaborators := "npm access list collaborators @compozy/cli --json"
+	t.Run("Should pass when the version is new and the user can publish", func(t *testing.T) {
+		svc, dir := newTestNpmService(t, manifest, npmResponses{
+			"npm view @compozy/cli@1.2.0 version": "",
+			"npm whoami":                          "releaser\n",
+			collaborators:                         `{"releaser":"read-write","reader":"read-only"}`,
+		})
+		require.NoError(t, svc.ValidatePackageVersion(t.Context(), dir, "v1.2.0"))
+	})
+	t.Run("Should fail when the version is already published", func(t *testing.T) {
+		svc, dir := newTestNpmService(t, manifest, npmResponses{
+			"npm view @compozy/cli@1.2.0 version": "1.2.0\n",
+		})
+		err := svc.ValidatePackageVersion(t.Context(), dir, "1.2.0")
+		assert.ErrorIs(t, err, ErrPackageVersionPublished)
+	})
+	t.Run("Should fail when the user lacks publish rights", func(t *testing.T) {
+		svc, dir := newTestNpmService(t, manifest, npmResponses{
+			"npm view @compozy/cli@1.2.0 version": "",
+			"npm whoami":                          "reader",
+			collaborators:                         `{"releaser":"read-write","reader":"read-only"}`,
+		})
+		err := svc.ValidatePackageVersion(t.Context(), dir, "1.2.0")
+		assert.ErrorContains(t, err, "npm user reader does not have publish rights for @compozy/cli")
+	})
+	t.Run("Should fail when npm is not authenticated", func(t *testing.T) {
+		svc, dir := newTestNpmService(t, manifest, npmResponses{
+			"npm view @compozy/cli@1.2.0 version": "",
+			"npm whoami":                          errors.New("command failed (stderr: npm error code ENEEDAUTH)"),
+		})
+		err := svc.ValidatePackageVersion(t.Context(), dir, "1.2.0")
+		assert.ErrorContains(t, err, "npm is not authenticated")
+	})
+	t.Run("Should allow the first publish of a new package", func(t *testing.T) {
+		svc, dir := newTestNpmService(t, manifest, npmResponses{
+			"npm view @compozy/cli@1.2.0 version": errors.New("command failed (stderr: npm error code E404)"),
+			"npm whoami":                          "releaser",
+		})
+		require.NoError(t, svc.ValidatePackageVersion(t.Context(), dir, "1.2.0"))
+	})
+	t.Run("Should skip private packages", func(t *testing.T) {
+		svc, dir := newTestNpmService(t, `{"name":"workspace","private":true}`, npmResponses{})
+		require.NoError(t, svc.ValidatePackageVersion(t.Context(), dir, "1.2.0"))
+	})
+}
+
+func TestNpmService_AddDistTag(t *testing.T) {
+	t.Run("Should point the dist-tag to the released version", func(t *testing.T) {
+		svc, dir := newTestNpmService(t, `{"name":"@compozy/cli"}`, npmResponses{
+			"npm dist-tag add @compozy/cli@1.2.0 latest": "+latest: @compozy/cli@1.2.0\n",
+		})
+		require.NoError(t, svc.AddDistTag(t.Context(), dir, "v1.2.0", "latest"))
+	})
+	t.Run("Should surface registry failures", func(t *testing.T) {
+		svc, dir := newTestNpmService(t, `{"name":"@compozy/cli"}`, npmResponses{
+			"npm dist-tag add @compozy/cli@1.2.0 next": errors.New("command failed (stderr: npm error code E404)"),
+		})
+		err := svc.AddDistTag(t.Context(), dir, "1.2.0", "next")
+		assert.ErrorContains(t, err, "failed to tag @compozy/cli@1.2.0 as next")
+	})
+	t.Run("Should skip private packages", func(t *testing.T) {
+		svc, dir := newTestNpmService(t, `{"name":"internal","private":true}`, npmResponses{})
+		require.NoError(t, svc.AddDistTag(t.Context(), dir, "1.2.0", "latest"))
+	})
+}
+
+func TestNpmService_InjectedFilesystem(t *testing.T) {
+	t.Run("Should check and read packages through the injected filesystem", func(t *testing.T) {
+		dir := t.TempDir()
+		t.Chdir(dir)
+		resolvedDir, err := filepath.EvalSymlinks(dir)
+		require.NoError(t, err)
+		fs := afero.NewMemMapFs()
+		manifestPath := filepath.Join(resolvedDir, "cli", "package.json")
+		require.NoError(t, afero.WriteFile(fs, manifestPath, []byte(`{"private":true}`), 0o644))
+		svc := &npmService{fs: fs}
+		require.NoError(t, svc.ValidatePackageVersion(t.Context(), "cli", "1.2.0"))
+	})
+	t.Run("Should report packages missing from the injected filesystem", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		svc := &npmService{fs: afero.NewMemMapFs()}
+		err := svc.ValidatePackageVersion(t.Context(), "tools", "1.2.0")
+		assert.ErrorContains(t, err, "path does not exist")
+	})
+}
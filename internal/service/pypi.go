@@ -0,0 +1,21 @@
+package service
+
+import "context"
+
+// PyPIService defines the interface for building and uploading Python packages.
+
+type PyPIService interface {
+	// Build builds the distributions of the package at path into outDir with command, or
+	// python -m build when command is empty, and returns their paths.
+	Build(ctx context.Context, path, outDir string, command []string) ([]string, error)
+	// Check validates the metadata of the distributions with twine check.
+	Check(ctx context.Context, dists []string) error
+	// Upload uploads the distributions with twine, skipping the files the repository already has.
+	Upload(ctx context.Context, dists []string, opts PyPIUploadOptions) error
+}
+
+// PyPIUploadOptions configure twine upload.
+type PyPIUploadOptions struct {
+	RepositoryURL string // Upload URL of the package index; empty uploads to PyPI
+	Token         string // API token, sent as the password of the __token__ user
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+)
+
+// defaultPyPIBuildCommand builds an sdist and a wheel with the PyPA build frontend.
+var defaultPyPIBuildCommand = []string{"python", "-m", "build"}
+
+type pypiExecutor func(ctx context.Context, dir string, env []string, name string, args ...string) ([]byte, error)
+
+// pypiService is the implementation of the PyPIService interface.
+type pypiService struct {
+	// timeout for command execution
+	timeout time.Duration
+	// executor replaces runCommand in tests
+	executor pypiExecutor
+	// fs is used to check packages and find their distributions; defaults to the OS filesystem
+	fs afero.Fs
+}
+
+// NewPyPIService creates a new PyPIService that reads packages through fsRepo.
+func NewPyPIService(fsRepo afero.Fs) PyPIService {
+	return &pypiService{
+		timeout: DefaultPyPITimeout,
+		fs:      fsRepo,
+	}
+}
+
+func (s *pypiService) fileSystem() afero.Fs {
+	if s.fs != nil {
+		return s.fs
+	}
+	return afero.NewOsFs()
+}
+
+// Build runs the build command in the package at path with -o outDir, which python -m build, uv build
+// and poetry build take as their output directory, and returns the wheels and sdists it produced.
+// Building outside the package leaves its dist directory alone, which other builds, such as a
+// GoReleaser snapshot running at the same time, may be writing to.
+func (s *pypiService) Build(ctx context.Context, path, outDir string, command []string) ([]string, error) {
+	dir, err := s.packageDir(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(command) == 0 {
+		command = defaultPyPIBuildCommand
+	}
+	args := append(slices.Clone(command[1:]), "-o", outDir)
+	if _, err := s.runCommand(ctx, dir, nil, command[0], args...); err != nil {
+		return nil, fmt.Errorf("failed to build python package at %s: %w", path, err)
+	}
+	var dists []string
+	for _, pattern := range []string{"*.whl", "*.tar.gz"} {
+		matches, err := afero.Glob(s.fileSystem(), filepath.Join(outDir, pattern))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list distributions in %s: %w", outDir, err)
+		}
+		dists = append(dists, matches...)
+	}
+	if len(dists) == 0 {
+		return nil, fmt.Errorf("building %s produced no distributions in %s", path, outDir)
+	}
+	slices.Sort(dists)
+	return dists, nil
+}
+
+// packageDir returns the absolute directory of the Python package at path.
+func (s *pypiService) packageDir(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("package path cannot be empty")
+	}
+	dir, err := filepath.Abs(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve package path %s: %w", path, err)
+	}
+	for _, manifest := range []string{"pyproject.toml", "setup.py"} {
+		if exists, err := afero.Exists(s.fileSystem(), filepath.Join(dir, manifest)); err == nil && exists {
+			return dir, nil
+		}
+	}
+	return "", fmt.Errorf("pyproject.toml or setup.py not found in directory: %s", dir)
+}
+
+// Check runs twine check on the distributions, which validates the metadata PyPI renders.
+func (s *pypiService) Check(ctx context.Context, dists []string) error {
+	args := append([]string{"check", "--strict"}, dists...)
+	if _, err := s.runCommand(ctx, "", nil, "twine", args...); err != nil {
+		return fmt.Errorf("twine check failed: %w", err)
+	}
+	return nil
+}
+
+// Upload runs twine upload with token authentication. Files the repository already has are skipped,
+// so re-running a failed publish only uploads what is missing.
+func (s *pypiService) Upload(ctx context.Context, dists []string, opts PyPIUploadOptions) error {
+	args := []string{"upload", "--non-interactive", "--skip-existing"}
+	if opts.RepositoryURL != "" {
+		args = append(args, "--repository-url", opts.RepositoryURL)
+	}
+	args = append(args, dists...)
+	env := []string{"TWINE_USERNAME=__token__", "TWINE_PASSWORD=" + opts.Token}
+	if _, err := s.runCommand(ctx, "", env, "twine", args...); err != nil {
+		return fmt.Errorf("twine upload failed: %w", err)
+	}
+	return nil
+}
+
+func (s *pypiService) runCommand(
+	ctx context.Context,
+	dir string,
+	env []string,
+	name string,
+	args ...string,
+) ([]byte, error) {
+	if s.executor != nil {
+		return s.executor(ctx, dir, env, name, args...)
+	}
+	ctx, cancel, budget := commandContext(ctx, s.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmdEnv, err := commandEnv(ctx, domain.EnvServicePyPI, append(os.Environ(), env...))
+	if err != nil {
+		return nil, err
+	}
+	cmd.Env = cmdEnv
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, budget.timeoutError()
+		}
+		if errMsg := strings.TrimSpace(stderr.String()); errMsg != "" {
+			return nil, fmt.Errorf("command failed: %w (stderr: %s)", err, errMsg)
+		}
+		return nil, fmt.Errorf("command failed: %w", err)
+	}
+	return stdout.Bytes(), nil
+}
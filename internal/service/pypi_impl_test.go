@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPyPIService_Build(t *testing.T) {
+	t.Run("Should build into the output directory and leave the dist directory alone", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		dir, err := filepath.Abs("sdk")
+		require.NoError(t, err)
+		outDir := filepath.Join(t.TempDir(), "0")
+		distFile := filepath.Join(dir, "dist", "app_1.2.0_linux_amd64.tar.gz")
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "pyproject.toml"), []byte("[project]\n"), 0o644))
+		require.NoError(t, afero.WriteFile(fs, distFile, nil, 0o644))
+		var command string
+		svc := &pypiService{
+			fs: fs,
+			executor: func(_ context.Context, cwd string, _ []string, name string, args ...string) ([]byte, error) {
+				assert.Equal(t, dir, cwd)
+				command = name + " " + strings.Join(args, " ")
+				for _, dist := range []string{"acme-1.2.0.tar.gz", "acme-1.2.0-py3-none-any.whl"} {
+					require.NoError(t, afero.WriteFile(fs, filepath.Join(outDir, dist), nil, 0o644))
+				}
+				return nil, nil
+			},
+		}
+		dists, err := svc.Build(t.Context(), "sdk", outDir, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "python -m build -o "+outDir, command)
+		assert.Equal(t, []string{
+			filepath.Join(outDir, "acme-1.2.0-py3-none-any.whl"),
+			filepath.Join(outDir, "acme-1.2.0.tar.gz"),
+		}, dists)
+		exists, err := afero.Exists(fs, distFile)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+	t.Run("Should fail when the build produces no distributions", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		dir, err := filepath.Abs("sdk")
+		require.NoError(t, err)
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "setup.py"), nil, 0o644))
+		svc := &pypiService{
+			fs: fs,
+			executor: func(_ context.Context, _ string, _ []string, name string, args ...string) ([]byte, error) {
+				assert.Equal(t, "uv", name)
+				assert.Equal(t, []string{"build", "-o", "out"}, args)
+				return nil, nil
+			},
+		}
+		_, err = svc.Build(t.Context(), "sdk", "out", []string{"uv", "build"})
+		require.ErrorContains(t, err, "produced no distributions")
+	})
+	t.Run("Should fail without a pyproject.toml or setup.py", func(t *testing.T) {
+		svc := &pypiService{fs: afero.NewMemMapFs()}
+		_, err := svc.Build(t.Context(), "sdk", "out", nil)
+		require.ErrorContains(t, err, "pyproject.toml or setup.py not found")
+	})
+}
+
+func TestPyPIService_Upload(t *testing.T) {
+	t.Run("Should upload with token authentication to the repository URL", func(t *testing.T) {
+		var command string
+		var env []string
+		svc := &pypiService{
+			executor: func(_ context.Context, _ string, cmdEnv []string, name string, args ...string) ([]byte, error) {
+				command = name + " " + strings.Join(args, " ")
+				env = cmdEnv
+				return nil, nil
+			},
+		}
+		opts := PyPIUploadOptions{RepositoryURL: "https://test.pypi.org/legacy/", Token: "pypi-token"}
+		require.NoError(t, svc.Upload(t.Context(), []string{"dist/acme-1.2.0.tar.gz"}, opts))
+		assert.Equal(t, "twine upload --non-interactive --skip-existing "+
+			"--repository-url https://test.pypi.org/legacy/ dist/acme-1.2.0.tar.gz", command)
+		assert.Equal(t, []string{"TWINE_USERNAME=__token__", "TWINE_PASSWORD=pypi-token"}, env)
+	})
+	t.Run("Should report a failed upload", func(t *testing.T) {
+		svc := &pypiService{
+			executor: func(_ context.Context, _ string, _ []string, _ string, _ ...string) ([]byte, error) {
+				return nil, errors.New("command failed: exit status 1 (stderr: 403 Forbidden)")
+			},
+		}
+		err := svc.Upload(t.Context(), []string{"dist/acme-1.2.0.tar.gz"}, PyPIUploadOptions{Token: "pypi-token"})
+		require.ErrorContains(t, err, "twine upload failed: command failed")
+	})
+}
@@ -0,0 +1,10 @@
+package service
+
+import "context"
+
+// ToolVersionService defines the interface for reading the versions of installed external tools.
+
+type ToolVersionService interface {
+	// InstalledVersion returns the version tool reports, or an empty string when it is not installed.
+	InstalledVersion(ctx context.Context, tool string) (string, error)
+}
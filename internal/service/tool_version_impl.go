@@ -0,0 +1,66 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+)
+
+// toolVersionService is the implementation of the ToolVersionService interface.
+type toolVersionService struct {
+	timeout  time.Duration
+	executor commandExecutor
+}
+
+// NewToolVersionService creates a new ToolVersionService.
+func NewToolVersionService() ToolVersionService {
+	return &toolVersionService{timeout: DefaultToolVersionTimeout}
+}
+
+// InstalledVersion runs tool --version and parses the version from its output.
+func (s *toolVersionService) InstalledVersion(ctx context.Context, tool string) (string, error) {
+	output, err := s.runCommand(ctx, tool, "--version")
+	if errors.Is(err, exec.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s version: %w", tool, err)
+	}
+	version, err := domain.ParseToolVersion(string(output))
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s version: %w", tool, err)
+	}
+	return version, nil
+}
+
+func (s *toolVersionService) runCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	if s.executor != nil {
+		return s.executor(ctx, name, args...)
+	}
+	return s.executeCommand(ctx, name, args...)
+}
+
+// executeCommand runs a command with timeout and proper resource cleanup.
+func (s *toolVersionService) executeCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	ctx, cancel, budget := commandContext(ctx, s.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, name, args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, budget.timeoutError()
+		}
+		if errMsg := stderr.String(); errMsg != "" {
+			return nil, fmt.Errorf("command failed: %w (stderr: %s)", err, errMsg)
+		}
+		return nil, fmt.Errorf("command failed: %w", err)
+	}
+	return stdout.Bytes(), nil
+}
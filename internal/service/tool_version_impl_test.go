@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolVersionService_InstalledVersion(t *testing.T) {
+	t.Run("Should parse the version from the tool output", func(t *testing.T) {
+		command := &capturedCommand{}
+		svc := &toolVersionService{
+			executor: func(_ context.Context, name string, args ...string) ([]byte, error) {
+				command.name = name
+				command.args = append([]string(nil), args...)
+				return []byte("git-cliff 2.8.0\n"), nil
+			},
+		}
+		version, err := svc.InstalledVersion(t.Context(), "git-cliff")
+		require.NoError(t, err)
+		assert.Equal(t, "2.8.0", version)
+		assert.Equal(t, "git-cliff", command.name)
+		assert.Equal(t, []string{"--version"}, command.args)
+	})
+	t.Run("Should report a missing tool as not installed", func(t *testing.T) {
+		svc := &toolVersionService{
+			executor: func(_ context.Context, _ string, _ ...string) ([]byte, error) {
+				return nil, fmt.Errorf("command failed: %w", exec.ErrNotFound)
+			},
+		}
+		version, err := svc.InstalledVersion(t.Context(), "goreleaser")
+		require.NoError(t, err)
+		assert.Empty(t, version)
+	})
+	t.Run("Should fail when the tool fails", func(t *testing.T) {
+		svc := &toolVersionService{
+			executor: func(_ context.Context, _ string, _ ...string) ([]byte, error) {
+				return nil, errors.New("exit status 1")
+			},
+		}
+		_, err := svc.InstalledVersion(t.Context(), "goreleaser")
+		assert.ErrorContains(t, err, "failed to read goreleaser version")
+	})
+	t.Run("Should fail when the output has no version", func(t *testing.T) {
+		svc := &toolVersionService{
+			executor: func(_ context.Context, _ string, _ ...string) ([]byte, error) {
+				return []byte("unknown"), nil
+			},
+		}
+		_, err := svc.InstalledVersion(t.Context(), "git-cliff")
+		assert.ErrorContains(t, err, "no version found")
+	})
+}
@@ -0,0 +1,103 @@
+// Package telemetry traces release workflows with OpenTelemetry. Spans are exported over OTLP/HTTP
+// when an endpoint is configured; otherwise the global no-op tracer makes them free.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// instrumentationName identifies the spans of pr-release among other libraries.
+	instrumentationName = "github.com/compozy/releasepr"
+	serviceName         = "pr-release"
+	// tracesPath is appended to endpoints given without a path, as in OTEL_EXPORTER_OTLP_ENDPOINT.
+	tracesPath = "/v1/traces"
+)
+
+// otelEndpointEnv lists the standard variables the OTLP exporter reads on its own.
+var otelEndpointEnv = []string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "OTEL_EXPORTER_OTLP_ENDPOINT"}
+
+// Shutdown flushes pending spans and stops the exporter.
+type Shutdown func(ctx context.Context) error
+
+// Setup installs an OTLP/HTTP tracer provider when endpoint or one of the standard
+// OTEL_EXPORTER_OTLP_*ENDPOINT variables is set, and leaves tracing disabled otherwise. The returned
+// Shutdown must run before the process exits so the last spans are sent.
+func Setup(ctx context.Context, endpoint, version string) (Shutdown, error) {
+	var options []otlptracehttp.Option
+	switch {
+	case endpoint != "":
+		tracesURL, err := TracesURL(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, otlptracehttp.WithEndpointURL(tracesURL))
+	case !otelEndpointConfigured():
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := otlptracehttp.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return provider.Shutdown, nil
+}
+
+// TracesURL returns the OTLP/HTTP traces URL of endpoint, adding /v1/traces when it has no path.
+func TracesURL(endpoint string) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return "", errors.New("OTLP endpoint must be an http(s) URL")
+	}
+	if strings.Trim(parsed.Path, "/") == "" {
+		parsed.Path = tracesPath
+	}
+	return parsed.String(), nil
+}
+
+func otelEndpointConfigured() bool {
+	for _, name := range otelEndpointEnv {
+		if os.Getenv(name) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Start opens a span named name as a child of the span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err on span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
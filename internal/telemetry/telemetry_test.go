@@ -0,0 +1,88 @@
+package telemetry
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// recordSpans installs a tracer provider that keeps ended spans in memory for the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestTracesURL(t *testing.T) {
+	t.Run("Should add the traces path to bare endpoints", func(t *testing.T) {
+		tracesURL, err := TracesURL("http://tempo:4318")
+		require.NoError(t, err)
+		assert.Equal(t, "http://tempo:4318/v1/traces", tracesURL)
+		tracesURL, err = TracesURL("https://otlp.example.com/otlp/v1/traces")
+		require.NoError(t, err)
+		assert.Equal(t, "https://otlp.example.com/otlp/v1/traces", tracesURL)
+	})
+	t.Run("Should reject endpoints that are not http(s) URLs", func(t *testing.T) {
+		for _, endpoint := range []string{"tempo:4318", "grpc://tempo:4317", "http://"} {
+			_, err := TracesURL(endpoint)
+			assert.Error(t, err, endpoint)
+		}
+	})
+}
+
+func TestSetup(t *testing.T) {
+	t.Run("Should stay disabled without an endpoint", func(t *testing.T) {
+		t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+		t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+		previous := otel.GetTracerProvider()
+		shutdown, err := Setup(t.Context(), "", "v1.0.0")
+		require.NoError(t, err)
+		require.NoError(t, shutdown(t.Context()))
+		assert.Equal(t, previous, otel.GetTracerProvider())
+	})
+	t.Run("Should export spans to the configured endpoint on shutdown", func(t *testing.T) {
+		var received atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/v1/traces" {
+				received.Add(1)
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+		previous := otel.GetTracerProvider()
+		t.Cleanup(func() { otel.SetTracerProvider(previous) })
+		shutdown, err := Setup(t.Context(), server.URL, "v1.0.0")
+		require.NoError(t, err)
+		_, span := Start(t.Context(), "pr-release")
+		End(span, nil)
+		require.NoError(t, shutdown(t.Context()))
+		assert.Equal(t, int32(1), received.Load())
+	})
+}
+
+func TestEnd(t *testing.T) {
+	t.Run("Should mark spans of failed calls as errors", func(t *testing.T) {
+		recorder := recordSpans(t)
+		_, span := Start(t.Context(), "step")
+		End(span, errors.New("boom"))
+		_, span = Start(t.Context(), "step")
+		End(span, nil)
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, "boom", spans[0].Status().Description)
+		assert.Equal(t, codes.Unset, spans[1].Status().Code)
+	})
+}
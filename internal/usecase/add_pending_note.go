@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/spf13/afero"
+)
+
+// AddPendingNoteInput contains the inputs of a manual changelog entry.
+type AddPendingNoteInput struct {
+	Type        string
+	Scope       string
+	Description string
+}
+
+// AddPendingNoteUseCase appends a manual changelog entry to the pending notes file.
+type AddPendingNoteUseCase struct {
+	FSRepo repository.FileSystemRepository
+}
+
+// Execute validates the entry and appends it, creating the file when needed. An existing file with
+// invalid entries is reported instead of being extended.
+func (uc *AddPendingNoteUseCase) Execute(_ context.Context, input AddPendingNoteInput) (domain.PendingNote, error) {
+	note, err := domain.NewPendingNote(input.Type, input.Scope, input.Description)
+	if err != nil {
+		return domain.PendingNote{}, err
+	}
+	content, err := uc.readPendingNotes()
+	if err != nil {
+		return domain.PendingNote{}, fmt.Errorf("failed to read %s: %w", domain.PendingNotesFile, err)
+	}
+	if _, err := domain.ParsePendingNotes(string(content)); err != nil {
+		return domain.PendingNote{}, fmt.Errorf("invalid %s: %w", domain.PendingNotesFile, err)
+	}
+	updated := domain.AppendPendingNote(string(content), note)
+	if err := afero.WriteFile(uc.fsRepo(), domain.PendingNotesFile, []byte(updated), 0644); err != nil {
+		return domain.PendingNote{}, fmt.Errorf("failed to write %s: %w", domain.PendingNotesFile, err)
+	}
+	return note, nil
+}
+
+func (uc *AddPendingNoteUseCase) readPendingNotes() ([]byte, error) {
+	exists, err := afero.Exists(uc.fsRepo(), domain.PendingNotesFile)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return afero.ReadFile(uc.fsRepo(), domain.PendingNotesFile)
+}
+
+func (uc *AddPendingNoteUseCase) fsRepo() repository.FileSystemRepository {
+	if uc.FSRepo != nil {
+		return uc.FSRepo
+	}
+	return afero.NewOsFs()
+}
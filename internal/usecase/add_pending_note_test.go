@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddPendingNoteUseCase_Execute(t *testing.T) {
+	t.Run("Should create the file and append entries in order", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		uc := &AddPendingNoteUseCase{FSRepo: fsRepo}
+		_, err := uc.Execute(t.Context(), AddPendingNoteInput{Type: "feat", Scope: "cli", Description: "add flag"})
+		require.NoError(t, err)
+		note, err := uc.Execute(t.Context(), AddPendingNoteInput{Type: "Fix", Description: "revert cache change"})
+		require.NoError(t, err)
+		assert.Equal(t, "fix: revert cache change", note.Header())
+		data, err := afero.ReadFile(fsRepo, domain.PendingNotesFile)
+		require.NoError(t, err)
+		notes, err := domain.ParsePendingNotes(string(data))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"feat(cli): add flag", "fix: revert cache change"}, notes.Headers())
+	})
+	t.Run("Should reject invalid entries without touching the file", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		uc := &AddPendingNoteUseCase{FSRepo: fsRepo}
+		_, err := uc.Execute(t.Context(), AddPendingNoteInput{Type: "feat", Description: " "})
+		require.Error(t, err)
+		exists, err := afero.Exists(fsRepo, domain.PendingNotesFile)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+	t.Run("Should refuse to extend a file with malformed entries", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, domain.PendingNotesFile, []byte("- vendor sync\n"), 0644))
+		uc := &AddPendingNoteUseCase{FSRepo: fsRepo}
+		_, err := uc.Execute(t.Context(), AddPendingNoteInput{Type: "chore", Description: "sync vendor"})
+		assert.ErrorContains(t, err, "line 1")
+	})
+}
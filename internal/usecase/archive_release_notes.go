@@ -81,6 +81,31 @@ func (uc *ArchiveReleaseNotesUseCase) Execute(ctx context.Context, version strin
 	return result, nil
 }
 
+// Plan returns the moves Execute would make for the provided version without moving anything.
+func (uc *ArchiveReleaseNotesUseCase) Plan(version string) ([]ArchivedReleaseNoteMove, error) {
+	trimmedVersion := strings.TrimSpace(version)
+	if trimmedVersion == "" {
+		return nil, fmt.Errorf("version cannot be empty")
+	}
+	exists, err := afero.DirExists(uc.fsRepo(), releaseNotesDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect release notes directory: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	files, err := uc.activeReleaseNoteFiles()
+	if err != nil {
+		return nil, err
+	}
+	archiveDir := releaseNotesArchiveDir(trimmedVersion)
+	moves := make([]ArchivedReleaseNoteMove, 0, len(files))
+	for _, file := range files {
+		moves = append(moves, ArchivedReleaseNoteMove{From: file, To: filepath.Join(archiveDir, filepath.Base(file))})
+	}
+	return moves, nil
+}
+
 // ToRollbackData converts the archive result into JSON-friendly saga data.
 func (r ArchiveReleaseNotesResult) ToRollbackData() map[string]any {
 	moves := make([]map[string]any, 0, len(r.Moves))
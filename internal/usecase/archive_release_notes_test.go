@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/compozy/releasepr/internal/domain"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,10 +26,34 @@ func (s *archiveGitRepoStub) CommitsSinceTag(context.Context, string) (int, erro
 	return 0, nil
 }
 
+func (s *archiveGitRepoStub) CommitSubjectsSinceTag(context.Context, string) ([]string, error) {
+	return nil, nil
+}
+
 func (s *archiveGitRepoStub) TagExists(context.Context, string) (bool, error) {
 	return false, nil
 }
 
+func (s *archiveGitRepoStub) TagCommit(context.Context, string) (string, error) {
+	return "", nil
+}
+
+func (s *archiveGitRepoStub) ReleaseTags(context.Context) ([]domain.ReleaseTag, error) {
+	return nil, nil
+}
+
+func (s *archiveGitRepoStub) TagAnnotation(context.Context, string) (string, bool, error) {
+	return "", false, nil
+}
+
+func (s *archiveGitRepoStub) CreateTagForce(context.Context, string, string, string) error {
+	return nil
+}
+
+func (s *archiveGitRepoStub) PushTagForce(context.Context, string) error {
+	return nil
+}
+
 func (s *archiveGitRepoStub) CreateBranch(context.Context, string) error {
 	return nil
 }
@@ -85,7 +110,7 @@ func (s *archiveGitRepoStub) ListLocalBranches(context.Context) ([]string, error
 	return []string{"main"}, nil
 }
 
-func (s *archiveGitRepoStub) ListRemoteBranches(context.Context) ([]string, error) {
+func (s *archiveGitRepoStub) ListRemoteBranches(context.Context, string) ([]string, error) {
 	return []string{"origin/main"}, nil
 }
 
@@ -105,6 +130,10 @@ func (s *archiveGitRepoStub) MoveFile(_ context.Context, from, to string) error
 	return s.fsRepo.Rename(from, to)
 }
 
+func (s *archiveGitRepoStub) RemoveFile(_ context.Context, path string) error {
+	return s.fsRepo.Remove(path)
+}
+
 func (s *archiveGitRepoStub) RestoreFile(context.Context, string) error {
 	return nil
 }
@@ -113,10 +142,42 @@ func (s *archiveGitRepoStub) ResetHard(context.Context, string) error {
 	return nil
 }
 
+func (s *archiveGitRepoStub) SyncBranch(context.Context, string) error {
+	return nil
+}
+
 func (s *archiveGitRepoStub) GetFileStatus(context.Context, string) (string, error) {
 	return "", nil
 }
 
+func (s *archiveGitRepoStub) IsSyncedWithBase(context.Context, string) (bool, error) {
+	return true, nil
+}
+
+func (s *archiveGitRepoStub) RebaseOntoBase(context.Context, string) error {
+	return nil
+}
+
+func (s *archiveGitRepoStub) CommitMessagesSinceTag(context.Context, string) ([]string, error) {
+	return nil, nil
+}
+
+func (s *archiveGitRepoStub) HeadCommitDiff(context.Context) ([]domain.FileDiffStat, error) {
+	return nil, nil
+}
+
+func (s *archiveGitRepoStub) SubmoduleUpdates(context.Context, string) (domain.SubmoduleUpdates, error) {
+	return nil, nil
+}
+
+func (s *archiveGitRepoStub) BumpSubmodules(context.Context) (domain.SubmoduleUpdates, error) {
+	return nil, nil
+}
+
+func (s *archiveGitRepoStub) ReleaseStats(context.Context, string) (domain.ReleaseStats, error) {
+	return domain.ReleaseStats{}, nil
+}
+
 func TestArchiveReleaseNotesUseCase_Execute(t *testing.T) {
 	t.Run("Should archive active release notes and create gitkeep", func(t *testing.T) {
 		fsRepo := afero.NewMemMapFs()
@@ -183,6 +244,23 @@ func TestArchiveReleaseNotesUseCase_Execute(t *testing.T) {
 	})
 }
 
+func TestArchiveReleaseNotesUseCase_Plan(t *testing.T) {
+	t.Run("Should list the archive moves without moving the notes", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, fsRepo.MkdirAll(releaseNotesDir, 0755))
+		require.NoError(t, afero.WriteFile(fsRepo, ".release-notes/a.md", []byte("a"), 0644))
+		uc := &ArchiveReleaseNotesUseCase{FSRepo: fsRepo}
+		moves, err := uc.Plan("v1.2.3")
+		require.NoError(t, err)
+		assert.Equal(t, []ArchivedReleaseNoteMove{
+			{From: ".release-notes/a.md", To: ".release-notes/archive/v1.2.3/a.md"},
+		}, moves)
+		exists, err := afero.Exists(fsRepo, ".release-notes/a.md")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
+
 func TestParseArchiveReleaseNotesResult(t *testing.T) {
 	t.Run("Should rebuild archive rollback data from persisted map", func(t *testing.T) {
 		result, err := ParseArchiveReleaseNotesResult(map[string]any{
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/compozy/releasepr/internal/domain"
+)
+
+// RenderBlogPostUseCase renders the blog post draft of a release from a template.
+type RenderBlogPostUseCase struct {
+	Template string
+}
+
+// Execute runs the use case; referencing an unknown variable is an error.
+func (uc *RenderBlogPostUseCase) Execute(_ context.Context, post domain.BlogPost) (string, error) {
+	tmpl, err := template.New("blog post").Option("missingkey=error").Parse(uc.Template)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse blog post template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, post); err != nil {
+		return "", fmt.Errorf("failed to execute blog post template: %w", err)
+	}
+	return strings.TrimSpace(buf.String()) + "\n", nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderBlogPostUseCase_Execute(t *testing.T) {
+	post := domain.NewBlogPost("compozy", "releasepr", "v1.4.0", "2026-07-18",
+		"## 1.4.0 - 2026-07-17\n\n### Features\n\n- Add blog posts\n")
+	t.Run("Should render front matter, summary and sections with the built-in template", func(t *testing.T) {
+		text, err := LoadTemplate(nil, TemplateBlogPost)
+		require.NoError(t, err)
+		uc := &RenderBlogPostUseCase{Template: text}
+		draft, err := uc.Execute(t.Context(), post)
+		require.NoError(t, err)
+		assert.Equal(t, `---
+title: "releasepr v1.4.0"
+date: 2026-07-18
+slug: releasepr-1-4-0
+version: "v1.4.0"
+draft: true
+tags: ["release"]
+---
+
+releasepr v1.4.0 ships 1 change in Features.
+
+## Features
+
+- Add blog posts
+
+Read the full release notes on [GitHub](https://github.com/compozy/releasepr/releases/tag/v1.4.0).
+`, draft)
+	})
+	t.Run("Should reject templates referencing an unknown variable", func(t *testing.T) {
+		uc := &RenderBlogPostUseCase{Template: "{{.Nope}}"}
+		_, err := uc.Execute(t.Context(), post)
+		assert.ErrorContains(t, err, "failed to execute blog post template")
+	})
+}
@@ -15,6 +15,8 @@ import (
 type CalculateVersionUseCase struct {
 	GitRepo  repository.GitRepository
 	CliffSvc service.CliffService
+	// Strategy computes the next version; nil infers a semver bump from commits with CliffSvc.
+	Strategy VersionStrategy
 }
 
 // Execute runs the use case.
@@ -31,5 +33,5 @@ func (uc *CalculateVersionUseCase) Execute(ctx context.Context) (*domain.Version
 			latestTag = "v0.0.0" // Default fallback
 		}
 	}
-	return uc.CliffSvc.CalculateNextVersion(ctx, latestTag)
+	return versionStrategy(uc.Strategy, uc.CliffSvc).NextVersion(ctx, latestTag)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/compozy/releasepr/internal/domain"
 	"github.com/compozy/releasepr/internal/repository"
 	"github.com/compozy/releasepr/internal/service"
 )
@@ -13,27 +14,60 @@ import (
 type CheckChangesUseCase struct {
 	GitRepo  repository.GitRepository
 	CliffSvc service.CliffService
+	// Strategy computes the next version; nil infers a semver bump from commits with CliffSvc.
+	Strategy VersionStrategy
+	// Threshold holds back releases of trivial changes; the zero threshold releases any change.
+	Threshold domain.ReleaseThreshold
+}
+
+// ChangesCheck is the outcome of CheckChangesUseCase. Reason explains why changes since LatestTag
+// are not released when they do not meet the release threshold.
+type ChangesCheck struct {
+	HasChanges bool
+	LatestTag  string
+	Reason     string
 }
 
 // Execute runs the use case.
 func (uc *CheckChangesUseCase) Execute(ctx context.Context) (bool, string, error) {
+	check, err := uc.Check(ctx)
+	return check.HasChanges, check.LatestTag, err
+}
+
+// Check reports whether there is something to release since the latest tag, and why not.
+func (uc *CheckChangesUseCase) Check(ctx context.Context) (ChangesCheck, error) {
 	latestTag, err := uc.GitRepo.LatestTag(ctx)
 	if err != nil {
-		return false, "", fmt.Errorf("failed to get latest tag: %w", err)
+		return ChangesCheck{}, fmt.Errorf("failed to get latest tag: %w", err)
 	}
 	if latestTag == "" {
-		return true, "", nil // Initial release
+		return ChangesCheck{HasChanges: true}, nil // Initial release
 	}
+	check := ChangesCheck{LatestTag: latestTag}
 	commitsSince, err := uc.GitRepo.CommitsSinceTag(ctx, latestTag)
 	if err != nil {
-		return false, latestTag, fmt.Errorf("failed to get commits since tag: %w", err)
+		return check, fmt.Errorf("failed to get commits since tag: %w", err)
 	}
 	if commitsSince == 0 {
-		return false, latestTag, nil
+		return check, nil
+	}
+	if !uc.Threshold.IsZero() {
+		var subjects []string
+		if len(uc.Threshold.RequireTypes) > 0 {
+			if subjects, err = uc.GitRepo.CommitSubjectsSinceTag(ctx, latestTag); err != nil {
+				return check, fmt.Errorf("failed to list commits since tag: %w", err)
+			}
+		}
+		met, reason := uc.Threshold.Evaluate(latestTag, commitsSince, subjects)
+		if !met {
+			check.Reason = reason
+			return check, nil
+		}
 	}
-	nextVer, err := uc.CliffSvc.CalculateNextVersion(ctx, latestTag)
+	nextVer, err := versionStrategy(uc.Strategy, uc.CliffSvc).NextVersion(ctx, latestTag)
 	if err != nil {
-		return false, latestTag, fmt.Errorf("failed to calculate next version: %w", err)
+		return check, fmt.Errorf("failed to calculate next version: %w", err)
 	}
-	return nextVer.String() != latestTag, latestTag, nil
+	check.HasChanges = nextVer.String() != latestTag
+	return check, nil
 }
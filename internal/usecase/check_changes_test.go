@@ -26,6 +26,14 @@ func (m *mockGitRepository) CommitsSinceTag(ctx context.Context, tag string) (in
 	return args.Int(0), args.Error(1)
 }
 
+func (m *mockGitRepository) CommitSubjectsSinceTag(ctx context.Context, tag string) ([]string, error) {
+	args := m.Called(ctx, tag)
+	if subjects := args.Get(0); subjects != nil {
+		return subjects.([]string), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (m *mockGitRepository) TagExists(ctx context.Context, tag string) (bool, error) {
 	args := m.Called(ctx, tag)
 	return args.Bool(0), args.Error(1)
@@ -66,6 +74,33 @@ func (m *mockCliffService) GenerateFullChangelog(ctx context.Context, version st
 	return args.String(0), args.Error(1)
 }
 
+func (m *mockCliffService) GenerateFilteredChangelog(
+	ctx context.Context,
+	version, mode string,
+	filter domain.CommitFilter,
+) (string, error) {
+	args := m.Called(ctx, version, mode, filter)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockCliffService) GenerateFilteredFullChangelog(
+	ctx context.Context,
+	version string,
+	filter domain.CommitFilter,
+) (string, error) {
+	args := m.Called(ctx, version, filter)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockCliffService) GenerateRangeChangelog(
+	ctx context.Context,
+	from, to string,
+	filter domain.CommitFilter,
+) (string, error) {
+	args := m.Called(ctx, from, to, filter)
+	return args.String(0), args.Error(1)
+}
+
 func (m *mockCliffService) CalculateNextVersion(ctx context.Context, currentVersion string) (*domain.Version, error) {
 	args := m.Called(ctx, currentVersion)
 	if args.Get(0) == nil {
@@ -163,4 +198,41 @@ func TestCheckChangesUseCase_Execute(t *testing.T) {
 		assert.Equal(t, "v1.0.0", latestTag)
 		gitRepo.AssertExpectations(t)
 	})
+	t.Run("Should hold back changes below the release threshold", func(t *testing.T) {
+		gitRepo := new(mockGitRepository)
+		cliffSvc := new(mockCliffService)
+		uc := &CheckChangesUseCase{
+			GitRepo:   gitRepo,
+			CliffSvc:  cliffSvc,
+			Threshold: domain.ReleaseThreshold{MinCommits: 2, RequireTypes: []string{"feat", "fix"}},
+		}
+		ctx := t.Context()
+		gitRepo.On("LatestTag", ctx).Return("v1.0.0", nil)
+		gitRepo.On("CommitsSinceTag", ctx, "v1.0.0").Return(3, nil)
+		gitRepo.On("CommitSubjectsSinceTag", ctx, "v1.0.0").Return([]string{"chore: a", "ci: b", "docs: c"}, nil)
+		check, err := uc.Check(ctx)
+		require.NoError(t, err)
+		assert.False(t, check.HasChanges)
+		assert.Equal(t, "no feat, fix commit since v1.0.0 (require_types)", check.Reason)
+		cliffSvc.AssertNotCalled(t, "CalculateNextVersion", mock.Anything, mock.Anything)
+	})
+	t.Run("Should release changes meeting the release threshold", func(t *testing.T) {
+		gitRepo := new(mockGitRepository)
+		cliffSvc := new(mockCliffService)
+		uc := &CheckChangesUseCase{
+			GitRepo:   gitRepo,
+			CliffSvc:  cliffSvc,
+			Threshold: domain.ReleaseThreshold{MinCommits: 2},
+		}
+		ctx := t.Context()
+		nextVer, _ := domain.NewVersion("v1.0.1")
+		gitRepo.On("LatestTag", ctx).Return("v1.0.0", nil)
+		gitRepo.On("CommitsSinceTag", ctx, "v1.0.0").Return(2, nil)
+		cliffSvc.On("CalculateNextVersion", ctx, "v1.0.0").Return(nextVer, nil)
+		check, err := uc.Check(ctx)
+		require.NoError(t, err)
+		assert.True(t, check.HasChanges)
+		assert.Empty(t, check.Reason)
+		gitRepo.AssertNotCalled(t, "CommitSubjectsSinceTag", mock.Anything, mock.Anything)
+	})
 }
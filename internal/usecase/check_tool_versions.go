@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/service"
+)
+
+// CheckToolVersionsUseCase compares the installed external tools with the versions pinned in tools_lock.
+type CheckToolVersionsUseCase struct {
+	ToolVersionSvc service.ToolVersionService
+	// Lock maps a tool name to its pinned version; tools missing from it are reported but never fail.
+	Lock map[string]string
+}
+
+// Execute returns one check per pinnable tool, in a stable order.
+func (uc *CheckToolVersionsUseCase) Execute(ctx context.Context) ([]domain.ToolCheck, error) {
+	tools := domain.PinnableTools()
+	checks := make([]domain.ToolCheck, 0, len(tools))
+	for _, tool := range tools {
+		installed, err := uc.ToolVersionSvc.InstalledVersion(ctx, tool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s: %w", tool, err)
+		}
+		checks = append(checks, domain.ToolCheck{Tool: tool, Pinned: uc.Lock[tool], Installed: installed})
+	}
+	return checks, nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockToolVersionService struct {
+	mock.Mock
+}
+
+func (m *mockToolVersionService) InstalledVersion(ctx context.Context, tool string) (string, error) {
+	args := m.Called(ctx, tool)
+	return args.String(0), args.Error(1)
+}
+
+func TestCheckToolVersionsUseCase_Execute(t *testing.T) {
+	t.Run("Should compare every pinnable tool with its pin", func(t *testing.T) {
+		ctx := t.Context()
+		svc := new(mockToolVersionService)
+		svc.On("InstalledVersion", ctx, domain.ToolGitCliff).Return("2.9.1", nil)
+		svc.On("InstalledVersion", ctx, domain.ToolGoReleaser).Return("", nil)
+		uc := &CheckToolVersionsUseCase{
+			ToolVersionSvc: svc,
+			Lock:           map[string]string{domain.ToolGitCliff: "2.8"},
+		}
+		checks, err := uc.Execute(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.ToolCheck{
+			{Tool: domain.ToolGitCliff, Pinned: "2.8", Installed: "2.9.1"},
+			{Tool: domain.ToolGoReleaser},
+		}, checks)
+		assert.False(t, checks[0].OK())
+		assert.True(t, checks[1].OK())
+		svc.AssertExpectations(t)
+	})
+	t.Run("Should fail when a version cannot be read", func(t *testing.T) {
+		ctx := t.Context()
+		svc := new(mockToolVersionService)
+		svc.On("InstalledVersion", ctx, domain.ToolGitCliff).Return("", errors.New("exit status 1"))
+		uc := &CheckToolVersionsUseCase{ToolVersionSvc: svc}
+		_, err := uc.Execute(ctx)
+		assert.ErrorContains(t, err, "failed to check git-cliff")
+	})
+}
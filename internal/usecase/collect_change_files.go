@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+// changeFilesDir holds the pending change files read in change-files detection mode.
+const changeFilesDir = ".changes"
+
+// changeFilesReadme documents the directory and is never treated as a change file.
+const changeFilesReadme = "readme.md"
+
+type changeFileFrontmatter struct {
+	Bump string `yaml:"bump"`
+}
+
+// CollectChangeFilesUseCase loads the pending change files that make up the next release.
+type CollectChangeFilesUseCase struct {
+	FSRepo repository.FileSystemRepository
+}
+
+// Execute returns the change files in `.changes/`, ordered by file name. Unlike release notes, an
+// invalid change file fails the run, since skipping it could release the wrong version.
+func (uc *CollectChangeFilesUseCase) Execute(_ context.Context) (domain.ChangeFiles, error) {
+	exists, err := afero.DirExists(uc.fsRepo(), changeFilesDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect change files directory: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	entries, err := afero.ReadDir(uc.fsRepo(), changeFilesDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read change files directory: %w", err)
+	}
+	files := make(domain.ChangeFiles, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" || strings.EqualFold(entry.Name(), changeFilesReadme) {
+			continue
+		}
+		path := filepath.Join(changeFilesDir, entry.Name())
+		file, err := uc.parseChangeFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid change file %s: %w", path, err)
+		}
+		files = append(files, *file)
+	}
+	return files, nil
+}
+
+func (uc *CollectChangeFilesUseCase) parseChangeFile(path string) (*domain.ChangeFile, error) {
+	data, err := afero.ReadFile(uc.fsRepo(), path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read change file: %w", err)
+	}
+	frontmatter, body, err := splitReleaseNoteFrontmatter(string(data))
+	if err != nil {
+		return nil, err
+	}
+	var metadata changeFileFrontmatter
+	if err := yaml.Unmarshal([]byte(frontmatter), &metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse frontmatter: %w", err)
+	}
+	bump, err := domain.ParseBumpLevel(metadata.Bump)
+	if err != nil {
+		return nil, err
+	}
+	summary := strings.TrimSpace(body)
+	if summary == "" {
+		return nil, fmt.Errorf("summary cannot be empty")
+	}
+	return &domain.ChangeFile{Bump: bump, Summary: summary, SourcePath: path}, nil
+}
+
+func (uc *CollectChangeFilesUseCase) fsRepo() repository.FileSystemRepository {
+	if uc.FSRepo != nil {
+		return uc.FSRepo
+	}
+	return afero.NewOsFs()
+}
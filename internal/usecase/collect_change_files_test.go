@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectChangeFilesUseCase_Execute(t *testing.T) {
+	t.Run("Should load change files in name order and skip the readme", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		retry := "---\nbump: patch\n---\n\nFix retries.\n"
+		require.NoError(t, afero.WriteFile(fsRepo, ".changes/b-retry.md", []byte(retry), 0644))
+		export := "---\nbump: minor\n---\nAdd export.\n"
+		require.NoError(t, afero.WriteFile(fsRepo, ".changes/a-export.md", []byte(export), 0644))
+		require.NoError(t, afero.WriteFile(fsRepo, ".changes/README.md", []byte("# Change files\n"), 0644))
+		uc := &CollectChangeFilesUseCase{FSRepo: fsRepo}
+		files, err := uc.Execute(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, domain.ChangeFiles{
+			{Bump: domain.BumpLevelMinor, Summary: "Add export.", SourcePath: ".changes/a-export.md"},
+			{Bump: domain.BumpLevelPatch, Summary: "Fix retries.", SourcePath: ".changes/b-retry.md"},
+		}, files)
+	})
+	t.Run("Should return nothing without a changes directory", func(t *testing.T) {
+		uc := &CollectChangeFilesUseCase{FSRepo: afero.NewMemMapFs()}
+		files, err := uc.Execute(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+	t.Run("Should fail on an invalid bump level", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, ".changes/big.md", []byte("---\nbump: huge\n---\nBig.\n"), 0644))
+		uc := &CollectChangeFilesUseCase{FSRepo: fsRepo}
+		_, err := uc.Execute(t.Context())
+		assert.ErrorContains(t, err, "invalid change file .changes/big.md")
+	})
+	t.Run("Should fail on an empty summary", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, ".changes/empty.md", []byte("---\nbump: patch\n---\n\n"), 0644))
+		uc := &CollectChangeFilesUseCase{FSRepo: fsRepo}
+		_, err := uc.Execute(t.Context())
+		assert.ErrorContains(t, err, "summary cannot be empty")
+	})
+}
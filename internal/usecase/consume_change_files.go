@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/repository"
+	"github.com/spf13/afero"
+)
+
+// ConsumedChangeFile stores a deleted change file and its content for rollback.
+type ConsumedChangeFile struct {
+	Path    string
+	Content string
+}
+
+// ConsumeChangeFilesResult contains the serialized rollback state for consumed change files.
+type ConsumeChangeFilesResult struct {
+	Files []ConsumedChangeFile
+}
+
+// ConsumeChangeFilesUseCase deletes the change files included in a release and stages the deletions.
+type ConsumeChangeFilesUseCase struct {
+	FSRepo  repository.FileSystemRepository
+	GitRepo repository.GitExtendedRepository
+}
+
+// Execute deletes files, restoring the already deleted ones when a deletion fails.
+func (uc *ConsumeChangeFilesUseCase) Execute(
+	ctx context.Context,
+	files domain.ChangeFiles,
+) (*ConsumeChangeFilesResult, error) {
+	result := &ConsumeChangeFilesResult{}
+	for _, path := range files.Paths() {
+		content, err := afero.ReadFile(uc.fsRepo(), path)
+		if err == nil {
+			err = uc.GitRepo.RemoveFile(ctx, path)
+		}
+		if err != nil {
+			consumeErr := fmt.Errorf("failed to consume change file %s: %w", path, err)
+			if restoreErr := uc.Restore(ctx, result); restoreErr != nil {
+				return nil, errors.Join(consumeErr, restoreErr)
+			}
+			return nil, consumeErr
+		}
+		result.Files = append(result.Files, ConsumedChangeFile{Path: path, Content: string(content)})
+	}
+	return result, nil
+}
+
+// Restore writes consumed change files back and stages them again.
+func (uc *ConsumeChangeFilesUseCase) Restore(ctx context.Context, result *ConsumeChangeFilesResult) error {
+	var restoreErrors []error
+	for _, file := range result.Files {
+		if err := afero.WriteFile(uc.fsRepo(), file.Path, []byte(file.Content), 0644); err != nil {
+			restoreErrors = append(restoreErrors, fmt.Errorf("failed to restore change file %s: %w", file.Path, err))
+			continue
+		}
+		if err := uc.GitRepo.AddFiles(ctx, file.Path); err != nil {
+			restoreErrors = append(restoreErrors, fmt.Errorf("failed to stage change file %s: %w", file.Path, err))
+		}
+	}
+	return errors.Join(restoreErrors...)
+}
+
+// ToRollbackData converts the result into JSON-friendly saga data.
+func (r ConsumeChangeFilesResult) ToRollbackData() map[string]any {
+	files := make([]map[string]any, 0, len(r.Files))
+	for _, file := range r.Files {
+		files = append(files, map[string]any{
+			"path":    file.Path,
+			"content": file.Content,
+		})
+	}
+	return map[string]any{"consumed_files": files}
+}
+
+// ParseConsumeChangeFilesResult reconstructs rollback data loaded from persisted saga state.
+func ParseConsumeChangeFilesResult(data map[string]any) (*ConsumeChangeFilesResult, error) {
+	result := &ConsumeChangeFilesResult{}
+	rawFiles, ok := data["consumed_files"]
+	if !ok {
+		return result, nil
+	}
+	items, ok := rawFiles.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid consumed change files rollback data")
+	}
+	for _, item := range items {
+		fileMap, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid consumed change file rollback data")
+		}
+		path, pathOK := fileMap["path"].(string)
+		content, contentOK := fileMap["content"].(string)
+		if !pathOK || !contentOK {
+			return nil, fmt.Errorf("invalid consumed change file rollback data")
+		}
+		result.Files = append(result.Files, ConsumedChangeFile{Path: path, Content: content})
+	}
+	return result, nil
+}
+
+func (uc *ConsumeChangeFilesUseCase) fsRepo() repository.FileSystemRepository {
+	if uc.FSRepo != nil {
+		return uc.FSRepo
+	}
+	return afero.NewOsFs()
+}
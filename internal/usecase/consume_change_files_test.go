@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsumeChangeFilesUseCase_Execute(t *testing.T) {
+	t.Run("Should delete change files and restore them from rollback data", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, ".changes/fix.md", []byte("---\nbump: patch\n---\nFix.\n"), 0644))
+		uc := &ConsumeChangeFilesUseCase{FSRepo: fsRepo, GitRepo: &archiveGitRepoStub{fsRepo: fsRepo}}
+		files := domain.ChangeFiles{{Bump: domain.BumpLevelPatch, Summary: "Fix.", SourcePath: ".changes/fix.md"}}
+		result, err := uc.Execute(t.Context(), files)
+		require.NoError(t, err)
+		exists, err := afero.Exists(fsRepo, ".changes/fix.md")
+		require.NoError(t, err)
+		assert.False(t, exists)
+		data, err := json.Marshal(result.ToRollbackData())
+		require.NoError(t, err)
+		var persisted map[string]any
+		require.NoError(t, json.Unmarshal(data, &persisted))
+		parsed, err := ParseConsumeChangeFilesResult(persisted)
+		require.NoError(t, err)
+		require.NoError(t, uc.Restore(t.Context(), parsed))
+		content, err := afero.ReadFile(fsRepo, ".changes/fix.md")
+		require.NoError(t, err)
+		assert.Equal(t, "---\nbump: patch\n---\nFix.\n", string(content))
+	})
+	t.Run("Should restore deleted files when a later deletion fails", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fsRepo, ".changes/a.md", []byte("a"), 0644))
+		uc := &ConsumeChangeFilesUseCase{FSRepo: fsRepo, GitRepo: &archiveGitRepoStub{fsRepo: fsRepo}}
+		files := domain.ChangeFiles{{SourcePath: ".changes/a.md"}, {SourcePath: ".changes/missing.md"}}
+		_, err := uc.Execute(t.Context(), files)
+		require.ErrorContains(t, err, "failed to consume change file .changes/missing.md")
+		exists, err := afero.Exists(fsRepo, ".changes/a.md")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 
+	"github.com/compozy/releasepr/internal/domain"
 	"github.com/compozy/releasepr/internal/service"
 )
 
@@ -10,9 +11,38 @@ import (
 
 type GenerateChangelogUseCase struct {
 	CliffSvc service.CliffService
+	// Policy sanitizes commit-derived markdown when set.
+	Policy *domain.MarkdownPolicy
+	// Filter excludes commits from the changelog when non-zero.
+	Filter domain.CommitFilter
+	// Style retitles the changelog groups when set.
+	Style domain.ChangelogStyle
 }
 
 // Execute runs the use case.
 func (uc *GenerateChangelogUseCase) Execute(ctx context.Context, version, mode string) (string, error) {
-	return uc.CliffSvc.GenerateChangelog(ctx, version, mode)
+	var changelog string
+	var err error
+	if uc.Filter.IsZero() {
+		changelog, err = uc.CliffSvc.GenerateChangelog(ctx, version, mode)
+	} else {
+		changelog, err = uc.CliffSvc.GenerateFilteredChangelog(ctx, version, mode, uc.Filter)
+	}
+	return uc.sanitize(changelog, err)
+}
+
+// ExecuteRange renders the changelog of the revision range from..to, e.g. a hotfix branch or an
+// audit window between two tags.
+func (uc *GenerateChangelogUseCase) ExecuteRange(ctx context.Context, from, to string) (string, error) {
+	return uc.sanitize(uc.CliffSvc.GenerateRangeChangelog(ctx, from, to, uc.Filter))
+}
+
+func (uc *GenerateChangelogUseCase) sanitize(changelog string, err error) (string, error) {
+	if err != nil {
+		return changelog, err
+	}
+	if uc.Policy != nil {
+		changelog = uc.Policy.Sanitize(changelog)
+	}
+	return uc.Style.Apply(changelog), nil
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/compozy/releasepr/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -40,6 +41,19 @@ func TestGenerateChangelogUseCase_Execute(t *testing.T) {
 		assert.Equal(t, expectedChangelog, changelog)
 		cliffSvc.AssertExpectations(t)
 	})
+	t.Run("Should retitle the groups in the configured style", func(t *testing.T) {
+		cliffSvc := new(mockCliffService)
+		uc := &GenerateChangelogUseCase{
+			CliffSvc: cliffSvc,
+			Style:    domain.ChangelogStylePlain,
+		}
+		ctx := context.Background()
+		cliffSvc.On("GenerateChangelog", ctx, "v2.0.0", "release").
+			Return("## v2.0.0\n\n### 🎉 Features\n- New feature", nil)
+		changelog, err := uc.Execute(ctx, "v2.0.0", "release")
+		require.NoError(t, err)
+		assert.Equal(t, "## v2.0.0\n\n### Features\n- New feature", changelog)
+	})
 	t.Run("Should generate changelog for update mode", func(t *testing.T) {
 		cliffSvc := new(mockCliffService)
 		uc := &GenerateChangelogUseCase{
@@ -71,4 +85,49 @@ func TestGenerateChangelogUseCase_Execute(t *testing.T) {
 		assert.Empty(t, changelog)
 		cliffSvc.AssertExpectations(t)
 	})
+	t.Run("Should sanitize commit-derived markdown when a policy is set", func(t *testing.T) {
+		cliffSvc := new(mockCliffService)
+		policy := domain.NewMarkdownPolicy(domain.MarkdownConstructLinks)
+		uc := &GenerateChangelogUseCase{
+			CliffSvc: cliffSvc,
+			Policy:   &policy,
+		}
+		ctx := context.Background()
+		raw := "### Features\n- add <script>alert(1)</script> ![x](https://evil.test/x.png) [docs](https://example.com)"
+		cliffSvc.On("GenerateChangelog", ctx, "v1.2.0", "release").Return(raw, nil)
+		changelog, err := uc.Execute(ctx, "v1.2.0", "release")
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			"### Features\n- add &lt;script>alert(1)&lt;/script> x [docs](https://example.com)",
+			changelog,
+		)
+		cliffSvc.AssertExpectations(t)
+	})
+}
+
+func TestGenerateChangelogUseCase_ExecuteRange(t *testing.T) {
+	t.Run("Should render the range with the filter and sanitize it", func(t *testing.T) {
+		cliffSvc := new(mockCliffService)
+		policy, err := domain.ParseMarkdownPolicy(nil)
+		require.NoError(t, err)
+		filter := domain.CommitFilter{ExcludeTypes: []string{"chore"}}
+		uc := &GenerateChangelogUseCase{CliffSvc: cliffSvc, Policy: &policy, Filter: filter}
+		ctx := t.Context()
+		cliffSvc.On("GenerateRangeChangelog", ctx, "v1.2.0", "hotfix/1.2", filter).
+			Return("## 1.2.1\n\n- fix <script>", nil)
+		changelog, err := uc.ExecuteRange(ctx, "v1.2.0", "hotfix/1.2")
+		require.NoError(t, err)
+		assert.Equal(t, policy.Sanitize("## 1.2.1\n\n- fix <script>"), changelog)
+		cliffSvc.AssertExpectations(t)
+	})
+	t.Run("Should return the error of the cliff service", func(t *testing.T) {
+		cliffSvc := new(mockCliffService)
+		uc := &GenerateChangelogUseCase{CliffSvc: cliffSvc}
+		ctx := t.Context()
+		cliffSvc.On("GenerateRangeChangelog", ctx, "v1.2.0", "HEAD", domain.CommitFilter{}).
+			Return("", errors.New("unknown revision"))
+		_, err := uc.ExecuteRange(ctx, "v1.2.0", "HEAD")
+		assert.ErrorContains(t, err, "unknown revision")
+	})
 }
@@ -1,17 +1,26 @@
 package usecase
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"strings"
-	"text/template"
 
 	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
 )
 
 // PreparePRBodyUseCase contains the logic for the prepare-pr-body command.
 type PreparePRBodyUseCase struct {
+	// Template replaces the built-in PR body template when set; see releaseTemplateData for its variables.
+	Template string
+	// Header replaces the heading and intro of the built-in template when set; an empty header
+	// removes them. It is a template with the same variables.
+	Header *string
+	// Footer is appended to the built-in template when set. It is a template with the same variables.
+	Footer string
+	// FSRepo holds the overrides of the built-in header and sections in TemplateOverrideDir; nil
+	// selects the embedded defaults.
+	FSRepo afero.Fs
 }
 
 func (uc *PreparePRBodyUseCase) validateMarkdownContent(fieldName, content string) error {
@@ -37,40 +46,47 @@ func (uc *PreparePRBodyUseCase) Execute(_ context.Context, release *domain.Relea
 	if err := uc.validateMarkdownContent("release notes", release.ReleaseNotes); err != nil {
 		return "", err
 	}
-	safeData := struct {
-		Version      string
-		Changelog    string
-		ReleaseNotes string
-	}{
-		Version:      release.Version.String(),
-		Changelog:    strings.TrimSpace(release.Changelog),
-		ReleaseNotes: strings.TrimSpace(release.ReleaseNotes),
+	text := uc.Template
+	if strings.TrimSpace(text) == "" {
+		builtin, err := uc.builtinTemplate()
+		if err != nil {
+			return "", err
+		}
+		text = builtin
 	}
-	tmpl := template.New("pr-body")
-	tmpl = tmpl.Option("missingkey=error")
-	parsedTmpl, err := tmpl.Parse(prBodyTemplate)
+	output, err := renderReleaseTemplate("PR body", text, newReleaseTemplateData(release))
 	if err != nil {
-		return "", fmt.Errorf("failed to parse PR body template: %w", err)
-	}
-	var buf bytes.Buffer
-	if err := parsedTmpl.Execute(&buf, safeData); err != nil {
-		return "", fmt.Errorf("failed to execute PR body template: %w", err)
+		return "", err
 	}
-	output := buf.String()
 	if err := uc.validateMarkdownContent("pr body", output); err != nil {
 		return "", fmt.Errorf("potential injection detected in PR body output")
 	}
 	return output, nil
 }
 
-const prBodyTemplate = `
-## Release {{.Version}}
-
-This PR prepares the release of version {{.Version}}.
-
-### Changelog
-
-{{.Changelog}}{{if .ReleaseNotes}}
-
-{{.ReleaseNotes}}{{end}}
-`
+// builtinTemplate surrounds the sections of the built-in template with the header and footer. The
+// configured header wins over the header template file.
+func (uc *PreparePRBodyUseCase) builtinTemplate() (string, error) {
+	sections, err := LoadTemplate(uc.FSRepo, TemplatePRBodySections)
+	if err != nil {
+		return "", err
+	}
+	var header string
+	if uc.Header != nil {
+		header = strings.TrimSpace(*uc.Header)
+	} else {
+		text, err := LoadTemplate(uc.FSRepo, TemplatePRBodyHeader)
+		if err != nil {
+			return "", err
+		}
+		header = strings.TrimSpace(text)
+	}
+	text := "\n" + strings.TrimSpace(sections) + "\n"
+	if header != "" {
+		text = "\n" + header + "\n" + text
+	}
+	if footer := strings.TrimSpace(uc.Footer); footer != "" {
+		text += "\n" + footer + "\n"
+	}
+	return text, nil
+}
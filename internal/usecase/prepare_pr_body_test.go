@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/compozy/releasepr/internal/domain"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -114,4 +115,160 @@ func TestPreparePRBodyUseCase_Execute(t *testing.T) {
 		assert.Empty(t, body)
 		assert.ErrorContains(t, err, "changelog contains invalid null byte")
 	})
+	t.Run("Should render build artifacts table when artifacts are present", func(t *testing.T) {
+		uc := &PreparePRBodyUseCase{}
+		version, _ := domain.NewVersion("v1.2.0")
+		release := &domain.Release{
+			Version:   version,
+			Changelog: "### Features\n- New feature",
+			Artifacts: []domain.ArtifactBuild{
+				{OS: "darwin", Arch: "arm64"},
+				{OS: "linux", Arch: "amd64"},
+			},
+		}
+		body, err := uc.Execute(t.Context(), release)
+		require.NoError(t, err)
+		assert.Contains(t, body, "### Build Artifacts")
+		assert.Contains(t, body, "| OS | Architecture |")
+		assert.Contains(t, body, "| darwin | arm64 |")
+		assert.Contains(t, body, "| linux | amd64 |")
+	})
+	t.Run("Should omit build artifacts section when no artifacts are present", func(t *testing.T) {
+		uc := &PreparePRBodyUseCase{}
+		version, _ := domain.NewVersion("v1.2.0")
+		release := &domain.Release{
+			Version:   version,
+			Changelog: "### Features\n- New feature",
+		}
+		body, err := uc.Execute(t.Context(), release)
+		require.NoError(t, err)
+		assert.NotContains(t, body, "### Build Artifacts")
+	})
+	t.Run("Should list the issues the release closes", func(t *testing.T) {
+		uc := &PreparePRBodyUseCase{}
+		version, _ := domain.NewVersion("v1.2.0")
+		release := &domain.Release{
+			Version:      version,
+			Changelog:    "### Bug Fixes\n- Fix retries, fixes #7",
+			ClosedIssues: []int{7, 9},
+		}
+		body, err := uc.Execute(t.Context(), release)
+		require.NoError(t, err)
+		assert.Contains(t, body, "### Closes\n\n- Closes #7\n- Closes #9\n")
+	})
+	t.Run("Should list the merged pull requests in a table", func(t *testing.T) {
+		uc := &PreparePRBodyUseCase{}
+		version, _ := domain.NewVersion("v1.2.0")
+		release := &domain.Release{
+			Version:   version,
+			Changelog: "### Features\n- New feature",
+			MergedPullRequests: []domain.PullRequest{
+				{Number: 12, Title: "feat: add doctor", Author: "alice", Labels: []string{"enhancement"}},
+				{Number: 15, Title: "fix: retry uploads", Author: "bob"},
+			},
+		}
+		body, err := uc.Execute(t.Context(), release)
+		require.NoError(t, err)
+		assert.Contains(t, body, "### Merged Pull Requests\n\n"+
+			"| PR | Title | Author | Labels |\n| --- | --- | --- | --- |\n"+
+			"| #12 | feat: add doctor | @alice | `enhancement` |\n"+
+			"| #15 | fix: retry uploads | @bob |  |\n")
+	})
+	t.Run("Should omit the merged pull requests table without pull requests", func(t *testing.T) {
+		uc := &PreparePRBodyUseCase{}
+		version, _ := domain.NewVersion("v1.2.0")
+		body, err := uc.Execute(t.Context(), &domain.Release{Version: version, Changelog: "- change"})
+		require.NoError(t, err)
+		assert.NotContains(t, body, "Merged Pull Requests")
+	})
+	t.Run("Should stamp the release date when one is provided", func(t *testing.T) {
+		uc := &PreparePRBodyUseCase{}
+		version, _ := domain.NewVersion("v1.2.0")
+		release := &domain.Release{
+			Version:   version,
+			Changelog: "### Features\n- New feature",
+			Date:      "2026-10-16",
+		}
+		body, err := uc.Execute(t.Context(), release)
+		require.NoError(t, err)
+		assert.Contains(t, body, "This PR prepares the release of version v1.2.0, dated 2026-10-16.")
+	})
+	t.Run("Should replace the built-in header and append the footer", func(t *testing.T) {
+		header := "# {{.Version}} is coming"
+		uc := &PreparePRBodyUseCase{Header: &header, Footer: "Shipped by Acme for {{.Version}}"}
+		version, _ := domain.NewVersion("v1.2.0")
+		body, err := uc.Execute(t.Context(), &domain.Release{Version: version, Changelog: "- New feature"})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(strings.TrimSpace(body), "# v1.2.0 is coming\n"))
+		assert.NotContains(t, body, "This PR prepares the release")
+		assert.Contains(t, body, "### Changelog")
+		assert.True(t, strings.HasSuffix(strings.TrimSpace(body), "Shipped by Acme for v1.2.0"))
+	})
+	t.Run("Should remove the built-in header when it is set empty", func(t *testing.T) {
+		header := ""
+		uc := &PreparePRBodyUseCase{Header: &header}
+		version, _ := domain.NewVersion("v1.2.0")
+		body, err := uc.Execute(t.Context(), &domain.Release{Version: version, Changelog: "- New feature"})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(strings.TrimSpace(body), "### Changelog"))
+		assert.NotContains(t, body, "## Release v1.2.0")
+	})
+	t.Run("Should use the header and sections of the override directory", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		headerPath := TemplateOverrideDir + "/" + TemplatePRBodyHeader
+		sectionsPath := TemplateOverrideDir + "/" + TemplatePRBodySections
+		require.NoError(t, afero.WriteFile(fsRepo, headerPath, []byte("# Shipping {{.Version}}"), 0644))
+		require.NoError(t, afero.WriteFile(fsRepo, sectionsPath, []byte("{{.Changelog}}"), 0644))
+		uc := &PreparePRBodyUseCase{FSRepo: fsRepo}
+		version, _ := domain.NewVersion("v1.2.0")
+		body, err := uc.Execute(t.Context(), &domain.Release{Version: version, Changelog: "- New feature"})
+		require.NoError(t, err)
+		assert.Equal(t, "\n# Shipping v1.2.0\n\n- New feature\n", body)
+	})
+	t.Run("Should render a custom template with the link variables", func(t *testing.T) {
+		uc := &PreparePRBodyUseCase{
+			Template: "{{.Version}} since {{.PreviousTag}}: {{.CompareURL}}\n" +
+				"Milestone: {{.MilestoneURL}}\nThanks {{range .Contributors}}{{.}} {{end}}",
+		}
+		version, _ := domain.NewVersion("v1.2.0")
+		release := &domain.Release{
+			Version: version,
+			Links: domain.ReleaseLinks{
+				PreviousTag:  "v1.1.0",
+				CompareURL:   "https://github.com/compozy/releasepr/compare/v1.1.0...v1.2.0",
+				MilestoneURL: "https://github.com/compozy/releasepr/milestone/4",
+				Contributors: []string{"@alice", "@bob"},
+			},
+		}
+		body, err := uc.Execute(t.Context(), release)
+		require.NoError(t, err)
+		assert.Equal(t, "v1.2.0 since v1.1.0: https://github.com/compozy/releasepr/compare/v1.1.0...v1.2.0\n"+
+			"Milestone: https://github.com/compozy/releasepr/milestone/4\nThanks @alice @bob ", body)
+	})
+	t.Run("Should reject a custom template referencing an unknown variable", func(t *testing.T) {
+		uc := &PreparePRBodyUseCase{Template: "{{.Nope}}"}
+		version, _ := domain.NewVersion("v1.2.0")
+		_, err := uc.Execute(t.Context(), &domain.Release{Version: version})
+		require.ErrorContains(t, err, "failed to execute PR body template")
+	})
+}
+
+func TestRenderReleaseBodyUseCase_Execute(t *testing.T) {
+	t.Run("Should render the release body from the template", func(t *testing.T) {
+		uc := &RenderReleaseBodyUseCase{Template: "{{.Changelog}}\n\n[Full diff]({{.CompareURL}}) · {{.PRURL}}\n"}
+		version, _ := domain.NewVersion("v1.2.0")
+		release := &domain.Release{
+			Version:   version,
+			Changelog: "### Features\n- New feature\n",
+			Links: domain.ReleaseLinks{
+				CompareURL: "https://github.com/compozy/releasepr/compare/v1.1.0...v1.2.0",
+				PRURL:      "https://github.com/compozy/releasepr/pull/42",
+			},
+		}
+		body, err := uc.Execute(t.Context(), release)
+		require.NoError(t, err)
+		assert.Equal(t, "### Features\n- New feature\n\n"+
+			"[Full diff](https://github.com/compozy/releasepr/compare/v1.1.0...v1.2.0)"+
+			" · https://github.com/compozy/releasepr/pull/42", body)
+	})
 }
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/compozy/releasepr/internal/domain"
+)
+
+// releaseTemplateData is what the PR body and release notes templates can reference.
+type releaseTemplateData struct {
+	Version      string
+	PreviousTag  string
+	Date         string
+	Changelog    string
+	ReleaseNotes string
+	CompareURL   string
+	PRURL        string
+	MilestoneURL string
+	Contributors []string
+	Artifacts    []domain.ArtifactBuild
+	ClosedIssues []int
+	// MergedPullRequests render as table rows with {{.MarkdownRow}}
+	MergedPullRequests []domain.PullRequest
+}
+
+func newReleaseTemplateData(release *domain.Release) releaseTemplateData {
+	return releaseTemplateData{
+		Version:            release.Version.String(),
+		PreviousTag:        release.Links.PreviousTag,
+		Date:               strings.TrimSpace(release.Date),
+		Changelog:          strings.TrimSpace(release.Changelog),
+		ReleaseNotes:       strings.TrimSpace(release.ReleaseNotes),
+		CompareURL:         release.Links.CompareURL,
+		PRURL:              release.Links.PRURL,
+		MilestoneURL:       release.Links.MilestoneURL,
+		Contributors:       release.Links.Contributors,
+		Artifacts:          release.Artifacts,
+		ClosedIssues:       release.ClosedIssues,
+		MergedPullRequests: release.MergedPullRequests,
+	}
+}
+
+// renderReleaseTemplate executes a release template; referencing an unknown variable is an error.
+func renderReleaseTemplate(name, text string, data releaseTemplateData) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s template: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute %s template: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// RenderReleaseBodyUseCase renders RELEASE_BODY.md from a custom release notes template.
+type RenderReleaseBodyUseCase struct {
+	Template string
+}
+
+// Execute runs the use case.
+func (uc *RenderReleaseBodyUseCase) Execute(_ context.Context, release *domain.Release) (string, error) {
+	if release == nil || release.Version == nil {
+		return "", fmt.Errorf("release version cannot be nil")
+	}
+	body, err := renderReleaseTemplate("release notes", uc.Template, newReleaseTemplateData(release))
+	if err != nil {
+		return "", err
+	}
+	if strings.ContainsRune(body, '\x00') {
+		return "", fmt.Errorf("release notes template output contains invalid null byte")
+	}
+	return strings.TrimSpace(body), nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"text/template"
+
+	"github.com/spf13/afero"
+)
+
+// TemplateOverrideDir is where a repository overrides the built-in templates: a file named like a
+// built-in template replaces it, so customized templates survive upgrades of the binary.
+const TemplateOverrideDir = ".releasepr/templates"
+
+// Names of the built-in templates, each backed by templates/<name> and overridable in TemplateOverrideDir.
+const (
+	TemplatePRBodyHeader    = "pr_body_header.md.tmpl"
+	TemplatePRBodySections  = "pr_body_sections.md.tmpl"
+	TemplateReleaseNotes    = "release_notes.md.tmpl"
+	TemplateDryRunComment   = "dry_run_comment.md.tmpl"
+	TemplateAbortComment    = "abort_comment.md.tmpl"
+	TemplateRollbackComment = "rollback_comment.md.tmpl"
+	TemplateReleasedComment = "released_comment.md.tmpl"
+	TemplateBlogPost        = "blog_post.md.tmpl"
+)
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+// LoadTemplate returns the override of the named template in TemplateOverrideDir of fsRepo when one
+// exists, otherwise the built-in template. A nil fsRepo always selects the built-in template.
+func LoadTemplate(fsRepo afero.Fs, name string) (string, error) {
+	if fsRepo != nil {
+		overridePath := path.Join(TemplateOverrideDir, name)
+		data, err := afero.ReadFile(fsRepo, overridePath)
+		if err == nil {
+			return string(data), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("failed to read template override %s: %w", overridePath, err)
+		}
+	}
+	data, err := templateFiles.ReadFile("templates/" + name)
+	if err != nil {
+		return "", fmt.Errorf("unknown template %s: %w", name, err)
+	}
+	return string(data), nil
+}
+
+// RenderTemplate loads the named template with LoadTemplate and executes it with data; referencing
+// an unknown variable is an error.
+func RenderTemplate(fsRepo afero.Fs, name string, data any) (string, error) {
+	text, err := LoadTemplate(fsRepo, name)
+	if err != nil {
+		return "", err
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template %s: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute template %s: %w", name, err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"path"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTemplate(t *testing.T) {
+	t.Run("Should fall back to the built-in template without an override", func(t *testing.T) {
+		text, err := LoadTemplate(afero.NewMemMapFs(), TemplateReleasedComment)
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Released in [{{.Tag}}]({{.URL}})\n", text)
+		builtin, err := LoadTemplate(nil, TemplateReleasedComment)
+		require.NoError(t, err)
+		assert.Equal(t, text, builtin)
+	})
+	t.Run("Should prefer the override directory", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		overridePath := path.Join(TemplateOverrideDir, TemplateReleasedComment)
+		require.NoError(t, afero.WriteFile(fsRepo, overridePath, []byte("Shipped in {{.Tag}}"), 0644))
+		text, err := RenderTemplate(fsRepo, TemplateReleasedComment, map[string]string{"Tag": "v1.2.0"})
+		require.NoError(t, err)
+		assert.Equal(t, "Shipped in v1.2.0", text)
+	})
+	t.Run("Should reject unknown templates", func(t *testing.T) {
+		_, err := LoadTemplate(nil, "nope.md.tmpl")
+		require.ErrorContains(t, err, "unknown template nope.md.tmpl")
+	})
+	t.Run("Should reject overrides referencing an unknown variable", func(t *testing.T) {
+		fsRepo := afero.NewMemMapFs()
+		overridePath := path.Join(TemplateOverrideDir, TemplateAbortComment)
+		require.NoError(t, afero.WriteFile(fsRepo, overridePath, []byte("{{.Nope}}"), 0644))
+		_, err := RenderTemplate(fsRepo, TemplateAbortComment, map[string]string{})
+		require.ErrorContains(t, err, "failed to execute template abort_comment.md.tmpl")
+	})
+}
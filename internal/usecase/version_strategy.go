@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/compozy/releasepr/internal/service"
+)
+
+// VersionStrategy computes the version that follows latestTag. Returning latestTag's own version
+// means there is nothing to release.
+type VersionStrategy interface {
+	NextVersion(ctx context.Context, latestTag string) (*domain.Version, error)
+}
+
+// SemverStrategy infers the semver bump from conventional commits with git-cliff, or applies a
+// fixed bump level when one is set.
+type SemverStrategy struct {
+	CliffSvc service.CliffService
+	// Bump, when set, increments the latest tag by this level instead of inferring it from commits.
+	Bump domain.BumpLevel
+}
+
+// NextVersion implements VersionStrategy.
+func (s *SemverStrategy) NextVersion(ctx context.Context, latestTag string) (*domain.Version, error) {
+	if s.Bump == "" {
+		return s.CliffSvc.CalculateNextVersion(ctx, latestTag)
+	}
+	current, err := domain.NewVersion(latestTag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse latest tag %s: %w", latestTag, err)
+	}
+	return current.Bump(s.Bump), nil
+}
+
+// CalVerStrategy derives versions from the release date in one of the domain CalVer formats.
+type CalVerStrategy struct {
+	Format string
+	// Now returns the release time in the release timezone.
+	Now func() time.Time
+}
+
+// NextVersion implements VersionStrategy. Tags that are not versions, e.g. before the first
+// release, count as no release at all.
+func (s *CalVerStrategy) NextVersion(_ context.Context, latestTag string) (*domain.Version, error) {
+	latest, err := domain.NewVersion(latestTag)
+	if err != nil {
+		latest = nil
+	}
+	return domain.NextCalVer(s.Format, s.now(), latest)
+}
+
+func (s *CalVerStrategy) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// versionStrategy returns strategy, defaulting to the commit-driven semver strategy.
+func versionStrategy(strategy VersionStrategy, cliffSvc service.CliffService) VersionStrategy {
+	if strategy != nil {
+		return strategy
+	}
+	return &SemverStrategy{CliffSvc: cliffSvc}
+}
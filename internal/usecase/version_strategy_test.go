@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/compozy/releasepr/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemverStrategy_NextVersion(t *testing.T) {
+	t.Run("Should infer the bump from commits without a bump level", func(t *testing.T) {
+		cliffSvc := new(mockCliffService)
+		ctx := context.Background()
+		expectedVer, _ := domain.NewVersion("v1.1.0")
+		cliffSvc.On("CalculateNextVersion", ctx, "v1.0.0").Return(expectedVer, nil)
+		version, err := (&SemverStrategy{CliffSvc: cliffSvc}).NextVersion(ctx, "v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, expectedVer, version)
+		cliffSvc.AssertExpectations(t)
+	})
+	t.Run("Should apply a fixed bump level", func(t *testing.T) {
+		cliffSvc := new(mockCliffService)
+		strategy := &SemverStrategy{CliffSvc: cliffSvc, Bump: domain.BumpLevelMajor}
+		version, err := strategy.NextVersion(context.Background(), "v1.2.3")
+		require.NoError(t, err)
+		assert.Equal(t, "v2.0.0", version.String())
+		cliffSvc.AssertNotCalled(t, "CalculateNextVersion")
+	})
+}
+
+func TestCalVerStrategy_NextVersion(t *testing.T) {
+	now := func() time.Time { return time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC) }
+	t.Run("Should number releases within the month", func(t *testing.T) {
+		strategy := &CalVerStrategy{Format: domain.CalVerYearMonthMicro, Now: now}
+		version, err := strategy.NextVersion(context.Background(), "v2026.10.1")
+		require.NoError(t, err)
+		assert.Equal(t, "v2026.10.2", version.String())
+	})
+	t.Run("Should start from the date when no release exists", func(t *testing.T) {
+		strategy := &CalVerStrategy{Format: domain.CalVerShortYearMonthDay, Now: now}
+		version, err := strategy.NextVersion(context.Background(), "")
+		require.NoError(t, err)
+		assert.Equal(t, "v26.10.16", version.String())
+	})
+	t.Run("Should be used by the calculate version use case", func(t *testing.T) {
+		gitRepo := new(mockGitRepository)
+		ctx := context.Background()
+		gitRepo.On("LatestTag", ctx).Return("v2026.9.3", nil)
+		uc := &CalculateVersionUseCase{
+			GitRepo:  gitRepo,
+			CliffSvc: new(mockCliffService),
+			Strategy: &CalVerStrategy{Format: domain.CalVerYearMonthMicro, Now: now},
+		}
+		version, err := uc.Execute(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "v2026.10.0", version.String())
+	})
+}